@@ -5,16 +5,22 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
+	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
+	"syscall"
+	"text/tabwriter"
 	"time"
 
 	"golang.org/x/term"
@@ -51,14 +57,29 @@ func (s *stringSliceFlag) Set(value string) error {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "init" {
+		os.Exit(runInit(os.Args[2:], os.Stderr))
+	}
+
 	help := flag.Bool("help", false, "Show help")
 	versionFlag := flag.Bool("version", false, "Show version")
+	verbose := flag.Bool("verbose", false, "Log how issues were loaded to stderr: each project's path, prefix, data file and format, issue counts, dependency rewrites and skipped records")
+	veryVerbose := flag.Bool("vv", false, "Like --verbose, plus per-record detail: each namespaced ID and rewritten dependency")
 	// Update flags (bv-182)
 	updateFlag := flag.Bool("update", false, "Update bv to the latest version")
 	checkUpdateFlag := flag.Bool("check-update", false, "Check if a new version is available")
 	rollbackFlag := flag.Bool("rollback", false, "Rollback to the previous version (from backup)")
-	yesFlag := flag.Bool("yes", false, "Skip confirmation prompts (use with --update)")
+	yesFlag := flag.Bool("yes", false, "Skip confirmation prompts (use with --update or --clear-projects)")
 	exportFile := flag.String("export-md", "", "Export issues to a Markdown file (e.g., report.md)")
+	exportGitHub := flag.Bool("export-github", false, "Write issues as a GitHub issue import JSON array to stdout")
+	showIgnored := flag.Bool("show-ignored", false, "Include issues excluded by ignore_statuses/ignore_labels in display.yaml")
+	validate := flag.Bool("validate", false, "Check the loaded issues for problems (invalid fields, dangling dependencies), print a report, and exit (1 if problems found)")
+	compareProjects := flag.Bool("compare-projects", false, "Print a side-by-side table comparing the loaded projects and exit")
+	explainID := flag.String("explain", "", "Print a dossier for one issue (fields, readiness, blockers, dependents, label health, age) and exit")
+	dumpIssues := flag.Bool("dump-issues", false, "Write the loaded (and filtered) issues to stdout as JSONL, one line per issue")
+	allowWrite := flag.Bool("allow-write", false, "Let the TUI edit issues in beads.jsonl (> / < change priority, D edits dependencies); required for --normalize to write")
+	normalizePath := flag.String("normalize", "", "Rewrite a beads JSONL file (or a project's, given its directory) sorted by ID with canonical field order, merged duplicate and no self dependencies; previews without --allow-write")
+	snapshotDir := flag.String("snapshot", "", "Write issues JSONL, DOT/Mermaid graphs, and triage/plan/health JSON into a directory")
 	robotHelp := flag.Bool("robot-help", false, "Show AI agent help")
 	robotInsights := flag.Bool("robot-insights", false, "Output graph analysis and insights as JSON for AI agents")
 	robotPlan := flag.Bool("robot-plan", false, "Output dependency-respecting execution plan as JSON for AI agents")
@@ -66,14 +87,38 @@ func main() {
 	robotTriage := flag.Bool("robot-triage", false, "Output unified triage as JSON (the mega-command for AI agents)")
 	robotTriageByTrack := flag.Bool("robot-triage-by-track", false, "Group triage recommendations by execution track (bv-87)")
 	robotTriageByLabel := flag.Bool("robot-triage-by-label", false, "Group triage recommendations by label (bv-87)")
+	triageTop := flag.Int("top", 10, "Number of --robot-triage recommendations")
+	perProjectTop := flag.Int("per-project-top", 0, "With --robot-triage: at most N recommendations per project, taken before --top (0 = no cap)")
+	triageHistory := flag.Bool("triage-history", false, "With --robot-triage: read the beads file's git history to add stalled_days and first_response_days to recommendations")
+	triageBaseline := flag.String("baseline", "", "Earlier --robot-triage JSON to compare against: adds a delta of new/resolved recommendations and quick_ref count changes")
 	robotNext := flag.Bool("robot-next", false, "Output only the top pick recommendation as JSON (minimal triage)")
-	robotDiff := flag.Bool("robot-diff", false, "Output diff as JSON (use with --diff-since)")
+	robotDiff := flag.Bool("robot-diff", false, "Output diff as JSON (use with --diff-since or --diff-config)")
 	robotRecipes := flag.Bool("robot-recipes", false, "Output available recipes as JSON for AI agents")
 	robotLabelHealth := flag.Bool("robot-label-health", false, "Output label health metrics as JSON for AI agents")
 	robotLabelFlow := flag.Bool("robot-label-flow", false, "Output cross-label dependency flow as JSON for AI agents")
 	robotLabelAttention := flag.Bool("robot-label-attention", false, "Output attention-ranked labels as JSON for AI agents")
 	attentionLimit := flag.Int("attention-limit", 5, "Limit number of labels in --robot-label-attention output")
 	robotAlerts := flag.Bool("robot-alerts", false, "Output alerts (drift + proactive) as JSON for AI agents")
+	robotInversions := flag.Bool("robot-inversions", false, "Output priority inversions (P0/P1 issues blocked by P2+ issues) as JSON")
+	robotDeadends := flag.Bool("robot-deadends", false, "Output open issues whose blockers were closed unsuccessfully (wontfix, cancelled, ...) as JSON")
+	robotZombies := flag.Bool("robot-zombies", false, "Output dependency targets that no loaded project contains (deleted or never created), with the issues referencing them, as JSON")
+	robotReadyWait := flag.Bool("robot-ready-wait", false, "Output open, unblocked issues nobody has started, ranked by how long they have been ready, as JSON")
+	robotDuplicates := flag.Bool("robot-duplicates", false, "Output issues explicitly linked as duplicates (dependency type \"duplicate\") as JSON")
+	robotSimilar := flag.Bool("robot-similar", false, "Output pairs of open issues with similar titles (possible duplicates, across projects) as JSON")
+	similarThreshold := flag.Float64("similar-threshold", analysis.DefaultSimilarThreshold, "With --robot-similar: minimum title similarity, 0-1")
+	similarCrossProject := flag.Bool("similar-cross-project", false, "With --robot-similar: only report pairs from different projects")
+	robotBottlenecks := flag.Bool("robot-bottlenecks", false, "Output open issues ranked by how many open issues transitively depend on them as JSON")
+	robotCompareProjects := flag.Bool("robot-compare-projects", false, "Output a per-project comparison (counts, avg age, label health, dependency edges) as JSON")
+	robotStats := flag.Bool("robot-stats", false, "Output one flat JSON object of numeric metrics (counts, ages, cycles, bottlenecks, label health) for time-series tracking")
+	robotExplain := flag.String("robot-explain", "", "Output the --explain dossier for issue ID as JSON")
+	maxParallel := flag.Int("max-parallel", 0, "With --robot-plan: also schedule open issues into at most N parallel lanes using estimates (0 = off)")
+	planOpenOnly := flag.Bool("plan-open-only", false, "With --robot-plan: prune closed issues from the graph, treating their edges as satisfied")
+	robotPretty := flag.Bool("pretty", false, "Indent robot JSON output for reading (default: compact, one line)")
+	robotStream := flag.Bool("stream", false, "With --robot-triage, --robot-stale or --robot-plan: emit NDJSON (a header line with totals, then one object per record)")
+	robotStale := flag.Bool("robot-stale", false, "Output stale issues and suggest_close cleanup candidates as JSON (never closes anything)")
+	staleDays := flag.Int("stale-days", analysis.DefaultStaleThresholdDays, "Days without update before an issue counts as stale (--robot-stale)")
+	closeAfterDays := flag.Int("close-after-days", analysis.DefaultCloseAfterDays, "Days without update before an open issue is suggested for closing (--robot-stale)")
+	closeMaxDependents := flag.Int("close-max-dependents", 0, "Most open dependents an issue may have and still be suggested for closing (--robot-stale)")
 	// Smart suggestions (bv-180)
 	robotSuggest := flag.Bool("robot-suggest", false, "Output smart suggestions (duplicates, dependencies, labels, cycles) as JSON")
 	suggestType := flag.String("suggest-type", "", "Filter suggestions by type: duplicate, dependency, label, cycle")
@@ -92,8 +137,23 @@ func main() {
 	robotMinConf := flag.Float64("robot-min-confidence", 0.0, "Filter robot outputs by minimum confidence (0.0-1.0)")
 	robotMaxResults := flag.Int("robot-max-results", 0, "Limit robot output count (0 = use defaults)")
 	robotByLabel := flag.String("robot-by-label", "", "Filter robot outputs by label (exact match)")
-	robotByAssignee := flag.String("robot-by-assignee", "", "Filter robot outputs by assignee (exact match)")
+	robotByAssignee := flag.String("robot-by-assignee", "", "Filter robot outputs by assignee (exact match, or a glob like 'team-backend/*')")
 	// Label subgraph scoping (bv-122)
+	sortFlag := flag.String("sort", "", "Initial list sort: default, created-asc, created-desc, priority, updated (overrides display.yaml)")
+	noColor := flag.Bool("no-color", false, "Disable all colors and text styling (also set by the NO_COLOR environment variable)")
+	themeFile := flag.String("theme-file", "", "Load TUI colors from a theme YAML file mapping roles (Primary, Blocked, Healthy, ...) to hex colors (default ~/.config/bv/theme.yaml if present)")
+	viewName := flag.String("view", "", "Open the TUI with a saved view from display.yaml (flags below override its fields)")
+	saveViewName := flag.String("save-view", "", "Save --repo/--status/--priority/--type/--resolution/--assignee/--watching/--min-deps/--min-dependents/--sort/--start-view as a named view and exit")
+	statusFilter := flag.String("status", "", "TUI filter: comma-separated statuses (e.g., open,in_progress)")
+	priorityFilter := flag.String("priority", "", "TUI filter: comma-separated priorities (e.g., 0,1)")
+	typeFilter := flag.String("type", "", "TUI filter: comma-separated issue types (e.g., bug,feature)")
+	resolutionFilter := flag.String("resolution", "", "TUI filter: closed issues with any of these comma-separated resolutions (e.g., wontfix,duplicate; none = closed without one)")
+	assigneeFilter := flag.String("assignee", "", "TUI filter: assignee (case-insensitive; globs like '*@example.com' allowed)")
+	watchingFilter := flag.String("watching", "", "TUI filter: issues this user watches (with --assignee: assigned to or watched)")
+	minDepsFilter := flag.Int("min-deps", 0, "TUI filter: keep issues with at least N blocking dependencies")
+	minDependentsFilter := flag.Int("min-dependents", 0, "TUI filter: keep issues that at least N issues depend on (e.g., 3 = blocking 3+ issues)")
+	startView := flag.String("start-view", "", "Initial TUI view: list, board, graph, insights")
+	labelGroupByPrefix := flag.Bool("label-group-by-prefix", false, "Group the label dashboard by label prefix (text before ':', e.g. area:backend)")
 	labelScope := flag.String("label", "", "Scope analysis to label's subgraph (affects --robot-insights, --robot-plan, --robot-priority)")
 	alertSeverity := flag.String("severity", "", "Filter robot alerts by severity (info|warning|critical)")
 	alertType := flag.String("alert-type", "", "Filter robot alerts by alert type (e.g., stale_issue)")
@@ -104,18 +164,32 @@ func main() {
 	robotSearch := flag.Bool("robot-search", false, "Output semantic search results as JSON for AI agents (use with --search)")
 	searchLimit := flag.Int("search-limit", 10, "Max results for --search/--robot-search")
 	diffSince := flag.String("diff-since", "", "Show changes since historical point (commit SHA, branch, tag, or date)")
+	diffConfig := flag.String("diff-config", "", "Compare two saved project configs and their current issues: --diff-config OLD.yaml --diff-config-to NEW.yaml")
+	diffConfigTo := flag.String("diff-config-to", "", "The newer project config for --diff-config")
+	sinceCommit := flag.String("since-commit", "", "Show only issues whose beads file lines changed since a git ref (e.g. main), uncommitted edits included; the graph still uses every issue")
 	asOf := flag.String("as-of", "", "View state at point in time (commit SHA, branch, tag, or date)")
 	forceFullAnalysis := flag.Bool("force-full-analysis", false, "Compute all metrics regardless of graph size (may be slow for large graphs)")
 	profileStartup := flag.Bool("profile-startup", false, "Output detailed startup timing profile for diagnostics")
 	profileJSON := flag.Bool("profile-json", false, "Output profile in JSON format (use with --profile-startup)")
 	noHooks := flag.Bool("no-hooks", false, "Skip running hooks during export")
 	workspaceConfig := flag.String("workspace", "", "Load issues from workspace config file (.bv/workspace.yaml)")
-	repoFilter := flag.String("repo", "", "Filter issues by repository prefix (e.g., 'api-' or 'api')")
+	serveAddr := flag.String("serve", "", "Serve read-only JSON endpoints on addr (e.g. :8080; binds localhost unless a host is given)")
+	refresh := flag.Duration("refresh", 0, "Reload all issues on an interval in the TUI, e.g. 30s (0 = disabled)")
+	notify := flag.Bool("notify", false, "TUI: ring the terminal bell and show a banner when a reload blocks a high-priority issue")
+	notifyPriority := flag.Int("notify-priority", 1, "Lowest priority that --notify alerts on (0-4; default 1 alerts on P0 and P1)")
+	repoFilter := flag.String("repo", "", "Filter issues by repository prefix (e.g., 'api-', 'api', or an unambiguous partial like 'ap')")
+	isolateProject := flag.String("isolate", "", "With --robot-plan: report issues that would become permanently blocked if this project were removed (e.g., 'api')")
 	// Multi-project flags
 	var projectPaths stringSliceFlag
 	flag.Var(&projectPaths, "project", "Path to project directory (can be repeated, e.g., --project ~/code/api --project ~/code/web)")
+	projectRoot := flag.String("project-root", "", "Load a monorepo: the root's .beads as the main project plus every nested .beads as a sub-project named under it")
+	allowEmpty := flag.Bool("allow-empty", true, "Load a project whose .beads directory has no issues file yet as empty instead of failing (see --strict)")
+	strict := flag.Bool("strict", false, "Fail to load projects whose .beads directory has no issues file (overrides --allow-empty)")
+	projectsFile := flag.String("projects-file", "", "Load projects listed in a plain text file, one path per line ('#' comments; '-' reads stdin); combines with --project")
 	saveProjects := flag.Bool("save-projects", false, "Save current project list to ~/.config/bv/projects.yaml")
+	projectPathMode := flag.String("project-path-mode", string(config.PathModeAbsolute), "How --save-projects stores paths: absolute, config (relative to projects.yaml), or home (relative to $HOME)")
 	clearProjects := flag.Bool("clear-projects", false, "Clear saved project list")
+	profile := flag.String("profile", "", "Use the named project profile (~/.config/bv/profiles/NAME.yaml) instead of projects.yaml for saved projects, --save-projects and --clear-projects")
 	saveBaseline := flag.String("save-baseline", "", "Save current metrics as baseline with optional description")
 	baselineInfo := flag.Bool("baseline-info", false, "Show information about the current baseline")
 	checkDrift := flag.Bool("check-drift", false, "Check for drift from baseline (exit codes: 0=OK, 1=critical, 2=warning)")
@@ -178,6 +252,25 @@ func main() {
 	_ = labelScope
 	_ = agentBrief
 
+	// --no-color and NO_COLOR (https://no-color.org) turn off all styling
+	if *noColor || os.Getenv("NO_COLOR") != "" {
+		ui.DisableColor()
+	}
+
+	// --verbose and -vv log to stderr in every mode, so robot stdout stays clean
+	verbosity := 0
+	if *verbose {
+		verbosity = 1
+	}
+	if *veryVerbose {
+		verbosity = 2
+	}
+	verboseLog := log.New(os.Stderr, "", 0)
+
+	// Projects with a .beads directory but no issues file yet load as empty
+	// unless --strict (or --allow-empty=false) is given
+	allowEmptyProjects := *allowEmpty && !*strict
+
 	envRobot := os.Getenv("BV_ROBOT") == "1"
 	stdoutIsTTY := term.IsTerminal(int(os.Stdout.Fd()))
 
@@ -196,6 +289,17 @@ func main() {
 		*robotLabelFlow ||
 		*robotLabelAttention ||
 		*robotAlerts ||
+		*robotInversions ||
+		*robotDeadends ||
+		*robotZombies ||
+		*robotReadyWait ||
+		*robotDuplicates ||
+		*robotSimilar ||
+		*robotBottlenecks ||
+		*robotStale ||
+		*robotCompareProjects ||
+		*robotStats ||
+		*robotExplain != "" ||
 		*robotSuggest ||
 		*robotGraph ||
 		*robotSearch ||
@@ -208,9 +312,12 @@ func main() {
 		*robotByLabel != "" ||
 		*robotByAssignee != "" ||
 		*robotCapacity ||
-		// When stdout is non-TTY, --diff-since auto-enables JSON output. Mark this
-		// as robot mode early so parsers keep stdout JSON clean.
-		(*diffSince != "" && !stdoutIsTTY)
+		// When stdout is non-TTY, --diff-since and --diff-config auto-enable
+		// JSON output. Mark this as robot mode early so parsers keep stdout
+		// JSON clean.
+		((*diffSince != "" || *diffConfig != "") && !stdoutIsTTY)
+
+	prettyRobotJSON = *robotPretty
 
 	// Mark robot mode for downstream packages (e.g., parsers) to keep stdout JSON clean.
 	if robotMode && !envRobot {
@@ -218,6 +325,13 @@ func main() {
 		envRobot = true
 	}
 
+	// Robot contract: stdout carries only JSON. Text from commands that can
+	// run ahead of a robot flag (baseline info/save) goes to stderr instead.
+	var humanOut io.Writer = os.Stdout
+	if robotMode {
+		humanOut = os.Stderr
+	}
+
 	// Handle -r shorthand
 	if *recipeShort != "" && *recipeName == "" {
 		*recipeName = *recipeShort
@@ -225,6 +339,7 @@ func main() {
 
 	if *help {
 		fmt.Println("Usage: bv [options]")
+		fmt.Println("       bv init [--force] [--sample] [--config] [DIR]")
 		fmt.Println("\nA TUI viewer for beads issue tracker.")
 		flag.PrintDefaults()
 		os.Exit(0)
@@ -235,6 +350,10 @@ func main() {
 		fmt.Println("====================================")
 		fmt.Println("This tool provides structural analysis of the issue tracker graph (DAG).")
 		fmt.Println("Use these commands to understand project state without parsing raw JSONL.")
+		fmt.Println("Every JSON output carries a top-level schema_version; it is bumped when the structure changes.")
+		fmt.Println("When dependencies point at issues that aren't loaded, outputs also carry dangling_deps[]:")
+		fmt.Println("issue_id, depends_on_id, type, target_project, reason (project_not_loaded | not_found).")
+		fmt.Println("Robot modes write only JSON to stdout; errors go to stderr with a non-zero exit code.")
 		fmt.Println("")
 		fmt.Println("Commands:")
 		fmt.Println("  --robot-plan")
@@ -245,6 +364,10 @@ func main() {
 		fmt.Println("      - items: Actionable issues sorted by priority within each track")
 		fmt.Println("      - unblocks: Issues that become actionable when this item is done")
 		fmt.Println("      - summary: Highlights highest-impact item to work on first")
+		fmt.Println("      Add --max-parallel N to also pack open issues into at most N lanes")
+		fmt.Println("      (parallel_schedule: per-lane order, start/end minutes, total_minutes).")
+		fmt.Println("      Add --plan-open-only to drop closed issues from the graph entirely, so")
+		fmt.Println("      closed work no longer joins tracks; pruned_closed counts what was dropped.")
 		fmt.Println("")
 		fmt.Println("  --robot-insights")
 		fmt.Println("      Outputs a JSON object containing deep graph analysis.")
@@ -270,11 +393,32 @@ func main() {
 		fmt.Println("      Key sections:")
 		fmt.Println("      - meta: Generation timestamp, data stats")
 		fmt.Println("      - quick_ref: At-a-glance summary (open/actionable/blocked counts, top 3 picks)")
+		fmt.Println("        Counts are chosen by triage.quick_ref_fields in display.yaml: open, closed, blocked,")
+		fmt.Println("        ready (actionable_count), in_progress, stale, overdue, unassigned.")
 		fmt.Println("      - recommendations: Ranked actionable items with scores and reasoning")
 		fmt.Println("      - quick_wins: Low-complexity, high-impact items")
 		fmt.Println("      - blockers_to_clear: Items that unblock the most downstream work")
 		fmt.Println("      - project_health: Counts, graph metrics, overall status")
+		fmt.Println("      - data_quality: Share of issues with timestamps, estimates, assignees and valid")
+		fmt.Println("        dependencies, a 0-100 score, warnings, and the same per project (by_project)")
 		fmt.Println("      - commands: Copy-paste commands for common next steps")
+		fmt.Println("      --top N sets the number of recommendations (default 10). In multi-project mode,")
+		fmt.Println("      --per-project-top N takes at most N per project first, so one busy project cannot")
+		fmt.Println("      fill the list; meta.per_project_capped_count says how many it held back.")
+		fmt.Println("      Recommendations carry age_days. --triage-history also reads the beads file's git history")
+		fmt.Println("      for stalled_days (since the last status change) and first_response_days (single repo only).")
+		fmt.Println("      Add --baseline FILE (an earlier --robot-triage output) to include delta:")
+		fmt.Println("      new_recommendations, resolved_recommendations, and quick_ref count changes.")
+		fmt.Println("      Example: bv --robot-triage > yesterday.json; bv --robot-triage --baseline yesterday.json")
+		fmt.Println("")
+		fmt.Println("  --pretty (with any --robot-* flag)")
+		fmt.Println("      Indent the JSON for reading. By default robot output is compact: one line per document.")
+		fmt.Println("      The data is the same either way; --stream output stays one object per line.")
+		fmt.Println("")
+		fmt.Println("  --stream (with --robot-triage, --robot-stale, --robot-plan)")
+		fmt.Println("      NDJSON instead of one document: a header line (record: \"header\", metadata, quick_ref,")
+		fmt.Println("      totals per record type), then one line per record (recommendation, quick_win, blocker;")
+		fmt.Println("      stale, suggest_close; item, start_step, lane_item), each with a \"record\" field naming its type.")
 		fmt.Println("")
 		fmt.Println("  --robot-next")
 		fmt.Println("      Minimal triage: returns only the single top recommendation.")
@@ -381,6 +525,12 @@ func main() {
 		fmt.Println("      Generates a readable status report with Mermaid.js visualizations.")
 		fmt.Println("      Runs pre-export and post-export hooks if configured in .bv/hooks.yaml")
 		fmt.Println("")
+		fmt.Println("  --export-github")
+		fmt.Println("      Writes a JSON array for migrating into GitHub Issues: title, body,")
+		fmt.Println("      labels, state, and a comment recording the beads ID and project.")
+		fmt.Println("      Dependencies are appended to the body as a checklist.")
+		fmt.Println("      Example: bv --export-github > github-issues.json")
+		fmt.Println("")
 		fmt.Println("  --no-hooks")
 		fmt.Println("      Skip running hooks during export. Useful for CI or quick exports.")
 		fmt.Println("")
@@ -391,6 +541,10 @@ func main() {
 		fmt.Println("      Environment variables: BV_EXPORT_PATH, BV_EXPORT_FORMAT,")
 		fmt.Println("        BV_ISSUE_COUNT, BV_TIMESTAMP")
 		fmt.Println("")
+		fmt.Println("  --since-commit <ref>")
+		fmt.Println("      Limits the TUI and robot output to issues whose lines in .beads/*.jsonl changed")
+		fmt.Println("      between ref and the working tree (git diff). Without git, all issues are shown.")
+		fmt.Println("")
 		fmt.Println("  --diff-since <commit|date>")
 		fmt.Println("      Shows changes since a historical point.")
 		fmt.Println("      Accepts: SHA, branch name, tag, HEAD~N, or date (YYYY-MM-DD)")
@@ -403,6 +557,16 @@ func main() {
 		fmt.Println("      - resolved_cycles: Circular dependencies fixed")
 		fmt.Println("      - summary.health_trend: 'improving', 'degrading', or 'stable'")
 		fmt.Println("")
+		fmt.Println("  --diff-config <old.yaml> --diff-config-to <new.yaml>")
+		fmt.Println("      Compares two saved project configs (projects.yaml format) by loading the")
+		fmt.Println("      current issues of every project each one lists.")
+		fmt.Println("      Key output:")
+		fmt.Println("      - added_projects / removed_projects: Projects in only one config")
+		fmt.Println("      - changed_projects: Projects in both (matched by path, then name) whose issues")
+		fmt.Println("        differ, with a diff{...} each and from_name if renamed")
+		fmt.Println("      - added_issues / removed_issues: Issue IDs in only one load")
+		fmt.Println("      - summary: Project and issue counts")
+		fmt.Println("")
 		fmt.Println("  --as-of <commit|date>")
 		fmt.Println("      View issue state at a point in time (works with all robot commands).")
 		fmt.Println("      Useful for historical analysis without modifying the working tree.")
@@ -410,7 +574,7 @@ func main() {
 		fmt.Println("      Examples: --as-of HEAD~30, --as-of v1.0.0, --as-of '2024-01-01'")
 		fmt.Println("")
 		fmt.Println("  --robot-diff")
-		fmt.Println("      Output diff as JSON (use with --diff-since).")
+		fmt.Println("      Output diff as JSON (use with --diff-since or --diff-config).")
 		fmt.Println("      Fields: generated_at, resolved_revision, from_data_hash, to_data_hash, diff{...}")
 		fmt.Println("      Diff payload includes metric deltas, cycles introduced/resolved, and modified issues.")
 		fmt.Println("")
@@ -502,6 +666,63 @@ func main() {
 		fmt.Println("  --robot-plan")
 		fmt.Println("      Execution tracks grouped for parallel work. Includes data_hash, analysis_config, status.")
 		fmt.Println("      plan.tracks[].items[].unblocks shows what completes next; summary.highest_impact surfaces best unblocker.")
+		fmt.Println("      --isolate PROJECT adds isolation.would_block: issues permanently blocked if PROJECT were removed.")
+		fmt.Println("      --plan-open-only prunes closed issues (their edges count as satisfied) before planning.")
+		fmt.Println("")
+		fmt.Println("  --robot-inversions")
+		fmt.Println("      Priority inversions: open P0/P1 issues blocked by P2-or-lower issues (across projects).")
+		fmt.Println("      inversions[]: blocked_id, blocked_priority, blocker_id, blocker_priority, suggested_priority.")
+		fmt.Println("")
+		fmt.Println("  --robot-deadends")
+		fmt.Println("      Open issues blocked by issues closed without being done: status or close_reason matching")
+		fmt.Println("      unsuccessful_statuses in display.yaml (default: wontfix, cancelled, canceled, out of scope).")
+		fmt.Println("      dead_ends[]: id, title, status, priority, blockers[] (id, title, status, close_reason, closed_at).")
+		fmt.Println("")
+		fmt.Println("  --robot-zombies")
+		fmt.Println("      Dependency targets missing from a project that is loaded: deleted or never created.")
+		fmt.Println("      Targets in projects that aren't loaded stay in dangling_deps only (they may exist).")
+		fmt.Println("      zombies[]: id, project, referenced_by[] (issue IDs), reference_count; most referenced first.")
+		fmt.Println("")
+		fmt.Println("  --robot-ready-wait")
+		fmt.Println("      Open issues with no open blockers that nobody has started, waiting longest first.")
+		fmt.Println("      ready_waits[]: id, title, priority, assignee, ready_since, source (blocker_closed: when the")
+		fmt.Println("      last blocker closed, last_blocker; created: never blocked), wait_days. Limit with --robot-max-results.")
+		fmt.Println("")
+		fmt.Println("  --robot-duplicates")
+		fmt.Println("      Issues linked with a \"duplicate\" dependency. Unlike --robot-suggest, only recorded links.")
+		fmt.Println("      duplicates[]: issue_id, duplicate_of_id, titles, statuses, both_open (neither side closed yet).")
+		fmt.Println("")
+		fmt.Println("  --robot-similar [--similar-threshold 0.6] [--similar-cross-project]")
+		fmt.Println("      Open issues with similar titles that are not linked yet: possible duplicates to consolidate.")
+		fmt.Println("      pairs[]: issue_a, issue_b, titles, projects, same_project, score (title word-set Jaccard), common_tokens.")
+		fmt.Println("")
+		fmt.Println("  --robot-bottlenecks")
+		fmt.Println("      Open issues ranked by open transitive dependents (highest-leverage work, across projects).")
+		fmt.Println("      bottlenecks[]: id, title, priority, unblocks_count, direct_count. Limit with --robot-max-results.")
+		fmt.Println("")
+		fmt.Println("  --robot-stale [--stale-days N] [--close-after-days N] [--close-max-dependents N]")
+		fmt.Println("      Issues not updated for --stale-days (default 14), most stale first: stale[].")
+		fmt.Println("      suggest_close[]: open issues idle for --close-after-days (default 90) with at most")
+		fmt.Println("      --close-max-dependents (default 0) open dependents. Suggestions only; nothing is closed.")
+		fmt.Println("      stale.count_comments: true in display.yaml counts the last comment as an update.")
+		fmt.Println("")
+		fmt.Println("  --robot-compare-projects")
+		fmt.Println("      One row per loaded project: total/open/closed/blocked/actionable, avg_age_days (open issues),")
+		fmt.Println("      labels (healthy/warning/critical, avg_health), dependency_edges, cross_project_edges.")
+		fmt.Println("      --compare-projects prints the same as a table.")
+		fmt.Println("")
+		fmt.Println("  --robot-stats")
+		fmt.Println("      One compact line of numbers for metrics stores: timestamp (unix seconds), total, open,")
+		fmt.Println("      closed, blocked, ready, avg_age_days, median_age_days, cycle_count, bottleneck_count,")
+		fmt.Println("      label_health_avg, and closed split by resolution: closed_fixed, closed_wontfix,")
+		fmt.Println("      closed_duplicate, closed_invalid, closed_other, closed_unresolved. Besides schema_version,")
+		fmt.Println("      no envelope or nested fields, so keys stay stable.")
+		fmt.Println("")
+		fmt.Println("  --robot-explain <id>")
+		fmt.Println("      Everything about one issue: issue (all fields), ready + ready_reason, blockers[]")
+		fmt.Println("      (direct and transitive: id, status, depth, via, missing), dependents[] (id, status, type),")
+		fmt.Println("      labels[] (health, health_level), age_days, days_since_update, stale.")
+		fmt.Println("      Unknown IDs exit 1. --explain <id> prints the same for humans.")
 		fmt.Println("")
 		fmt.Println("  --robot-priority")
 		fmt.Println("      Priority recommendations with explanations. Includes data_hash, analysis_config, status.")
@@ -542,6 +763,19 @@ func main() {
 		fmt.Println("      Aggregates issues from multiple repositories with namespaced IDs.")
 		fmt.Println("      Example: bv --workspace .bv/workspace.yaml")
 		fmt.Println("")
+		fmt.Println("  --project-root DIR")
+		fmt.Println("      Load a monorepo: DIR's .beads is the main project and every nested .beads")
+		fmt.Println("      becomes a sub-project named under the closest project above it")
+		fmt.Println("      (mono/services/api). Prefixes are each directory name, e.g. mono-, api-.")
+		fmt.Println("      Example: bv --project-root .")
+		fmt.Println("")
+		fmt.Println("  --normalize PATH [--allow-write]")
+		fmt.Println("      Rewrite a beads JSONL file for clean diffs: records sorted by ID, canonical field")
+		fmt.Println("      order, duplicate dependencies merged, self-dependencies dropped. PATH may be the")
+		fmt.Println("      file or a project directory. Without --allow-write it only reports what would change;")
+		fmt.Println("      an already normalized file is left untouched.")
+		fmt.Println("      Example: bv --normalize . --allow-write")
+		fmt.Println("")
 		fmt.Println("  --repo PREFIX")
 		fmt.Println("      Filter issues by repository prefix.")
 		fmt.Println("      Use with --workspace to focus on one repo in a multi-repo view.")
@@ -570,6 +804,13 @@ func main() {
 		fmt.Println("      Output drift check as JSON (use with --check-drift).")
 		fmt.Println("      Output: {has_drift, exit_code, summary, alerts, baseline}")
 		fmt.Println("")
+		fmt.Println("  HTTP Server:")
+		fmt.Println("      --serve <addr>")
+		fmt.Println("          Serve read-only JSON for dashboards: /health, /issues, /issues/{id},")
+		fmt.Println("          /triage, /plan. Issues are re-read on every request.")
+		fmt.Println("          Binds 127.0.0.1 unless a host is given (e.g. 0.0.0.0:8080).")
+		fmt.Println("          Example: bv --serve :8080")
+		fmt.Println("")
 		fmt.Println("  Static Site Export & GitHub Pages (bv-7pu):")
 		fmt.Println("      --pages")
 		fmt.Println("          Launch interactive Pages deployment wizard.")
@@ -791,9 +1032,7 @@ func main() {
 			Recipes: summaries,
 		}
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding recipes: %v\n", err)
 			os.Exit(1)
 		}
@@ -807,8 +1046,8 @@ func main() {
 	// Handle --baseline-info
 	if *baselineInfo {
 		if !baseline.Exists(baselinePath) {
-			fmt.Println("No baseline found.")
-			fmt.Println("Create one with: bv --save-baseline \"description\"")
+			fmt.Fprintln(humanOut, "No baseline found.")
+			fmt.Fprintln(humanOut, "Create one with: bv --save-baseline \"description\"")
 			os.Exit(0)
 		}
 		bl, err := baseline.Load(baselinePath)
@@ -816,7 +1055,7 @@ func main() {
 			fmt.Fprintf(os.Stderr, "Error loading baseline: %v\n", err)
 			os.Exit(1)
 		}
-		fmt.Print(bl.Summary())
+		fmt.Fprint(humanOut, bl.Summary())
 		os.Exit(0)
 	}
 
@@ -835,9 +1074,109 @@ func main() {
 		}
 	}
 
+	if !config.PathMode(*projectPathMode).IsValid() {
+		fmt.Fprintf(os.Stderr, "Error: invalid --project-path-mode %q (expected absolute, config, or home)\n", *projectPathMode)
+		os.Exit(1)
+	}
+	if *profile != "" {
+		if err := config.ValidateProfileName(*profile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: --profile: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	if *sortFlag != "" && !config.SortKey(*sortFlag).IsValid() {
+		fmt.Fprintf(os.Stderr, "Error: invalid --sort %q (expected default, created-asc, created-desc, priority, or updated)\n", *sortFlag)
+		os.Exit(1)
+	}
+
+	// Build the saved view requested via --view plus any view flags
+	viewFlags, err := savedViewFromFlags(*repoFilter, *statusFilter, *priorityFilter, *typeFilter, *assigneeFilter, *sortFlag, *startView)
+	if err == nil {
+		viewFlags.Watching = strings.TrimSpace(*watchingFilter)
+		viewFlags.Resolution = splitCommaList(*resolutionFilter)
+		err = setDependencyCountFilters(&viewFlags, *minDepsFilter, *minDependentsFilter)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// display.yaml, read once and passed to everything below; nil when it
+	// cannot be read, in which case settings fall back to their defaults
+	displayCfg, displayErr := config.LoadDisplay()
+
+	// Handle --save-view flag
+	if *saveViewName != "" {
+		if displayErr != nil {
+			fmt.Fprintf(os.Stderr, "Error loading display config: %v\n", displayErr)
+			os.Exit(1)
+		}
+		displayCfg.SaveView(*saveViewName, viewFlags)
+		if err := config.SaveDisplay(displayCfg); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving view: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Saved view %q (%s) to %s\n", *saveViewName, ui.DescribeSavedView(viewFlags), config.DisplayConfigPath())
+		os.Exit(0)
+	}
+
+	var tuiView *config.SavedView
+	if *viewName != "" {
+		if displayErr != nil {
+			fmt.Fprintf(os.Stderr, "Error loading display config: %v\n", displayErr)
+			os.Exit(1)
+		}
+		saved, ok := displayCfg.Views[*viewName]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: unknown view %q\n", *viewName)
+			if names := displayCfg.ViewNames(); len(names) > 0 {
+				fmt.Fprintf(os.Stderr, "Available views: %s\n", strings.Join(names, ", "))
+			} else {
+				fmt.Fprintln(os.Stderr, "No saved views; create one with --save-view NAME")
+			}
+			os.Exit(1)
+		}
+		merged := mergeSavedView(saved, viewFlags)
+		tuiView = &merged
+	} else if *statusFilter != "" || *priorityFilter != "" || *typeFilter != "" || *resolutionFilter != "" || *assigneeFilter != "" || viewFlags.Watching != "" || viewFlags.MinDeps > 0 || viewFlags.MinDependents > 0 || *startView != "" {
+		// Filter flags without --view act as an unnamed view
+		tuiView = &viewFlags
+	}
+
+	// Handle --normalize: tidy a beads file for version control
+	if *normalizePath != "" {
+		os.Exit(runNormalize(*normalizePath, *allowWrite, os.Stdout, os.Stderr))
+	}
+
 	// Handle --clear-projects flag
 	if *clearProjects {
-		if err := config.ClearProjects(); err != nil {
+		savedConfig, err := config.LoadProjectsProfile(*profile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading saved projects: %v\n", err)
+			os.Exit(1)
+		}
+		if len(savedConfig.Projects) == 0 {
+			fmt.Println("No saved projects to clear.")
+			os.Exit(0)
+		}
+
+		// Confirm unless --yes is provided; never delete silently from scripts
+		if !*yesFlag {
+			if !stdoutIsTTY {
+				fmt.Fprintf(os.Stderr, "Refusing to clear %d saved projects without confirmation; re-run with --yes\n", len(savedConfig.Projects))
+				os.Exit(1)
+			}
+			fmt.Printf("Remove %d saved projects? [y/N]: ", len(savedConfig.Projects))
+			var response string
+			fmt.Scanln(&response)
+			response = strings.ToLower(strings.TrimSpace(response))
+			if response != "y" && response != "yes" {
+				fmt.Println("Clear cancelled")
+				os.Exit(0)
+			}
+		}
+
+		if err := config.ClearProjectsProfile(*profile); err != nil {
 			fmt.Fprintf(os.Stderr, "Error clearing projects: %v\n", err)
 			os.Exit(1)
 		}
@@ -845,18 +1184,127 @@ func main() {
 		os.Exit(0)
 	}
 
+	// Handle --diff-config: two project sets, loaded independently of the
+	// current directory
+	if *diffConfig != "" {
+		if *diffConfigTo == "" {
+			fmt.Fprintln(os.Stderr, "Error: --diff-config needs two files: --diff-config OLD.yaml --diff-config-to NEW.yaml")
+			os.Exit(1)
+		}
+		if !*robotDiff && (envRobot || !stdoutIsTTY) {
+			*robotDiff = true
+		}
+		fromSet, err := loadProjectSet(*diffConfig, allowEmptyProjects)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		toSet, err := loadProjectSet(*diffConfigTo, allowEmptyProjects)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		diff := analysis.CompareProjectSets(fromSet, toSet)
+
+		if *robotDiff {
+			output := struct {
+				GeneratedAt string                   `json:"generated_at"`
+				FromConfig  string                   `json:"from_config"`
+				ToConfig    string                   `json:"to_config"`
+				Diff        *analysis.ProjectSetDiff `json:"diff"`
+			}{
+				GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+				FromConfig:  *diffConfig,
+				ToConfig:    *diffConfigTo,
+				Diff:        diff,
+			}
+			if err := encodeRobotJSON(os.Stdout, output); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding diff: %v\n", err)
+				os.Exit(1)
+			}
+		} else {
+			printProjectSetDiff(os.Stdout, diff, *diffConfig, *diffConfigTo)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --projects-file: a plain path list, e.g. generated by a script
+	if *projectsFile != "" {
+		listed, err := loadProjectsFile(*projectsFile, os.Stderr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading projects file: %v\n", err)
+			os.Exit(1)
+		}
+		if len(listed) == 0 && len(projectPaths) == 0 {
+			fmt.Fprintf(os.Stderr, "Error: no usable projects in %s\n", *projectsFile)
+			os.Exit(1)
+		}
+		projectPaths = append(projectPaths, listed...)
+	}
+
+	// Handle --project-root: the root project plus nested sub-projects, with
+	// prefixes applied after the project config is built
+	var rootRepos map[string]workspace.RepoConfig
+	if *projectRoot != "" {
+		discovered, err := workspace.DiscoverProjectRoot(*projectRoot, workspace.DefaultExcludePatterns())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		rootRepos = make(map[string]workspace.RepoConfig, len(discovered))
+		for _, repo := range discovered {
+			rootRepos[repo.Path] = repo
+			projectPaths = append(projectPaths, repo.Path)
+		}
+	}
+
 	// Load saved projects if no --project flags provided
+	projectsFromSaved := false // The Project Manager may autosave only a saved list
+	var savedDisabled []ui.ProjectEntry
 	if len(projectPaths) == 0 && *workspaceConfig == "" {
-		savedConfig, err := config.LoadProjects()
+		if *profile != "" {
+			if _, err := os.Stat(config.ProfilePath(*profile)); os.IsNotExist(err) {
+				fmt.Fprintf(os.Stderr, "Error: no profile %q (%s)\n", *profile, config.ProfilePath(*profile))
+				if names, _ := config.ListProfiles(); len(names) > 0 {
+					fmt.Fprintf(os.Stderr, "Available profiles: %s\n", strings.Join(names, ", "))
+				} else {
+					fmt.Fprintln(os.Stderr, "No saved profiles; create one with --project PATH --save-projects --profile NAME")
+				}
+				os.Exit(1)
+			}
+		}
+		savedConfig, err := config.LoadProjectsProfile(*profile)
 		if err != nil {
 			if !envRobot {
 				fmt.Fprintf(os.Stderr, "Warning: failed to load saved projects: %v\n", err)
 			}
 		} else if len(savedConfig.Projects) > 0 {
-			projectPaths = savedConfig.EnabledPaths()
+			projectPaths = savedConfig.EnabledPaths(savedConfig.BaseDir())
+			projectsFromSaved = true
+			savedDisabled = disabledProjectEntries(savedConfig)
 		}
 	}
 
+	// Ignore rules from display.yaml (loaded either way so custom ignored
+	// statuses parse); --show-ignored keeps the matching issues
+	ignoreIssue := ignoreRulesFrom(displayCfg)
+	if *showIgnored {
+		ignoreIssue = nil
+	}
+
+	// Priority for issues loaded without a valid one and the story point
+	// conversion, passed to every load of the beads files below (reloads
+	// and git history included)
+	parseOpts := parseOptionsFrom(displayCfg)
+
+	// Unsuccessful resolutions for --robot-deadends, accepted by the loader
+	// so blockers with a status such as "wontfix" are kept
+	var unsuccessfulStatuses []string
+	if *robotDeadends {
+		unsuccessfulStatuses = unsuccessfulStatusesFrom(displayCfg)
+		parseOpts.Statuses = append(parseOpts.Statuses, toStatuses(unsuccessfulStatuses)...)
+	}
+
 	// Load issues from current directory or workspace (with timing for profile)
 	loadStart := time.Now()
 	var issues []model.Issue
@@ -865,6 +1313,10 @@ func main() {
 	var asOfResolved string                   // Resolved commit SHA when using --as-of (for robot output metadata)
 	var projectConfigs []workspace.RepoConfig // Track configs for CRUD context
 	var projectPathsMap map[string]string     // prefix -> beads file path for CRUD
+	var projectTags map[string][]string       // prefix -> .bv.yaml tags for the project manager
+	var repoColors map[string]string          // prefix -> .bv.yaml badge color
+	var projectFilters *config.SavedView      // .bv.yaml default filters when one project is loaded
+	var reloadIssues ui.IssueReloader         // Re-reads all sources for --refresh (nil = beadsPath)
 	_ = projectConfigs                        // Will be used for project manager UI
 
 	if *asOf != "" {
@@ -882,6 +1334,7 @@ func main() {
 			os.Exit(1)
 		}
 		gitLoader := loader.NewGitLoader(cwd)
+		gitLoader.SetParseOptions(parseOpts)
 		issues, err = gitLoader.LoadAt(*asOf)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading issues at %s: %v\n", *asOf, err)
@@ -900,25 +1353,48 @@ func main() {
 		}
 	} else if len(projectPaths) > 0 {
 		// Load from multiple projects via --project flags
-		wsConfig, err := buildConfigFromPaths(projectPaths)
+		savedProjects, _ := config.LoadProjectsProfile(*profile) // Overrides are optional; load errors were reported above
+		wsConfig, locals, err := buildConfigFromPaths(projectPaths, savedProjects)
+		if err == nil && rootRepos != nil {
+			err = applyProjectRoot(wsConfig, rootRepos)
+		}
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error building project config: %v\n", err)
 			os.Exit(1)
 		}
 		projectConfigs = wsConfig.Repos
 
-		// Build project paths map for CRUD context
+		// Build project paths map for CRUD context, plus .bv.yaml tags and colors
 		projectPathsMap = make(map[string]string)
-		for _, repo := range projectConfigs {
+		projectTags = make(map[string][]string)
+		repoColors = make(map[string]string)
+		for i, repo := range projectConfigs {
 			beadsDir := filepath.Join(repo.Path, repo.GetBeadsPath())
 			jsonlPath, err := loader.FindJSONLPath(beadsDir)
 			if err == nil {
 				projectPathsMap[repo.GetPrefix()] = jsonlPath
+			} else if allowEmptyProjects && errors.Is(err, loader.ErrNoIssuesFile) {
+				projectPathsMap[repo.GetPrefix()] = filepath.Join(beadsDir, loader.NewIssuesFileName)
+			}
+			if len(locals[i].Tags) > 0 {
+				projectTags[repo.GetPrefix()] = locals[i].Tags
+			}
+			if locals[i].Color != "" {
+				repoColors[repo.GetPrefix()] = locals[i].Color
 			}
 		}
+		if len(locals) == 1 && locals[0].HasFilters() {
+			projectFilters = &locals[0].Filters
+		}
 
 		// Use a placeholder root since paths are absolute
 		aggLoader := workspace.NewAggregateLoader(wsConfig, "")
+		aggLoader.SetAllowEmpty(allowEmptyProjects)
+		aggLoader.SetParseOptions(parseOpts)
+		if verbosity > 0 {
+			aggLoader.SetLogger(verboseLog)
+			aggLoader.SetVerbosity(verbosity)
+		}
 		loadedIssues, results, err := aggLoader.LoadAll(context.Background())
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading projects: %v\n", err)
@@ -927,6 +1403,14 @@ func main() {
 		issues = loadedIssues
 		summary := workspace.Summarize(results)
 		workspaceInfo = &summary
+		// Reloads stay quiet: the TUI owns the terminal by then
+		quietLoader := workspace.NewAggregateLoader(wsConfig, "")
+		quietLoader.SetAllowEmpty(allowEmptyProjects)
+		quietLoader.SetParseOptions(parseOpts)
+		reloadIssues = func() ([]model.Issue, error) {
+			reloaded, _, err := quietLoader.LoadAll(context.Background())
+			return reloaded, err
+		}
 
 		// Print loading summary
 		if summary.FailedRepos > 0 && !envRobot {
@@ -939,21 +1423,34 @@ func main() {
 		// Handle --save-projects flag
 		if *saveProjects {
 			projConfig := &config.ProjectsConfig{}
+			baseDir := filepath.Dir(config.ProfilePath(*profile))
 			for _, p := range projectPaths {
-				projConfig.AddProject(p)
+				projConfig.AddProject(p, config.PathMode(*projectPathMode), baseDir)
 			}
-			if err := config.SaveProjects(projConfig); err != nil {
+			if err := config.SaveProjectsProfile(projConfig, *profile); err != nil {
 				fmt.Fprintf(os.Stderr, "Error saving projects: %v\n", err)
 			} else if !envRobot {
-				fmt.Fprintf(os.Stderr, "Saved %d projects to %s\n", len(projectPaths), config.ProjectsConfigPath())
+				fmt.Fprintf(os.Stderr, "Saved %d projects to %s\n", len(projectPaths), config.ProfilePath(*profile))
 			}
 		}
 
 		// No single beadsPath in multi-project mode
 		beadsPath = ""
 	} else if *workspaceConfig != "" {
-		// Load from workspace configuration
-		loadedIssues, results, err := workspace.LoadAllFromConfig(context.Background(), *workspaceConfig)
+		// Load from workspace configuration, with each repo's .bv.yaml
+		wsLoader, tags, colors, err := newWorkspaceLoader(*workspaceConfig)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error loading workspace: %v\n", err)
+			os.Exit(1)
+		}
+		projectTags, repoColors = tags, colors
+		wsLoader.SetAllowEmpty(allowEmptyProjects)
+		wsLoader.SetParseOptions(parseOpts)
+		if verbosity > 0 {
+			wsLoader.SetLogger(verboseLog)
+			wsLoader.SetVerbosity(verbosity)
+		}
+		loadedIssues, results, err := wsLoader.LoadAll(context.Background())
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading workspace: %v\n", err)
 			os.Exit(1)
@@ -961,6 +1458,17 @@ func main() {
 		issues = loadedIssues
 		summary := workspace.Summarize(results)
 		workspaceInfo = &summary
+		wsPath := *workspaceConfig
+		reloadIssues = func() ([]model.Issue, error) {
+			reloader, _, _, err := newWorkspaceLoader(wsPath)
+			if err != nil {
+				return nil, err
+			}
+			reloader.SetAllowEmpty(allowEmptyProjects)
+			reloader.SetParseOptions(parseOpts)
+			reloaded, _, err := reloader.LoadAll(context.Background())
+			return reloaded, err
+		}
 
 		// Print workspace loading summary
 		if summary.FailedRepos > 0 {
@@ -976,27 +1484,179 @@ func main() {
 	} else {
 		// Load from single repo (original behavior)
 		var err error
-		issues, err = loader.LoadIssues("")
+		issues, err = loader.LoadIssuesWithOptions("", parseOpts)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error loading beads: %v\n", err)
-			fmt.Fprintln(os.Stderr, "Make sure you are in a project initialized with 'bd init'.")
+			// An unreadable display.yaml still gets the default hints
+			hintCfg := config.DefaultDisplayConfig()
+			if displayCfg != nil {
+				hintCfg = *displayCfg
+			}
+			fmt.Fprintf(os.Stderr, "\nTo get started:\n%s\n", ui.OnboardingHints(hintCfg))
 			os.Exit(1)
 		}
 		// Get beads file path for live reload (respects BEADS_DIR env var)
 		beadsDir, _ := loader.GetBeadsDir("")
 		beadsPath, _ = loader.FindJSONLPath(beadsDir)
+		if verbosity > 0 {
+			if format, err := loader.DetectFileFormat(beadsPath); err == nil {
+				fmt.Fprintf(os.Stderr, "Loaded %d issues from %s (%s)\n", len(issues), beadsPath, format)
+			}
+		}
+
+		projectDir := filepath.Dir(beadsDir)
+		if local, err := config.LoadProjectLocal(projectDir); err != nil {
+			if !envRobot {
+				fmt.Fprintf(os.Stderr, "Warning: ignoring invalid %s: %v\n", config.ProjectLocalConfigPath(projectDir), err)
+			}
+		} else if local.HasFilters() {
+			projectFilters = &local.Filters
+		}
 	}
 	loadDuration := time.Since(loadStart)
 
+	// A lone project's .bv.yaml default filters open the TUI unless --view
+	// names a saved view; filter flags still override individual fields
+	if projectFilters != nil && *viewName == "" {
+		merged := mergeSavedView(*projectFilters, viewFlags)
+		tuiView = &merged
+	}
+
+	// Full issue set for pruning stale pins, before any filtering. Skipped
+	// when workspace repos failed to load so their pins survive.
+	pinUniverse := issues
+	if workspaceInfo != nil && workspaceInfo.FailedRepos > 0 {
+		pinUniverse = nil
+	}
+
+	// Drop ignored issues from everything below. Reloads are filtered where
+	// they are consumed: by the TUI (SetIgnoreFilter) and by --serve.
+	var ignoredCount int
+	if ignoreIssue != nil {
+		issues, ignoredCount = dropIgnored(issues, ignoreIssue)
+		robotIgnoredCount = ignoredCount
+	}
+
 	// Apply --repo filter if specified
 	if *repoFilter != "" {
+		var knownPrefixes []string
+		if workspaceInfo != nil {
+			knownPrefixes = workspaceInfo.RepoPrefixes
+		}
+		resolved, err := resolveRepoFilter(*repoFilter, repoPrefixesOf(issues, knownPrefixes))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if tuiView != nil && tuiView.Repo == *repoFilter {
+			tuiView.Repo = resolved
+		}
+		*repoFilter = resolved
 		issues = filterByRepo(issues, *repoFilter)
+		if reloadIssues != nil {
+			loadAll, repo := reloadIssues, *repoFilter
+			reloadIssues = func() ([]model.Issue, error) {
+				reloaded, err := loadAll()
+				if err != nil {
+					return nil, err
+				}
+				return filterByRepo(reloaded, repo), nil
+			}
+		}
+	}
+
+	// Handle --serve: long-running JSON API that re-reads issues per request
+	if *serveAddr != "" {
+		if *asOf != "" {
+			fmt.Fprintln(os.Stderr, "Error: --serve cannot be combined with --as-of")
+			os.Exit(1)
+		}
+		load := reloadIssues
+		if load == nil {
+			repo := *repoFilter
+			load = func() ([]model.Issue, error) {
+				loaded, err := loader.LoadIssuesWithOptions("", parseOpts)
+				if err != nil {
+					return nil, err
+				}
+				if repo != "" {
+					loaded = filterByRepo(loaded, repo)
+				}
+				return loaded, nil
+			}
+		}
+		if ignoreIssue != nil {
+			loadAll := load
+			load = func() ([]model.Issue, error) {
+				loaded, err := loadAll()
+				if err != nil {
+					return nil, err
+				}
+				loaded, _ = dropIgnored(loaded, ignoreIssue)
+				return loaded, nil
+			}
+		}
+		if err := runServeServer(*serveAddr, load, displayCfg); err != nil {
+			fmt.Fprintf(os.Stderr, "Error running server: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Apply --since-commit: the issues whose beads file lines changed since
+	// the ref, rechecked on every reload. The graph is still built from
+	// every issue so blockers outside the set keep gating readiness; only
+	// what is shown is narrowed.
+	var sinceIDs map[string]bool
+	var sinceScope func() []string
+	if *sinceCommit != "" {
+		if *asOf != "" || workspaceInfo != nil {
+			if !envRobot {
+				fmt.Fprintln(os.Stderr, "Warning: --since-commit is ignored with --as-of, --project and --workspace")
+			}
+		} else if cwd, err := os.Getwd(); err == nil {
+			gitLoader := loader.NewGitLoader(cwd)
+			changed, err := gitLoader.ChangedIssueIDs(*sinceCommit)
+			if err != nil {
+				if !envRobot {
+					fmt.Fprintf(os.Stderr, "Warning: --since-commit %s: %v; showing all issues\n", *sinceCommit, err)
+				}
+			} else {
+				sinceIDs = make(map[string]bool, len(changed))
+				for _, id := range changed {
+					sinceIDs[id] = true
+				}
+				ref := *sinceCommit
+				sinceScope = func() []string {
+					if ids, err := gitLoader.ChangedIssueIDs(ref); err == nil {
+						changed = ids
+					}
+					return changed
+				}
+				if !envRobot {
+					fmt.Fprintf(os.Stderr, "Showing %d issues changed since %s\n", len(filterByIDs(issues, sinceIDs)), *sinceCommit)
+				}
+			}
+		}
+	}
+
+	// Let ID-taking flags accept external IDs (e.g. Jira keys)
+	resolveIssueRefFlag(issues, "graph-root", graphRoot)
+	resolveIssueRefFlag(issues, "bead-history", beadHistory)
+	if *robotForecast != "all" {
+		resolveIssueRefFlag(issues, "robot-forecast", robotForecast)
 	}
 
 	issuesForSearch := issues
 
 	// Stable data hash for robot outputs (after repo filter but before recipes/TUI)
 	dataHash := analysis.ComputeDataHash(issues)
+	robotDanglingDeps = analysis.FindDanglingDeps(issues)
+
+	// Handle --validate
+	if *validate {
+		os.Exit(printValidation(os.Stdout, issues, robotDanglingDeps, parseOpts.Statuses))
+	}
 
 	// Label subgraph scoping (bv-122)
 	// When --label is specified, extract the label's subgraph and use it for all robot analysis.
@@ -1142,9 +1802,7 @@ func main() {
 				})
 			}
 
-			enc := json.NewEncoder(os.Stdout)
-			enc.SetIndent("", "  ")
-			if err := enc.Encode(out); err != nil {
+			if err := encodeRobotJSON(os.Stdout, out); err != nil {
 				fmt.Fprintf(os.Stderr, "Error encoding robot-search: %v\n", err)
 				os.Exit(1)
 			}
@@ -1340,9 +1998,7 @@ func main() {
 				"jq '.results.attention_needed' - Labels needing attention",
 			},
 		}
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding label health: %v\n", err)
 			os.Exit(1)
 		}
@@ -1370,9 +2026,7 @@ func main() {
 				"jq '.flow.flow_matrix' - raw matrix (row=from, col=to, align with .flow.labels)",
 			},
 		}
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding label flow: %v\n", err)
 			os.Exit(1)
 		}
@@ -1455,9 +2109,7 @@ func main() {
 			})
 		}
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding label attention: %v\n", err)
 			os.Exit(1)
 		}
@@ -1494,9 +2146,7 @@ func main() {
 			os.Exit(1)
 		}
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(result); err != nil {
+		if err := encodeRobotJSON(os.Stdout, result); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding graph: %v\n", err)
 			os.Exit(1)
 		}
@@ -1683,72 +2333,489 @@ func main() {
 			output.Summary.Total++
 		}
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding alerts: %v\n", err)
 			os.Exit(1)
 		}
 		os.Exit(0)
 	}
 
-	// Handle --robot-suggest (bv-180)
-	if *robotSuggest {
-		config := analysis.DefaultSuggestAllConfig()
-		config.MinConfidence = *suggestConfidence
-		config.FilterBead = *suggestBead
+	// Handle --robot-inversions
+	if *robotInversions {
+		inversions := analysis.DetectPriorityInversions(issues)
 
-		// Parse filter type
-		switch *suggestType {
-		case "duplicate", "duplicates":
-			config.FilterType = analysis.SuggestionPotentialDuplicate
-		case "dependency", "dependencies":
-			config.FilterType = analysis.SuggestionMissingDependency
-		case "label", "labels":
-			config.FilterType = analysis.SuggestionLabelSuggestion
-		case "cycle", "cycles":
-			config.FilterType = analysis.SuggestionCycleWarning
-		case "":
-			// All types
-		default:
-			fmt.Fprintf(os.Stderr, "Invalid suggest-type: %s (use: duplicate, dependency, label, cycle)\n", *suggestType)
-			os.Exit(1)
+		output := struct {
+			GeneratedAt string                       `json:"generated_at"`
+			DataHash    string                       `json:"data_hash"`
+			AsOf        string                       `json:"as_of,omitempty"`
+			AsOfCommit  string                       `json:"as_of_commit,omitempty"`
+			Count       int                          `json:"count"`
+			Inversions  []analysis.PriorityInversion `json:"inversions"`
+			UsageHints  []string                     `json:"usage_hints"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			AsOf:        *asOf,
+			AsOfCommit:  asOfResolved,
+			Count:       len(inversions),
+			Inversions:  inversions,
+			UsageHints: []string{
+				"jq '.inversions[] | {blocker_id, suggested_priority}' - Blockers to re-prioritize",
+				"jq '[.inversions[] | select(.blocked_priority == 0)]' - Inversions holding up P0 work",
+				"--repo api - Limit to one project (cross-project edges need both sides loaded)",
+			},
 		}
 
-		output := analysis.GenerateRobotSuggestOutput(issues, config, dataHash)
-
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
-			fmt.Fprintf(os.Stderr, "Error encoding suggestions: %v\n", err)
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding inversions: %v\n", err)
 			os.Exit(1)
 		}
 		os.Exit(0)
 	}
 
-	// Handle --profile-startup
-	if *profileStartup {
-		runProfileStartup(issues, loadDuration, *profileJSON, *forceFullAnalysis)
+	// Handle --robot-deadends
+	if *robotDeadends {
+		deadEnds := analysis.FindDeadEnds(issues, unsuccessfulStatuses)
+
+		output := struct {
+			GeneratedAt          string             `json:"generated_at"`
+			DataHash             string             `json:"data_hash"`
+			AsOf                 string             `json:"as_of,omitempty"`
+			AsOfCommit           string             `json:"as_of_commit,omitempty"`
+			UnsuccessfulStatuses []string           `json:"unsuccessful_statuses"`
+			Count                int                `json:"count"`
+			DeadEnds             []analysis.DeadEnd `json:"dead_ends"`
+			UsageHints           []string           `json:"usage_hints"`
+		}{
+			GeneratedAt:          time.Now().UTC().Format(time.RFC3339),
+			DataHash:             dataHash,
+			AsOf:                 *asOf,
+			AsOfCommit:           asOfResolved,
+			UnsuccessfulStatuses: unsuccessfulStatuses,
+			Count:                len(deadEnds),
+			DeadEnds:             deadEnds,
+			UsageHints: []string{
+				"jq '.dead_ends[] | {id, blockers: [.blockers[].id]}' - Issues to re-scope and what they waited on",
+				"jq '[.dead_ends[] | select(.priority <= 1)]' - High-priority work that can no longer proceed",
+				"unsuccessful_statuses: [wontfix, duplicate] in display.yaml - Change what counts as unsuccessful",
+			},
+		}
+
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding dead ends: %v\n", err)
+			os.Exit(1)
+		}
 		os.Exit(0)
 	}
 
-	// Handle --save-baseline
-	if *saveBaseline != "" {
-		analyzer := analysis.NewAnalyzer(issues)
-		if *forceFullAnalysis {
-			cfg := analysis.FullAnalysisConfig()
-			analyzer.SetConfig(&cfg)
+	// Handle --robot-zombies
+	if *robotZombies {
+		zombies := analysis.FindZombies(issues)
+		if zombies == nil {
+			zombies = []analysis.Zombie{}
 		}
-		stats := analyzer.Analyze()
 
-		// Compute status counts from issues
-		openCount, closedCount, blockedCount := 0, 0, 0
-		for _, issue := range issues {
-			switch issue.Status {
-			case model.StatusOpen, model.StatusInProgress:
-				openCount++
-			case model.StatusClosed:
-				closedCount++
+		output := struct {
+			GeneratedAt string            `json:"generated_at"`
+			DataHash    string            `json:"data_hash"`
+			AsOf        string            `json:"as_of,omitempty"`
+			AsOfCommit  string            `json:"as_of_commit,omitempty"`
+			Count       int               `json:"count"`
+			Zombies     []analysis.Zombie `json:"zombies"`
+			UsageHints  []string          `json:"usage_hints"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			AsOf:        *asOf,
+			AsOfCommit:  asOfResolved,
+			Count:       len(zombies),
+			Zombies:     zombies,
+			UsageHints: []string{
+				"jq '.zombies[] | {id, referenced_by}' - Missing issues and who still points at them",
+				"jq '[.zombies[] | select(.reference_count > 1)]' - Missing issues many others depend on",
+				"jq '.dangling_deps | map(select(.reason == \"project_not_loaded\"))' - References into projects that aren't loaded",
+			},
+		}
+
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding zombies: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-ready-wait
+	if *robotReadyWait {
+		waits := analysis.ReadyWaits(issues, time.Now(), *robotMaxResults)
+
+		output := struct {
+			GeneratedAt string               `json:"generated_at"`
+			DataHash    string               `json:"data_hash"`
+			AsOf        string               `json:"as_of,omitempty"`
+			AsOfCommit  string               `json:"as_of_commit,omitempty"`
+			Count       int                  `json:"count"`
+			ReadyWaits  []analysis.ReadyWait `json:"ready_waits"`
+			UsageHints  []string             `json:"usage_hints"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			AsOf:        *asOf,
+			AsOfCommit:  asOfResolved,
+			Count:       len(waits),
+			ReadyWaits:  waits,
+			UsageHints: []string{
+				"jq '.ready_waits[0]' - The startable issue that has waited longest",
+				"jq '[.ready_waits[] | select(.priority <= 1 and .wait_days >= 14)]' - High-priority work nobody has picked up",
+				"jq '.ready_waits[] | select(.source == \"blocker_closed\") | {id, last_blocker, wait_days}' - Unblocked but not followed up",
+			},
+		}
+
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding ready waits: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-duplicates
+	if *robotDuplicates {
+		links := analysis.FindDuplicateLinks(issues)
+		openCount := 0
+		for _, l := range links {
+			if l.BothOpen {
+				openCount++
+			}
+		}
+
+		output := struct {
+			GeneratedAt string                   `json:"generated_at"`
+			DataHash    string                   `json:"data_hash"`
+			AsOf        string                   `json:"as_of,omitempty"`
+			AsOfCommit  string                   `json:"as_of_commit,omitempty"`
+			Count       int                      `json:"count"`
+			OpenCount   int                      `json:"open_count"`
+			Duplicates  []analysis.DuplicateLink `json:"duplicates"`
+			UsageHints  []string                 `json:"usage_hints"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			AsOf:        *asOf,
+			AsOfCommit:  asOfResolved,
+			Count:       len(links),
+			OpenCount:   openCount,
+			Duplicates:  links,
+			UsageHints: []string{
+				"jq '.duplicates[] | select(.both_open) | .issue_id' - Duplicates that can be closed",
+				"--robot-suggest --suggest-type duplicate - Similar issues not linked yet",
+			},
+		}
+
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding duplicates: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-similar
+	if *robotSimilar {
+		if *similarThreshold < 0 || *similarThreshold > 1 {
+			fmt.Fprintf(os.Stderr, "Error: invalid --similar-threshold %v (expected 0-1)\n", *similarThreshold)
+			os.Exit(1)
+		}
+		pairs := analysis.FindSimilarTitles(issues, *similarThreshold, *similarCrossProject)
+
+		output := struct {
+			GeneratedAt      string                 `json:"generated_at"`
+			DataHash         string                 `json:"data_hash"`
+			AsOf             string                 `json:"as_of,omitempty"`
+			AsOfCommit       string                 `json:"as_of_commit,omitempty"`
+			Threshold        float64                `json:"threshold"`
+			CrossProjectOnly bool                   `json:"cross_project_only"`
+			Count            int                    `json:"count"`
+			Pairs            []analysis.SimilarPair `json:"pairs"`
+			UsageHints       []string               `json:"usage_hints"`
+		}{
+			GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
+			DataHash:         dataHash,
+			AsOf:             *asOf,
+			AsOfCommit:       asOfResolved,
+			Threshold:        *similarThreshold,
+			CrossProjectOnly: *similarCrossProject,
+			Count:            len(pairs),
+			Pairs:            pairs,
+			UsageHints: []string{
+				"jq '.pairs[] | select(.same_project | not) | [.issue_a, .issue_b]' - Candidates across projects",
+				"--similar-threshold 0.8 - Only near-identical titles",
+				"bd dep add A B --type=duplicate - Record a confirmed duplicate (then see --robot-duplicates)",
+			},
+		}
+
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding similar issues: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-bottlenecks
+	if *robotBottlenecks {
+		bottlenecks := analysis.Bottlenecks(issues, *robotMaxResults)
+
+		output := struct {
+			GeneratedAt string                `json:"generated_at"`
+			DataHash    string                `json:"data_hash"`
+			AsOf        string                `json:"as_of,omitempty"`
+			AsOfCommit  string                `json:"as_of_commit,omitempty"`
+			Count       int                   `json:"count"`
+			Bottlenecks []analysis.Bottleneck `json:"bottlenecks"`
+			UsageHints  []string              `json:"usage_hints"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			AsOf:        *asOf,
+			AsOfCommit:  asOfResolved,
+			Count:       len(bottlenecks),
+			Bottlenecks: bottlenecks,
+			UsageHints: []string{
+				"jq '.bottlenecks[0]' - Highest-leverage issue to finish",
+				"jq '.bottlenecks[] | {id, unblocks_count}' - Leverage ranking",
+				"--robot-max-results 5 - Keep only the top entries",
+			},
+		}
+
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding bottlenecks: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-stale
+	if *robotStale {
+		report := analysis.FindStale(issues, analysis.StaleOptions{
+			StaleDays:      *staleDays,
+			CloseAfterDays: *closeAfterDays,
+			MaxDependents:  *closeMaxDependents,
+
+			MaxRecommendationAgeDays: maxRecommendationAgeFrom(displayCfg),
+			CountComments:            staleCountsCommentsFrom(displayCfg),
+		}, time.Now())
+
+		output := struct {
+			GeneratedAt        string                     `json:"generated_at"`
+			DataHash           string                     `json:"data_hash"`
+			AsOf               string                     `json:"as_of,omitempty"`
+			AsOfCommit         string                     `json:"as_of_commit,omitempty"`
+			StaleDays          int                        `json:"stale_days"`
+			CloseAfterDays     int                        `json:"close_after_days"`
+			CloseMaxDependents int                        `json:"close_max_dependents"`
+			Count              int                        `json:"count"`
+			Stale              []analysis.StaleIssue      `json:"stale"`
+			SuggestClose       []analysis.CloseSuggestion `json:"suggest_close"`
+			AgedOut            []analysis.AgedOutIssue    `json:"aged_out,omitempty"`
+			UsageHints         []string                   `json:"usage_hints"`
+		}{
+			GeneratedAt:        time.Now().UTC().Format(time.RFC3339),
+			DataHash:           dataHash,
+			AsOf:               *asOf,
+			AsOfCommit:         asOfResolved,
+			StaleDays:          *staleDays,
+			CloseAfterDays:     *closeAfterDays,
+			CloseMaxDependents: *closeMaxDependents,
+			Count:              len(report.Stale),
+			Stale:              report.Stale,
+			SuggestClose:       report.SuggestClose,
+			AgedOut:            report.AgedOut,
+			UsageHints: []string{
+				"jq '.suggest_close | map(.id)' - Cleanup candidates (review before closing with bd)",
+				"jq '.stale[] | select(.status == \"in_progress\")' - Claimed work that went quiet",
+				"--close-after-days 60 --close-max-dependents 1 - Widen the cleanup list",
+			},
+		}
+
+		if *robotStream {
+			header := struct {
+				GeneratedAt        string `json:"generated_at"`
+				DataHash           string `json:"data_hash"`
+				AsOf               string `json:"as_of,omitempty"`
+				AsOfCommit         string `json:"as_of_commit,omitempty"`
+				StaleDays          int    `json:"stale_days"`
+				CloseAfterDays     int    `json:"close_after_days"`
+				CloseMaxDependents int    `json:"close_max_dependents"`
+			}{output.GeneratedAt, output.DataHash, output.AsOf, output.AsOfCommit, output.StaleDays, output.CloseAfterDays, output.CloseMaxDependents}
+			sections := []streamSection{streamOf("stale", report.Stale), streamOf("suggest_close", report.SuggestClose)}
+			if len(report.AgedOut) > 0 {
+				sections = append(sections, streamOf("aged_out", report.AgedOut))
+			}
+			if err := encodeRobotStream(os.Stdout, header, sections...); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding stale issues: %v\n", err)
+				os.Exit(1)
+			}
+			os.Exit(0)
+		}
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding stale issues: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --compare-projects / --robot-compare-projects
+	if *compareProjects || *robotCompareProjects {
+		projects := analysis.CompareProjects(issues, time.Now())
+		if *compareProjects && !*robotCompareProjects {
+			printProjectComparison(os.Stdout, projects)
+			os.Exit(0)
+		}
+
+		output := struct {
+			GeneratedAt string                       `json:"generated_at"`
+			DataHash    string                       `json:"data_hash"`
+			AsOf        string                       `json:"as_of,omitempty"`
+			AsOfCommit  string                       `json:"as_of_commit,omitempty"`
+			Count       int                          `json:"count"`
+			Projects    []analysis.ProjectComparison `json:"projects"`
+			UsageHints  []string                     `json:"usage_hints"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    dataHash,
+			AsOf:        *asOf,
+			AsOfCommit:  asOfResolved,
+			Count:       len(projects),
+			Projects:    projects,
+			UsageHints: []string{
+				"jq '.projects | sort_by(-.labels.avg_health) | map(.project)' - Healthiest first",
+				"jq '.projects[] | {project, open, blocked}' - Open vs blocked per project",
+				"jq '.projects[] | select(.cross_project_edges > 0)' - Projects depending on others",
+			},
+		}
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding project comparison: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-stats: deliberately flat (no ignored_count or
+	// dangling_deps envelope), so it can be pushed to a metrics store as-is.
+	// schema_version is one more flat field; every other key is a number.
+	if *robotStats {
+		now := time.Now()
+		output := struct {
+			SchemaVersion string `json:"schema_version"`
+			Timestamp     int64  `json:"timestamp"`
+			analysis.AggregateStats
+		}{
+			SchemaVersion:  robotSchemaVersion,
+			Timestamp:      now.Unix(),
+			AggregateStats: analysis.ComputeAggregateStats(issues, now),
+		}
+		raw, err := json.Marshal(output)
+		if err == nil {
+			raw, err = formatRobotJSON(raw)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding stats: %v\n", err)
+			os.Exit(1)
+		}
+		os.Stdout.Write(raw)
+		os.Exit(0)
+	}
+
+	if *explainID != "" || *robotExplain != "" {
+		id := *robotExplain
+		if id == "" {
+			id = *explainID
+		}
+		exp, ok := analysis.ExplainIssue(issues, id, time.Now())
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Error: issue %q not found\n", id)
+			os.Exit(1)
+		}
+		if *robotExplain == "" {
+			printExplanation(os.Stdout, exp)
+			os.Exit(0)
+		}
+
+		output := struct {
+			GeneratedAt string `json:"generated_at"`
+			DataHash    string `json:"data_hash"`
+			analysis.IssueExplanation
+			UsageHints []string `json:"usage_hints"`
+		}{
+			GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
+			DataHash:         dataHash,
+			IssueExplanation: exp,
+			UsageHints: []string{
+				"jq '.blockers[] | select(.status != \"closed\")' - Open blockers on the chain",
+				"jq '.dependents | map(.id)' - What this issue holds up",
+				"jq '.labels[] | select(.health_level != \"healthy\")' - Unhealthy labels",
+			},
+		}
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding explanation: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --robot-suggest (bv-180)
+	if *robotSuggest {
+		config := analysis.DefaultSuggestAllConfig()
+		config.MinConfidence = *suggestConfidence
+		config.FilterBead = *suggestBead
+
+		// Parse filter type
+		switch *suggestType {
+		case "duplicate", "duplicates":
+			config.FilterType = analysis.SuggestionPotentialDuplicate
+		case "dependency", "dependencies":
+			config.FilterType = analysis.SuggestionMissingDependency
+		case "label", "labels":
+			config.FilterType = analysis.SuggestionLabelSuggestion
+		case "cycle", "cycles":
+			config.FilterType = analysis.SuggestionCycleWarning
+		case "":
+			// All types
+		default:
+			fmt.Fprintf(os.Stderr, "Invalid suggest-type: %s (use: duplicate, dependency, label, cycle)\n", *suggestType)
+			os.Exit(1)
+		}
+
+		output := analysis.GenerateRobotSuggestOutput(issues, config, dataHash)
+
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
+			fmt.Fprintf(os.Stderr, "Error encoding suggestions: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --profile-startup
+	if *profileStartup {
+		// Robot mode forces the JSON profile so stdout stays parseable
+		runProfileStartup(issues, loadDuration, *profileJSON || robotMode, *forceFullAnalysis)
+		os.Exit(0)
+	}
+
+	// Handle --save-baseline
+	if *saveBaseline != "" {
+		analyzer := analysis.NewAnalyzer(issues)
+		if *forceFullAnalysis {
+			cfg := analysis.FullAnalysisConfig()
+			analyzer.SetConfig(&cfg)
+		}
+		stats := analyzer.Analyze()
+
+		// Compute status counts from issues
+		openCount, closedCount, blockedCount := 0, 0, 0
+		for _, issue := range issues {
+			switch issue.Status {
+			case model.StatusOpen, model.StatusInProgress:
+				openCount++
+			case model.StatusClosed:
+				closedCount++
 			case model.StatusBlocked:
 				blockedCount++
 			}
@@ -1789,8 +2856,8 @@ func main() {
 			os.Exit(1)
 		}
 
-		fmt.Printf("Baseline saved to %s\n", baselinePath)
-		fmt.Print(bl.Summary())
+		fmt.Fprintf(humanOut, "Baseline saved to %s\n", baselinePath)
+		fmt.Fprint(humanOut, bl.Summary())
 		os.Exit(0)
 	}
 
@@ -1891,9 +2958,7 @@ func main() {
 			output.Baseline.CreatedAt = bl.CreatedAt.Format(time.RFC3339)
 			output.Baseline.CommitSHA = bl.CommitSHA
 
-			encoder := json.NewEncoder(os.Stdout)
-			encoder.SetIndent("", "  ")
-			if err := encoder.Encode(output); err != nil {
+			if err := encodeRobotJSON(os.Stdout, output); err != nil {
 				fmt.Fprintf(os.Stderr, "Error encoding drift result: %v\n", err)
 				os.Exit(1)
 			}
@@ -2058,9 +3123,7 @@ func main() {
 			},
 		}
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding insights: %v\n", err)
 			os.Exit(1)
 		}
@@ -2068,12 +3131,19 @@ func main() {
 	}
 
 	if *robotPlan {
-		analyzer := analysis.NewAnalyzer(issues)
+		// --plan-open-only drops closed issues before the graph is built. A
+		// blocker that isn't loaded counts as satisfied, so their edges stop
+		// blocking, and tracks, critical path and schedules see only open work.
+		planIssues := issues
+		if *planOpenOnly {
+			planIssues = filterOpen(issues)
+		}
+		analyzer := analysis.NewAnalyzer(planIssues)
 		// For --robot-plan we primarily need Phase 1 metrics (degree/topo/density).
 		// However, we still emit a stable status contract for agents. If the user
 		// explicitly asks for full analysis, honor it; otherwise, skip expensive
 		// centrality metrics and record the skip reasons deterministically.
-		cfg := analysis.ConfigForSize(len(issues), countEdges(issues))
+		cfg := analysis.ConfigForSize(len(planIssues), countEdges(planIssues))
 		if *forceFullAnalysis {
 			cfg = analysis.FullAnalysisConfig()
 		} else {
@@ -2091,7 +3161,26 @@ func main() {
 			cfg.CyclesSkipReason = skipReason
 		}
 
+		analyzer.SetPlanOptions(planOptionsFrom(displayCfg))
 		plan := analyzer.GetExecutionPlan()
+		if *maxParallel < 0 {
+			fmt.Fprintf(os.Stderr, "Error: invalid --max-parallel %d (expected N >= 1, or 0 for off)\n", *maxParallel)
+			os.Exit(1)
+		}
+		if *maxParallel > 0 {
+			schedule := analysis.ScheduleParallel(planIssues, *maxParallel)
+			plan.ParallelSchedule = &schedule
+		}
+		if sinceIDs != nil {
+			plan = analysis.NarrowPlan(plan, sinceIDs)
+		}
+
+		// What-if project removal: which issues elsewhere are stranded
+		var isolation *analysis.IsolationImpact
+		if *isolateProject != "" {
+			impact := analysis.ComputeIsolationImpact(planIssues, *isolateProject)
+			isolation = &impact
+		}
 
 		stats := analyzer.AnalyzeAsyncWithConfig(context.Background(), cfg)
 		stats.WaitForPhase2()
@@ -2099,16 +3188,20 @@ func main() {
 
 		// Wrap with metadata
 		output := struct {
-			GeneratedAt    string                  `json:"generated_at"`
-			DataHash       string                  `json:"data_hash"`
-			AsOf           string                  `json:"as_of,omitempty"`        // Historical snapshot ref
-			AsOfCommit     string                  `json:"as_of_commit,omitempty"` // Resolved commit SHA
-			AnalysisConfig analysis.AnalysisConfig `json:"analysis_config"`
-			Status         analysis.MetricStatus   `json:"status"`
-			LabelScope     string                  `json:"label_scope,omitempty"`   // bv-122: Label filter applied
-			LabelContext   *analysis.LabelHealth   `json:"label_context,omitempty"` // bv-122: Health context for scoped label
-			Plan           analysis.ExecutionPlan  `json:"plan"`
-			UsageHints     []string                `json:"usage_hints"` // bv-84: Agent-friendly hints
+			GeneratedAt    string                    `json:"generated_at"`
+			DataHash       string                    `json:"data_hash"`
+			AsOf           string                    `json:"as_of,omitempty"`        // Historical snapshot ref
+			AsOfCommit     string                    `json:"as_of_commit,omitempty"` // Resolved commit SHA
+			AnalysisConfig analysis.AnalysisConfig   `json:"analysis_config"`
+			Status         analysis.MetricStatus     `json:"status"`
+			LabelScope     string                    `json:"label_scope,omitempty"`   // bv-122: Label filter applied
+			LabelContext   *analysis.LabelHealth     `json:"label_context,omitempty"` // bv-122: Health context for scoped label
+			Plan           analysis.ExecutionPlan    `json:"plan"`
+			Isolation      *analysis.IsolationImpact `json:"isolation,omitempty"` // --isolate: what-if project removal
+			UsageHints     []string                  `json:"usage_hints"`         // bv-84: Agent-friendly hints
+
+			OpenOnly     bool `json:"open_only,omitempty"`     // --plan-open-only: closed issues pruned
+			PrunedClosed int  `json:"pruned_closed,omitempty"` // Closed issues left out of the graph
 		}{
 			GeneratedAt:    time.Now().UTC().Format(time.RFC3339),
 			DataHash:       dataHash,
@@ -2119,18 +3212,130 @@ func main() {
 			LabelScope:     *labelScope,
 			LabelContext:   labelScopeContext,
 			Plan:           plan,
+			Isolation:      isolation,
+			OpenOnly:       *planOpenOnly,
+			PrunedClosed:   len(issues) - len(planIssues),
 			UsageHints: []string{
 				"jq '.plan.tracks | length' - Number of parallel execution tracks",
 				"jq '.plan.tracks[0].items | map(.id)' - First track item IDs",
 				"jq '.plan.tracks[].items[] | select(.unblocks | length > 0)' - Items that unblock others",
+				"jq '.plan.track_order | map(.track_id)' - Tracks in dependency order",
+				"jq '.plan.summary.riskiest_track' - Track most likely to slip",
+				"jq '.plan.tracks | sort_by(-.risk) | map({track_id, risk, risk_factors})' - Tracks by risk",
 				"jq '.plan.summary' - High-level execution summary",
+				"jq '.plan.recommended_focus | map({id, unblocks_count})' - The few picks that free the most blocked work",
+				"jq '.plan.frontier[0]' - The single ready task that frees the most work right away",
+				"jq '.plan.frontier | map(select(.cross_project > 0)) | map(.id)' - Ready tasks that unblock other projects",
 				"jq '[.plan.tracks[].items[]] | length' - Total items across all tracks",
+				"jq '.plan.start_schedule.issues | group_by(.start_step) | map(map(.id))' - Open issues batched into waves",
+				"jq '.plan.start_schedule.cycles' - Blocking cycles that keep issues unscheduled",
+				"jq '.plan.parallel_schedule.lanes | map({lane_id, ids: [.items[].id]})' - Per-lane issue order (with --max-parallel N)",
+				"jq '.plan.unestimated' - Open issues without an estimate (durations for them are assumed)",
+				"jq '.plan.tracks[] | select(.unestimated) | .track_id' - Tracks resting on assumed estimates",
+				"jq '[.plan.parallel_schedule.lanes[].items[] | select(.estimate_source != \"explicit\")]' - Scheduled items using the assumed estimate",
 			},
 		}
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		if *robotStream {
+			// Tracks go in the header without their items; each item is a
+			// record naming its track
+			type trackHeader struct {
+				TrackID     string             `json:"track_id"`
+				Reason      string             `json:"reason"`
+				Risk        float64            `json:"risk"`
+				RiskFactors analysis.TrackRisk `json:"risk_factors"`
+				ItemCount   int                `json:"item_count"`
+				Unestimated []string           `json:"unestimated,omitempty"`
+			}
+			type planStreamItem struct {
+				TrackID string `json:"track_id"`
+				analysis.PlanItem
+			}
+			type laneStreamItem struct {
+				LaneID string `json:"lane_id"`
+				analysis.ScheduledIssue
+			}
+			type parallelHeader struct {
+				MaxParallel    int      `json:"max_parallel"`
+				TotalMinutes   int      `json:"total_minutes"`
+				WorkMinutes    int      `json:"work_minutes"`
+				AssumedMinutes int      `json:"assumed_minutes"`
+				AssumedFrom    string   `json:"assumed_from"`
+				LaneCount      int      `json:"lane_count"`
+				Unscheduled    []string `json:"unscheduled,omitempty"`
+			}
+			tracks := make([]trackHeader, 0, len(plan.Tracks))
+			var items []planStreamItem
+			for _, track := range plan.Tracks {
+				tracks = append(tracks, trackHeader{track.TrackID, track.Reason, track.Risk, track.RiskFactors, len(track.Items), track.Unestimated})
+				for _, item := range track.Items {
+					items = append(items, planStreamItem{track.TrackID, item})
+				}
+			}
+			var parallel *parallelHeader
+			var laneItems []laneStreamItem
+			if ps := plan.ParallelSchedule; ps != nil {
+				parallel = &parallelHeader{ps.MaxParallel, ps.TotalMinutes, ps.WorkMinutes, ps.AssumedMinutes, ps.AssumedFrom, len(ps.Lanes), ps.Unscheduled}
+				for _, lane := range ps.Lanes {
+					for _, item := range lane.Items {
+						laneItems = append(laneItems, laneStreamItem{lane.LaneID, item})
+					}
+				}
+			}
+			header := struct {
+				GeneratedAt      string                     `json:"generated_at"`
+				DataHash         string                     `json:"data_hash"`
+				AsOf             string                     `json:"as_of,omitempty"`
+				AsOfCommit       string                     `json:"as_of_commit,omitempty"`
+				Status           analysis.MetricStatus      `json:"status"`
+				LabelScope       string                     `json:"label_scope,omitempty"`
+				Tracks           []trackHeader              `json:"tracks"`
+				TrackOrder       []analysis.TrackDependency `json:"track_order"`
+				TotalActionable  int                        `json:"total_actionable"`
+				TotalBlocked     int                        `json:"total_blocked"`
+				Summary          analysis.PlanSummary       `json:"summary"`
+				RecommendedFocus []analysis.FocusPick       `json:"recommended_focus"`
+				Frontier         []analysis.FrontierItem    `json:"frontier"`
+				StartSteps       int                        `json:"start_steps"`
+				StartCycles      [][]string                 `json:"start_cycles,omitempty"`
+				Unscheduled      []string                   `json:"unscheduled,omitempty"`
+				Unestimated      []string                   `json:"unestimated"`
+				UnestimatedCount int                        `json:"unestimated_count"`
+				ParallelSchedule *parallelHeader            `json:"parallel_schedule,omitempty"`
+				Isolation        *analysis.IsolationImpact  `json:"isolation,omitempty"`
+			}{
+				GeneratedAt:      output.GeneratedAt,
+				DataHash:         dataHash,
+				AsOf:             *asOf,
+				AsOfCommit:       asOfResolved,
+				Status:           status,
+				LabelScope:       *labelScope,
+				Tracks:           tracks,
+				TrackOrder:       plan.TrackOrder,
+				TotalActionable:  plan.TotalActionable,
+				TotalBlocked:     plan.TotalBlocked,
+				Summary:          plan.Summary,
+				RecommendedFocus: plan.RecommendedFocus,
+				Frontier:         plan.Frontier,
+				StartSteps:       plan.StartSchedule.Steps,
+				StartCycles:      plan.StartSchedule.Cycles,
+				Unscheduled:      plan.StartSchedule.Unscheduled,
+				Unestimated:      plan.Unestimated,
+				UnestimatedCount: plan.UnestimatedCount,
+				ParallelSchedule: parallel,
+				Isolation:        isolation,
+			}
+			sections := []streamSection{streamOf("item", items), streamOf("start_step", plan.StartSchedule.Issues)}
+			if parallel != nil {
+				sections = append(sections, streamOf("lane_item", laneItems))
+			}
+			if err := encodeRobotStream(os.Stdout, header, sections...); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding execution plan: %v\n", err)
+				os.Exit(1)
+			}
+			os.Exit(0)
+		}
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding execution plan: %v\n", err)
 			os.Exit(1)
 		}
@@ -2152,6 +3357,11 @@ func main() {
 		recommendations := analyzer.GenerateEnhancedRecommendations()
 
 		// Apply robot filters (bv-84)
+		if err := analysis.ValidateAssigneePattern(*robotByAssignee); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --robot-by-assignee %q: %v\n", *robotByAssignee, err)
+			os.Exit(1)
+		}
+		assigneeGlob := analysis.IsAssigneeGlob(*robotByAssignee)
 		filtered := make([]analysis.EnhancedPriorityRecommendation, 0, len(recommendations))
 		issueMap := make(map[string]model.Issue, len(issues))
 		for _, iss := range issues {
@@ -2179,10 +3389,14 @@ func main() {
 					continue
 				}
 			}
-			// Filter by assignee
+			// Filter by assignee (exact, or glob)
 			if *robotByAssignee != "" {
 				if iss, ok := issueMap[rec.IssueID]; ok {
-					if iss.Assignee != *robotByAssignee {
+					if assigneeGlob {
+						if !analysis.MatchAssigneeGlob(*robotByAssignee, iss.Assignee) {
+							continue
+						}
+					} else if iss.Assignee != *robotByAssignee {
 						continue
 					}
 				} else {
@@ -2193,6 +3407,16 @@ func main() {
 		}
 		recommendations = filtered
 
+		// A glob can match many people; report who holds the matches
+		var byAssignee map[string]int
+		if assigneeGlob {
+			ids := make([]string, len(recommendations))
+			for i, rec := range recommendations {
+				ids[i] = rec.IssueID
+			}
+			byAssignee = analysis.CountByAssignee(issues, ids)
+		}
+
 		// Apply max results limit
 		maxResults := 10 // Default cap
 		if *robotMaxResults > 0 {
@@ -2229,9 +3453,10 @@ func main() {
 				ByAssignee    string  `json:"by_assignee,omitempty"`
 			} `json:"filters"`
 			Summary struct {
-				TotalIssues     int `json:"total_issues"`
-				Recommendations int `json:"recommendations"`
-				HighConfidence  int `json:"high_confidence"`
+				TotalIssues     int            `json:"total_issues"`
+				Recommendations int            `json:"recommendations"`
+				HighConfidence  int            `json:"high_confidence"`
+				ByAssignee      map[string]int `json:"by_assignee,omitempty"` // Per-person counts when --robot-by-assignee is a glob
 			} `json:"summary"`
 			Usage []string `json:"usage_hints"` // bv-84: Agent-friendly hints
 		}{
@@ -2253,6 +3478,7 @@ func main() {
 				"--robot-min-confidence 0.6 - Pre-filter by confidence",
 				"--robot-max-results 5 - Limit to top N results",
 				"--robot-by-label bug - Filter by specific label",
+				"--robot-by-assignee 'team-backend/*' - Glob over assignees; summary.by_assignee gives per-person counts",
 			},
 		}
 		output.Filters.MinConfidence = *robotMinConf
@@ -2262,10 +3488,9 @@ func main() {
 		output.Summary.TotalIssues = len(issues)
 		output.Summary.Recommendations = len(recommendations)
 		output.Summary.HighConfidence = highConfidence
+		output.Summary.ByAssignee = byAssignee
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding priority recommendations: %v\n", err)
 			os.Exit(1)
 		}
@@ -2274,13 +3499,55 @@ func main() {
 
 	if *robotTriage || *robotNext || *robotTriageByTrack || *robotTriageByLabel {
 		// bv-87: Support track/label-aware grouping for multi-agent coordination
+		if *triageTop < 1 {
+			fmt.Fprintf(os.Stderr, "Error: invalid --top %d (expected 1 or more)\n", *triageTop)
+			os.Exit(1)
+		}
+		if *perProjectTop < 0 {
+			fmt.Fprintf(os.Stderr, "Error: invalid --per-project-top %d (expected 0 or more)\n", *perProjectTop)
+			os.Exit(1)
+		}
 		opts := analysis.TriageOptions{
 			GroupByTrack:  *robotTriageByTrack,
 			GroupByLabel:  *robotTriageByLabel,
 			WaitForPhase2: true, // Triage needs full graph metrics
+			WIPLimits:     wipLimitsFrom(displayCfg),
+			AgeBuckets:    ageBucketsFrom(displayCfg),
+
+			TopN:                     *triageTop,
+			PerProjectTopN:           *perProjectTop,
+			MaxRecommendationAgeDays: maxRecommendationAgeFrom(displayCfg),
+			OnlyIDs:                  sinceIDs,
+		}
+		quickRefFields, err := quickRefFieldsFrom(displayCfg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s: %v\n", config.DisplayConfigPath(), err)
+			os.Exit(1)
+		}
+		opts.QuickRefFields = quickRefFields
+		// Status history splits age into stalled/first-response (single repo
+		// only); scanning it is slow, so only on request
+		if *triageHistory && beadsPath != "" {
+			opts.StatusChanges = loadStatusChanges(beadsPath)
 		}
 		triage := analysis.ComputeTriageWithOptions(issues, opts)
 
+		var delta *analysis.TriageDelta
+		if *triageBaseline != "" {
+			data, err := os.ReadFile(*triageBaseline)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading baseline: %v\n", err)
+				os.Exit(1)
+			}
+			bl, err := analysis.ParseTriageBaseline(data)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error parsing baseline %s: %v\n", *triageBaseline, err)
+				os.Exit(1)
+			}
+			d := analysis.ComputeTriageDelta(bl, triage, issues)
+			delta = &d
+		}
+
 		// bv-90: Load feedback data for output
 		var feedbackInfo *analysis.FeedbackJSON
 		if robotTriageBeadsDir, err := loader.GetBeadsDir(""); err == nil {
@@ -2306,9 +3573,7 @@ func main() {
 					AsOfCommit:  asOfResolved,
 					Message:     "No actionable items available",
 				}
-				encoder := json.NewEncoder(os.Stdout)
-				encoder.SetIndent("", "  ")
-				if err := encoder.Encode(output); err != nil {
+				if err := encodeRobotJSON(os.Stdout, output); err != nil {
 					fmt.Fprintf(os.Stderr, "Error encoding robot-next: %v\n", err)
 					os.Exit(1)
 				}
@@ -2342,22 +3607,61 @@ func main() {
 				ShowCmd:     fmt.Sprintf("bd show %s", top.ID),
 			}
 
-			encoder := json.NewEncoder(os.Stdout)
-			encoder.SetIndent("", "  ")
-			if err := encoder.Encode(output); err != nil {
+			if err := encodeRobotJSON(os.Stdout, output); err != nil {
 				fmt.Fprintf(os.Stderr, "Error encoding robot-next: %v\n", err)
 				os.Exit(1)
 			}
 			os.Exit(0)
 		}
 
-		// Full triage output with usage hints
-		output := struct {
+		if *robotStream {
+			header := struct {
+				GeneratedAt            string                              `json:"generated_at"`
+				DataHash               string                              `json:"data_hash"`
+				AsOf                   string                              `json:"as_of,omitempty"`
+				AsOfCommit             string                              `json:"as_of_commit,omitempty"`
+				Meta                   analysis.TriageMeta                 `json:"meta"`
+				QuickRef               analysis.QuickRef                   `json:"quick_ref"`
+				ProjectHealth          analysis.ProjectHealth              `json:"project_health"`
+				DataQuality            analysis.DataQuality                `json:"data_quality"`
+				Alerts                 []analysis.Alert                    `json:"alerts,omitempty"`
+				RecommendationsByTrack []analysis.TrackRecommendationGroup `json:"recommendations_by_track,omitempty"`
+				RecommendationsByLabel []analysis.LabelRecommendationGroup `json:"recommendations_by_label,omitempty"`
+				Delta                  *analysis.TriageDelta               `json:"delta,omitempty"`
+				Feedback               *analysis.FeedbackJSON              `json:"feedback,omitempty"`
+			}{
+				GeneratedAt:            time.Now().UTC().Format(time.RFC3339),
+				DataHash:               dataHash,
+				AsOf:                   *asOf,
+				AsOfCommit:             asOfResolved,
+				Meta:                   triage.Meta,
+				QuickRef:               triage.QuickRef,
+				ProjectHealth:          triage.ProjectHealth,
+				DataQuality:            triage.DataQuality,
+				Alerts:                 triage.Alerts,
+				RecommendationsByTrack: triage.RecommendationsByTrack,
+				RecommendationsByLabel: triage.RecommendationsByLabel,
+				Delta:                  delta,
+				Feedback:               feedbackInfo,
+			}
+			if err := encodeRobotStream(os.Stdout, header,
+				streamOf("recommendation", triage.Recommendations),
+				streamOf("quick_win", triage.QuickWins),
+				streamOf("blocker", triage.BlockersToClear)); err != nil {
+				fmt.Fprintf(os.Stderr, "Error encoding robot-triage: %v\n", err)
+				os.Exit(1)
+			}
+			os.Exit(0)
+		}
+
+		// Full triage output with usage hints
+		output := struct {
 			GeneratedAt string                 `json:"generated_at"`
 			DataHash    string                 `json:"data_hash"`
 			AsOf        string                 `json:"as_of,omitempty"`        // Historical snapshot ref (e.g., HEAD~30)
 			AsOfCommit  string                 `json:"as_of_commit,omitempty"` // Resolved commit SHA
 			Triage      analysis.TriageResult  `json:"triage"`
+			Delta       *analysis.TriageDelta  `json:"delta,omitempty"`    // Changes since --baseline
 			Feedback    *analysis.FeedbackJSON `json:"feedback,omitempty"` // bv-90: Feedback loop state
 			UsageHints  []string               `json:"usage_hints"`        // bv-84: Agent-friendly hints
 		}{
@@ -2366,6 +3670,7 @@ func main() {
 			AsOf:        *asOf,
 			AsOfCommit:  asOfResolved,
 			Triage:      triage,
+			Delta:       delta,
 			Feedback:    feedbackInfo,
 			UsageHints: []string{
 				"jq '.triage.quick_ref.top_picks[:3]' - Top 3 picks for immediate work",
@@ -2380,11 +3685,11 @@ func main() {
 				"jq '.triage.recommendations_by_track[].top_pick' - Top pick per track",
 				"jq '.triage.recommendations_by_label[].claim_command' - Claim commands per label",
 				"jq '.feedback.weight_adjustments' - View feedback-adjusted weights (bv-90)",
+				"jq '.triage.recommendations[] | select(.stalled_days == .age_days)' - Untouched since filed (needs --triage-history)",
+				"jq '.triage.alerts[]? | select(.type == \"wip\")' - WIP limit breaches (wip_limit in display.yaml)",
 			},
 		}
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding robot-triage: %v\n", err)
 			os.Exit(1)
 		}
@@ -2666,9 +3971,7 @@ func main() {
 		}
 
 		// Output JSON
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(report); err != nil {
+		if err := encodeRobotJSON(os.Stdout, report); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding history report: %v\n", err)
 			os.Exit(1)
 		}
@@ -2703,9 +4006,7 @@ func main() {
 				os.Exit(1)
 			}
 			// Output single sprint as JSON
-			encoder := json.NewEncoder(os.Stdout)
-			encoder.SetIndent("", "  ")
-			if err := encoder.Encode(found); err != nil {
+			if err := encodeRobotJSON(os.Stdout, found); err != nil {
 				fmt.Fprintf(os.Stderr, "Error encoding sprint: %v\n", err)
 				os.Exit(1)
 			}
@@ -2720,9 +4021,7 @@ func main() {
 				SprintCount: len(sprints),
 				Sprints:     sprints,
 			}
-			encoder := json.NewEncoder(os.Stdout)
-			encoder.SetIndent("", "  ")
-			if err := encoder.Encode(output); err != nil {
+			if err := encodeRobotJSON(os.Stdout, output); err != nil {
 				fmt.Fprintf(os.Stderr, "Error encoding sprints: %v\n", err)
 				os.Exit(1)
 			}
@@ -2783,9 +4082,7 @@ func main() {
 			burndown.ScopeChanges = scopeChanges
 		}
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(burndown); err != nil {
+		if err := encodeRobotJSON(os.Stdout, burndown); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding burndown: %v\n", err)
 			os.Exit(1)
 		}
@@ -2940,9 +4237,7 @@ func main() {
 			output.Filters = filters
 		}
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if outputErr = encoder.Encode(output); outputErr != nil {
+		if outputErr = encodeRobotJSON(os.Stdout, output); outputErr != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding forecast: %v\n", outputErr)
 			os.Exit(1)
 		}
@@ -3146,9 +4441,7 @@ func main() {
 		// Suppress unused variable warning
 		_ = medianMinutes
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		if err := encodeRobotJSON(os.Stdout, output); err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding capacity: %v\n", err)
 			os.Exit(1)
 		}
@@ -3169,6 +4462,7 @@ func main() {
 		}
 
 		gitLoader := loader.NewGitLoader(cwd)
+		gitLoader.SetParseOptions(parseOpts)
 
 		// Load historical issues
 		historicalIssues, err := gitLoader.LoadAt(*diffSince)
@@ -3210,9 +4504,7 @@ func main() {
 				Diff:             diff,
 			}
 
-			encoder := json.NewEncoder(os.Stdout)
-			encoder.SetIndent("", "  ")
-			if err := encoder.Encode(output); err != nil {
+			if err := encodeRobotJSON(os.Stdout, output); err != nil {
 				fmt.Fprintf(os.Stderr, "Error encoding diff: %v\n", err)
 				os.Exit(1)
 			}
@@ -3231,7 +4523,20 @@ func main() {
 		}
 
 		// Launch TUI with historical issues (already loaded, no live reload)
+		if *refresh > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: --refresh is ignored when --as-of is specified\n")
+		}
+		if *notify {
+			fmt.Fprintf(os.Stderr, "Warning: --notify is ignored when --as-of is specified\n")
+		}
+		applyThemeFile(*themeFile)
 		m := ui.NewModel(issues, activeRecipe, "")
+		m.SetParseOptions(parseOpts)
+		applyDisplayConfig(&m, displayCfg, displayErr, *sortFlag, nil)
+		if tuiView != nil {
+			m.ApplySavedView(*viewName, *tuiView)
+		}
+		m.SetLabelGroupByPrefix(*labelGroupByPrefix)
 		p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseCellMotion())
 
 		// Optional auto-quit for automated tests: set BV_TUI_AUTOCLOSE_MS
@@ -3254,6 +4559,44 @@ func main() {
 		os.Exit(0)
 	}
 
+	if *dumpIssues {
+		// Report a closed pipe (bv --dump-issues | head) as a write error
+		// instead of dying on SIGPIPE, then treat it as success.
+		signal.Ignore(syscall.SIGPIPE)
+		dumped := issues
+		if sinceIDs != nil {
+			dumped = filterByIDs(issues, sinceIDs)
+		}
+		if err := export.WriteJSONL(os.Stdout, dumped); err != nil && !export.IsBrokenPipe(err) {
+			fmt.Fprintf(os.Stderr, "Error dumping issues: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	if *exportGitHub {
+		if err := export.WriteGitHubImport(os.Stdout, issues); err != nil {
+			fmt.Fprintf(os.Stderr, "Error exporting GitHub issues: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	// Handle --snapshot: every export format in one directory
+	if *snapshotDir != "" {
+		fmt.Printf("Writing snapshot of %d issues to %s/...\n", len(issues), *snapshotDir)
+		files, err := writeSnapshot(*snapshotDir, issues, dataHash, displayCfg)
+		for _, name := range files {
+			fmt.Printf("  → %s\n", name)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing snapshot: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("Done!")
+		os.Exit(0)
+	}
+
 	if *exportFile != "" {
 		fmt.Printf("Exporting to %s...\n", *exportFile)
 
@@ -3316,8 +4659,30 @@ func main() {
 	}
 
 	// Initial Model with live reload support
+	applyThemeFile(*themeFile)
 	m := ui.NewModel(issues, activeRecipe, beadsPath)
 	defer m.Stop() // Clean up file watcher
+	m.SetParseOptions(parseOpts)
+	applyDisplayConfig(&m, displayCfg, displayErr, *sortFlag, pinUniverse)
+	if tuiView != nil {
+		m.ApplySavedView(*viewName, *tuiView)
+	}
+	m.SetLabelGroupByPrefix(*labelGroupByPrefix)
+	m.SetAllowWrite(*allowWrite)
+	if ignoreIssue != nil {
+		m.SetIgnoreFilter(ignoreIssue, ignoredCount)
+	}
+	m.EnableAutoRefresh(*refresh, reloadIssues)
+	if sinceScope != nil {
+		m.SetViewScope(sinceScope)
+	}
+	if *notify {
+		if *notifyPriority < 0 || *notifyPriority > 4 {
+			fmt.Fprintf(os.Stderr, "Error: invalid --notify-priority %d (expected 0-4)\n", *notifyPriority)
+			os.Exit(1)
+		}
+		m.EnableBlockedNotify(*notifyPriority)
+	}
 
 	// Enable workspace mode if loading from workspace config or multi-project
 	if workspaceInfo != nil {
@@ -3328,7 +4693,38 @@ func main() {
 			TotalIssues:  workspaceInfo.TotalIssues,
 			RepoPrefixes: workspaceInfo.RepoPrefixes,
 			ProjectPaths: projectPathsMap,
+			ProjectTags:  projectTags,
+			RepoColors:   repoColors,
+
+			UnloadedProjects: savedDisabled,
 		})
+		if projectsFromSaved {
+			// Project Manager edits go back to the saved profile when
+			// autosave_projects is on; the manager shows loaded projects by
+			// prefix and the rest (no prefix) by path
+			shown := make(map[string]string, len(projectPathsMap))
+			for _, repo := range projectConfigs {
+				if _, ok := projectPathsMap[repo.GetPrefix()]; ok {
+					shown[repo.GetPrefix()] = repo.Path
+				}
+			}
+			var unloaded []string
+			for _, e := range savedDisabled {
+				unloaded = append(unloaded, e.Path)
+			}
+			m.SetProjectsSaver(func(entries []ui.ProjectEntry) error {
+				saved, err := config.LoadProjectsProfile(*profile)
+				if err != nil {
+					return err
+				}
+				listed := applyProjectManagerEntries(saved, shown, unloaded, entries, config.PathMode(*projectPathMode))
+				if err := config.SaveProjectsProfile(saved, *profile); err != nil {
+					return err
+				}
+				unloaded = listed
+				return nil
+			})
+		}
 	}
 
 	// Run Program
@@ -3353,12 +4749,790 @@ func main() {
 	}
 }
 
+// applyDisplayConfig applies user display preferences (~/.config/bv/display.yaml,
+// loaded as displayCfg) to the TUI model. A load error (err) is reported but
+// non-fatal.
+// A non-empty sortKey (--sort) overrides the saved sort order for this session;
+// sort changes made in the TUI are saved back to display.yaml. Pins for
+// issues missing from allIssues are dropped; nil skips that cleanup.
+func applyDisplayConfig(m *ui.Model, displayCfg *config.DisplayConfig, err error, sortKey string, allIssues []model.Issue) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to load display config: %v\n", err)
+	} else {
+		if allIssues != nil {
+			prunePins(displayCfg, allIssues)
+		}
+		m.SetDisplayConfig(*displayCfg)
+		m.SetDisplaySaver(func(cfg config.DisplayConfig) error {
+			return config.SaveDisplay(&cfg)
+		})
+	}
+	if sortKey != "" {
+		reverse := displayCfg != nil && displayCfg.SortReverse
+		m.SetSortMode(ui.SortModeFromKey(config.SortKey(sortKey)), reverse)
+	}
+}
+
+// applyThemeFile loads custom TUI colors from path (--theme-file), or from
+// ~/.config/bv/theme.yaml when path is empty and that file exists. An
+// explicit file that cannot be read is fatal; bad roles or colors are
+// reported and keep their defaults.
+func applyThemeFile(path string) {
+	explicit := path != ""
+	if !explicit {
+		path = config.ThemeFilePath()
+	}
+	colors, err := config.LoadThemeFile(path)
+	if err != nil {
+		if explicit {
+			fmt.Fprintf(os.Stderr, "Error loading theme file: %v\n", err)
+			os.Exit(1)
+		}
+		if !os.IsNotExist(err) {
+			fmt.Fprintf(os.Stderr, "Warning: failed to load theme file: %v\n", err)
+		}
+		return
+	}
+	for _, problem := range ui.SetThemeColors(colors) {
+		fmt.Fprintf(os.Stderr, "Warning: %s: %s\n", path, problem)
+	}
+}
+
+// initOptions controls what bv init writes.
+type initOptions struct {
+	Force  bool // Overwrite an existing .beads/beads.jsonl and .bv.yaml
+	Sample bool // Seed beads.jsonl with one example issue instead of leaving it empty
+	Config bool // Also write a project-local .bv.yaml
+}
+
+// runNormalize implements --normalize PATH. PATH is a beads file, a .beads
+// directory, or a project directory containing one. Without write it only
+// reports what would change.
+func runNormalize(path string, write bool, stdout, stderr io.Writer) int {
+	file := path
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		beadsDir := path
+		if sub, err := os.Stat(filepath.Join(path, ".beads")); err == nil && sub.IsDir() {
+			beadsDir = filepath.Join(path, ".beads")
+		}
+		file, err = loader.FindJSONLPath(beadsDir)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
+	summary, err := loader.NormalizeFile(file, write)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error normalizing %s: %v\n", file, err)
+		return 1
+	}
+	if !summary.Changed {
+		fmt.Fprintf(stdout, "%s is already normalized (%d records)\n", file, summary.Records)
+		return 0
+	}
+
+	var changes []string
+	if summary.Sorted {
+		changes = append(changes, "sorted records by ID")
+	}
+	if summary.Reformatted > 0 {
+		changes = append(changes, fmt.Sprintf("reformatted %d records", summary.Reformatted))
+	}
+	if summary.DuplicateEdges > 0 {
+		changes = append(changes, fmt.Sprintf("merged %d duplicate dependencies", summary.DuplicateEdges))
+	}
+	if summary.SelfEdges > 0 {
+		changes = append(changes, fmt.Sprintf("dropped %d self-dependencies", summary.SelfEdges))
+	}
+	if len(changes) == 0 {
+		changes = append(changes, "normalized line endings and spacing")
+	}
+	if !write {
+		fmt.Fprintf(stdout, "Would normalize %s (%d records): %s\n", file, summary.Records, strings.Join(changes, ", "))
+		fmt.Fprintln(stdout, "Nothing written; re-run with --allow-write to rewrite the file.")
+		return 0
+	}
+	fmt.Fprintf(stdout, "Normalized %s (%d records): %s\n", file, summary.Records, strings.Join(changes, ", "))
+	return 0
+}
+
+// runInit implements `bv init [DIR]`: it scaffolds .beads/beads.jsonl in DIR
+// (default: the current directory) and reports what it created on stderr.
+func runInit(args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("init", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var opts initOptions
+	fs.BoolVar(&opts.Force, "force", false, "Overwrite an existing .beads/beads.jsonl (and .bv.yaml with --config)")
+	fs.BoolVar(&opts.Sample, "sample", false, "Add an example issue to beads.jsonl")
+	fs.BoolVar(&opts.Config, "config", false, "Also create a project-local "+config.ProjectLocalFileName)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: bv init [--force] [--sample] [--config] [DIR]")
+		fs.PrintDefaults()
+	}
+
+	// Accept flags before or after DIR.
+	var dirs []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return 0
+			}
+			return 2
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		dirs = append(dirs, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(dirs) > 1 {
+		fmt.Fprintf(stderr, "Error: bv init takes at most one directory, got %d\n", len(dirs))
+		return 2
+	}
+	dir := "."
+	if len(dirs) == 1 {
+		dir = dirs[0]
+	}
+
+	created, err := initBeadsDir(dir, opts)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	for _, path := range created {
+		fmt.Fprintf(stderr, "Created %s\n", path)
+	}
+	fmt.Fprintln(stderr, "\nNext steps:")
+	openCmd := "bv"
+	if dir != "." {
+		openCmd = "cd " + dir + " && bv"
+	}
+	fmt.Fprintf(stderr, "  %-29s# add issues with the beads CLI\n", `bd create "My first issue"`)
+	fmt.Fprintf(stderr, "  %-29s# open the viewer\n", openCmd)
+	return 0
+}
+
+// initBeadsDir writes .beads/beads.jsonl (and optionally .bv.yaml) under dir
+// and returns the absolute paths it wrote. Existing files are left alone and
+// reported as an error unless opts.Force is set; nothing is written then.
+func initBeadsDir(dir string, opts initOptions) ([]string, error) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	beadsDir := filepath.Join(absDir, ".beads")
+	jsonlPath := filepath.Join(beadsDir, "beads.jsonl")
+	configPath := config.ProjectLocalConfigPath(absDir)
+
+	if !opts.Force {
+		if _, err := os.Stat(beadsDir); err == nil {
+			return nil, fmt.Errorf("%s already exists (use --force to overwrite)", beadsDir)
+		}
+		if opts.Config {
+			if _, err := os.Stat(configPath); err == nil {
+				return nil, fmt.Errorf("%s already exists (use --force to overwrite)", configPath)
+			}
+		}
+	}
+
+	name := strings.ToLower(filepath.Base(absDir))
+	var jsonl []byte
+	if opts.Sample {
+		now := time.Now().UTC().Truncate(time.Second)
+		sample := model.Issue{
+			ID:          name + "-1",
+			Title:       "Welcome to beads",
+			Description: "An example issue created by bv init. Close or delete it once you have real work tracked.",
+			Status:      model.StatusOpen,
+			Priority:    2,
+			IssueType:   model.TypeTask,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		line, err := model.MarshalJSONL([]model.Issue{sample})
+		if err != nil {
+			return nil, err
+		}
+		jsonl = line
+	}
+
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(jsonlPath, jsonl, 0644); err != nil {
+		return nil, err
+	}
+	created := []string{jsonlPath}
+
+	if opts.Config {
+		if err := os.WriteFile(configPath, []byte(projectLocalTemplate(name)), 0644); err != nil {
+			return created, err
+		}
+		created = append(created, configPath)
+	}
+	return created, nil
+}
+
+// projectLocalTemplate is the starter .bv.yaml written by bv init --config.
+func projectLocalTemplate(name string) string {
+	return fmt.Sprintf(`# Project settings for bv, checked in next to .beads.
+# Your own projects.yaml entry overrides prefix, color and tags.
+
+# ID prefix used when this project is loaded alongside others.
+prefix: %q
+
+# Repo badge color: a hex value or an ANSI color number.
+# color: "#4ECDC4"
+
+# Free-form tags shown in the project manager.
+# tags: [backend]
+
+# View the TUI opens with when this is the only project loaded.
+# filters:
+#   status: [open, in_progress]
+#   sort: priority
+`, name+"-")
+}
+
+// wipLimitsFrom returns the WIP limits from the display config; nil (an
+// unreadable display.yaml) means no limits.
+func wipLimitsFrom(cfg *config.DisplayConfig) analysis.WIPLimits {
+	if cfg == nil {
+		return analysis.WIPLimits{}
+	}
+	return analysis.WIPLimits{Global: cfg.WIPLimit.Global, PerAssignee: cfg.WIPLimit.PerAssignee}
+}
+
+// ignoreRulesFrom returns a matcher for the issues excluded by the display
+// config's ignore_statuses and ignore_labels, or nil if nothing is ignored.
+func ignoreRulesFrom(cfg *config.DisplayConfig) func(*model.Issue) bool {
+	if cfg == nil || (len(cfg.IgnoreStatuses) == 0 && len(cfg.IgnoreLabels) == 0) {
+		return nil
+	}
+	return func(issue *model.Issue) bool {
+		return cfg.Ignores(string(issue.Status), issue.Labels)
+	}
+}
+
+// parseOptionsFrom returns the loader options set by the display config:
+// the default_priority given to issues loaded with a missing or invalid
+// priority, the minutes_per_story_point for issues with only
+// story_points, and the ignore_statuses, so issues using custom ones such
+// as "wontfix" load (and are counted as ignored) instead of being skipped.
+func parseOptionsFrom(cfg *config.DisplayConfig) loader.ParseOptions {
+	var opts loader.ParseOptions
+	if cfg != nil {
+		opts.DefaultPriority = cfg.DefaultPriority
+		opts.MinutesPerStoryPoint = cfg.MinutesPerStoryPoint
+		opts.Statuses = toStatuses(cfg.IgnoreStatuses)
+	}
+	return opts
+}
+
+// toStatuses converts status names from the display config, dropping
+// empty ones.
+func toStatuses(names []string) []model.Status {
+	var statuses []model.Status
+	for _, s := range names {
+		if s != "" {
+			statuses = append(statuses, model.Status(s))
+		}
+	}
+	return statuses
+}
+
+// unsuccessfulStatusesFrom returns the display config's
+// unsuccessful_statuses, or the built-in list when none are set or cfg is
+// nil.
+func unsuccessfulStatusesFrom(cfg *config.DisplayConfig) []string {
+	if cfg != nil && len(cfg.UnsuccessfulStatuses) > 0 {
+		return cfg.UnsuccessfulStatuses
+	}
+	return analysis.DefaultUnsuccessfulStatuses
+}
+
+// dropIgnored removes issues matched by ignore and reports how many it removed.
+func dropIgnored(issues []model.Issue, ignore func(*model.Issue) bool) ([]model.Issue, int) {
+	kept := make([]model.Issue, 0, len(issues))
+	for i := range issues {
+		if !ignore(&issues[i]) {
+			kept = append(kept, issues[i])
+		}
+	}
+	return kept, len(issues) - len(kept)
+}
+
+// quickRefFieldsFrom returns the triage quick_ref field selection from the
+// display config; nil means the default fields. Unknown field names are an
+// error.
+func quickRefFieldsFrom(cfg *config.DisplayConfig) ([]string, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	fields := cfg.Triage.QuickRefFields
+	if err := analysis.ValidateQuickRefFields(fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// ageBucketsFrom returns the triage age histogram buckets from the display
+// config; nil means the default buckets.
+func ageBucketsFrom(cfg *config.DisplayConfig) []int {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.AgeBuckets
+}
+
+// planOptionsFrom returns the plan settings from the display config; nil
+// means the defaults.
+func planOptionsFrom(cfg *config.DisplayConfig) analysis.PlanOptions {
+	if cfg == nil {
+		return analysis.PlanOptions{}
+	}
+	return analysis.PlanOptions{IgnoreRelated: !cfg.Plan.GroupsRelated(), SplitProjects: cfg.Plan.SplitProjects}
+}
+
+// maxRecommendationAgeFrom returns triage.max_recommendation_age_days from
+// the display config; nil means no cap.
+func maxRecommendationAgeFrom(cfg *config.DisplayConfig) int {
+	if cfg == nil {
+		return 0
+	}
+	return cfg.Triage.MaxRecommendationAgeDays
+}
+
+// staleCountsCommentsFrom reports whether the display config counts
+// comments as activity for --robot-stale; nil leaves it off.
+func staleCountsCommentsFrom(cfg *config.DisplayConfig) bool {
+	return cfg != nil && cfg.Stale.CountComments
+}
+
+// printValidation writes the --validate report and returns the exit code:
+// 0 when nothing was found, 1 otherwise. Issues that failed validation
+// outright were already skipped by the loader, so only the problems they
+// load with (model.ValidationError warnings, and dependency entries the
+// loader merged) are listed here. statuses are the custom statuses the
+// issues were loaded with.
+func printValidation(w io.Writer, issues []model.Issue, dangling []analysis.DanglingDep, statuses []model.Status) int {
+	type invalidField struct {
+		id  string
+		err model.ValidationError
+	}
+	var invalid []invalidField
+	for i := range issues {
+		for _, verr := range issues[i].Validate(statuses...) {
+			invalid = append(invalid, invalidField{issues[i].ID, verr})
+		}
+	}
+	type mergedDep struct {
+		id  string
+		dep *model.Dependency
+	}
+	var merged []mergedDep
+	for i := range issues {
+		for _, dep := range issues[i].Dependencies {
+			if dep != nil && dep.Merged > 1 {
+				merged = append(merged, mergedDep{issues[i].ID, dep})
+			}
+		}
+	}
+	if len(dangling) == 0 && len(invalid) == 0 && len(merged) == 0 {
+		fmt.Fprintf(w, "✓ No problems found in %d issues\n", len(issues))
+		return 0
+	}
+	if len(invalid) > 0 {
+		fmt.Fprintf(w, "Invalid fields (%d):\n", len(invalid))
+		for _, f := range invalid {
+			fmt.Fprintf(w, "  %s %s: %s\n", f.id, f.err.Field, f.err.Message)
+		}
+	}
+	if len(merged) > 0 {
+		fmt.Fprintf(w, "Duplicate dependencies (%d):\n", len(merged))
+		for _, m := range merged {
+			typ := m.dep.Type
+			if typ == "" {
+				typ = model.DepBlocks
+			}
+			types := []string{string(typ)}
+			if len(m.dep.Types) > 0 {
+				types = types[:0]
+				for _, t := range m.dep.Types {
+					types = append(types, string(t))
+				}
+			}
+			fmt.Fprintf(w, "  %s → %s: %d entries merged (%s)\n", m.id, m.dep.DependsOnID, m.dep.Merged, strings.Join(types, ", "))
+		}
+	}
+	if len(dangling) == 0 {
+		return 1
+	}
+	fmt.Fprintf(w, "Dangling dependencies (%d):\n", len(dangling))
+	for _, d := range dangling {
+		why := "not found"
+		switch {
+		case d.Reason == analysis.DanglingProjectNotLoaded:
+			why = fmt.Sprintf("project %q not loaded", d.TargetProject)
+		case d.TargetProject != "":
+			why = fmt.Sprintf("not found in loaded project %q", d.TargetProject)
+		}
+		fmt.Fprintf(w, "  %s → %s (%s): %s\n", d.IssueID, d.DependsOnID, d.Type, why)
+	}
+	return 1
+}
+
+// printProjectComparison writes one row per project for --compare-projects.
+func printProjectComparison(w io.Writer, projects []analysis.ProjectComparison) {
+	if len(projects) == 0 {
+		fmt.Fprintln(w, "No issues loaded.")
+		return
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROJECT\tOPEN\tCLOSED\tBLOCKED\tAVG AGE\tLABELS (ok/warn/crit)\tLABEL HEALTH\tDEPS\tCROSS-PROJECT")
+	for _, p := range projects {
+		labels := "-"
+		health := "-"
+		if p.Labels.Total > 0 {
+			labels = fmt.Sprintf("%d/%d/%d", p.Labels.Healthy, p.Labels.Warning, p.Labels.Critical)
+			health = strconv.Itoa(p.Labels.AvgHealth)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.1fd\t%s\t%s\t%d\t%d\n",
+			p.Project, p.Open, p.Closed, p.Blocked, p.AvgAgeDays, labels, health, p.DependencyEdges, p.CrossProjectEdges)
+	}
+	tw.Flush()
+}
+
+// printExplanation writes the human-readable --explain dossier.
+func printExplanation(w io.Writer, exp analysis.IssueExplanation) {
+	issue := exp.Issue
+	fmt.Fprintf(w, "%s  %s\n\n", issue.ID, issue.Title)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Status:\t%s\n", issue.Status)
+	fmt.Fprintf(tw, "Priority:\tP%d\n", issue.Priority)
+	fmt.Fprintf(tw, "Type:\t%s\n", issue.IssueType)
+	if issue.Assignee != "" {
+		fmt.Fprintf(tw, "Assignee:\t%s\n", issue.Assignee)
+	}
+	if issue.SourceRepo != "" {
+		fmt.Fprintf(tw, "Repo:\t%s\n", issue.SourceRepo)
+	}
+	ready := "no"
+	if exp.Ready {
+		ready = "yes"
+	}
+	fmt.Fprintf(tw, "Ready:\t%s (%s)\n", ready, exp.ReadyReason)
+	age := fmt.Sprintf("%dd old, updated %dd ago", exp.AgeDays, exp.DaysSinceUpdate)
+	if exp.Stale {
+		age += " (stale)"
+	}
+	fmt.Fprintf(tw, "Age:\t%s\n", age)
+	tw.Flush()
+
+	fmt.Fprintf(w, "\nBlockers (%d):\n", len(exp.Blockers))
+	if len(exp.Blockers) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, b := range exp.Blockers {
+		indent := strings.Repeat("  ", b.Depth)
+		via := ""
+		if b.Via != "" {
+			via = "  via " + b.Via
+		}
+		if b.Missing {
+			fmt.Fprintf(w, "%s%s  (not loaded)%s\n", indent, b.ID, via)
+			continue
+		}
+		fmt.Fprintf(w, "%s%s  [%s]  %s%s\n", indent, b.ID, b.Status, b.Title, via)
+	}
+
+	fmt.Fprintf(w, "\nDependents (%d):\n", len(exp.Dependents))
+	if len(exp.Dependents) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, d := range exp.Dependents {
+		fmt.Fprintf(w, "  %s  [%s]  %s  (%s)\n", d.ID, d.Status, d.Title, d.Type)
+	}
+
+	fmt.Fprintf(w, "\nLabels (%d):\n", len(exp.Labels))
+	if len(exp.Labels) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, l := range exp.Labels {
+		fmt.Fprintf(w, "  %s  health %d (%s), %d open\n", l.Label, l.Health, l.HealthLevel, l.OpenCount)
+	}
+
+	if desc := strings.TrimSpace(issue.Description); desc != "" {
+		fmt.Fprintf(w, "\nDescription:\n%s\n", desc)
+	}
+}
+
+// prunePins removes pins for issues that no longer exist and saves the
+// display config if anything changed.
+func prunePins(cfg *config.DisplayConfig, issues []model.Issue) {
+	if len(cfg.Pinned) == 0 {
+		return
+	}
+	ids := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		ids[issue.ID] = true
+	}
+	removed := cfg.PrunePinned(func(id string) bool { return ids[id] })
+	if len(removed) == 0 {
+		return
+	}
+	if err := config.SaveDisplay(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to save display config: %v\n", err)
+	}
+}
+
+// loadStatusChanges reads per-issue status-change times from the git history
+// of the beads file. Returns nil when the working directory is not a git
+// repository or history cannot be read.
+func loadStatusChanges(beadsPath string) map[string][]time.Time {
+	cwd, err := os.Getwd()
+	if err != nil || correlation.ValidateRepository(cwd) != nil {
+		return nil
+	}
+	events, err := correlation.NewExtractor(cwd, beadsPath).Extract(correlation.ExtractOptions{})
+	if err != nil {
+		return nil
+	}
+	return correlation.StatusChangeTimes(events)
+}
+
+// resolveIssueRefFlag rewrites an issue-ID flag value that names an external
+// ID to the matching beads ID. Ambiguous external IDs are a fatal error that
+// lists the candidates; unmatched values are left for the caller to report.
+func resolveIssueRefFlag(issues []model.Issue, name string, value *string) {
+	if *value == "" {
+		return
+	}
+	matches := model.ResolveIssueRef(issues, *value)
+	switch len(matches) {
+	case 0:
+		return
+	case 1:
+		*value = matches[0]
+	default:
+		fmt.Fprintf(os.Stderr, "Error: --%s %q matches %d issues by external ID; use one of:\n", name, *value, len(matches))
+		for _, id := range matches {
+			fmt.Fprintf(os.Stderr, "  %s\n", id)
+		}
+		os.Exit(1)
+	}
+}
+
+// Snapshot file names; fixed so successive snapshots diff cleanly in git.
+const (
+	snapshotIssuesFile  = "issues.jsonl"
+	snapshotDOTFile     = "graph.dot"
+	snapshotMermaidFile = "graph.mmd"
+	snapshotTriageFile  = "triage.json"
+	snapshotPlanFile    = "plan.json"
+	snapshotHealthFile  = "health.json"
+	snapshotMetaFile    = "meta.json"
+)
+
+// writeSnapshot writes the merged issues (sorted by ID), the dependency graph
+// as DOT and Mermaid, and the triage, plan, and health JSON into dir,
+// creating it if needed. Run-specific values (generation time, compute
+// timing) go only in meta.json, so snapshots of unchanged data are
+// byte-identical apart from that file. Returns the file names in the order
+// written.
+func writeSnapshot(dir string, issues []model.Issue, dataHash string, display *config.DisplayConfig) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	var written []string
+	write := func(name string, data []byte) error {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			return err
+		}
+		written = append(written, name)
+		return nil
+	}
+	writeJSON := func(name string, v interface{}) error {
+		var buf bytes.Buffer
+		if err := encodeRobotJSON(&buf, v); err != nil {
+			return fmt.Errorf("encoding %s: %w", name, err)
+		}
+		return write(name, buf.Bytes())
+	}
+
+	sorted := make([]model.Issue, len(issues))
+	copy(sorted, issues)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+	var jsonl bytes.Buffer
+	if err := export.WriteJSONL(&jsonl, sorted); err != nil {
+		return written, err
+	}
+	if err := write(snapshotIssuesFile, jsonl.Bytes()); err != nil {
+		return written, err
+	}
+
+	analyzer := analysis.NewAnalyzer(issues)
+	analyzer.SetPlanOptions(planOptionsFrom(display))
+	stats := analyzer.Analyze()
+	for _, g := range []struct {
+		name   string
+		format export.GraphExportFormat
+	}{
+		{snapshotDOTFile, export.GraphFormatDOT},
+		{snapshotMermaidFile, export.GraphFormatMermaid},
+	} {
+		result, err := export.ExportGraph(issues, &stats, export.GraphExportConfig{Format: g.format, DataHash: dataHash})
+		if err != nil {
+			return written, fmt.Errorf("exporting %s: %w", g.name, err)
+		}
+		if err := write(g.name, []byte(result.Graph)); err != nil {
+			return written, err
+		}
+	}
+
+	quickRefFields, err := quickRefFieldsFrom(display)
+	if err != nil {
+		return written, fmt.Errorf("%s: %w", config.DisplayConfigPath(), err)
+	}
+	triage := analysis.ComputeTriageWithOptions(issues, analysis.TriageOptions{WaitForPhase2: true, WIPLimits: wipLimitsFrom(display), AgeBuckets: ageBucketsFrom(display), QuickRefFields: quickRefFields, MaxRecommendationAgeDays: maxRecommendationAgeFrom(display)})
+	generatedAt, computeTimeMs := triage.Meta.GeneratedAt.UTC().Format(time.RFC3339), triage.Meta.ComputeTimeMs
+	triage.Meta.GeneratedAt, triage.Meta.ComputeTimeMs = time.Time{}, 0
+	if err := writeJSON(snapshotTriageFile, struct {
+		DataHash string                `json:"data_hash"`
+		Triage   analysis.TriageResult `json:"triage"`
+	}{dataHash, triage}); err != nil {
+		return written, err
+	}
+	if err := writeJSON(snapshotPlanFile, struct {
+		DataHash string                 `json:"data_hash"`
+		Plan     analysis.ExecutionPlan `json:"plan"`
+	}{dataHash, analyzer.GetExecutionPlan()}); err != nil {
+		return written, err
+	}
+	if err := writeJSON(snapshotHealthFile, struct {
+		DataHash      string                 `json:"data_hash"`
+		ProjectHealth analysis.ProjectHealth `json:"project_health"`
+	}{dataHash, triage.ProjectHealth}); err != nil {
+		return written, err
+	}
+	if err := writeJSON(snapshotMetaFile, struct {
+		GeneratedAt         string `json:"generated_at"`
+		DataHash            string `json:"data_hash"`
+		TriageComputeTimeMs int64  `json:"triage_compute_time_ms"`
+	}{generatedAt, dataHash, computeTimeMs}); err != nil {
+		return written, err
+	}
+	return written, nil
+}
+
+// savedViewFromFlags builds a saved view from the TUI filter flags,
+// validating each value.
+func savedViewFromFlags(repo, status, priority, issueType, assignee, sortKey, viewType string) (config.SavedView, error) {
+	v := config.SavedView{
+		Repo:     repo,
+		Assignee: strings.TrimSpace(assignee),
+		Sort:     config.SortKey(sortKey),
+		ViewType: config.ViewType(viewType),
+	}
+	if err := analysis.ValidateAssigneePattern(v.Assignee); err != nil {
+		return v, fmt.Errorf("invalid --assignee %q: %w", v.Assignee, err)
+	}
+	for _, s := range splitCommaList(status) {
+		if !model.Status(s).IsValid() {
+			return v, fmt.Errorf("invalid --status %q (expected open, in_progress, blocked, or closed)", s)
+		}
+		v.Status = append(v.Status, s)
+	}
+	for _, s := range splitCommaList(priority) {
+		p, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(s), "P"))
+		if err != nil || p < 0 || p > 4 {
+			return v, fmt.Errorf("invalid --priority %q (expected 0-4)", s)
+		}
+		v.Priority = append(v.Priority, p)
+	}
+	for _, s := range splitCommaList(issueType) {
+		if !model.IssueType(s).IsValid() {
+			return v, fmt.Errorf("invalid --type %q (expected bug, feature, task, epic, or chore)", s)
+		}
+		v.Type = append(v.Type, s)
+	}
+	if viewType != "" && !v.ViewType.IsValid() {
+		return v, fmt.Errorf("invalid --start-view %q (expected list, board, graph, or insights)", viewType)
+	}
+	return v, nil
+}
+
+// mergeSavedView overlays the fields set on the command line onto a saved view.
+func mergeSavedView(saved, flags config.SavedView) config.SavedView {
+	if flags.Repo != "" {
+		saved.Repo = flags.Repo
+	}
+	if len(flags.Status) > 0 {
+		saved.Status = flags.Status
+	}
+	if len(flags.Priority) > 0 {
+		saved.Priority = flags.Priority
+	}
+	if len(flags.Type) > 0 {
+		saved.Type = flags.Type
+	}
+	if len(flags.Resolution) > 0 {
+		saved.Resolution = flags.Resolution
+	}
+	if flags.Assignee != "" {
+		saved.Assignee = flags.Assignee
+	}
+	if flags.Watching != "" {
+		saved.Watching = flags.Watching
+	}
+	if flags.MinDeps > 0 {
+		saved.MinDeps = flags.MinDeps
+	}
+	if flags.MinDependents > 0 {
+		saved.MinDependents = flags.MinDependents
+	}
+	if flags.Sort != "" {
+		saved.Sort = flags.Sort
+	}
+	if flags.ViewType != "" {
+		saved.ViewType = flags.ViewType
+	}
+	return saved
+}
+
+// setDependencyCountFilters validates --min-deps and --min-dependents and
+// stores them on the view.
+func setDependencyCountFilters(v *config.SavedView, minDeps, minDependents int) error {
+	if minDeps < 0 {
+		return fmt.Errorf("invalid --min-deps %d (expected 0 or more)", minDeps)
+	}
+	if minDependents < 0 {
+		return fmt.Errorf("invalid --min-dependents %d (expected 0 or more)", minDependents)
+	}
+	v.MinDeps = minDeps
+	v.MinDependents = minDependents
+	return nil
+}
+
+// splitCommaList splits a comma-separated flag value, dropping empty entries.
+func splitCommaList(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
 // countEdges counts blocking dependencies for config sizing
 func countEdges(issues []model.Issue) int {
 	count := 0
 	for _, issue := range issues {
 		for _, dep := range issue.Dependencies {
-			if dep != nil && dep.Type == model.DepBlocks {
+			if dep != nil && dep.Type.IsBlocking() {
 				count++
 			}
 		}
@@ -3452,28 +5626,178 @@ func printDiffSummary(diff *analysis.SnapshotDiff, since string) {
 		fmt.Println()
 	}
 
-	// New cycles
-	if len(diff.NewCycles) > 0 {
-		fmt.Println("⚠ New Circular Dependencies:")
-		for _, cycle := range diff.NewCycles {
-			fmt.Printf("  %s\n", formatCycle(cycle))
+	// New cycles
+	if len(diff.NewCycles) > 0 {
+		fmt.Println("⚠ New Circular Dependencies:")
+		for _, cycle := range diff.NewCycles {
+			fmt.Printf("  %s\n", formatCycle(cycle))
+		}
+		fmt.Println()
+	}
+
+	// Metric deltas
+	fmt.Println("Metric Changes:")
+	if diff.MetricDeltas.TotalIssues != 0 {
+		fmt.Printf("  Total issues: %+d\n", diff.MetricDeltas.TotalIssues)
+	}
+	if diff.MetricDeltas.OpenIssues != 0 {
+		fmt.Printf("  Open issues: %+d\n", diff.MetricDeltas.OpenIssues)
+	}
+	if diff.MetricDeltas.BlockedIssues != 0 {
+		fmt.Printf("  Blocked issues: %+d\n", diff.MetricDeltas.BlockedIssues)
+	}
+	if diff.MetricDeltas.CycleCount != 0 {
+		fmt.Printf("  Cycles: %+d\n", diff.MetricDeltas.CycleCount)
+	}
+}
+
+// applyProjectManagerEntries updates a saved project config from the
+// Project Manager's list. shown maps the prefix of each loaded project the
+// manager listed to its directory, and unloaded holds the directories of
+// the listed projects that were not loaded (saved as disabled, or added).
+// Listed projects are enabled or disabled to match the manager, new ones
+// are added in mode, and listed ones that are gone are removed. Saved
+// projects the manager never listed (failed to load) are left alone. It
+// returns the unloaded directories still listed, for the next call.
+func applyProjectManagerEntries(saved *config.ProjectsConfig, shown map[string]string, unloaded []string, entries []ui.ProjectEntry, mode config.PathMode) []string {
+	baseDir := saved.BaseDir()
+	kept := make(map[string]bool, len(entries))
+	keptUnloaded := make(map[string]bool, len(entries))
+	var stillUnloaded []string
+	for _, e := range entries {
+		path := e.Path
+		if dir, ok := shown[e.Prefix]; ok && e.Prefix != "" {
+			path = dir
+			kept[e.Prefix] = true
+		} else {
+			keptUnloaded[path] = true
+			stillUnloaded = append(stillUnloaded, path)
+		}
+		saved.AddProject(path, mode, baseDir)
+		p := saved.Find(path, baseDir)
+		if p == nil {
+			continue
+		}
+		if e.IsActive {
+			p.Enabled = nil
+		} else {
+			disabled := false
+			p.Enabled = &disabled
+		}
+	}
+	for prefix, dir := range shown {
+		if !kept[prefix] {
+			saved.RemoveProject(dir, baseDir)
+		}
+	}
+	for _, dir := range unloaded {
+		if !keptUnloaded[dir] {
+			saved.RemoveProject(dir, baseDir)
+		}
+	}
+	return stillUnloaded
+}
+
+// disabledProjectEntries lists the saved projects with enabled: false as
+// Project Manager entries, so they can be switched back on.
+func disabledProjectEntries(saved *config.ProjectsConfig) []ui.ProjectEntry {
+	var entries []ui.ProjectEntry
+	for _, p := range saved.Projects {
+		if p.IsEnabled() {
+			continue
+		}
+		path := config.ResolveProjectPath(p.Path, saved.BaseDir())
+		name := p.Name
+		if name == "" {
+			name = filepath.Base(path)
+		}
+		entries = append(entries, ui.ProjectEntry{Name: name, Path: path, Tags: p.Tags})
+	}
+	return entries
+}
+
+// loadProjectSet loads the current issues of every enabled project in a
+// saved project config, for --diff-config. Unlike the saved project list,
+// a missing file or a project that fails to load is an error: either would
+// show up as removed projects. allowEmpty is as for the main load.
+func loadProjectSet(path string, allowEmpty bool) ([]analysis.ProjectIssues, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("reading project config: %w", err)
+	}
+	saved, err := config.LoadProjectsFrom(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	paths := saved.EnabledPaths(saved.BaseDir())
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no enabled projects in %s", path)
+	}
+	wsConfig, _, err := buildConfigFromPaths(paths, saved)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	setLoader := workspace.NewAggregateLoader(wsConfig, "")
+	setLoader.SetAllowEmpty(allowEmpty)
+	_, results, err := setLoader.LoadAll(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("loading projects from %s: %w", path, err)
+	}
+
+	set := make([]analysis.ProjectIssues, 0, len(results))
+	for i, res := range results {
+		if res.Error != nil {
+			return nil, fmt.Errorf("loading %s from %s: %w", res.RepoName, path, res.Error)
 		}
-		fmt.Println()
+		set = append(set, analysis.ProjectIssues{Name: res.RepoName, Path: wsConfig.Repos[i].Path, Prefix: res.Prefix, Issues: res.Issues})
 	}
+	return set, nil
+}
 
-	// Metric deltas
-	fmt.Println("Metric Changes:")
-	if diff.MetricDeltas.TotalIssues != 0 {
-		fmt.Printf("  Total issues: %+d\n", diff.MetricDeltas.TotalIssues)
+// printProjectSetDiff writes the human-readable --diff-config report.
+func printProjectSetDiff(w io.Writer, diff *analysis.ProjectSetDiff, from, to string) {
+	title := fmt.Sprintf("Changes from %s to %s", from, to)
+	fmt.Fprintln(w, title)
+	fmt.Fprintln(w, repeatChar('=', len(title)))
+	fmt.Fprintln(w)
+
+	s := diff.Summary
+	fmt.Fprintf(w, "Projects: %d added, %d removed, %d changed, %d unchanged\n",
+		s.ProjectsAdded, s.ProjectsRemoved, s.ProjectsChanged, s.ProjectsUnchanged)
+	fmt.Fprintf(w, "Issues:   %d added, %d removed, %d closed, %d reopened, %d modified\n",
+		s.IssuesAdded, s.IssuesRemoved, s.IssuesClosed, s.IssuesReopened, s.IssuesModified)
+
+	if len(diff.AddedProjects) > 0 {
+		fmt.Fprintln(w, "\nAdded Projects:")
+		for _, p := range diff.AddedProjects {
+			fmt.Fprintf(w, "  + %s (%s, %d issues)\n", p.Name, p.Path, p.IssueCount)
+		}
 	}
-	if diff.MetricDeltas.OpenIssues != 0 {
-		fmt.Printf("  Open issues: %+d\n", diff.MetricDeltas.OpenIssues)
+	if len(diff.RemovedProjects) > 0 {
+		fmt.Fprintln(w, "\nRemoved Projects:")
+		for _, p := range diff.RemovedProjects {
+			fmt.Fprintf(w, "  - %s (%s, %d issues)\n", p.Name, p.Path, p.IssueCount)
+		}
 	}
-	if diff.MetricDeltas.BlockedIssues != 0 {
-		fmt.Printf("  Blocked issues: %+d\n", diff.MetricDeltas.BlockedIssues)
+	if len(diff.ChangedProjects) > 0 {
+		fmt.Fprintln(w, "\nChanged Projects:")
+		for _, p := range diff.ChangedProjects {
+			ps := p.Diff.Summary
+			name := p.Name
+			if p.FromName != "" {
+				name = p.FromName + " → " + p.Name
+			}
+			fmt.Fprintf(w, "  ~ %s: %d new, %d removed, %d closed, %d reopened, %d modified\n",
+				name, ps.IssuesAdded, ps.IssuesRemoved, ps.IssuesClosed, ps.IssuesReopened, ps.IssuesModified)
+			if p.FromPath != p.ToPath {
+				fmt.Fprintf(w, "      path: %s → %s\n", p.FromPath, p.ToPath)
+			}
+		}
 	}
-	if diff.MetricDeltas.CycleCount != 0 {
-		fmt.Printf("  Cycles: %+d\n", diff.MetricDeltas.CycleCount)
+	if len(diff.AddedIssues) > 0 {
+		fmt.Fprintf(w, "\nAdded Issues: %s\n", strings.Join(diff.AddedIssues, ", "))
+	}
+	if len(diff.RemovedIssues) > 0 {
+		fmt.Fprintf(w, "\nRemoved Issues: %s\n", strings.Join(diff.RemovedIssues, ", "))
 	}
 }
 
@@ -3660,7 +5984,7 @@ func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 		if f.HasBlockers != nil {
 			hasOpenBlockers := false
 			for _, dep := range issue.Dependencies {
-				if dep.Type == model.DepBlocks && openBlockers[dep.DependsOnID] {
+				if dep.Type.IsBlocking() && openBlockers[dep.DependsOnID] {
 					hasOpenBlockers = true
 					break
 				}
@@ -3674,7 +5998,7 @@ func applyRecipeFilters(issues []model.Issue, r *recipe.Recipe) []model.Issue {
 		if f.Actionable != nil && *f.Actionable {
 			hasOpenBlockers := false
 			for _, dep := range issue.Dependencies {
-				if dep.Type == model.DepBlocks && openBlockers[dep.DependsOnID] {
+				if dep.Type.IsBlocking() && openBlockers[dep.DependsOnID] {
 					hasOpenBlockers = true
 					break
 				}
@@ -3807,9 +6131,14 @@ func runProfileStartup(issues []model.Issue, loadDuration time.Duration, jsonOut
 			Recommendations: generateProfileRecommendations(profile, loadDuration, totalWithLoad),
 		}
 
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(output); err != nil {
+		raw, err := json.Marshal(output)
+		if err == nil {
+			raw, err = formatRobotJSON(raw)
+		}
+		if err == nil {
+			_, err = os.Stdout.Write(raw)
+		}
+		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error encoding profile: %v\n", err)
 			os.Exit(1)
 		}
@@ -3977,53 +6306,240 @@ func generateProfileRecommendations(profile *analysis.StartupProfile, loadDurati
 	return recs
 }
 
-// filterByRepo filters issues to only include those from a specific repository.
-// The filter matches issue IDs that start with the given prefix.
-// If the prefix doesn't end with a separator character, it normalizes by checking
-// common patterns (prefix-, prefix:, etc.).
-func filterByRepo(issues []model.Issue, repoFilter string) []model.Issue {
-	if repoFilter == "" {
-		return issues
+// repoPrefixesOf returns the sorted, lowercased repo prefixes (without
+// separator) seen in issue IDs plus any known workspace prefixes.
+func repoPrefixesOf(issues []model.Issue, known []string) []string {
+	seen := make(map[string]bool)
+	add := func(p string) {
+		p = strings.ToLower(strings.TrimRight(strings.TrimSpace(p), "-:_"))
+		if p != "" {
+			seen[p] = true
+		}
+	}
+	for _, p := range known {
+		add(p)
+	}
+	for _, issue := range issues {
+		add(ui.ExtractRepoPrefix(issue.ID))
 	}
+	prefixes := make([]string, 0, len(seen))
+	for p := range seen {
+		prefixes = append(prefixes, p)
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
 
-	// Normalize the filter - ensure it's a proper prefix
-	filter := repoFilter
-	filterLower := strings.ToLower(filter)
-	// If filter doesn't end with common separators, try matching as-is or with separators
-	needsFlexibleMatch := !strings.HasSuffix(filter, "-") &&
-		!strings.HasSuffix(filter, ":") &&
-		!strings.HasSuffix(filter, "_")
+// resolveRepoFilter expands a partial --repo value to the one prefix it
+// fuzzy-matches. Exact matches always win; input matching several prefixes
+// is an error listing them, and input matching none is returned unchanged
+// so the usual ID and source_repo matching still applies.
+func resolveRepoFilter(repo string, prefixes []string) (string, error) {
+	query := strings.ToLower(strings.TrimRight(strings.TrimSpace(repo), "-:_"))
+	if query == "" {
+		return repo, nil
+	}
+	var candidates []string
+	for _, p := range prefixes {
+		if p == query {
+			return repo, nil
+		}
+		if ui.FuzzyScore(p, query) > 0 {
+			candidates = append(candidates, p)
+		}
+	}
+	switch len(candidates) {
+	case 0:
+		return repo, nil
+	case 1:
+		return candidates[0], nil
+	}
+	return "", fmt.Errorf("--repo %q is ambiguous; matches %s", repo, strings.Join(candidates, ", "))
+}
 
+// filterOpen keeps the issues that are not closed.
+func filterOpen(issues []model.Issue) []model.Issue {
 	var result []model.Issue
 	for _, issue := range issues {
-		idLower := strings.ToLower(issue.ID)
+		if issue.Status != model.StatusClosed {
+			result = append(result, issue)
+		}
+	}
+	return result
+}
 
-		// Check if issue ID starts with the filter (case-insensitive)
-		if strings.HasPrefix(idLower, filterLower) {
+// filterByIDs keeps the issues whose ID is in ids.
+func filterByIDs(issues []model.Issue, ids map[string]bool) []model.Issue {
+	var result []model.Issue
+	for _, issue := range issues {
+		if ids[issue.ID] {
 			result = append(result, issue)
-			continue
 		}
+	}
+	return result
+}
 
-		// If flexible matching is needed, try with common separators
-		if needsFlexibleMatch {
-			if strings.HasPrefix(idLower, filterLower+"-") ||
-				strings.HasPrefix(idLower, filterLower+":") ||
-				strings.HasPrefix(idLower, filterLower+"_") {
-				result = append(result, issue)
-				continue
-			}
+// filterByRepo filters issues to only include those from a specific repository,
+// as analysis.IssueInProject matches them ("api" keeps "api-1", "api:2" and
+// "api_3", and issues whose source_repo is or lies under "api").
+func filterByRepo(issues []model.Issue, repoFilter string) []model.Issue {
+	if repoFilter == "" {
+		return issues
+	}
+
+	var result []model.Issue
+	for i := range issues {
+		if analysis.IssueInProject(&issues[i], repoFilter) {
+			result = append(result, issues[i])
 		}
+	}
+	return result
+}
 
-		// Also check SourceRepo field if set (case-insensitive)
-		if issue.SourceRepo != "" && issue.SourceRepo != "." {
-			sourceRepoLower := strings.ToLower(issue.SourceRepo)
-			if strings.HasPrefix(sourceRepoLower, filterLower) {
-				result = append(result, issue)
-			}
+// robotSchemaVersion is emitted as the top-level schema_version of every
+// robot-mode JSON output. Bump it when the structure of any robot output
+// changes in a way parsers need to detect.
+const robotSchemaVersion = "1"
+
+// robotDanglingDeps lists dependencies of the loaded issues whose targets
+// are missing. encodeRobotJSON adds them as dangling_deps when non-empty so
+// every robot output says when its graph is incomplete.
+var robotDanglingDeps []analysis.DanglingDep
+
+// robotIgnoredCount is how many issues display.yaml ignore rules removed;
+// encodeRobotJSON reports it as ignored_count when non-zero.
+var robotIgnoredCount int
+
+// prettyRobotJSON is set by --pretty: robot JSON documents are indented
+// instead of compact.
+var prettyRobotJSON bool
+
+// formatRobotJSON finishes an encoded robot document for stdout: indented
+// when --pretty is set, otherwise left compact, plus a trailing newline.
+func formatRobotJSON(raw []byte) ([]byte, error) {
+	var out bytes.Buffer
+	if prettyRobotJSON {
+		if err := json.Indent(&out, raw, "", "  "); err != nil {
+			return nil, err
+		}
+	} else if err := json.Compact(&out, raw); err != nil {
+		return nil, err
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
+
+// encodeRobotJSON writes v as JSON with schema_version as the first field
+// (then ignored_count and dangling_deps, when there are any), compact unless
+// --pretty is set. All robot-mode outputs go through here so the envelope
+// stays uniform.
+func encodeRobotJSON(w io.Writer, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	envelope, err := robotEnvelopeFields()
+	if err != nil {
+		return err
+	}
+	raw = prependJSONFields(raw, envelope)
+
+	out, err := formatRobotJSON(raw)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// robotEnvelopeFields returns the shared leading fields of robot output:
+// schema_version, then ignored_count and dangling_deps when there are any.
+func robotEnvelopeFields() (string, error) {
+	fields := `"schema_version":` + strconv.Quote(robotSchemaVersion)
+	if robotIgnoredCount > 0 {
+		fields += `,"ignored_count":` + strconv.Itoa(robotIgnoredCount)
+	}
+	if len(robotDanglingDeps) > 0 {
+		deps, err := json.Marshal(robotDanglingDeps)
+		if err != nil {
+			return "", err
 		}
+		fields += `,"dangling_deps":` + string(deps)
 	}
+	return fields, nil
+}
 
-	return result
+// prependJSONFields inserts already-encoded fields at the start of a JSON
+// object. Non-objects are returned unchanged.
+func prependJSONFields(raw []byte, fields string) []byte {
+	if len(raw) == 0 || raw[0] != '{' {
+		return raw
+	}
+	var b bytes.Buffer
+	b.WriteByte('{')
+	b.WriteString(fields)
+	if len(bytes.TrimSpace(raw[1:])) > 1 {
+		b.WriteByte(',')
+	}
+	b.Write(raw[1:])
+	return b.Bytes()
+}
+
+// streamSection is one kind of record in --stream output.
+type streamSection struct {
+	Type  string // Value of each record's "record" field
+	Items []interface{}
+}
+
+// streamOf wraps items as a --stream section of the given record type.
+func streamOf[T any](recordType string, items []T) streamSection {
+	section := streamSection{Type: recordType, Items: make([]interface{}, len(items))}
+	for i := range items {
+		section.Items[i] = items[i]
+	}
+	return section
+}
+
+// encodeRobotStream writes --stream NDJSON: one compact JSON object per
+// line. The first line is the header, with "record":"header", the same
+// envelope fields as encodeRobotJSON and "totals" (records per type), so
+// consumers know what follows. Each section's records come next, each
+// tagged with its "record" type. ("type" is taken: recommendations carry
+// the issue type.)
+func encodeRobotStream(w io.Writer, header interface{}, sections ...streamSection) error {
+	raw, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+	envelope, err := robotEnvelopeFields()
+	if err != nil {
+		return err
+	}
+	totals := make(map[string]int, len(sections))
+	for _, section := range sections {
+		totals[section.Type] = len(section.Items)
+	}
+	totalsJSON, err := json.Marshal(totals)
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.Write(prependJSONFields(raw, `"record":"header",`+envelope+`,"totals":`+string(totalsJSON)))
+	bw.WriteByte('\n')
+	for _, section := range sections {
+		typeField := `"record":` + strconv.Quote(section.Type)
+		for _, item := range section.Items {
+			raw, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			bw.Write(prependJSONFields(raw, typeField))
+			bw.WriteByte('\n')
+		}
+	}
+	return bw.Flush()
 }
 
 // buildMetricItems converts a metrics map to a sorted slice of MetricItems
@@ -5248,17 +7764,25 @@ func generateHistoryForExport(issues []model.Issue) (*TimeTravelHistory, error)
 }
 
 // buildConfigFromPaths creates a synthetic workspace.Config from a list of project paths.
-// Each path becomes a repo with an auto-generated prefix based on directory name.
-func buildConfigFromPaths(paths []string) (*workspace.Config, error) {
+// Each path becomes a repo with an auto-generated prefix based on directory name,
+// unless the project's .bv.yaml or its saved entry sets one, or its
+// .beads/config.yaml declares an issue-prefix. A declared prefix already
+// claimed by an earlier project is ignored with a warning, as are paths
+// whose .beads resolves, through symlinks, to one already loaded. The
+// merged per-project settings are returned in repo order.
+func buildConfigFromPaths(paths []string, saved *config.ProjectsConfig) (*workspace.Config, []config.ProjectLocalConfig, error) {
 	wsConfig := &workspace.Config{
 		Repos: make([]workspace.RepoConfig, 0, len(paths)),
 	}
+	locals := make([]config.ProjectLocalConfig, 0, len(paths))
 
 	seen := make(map[string]int)
+	declared := make(map[string]string) // .beads/config.yaml prefix -> project path
+	loaded := make(map[string]string)   // real .beads directory -> project path as given
 	for _, p := range paths {
 		absPath, err := filepath.Abs(p)
 		if err != nil {
-			return nil, fmt.Errorf("invalid path %s: %w", p, err)
+			return nil, nil, fmt.Errorf("invalid path %s: %w", p, err)
 		}
 
 		// Expand ~ to home directory
@@ -5270,8 +7794,45 @@ func buildConfigFromPaths(paths []string) (*workspace.Config, error) {
 		// Verify path exists and has .beads directory
 		beadsDir := filepath.Join(absPath, ".beads")
 		if _, err := os.Stat(beadsDir); os.IsNotExist(err) {
-			return nil, fmt.Errorf("no .beads directory found in %s", absPath)
+			return nil, nil, fmt.Errorf("no .beads directory found in %s", absPath)
+		}
+
+		// Symlinks can reach one project by several paths; load it once,
+		// named after the first path it was given by
+		realBeads := beadsDir
+		if resolved, err := filepath.EvalSymlinks(beadsDir); err == nil {
+			realBeads = resolved
+		}
+		if first, dup := loaded[realBeads]; dup {
+			fmt.Fprintf(os.Stderr, "Warning: %s is the same project as %s; loading it once\n", absPath, first)
+			continue
+		}
+		loaded[realBeads] = absPath
+
+		// Project-level settings, with the user's saved entry taking precedence
+		local, err := config.LoadProjectLocal(absPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid %s: %w", config.ProjectLocalConfigPath(absPath), err)
+		}
+		merged := *local
+		if entry := saved.Find(absPath, saved.BaseDir()); entry != nil {
+			merged = merged.WithOverrides(*entry)
+		}
+		if merged.Prefix == "" {
+			beadsCfg, err := config.LoadBeadsProjectConfig(absPath)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid %s: %w", config.BeadsConfigPath(absPath), err)
+			}
+			if prefix := beadsCfg.Prefix(); prefix != "" {
+				if other, taken := declared[prefix]; taken {
+					fmt.Fprintf(os.Stderr, "Warning: %s and %s both declare issue-prefix %q; using the directory name for %s\n", other, absPath, beadsCfg.IssuePrefix, absPath)
+				} else {
+					declared[prefix] = absPath
+					merged.Prefix = prefix
+				}
+			}
 		}
+		locals = append(locals, merged)
 
 		// Generate unique name/prefix from directory name
 		baseName := filepath.Base(absPath)
@@ -5284,14 +7845,314 @@ func buildConfigFromPaths(paths []string) (*workspace.Config, error) {
 		}
 
 		wsConfig.Repos = append(wsConfig.Repos, workspace.RepoConfig{
-			Name: name,
-			Path: absPath,
+			Name:   name,
+			Path:   absPath,
+			Prefix: merged.Prefix,
 		})
 	}
 
 	if err := wsConfig.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid project config: %w", err)
+		return nil, nil, fmt.Errorf("invalid project config: %w", err)
+	}
+
+	return wsConfig, locals, nil
+}
+
+// newWorkspaceLoader loads a --workspace config and applies each repo's
+// .bv.yaml: its prefix when workspace.yaml sets none, and its tags and badge
+// color, returned by prefix.
+func newWorkspaceLoader(configPath string) (*workspace.AggregateLoader, map[string][]string, map[string]string, error) {
+	wsConfig, err := workspace.LoadConfig(configPath)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to load workspace config: %w", err)
+	}
+	root := filepath.Dir(filepath.Dir(configPath)) // .bv/workspace.yaml -> workspace root
+
+	locals := make([]config.ProjectLocalConfig, len(wsConfig.Repos))
+	for i := range wsConfig.Repos {
+		repo := &wsConfig.Repos[i]
+		dir := repo.Path
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(root, dir)
+		}
+		local, err := config.LoadProjectLocal(dir)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("invalid %s: %w", config.ProjectLocalConfigPath(dir), err)
+		}
+		if repo.Prefix == "" {
+			repo.Prefix = local.Prefix
+		}
+		locals[i] = *local
+	}
+	if err := wsConfig.Validate(); err != nil {
+		return nil, nil, nil, fmt.Errorf("invalid workspace config: %w", err)
+	}
+
+	tags := make(map[string][]string)
+	colors := make(map[string]string)
+	for i := range wsConfig.Repos {
+		prefix := wsConfig.Repos[i].GetPrefix()
+		if len(locals[i].Tags) > 0 {
+			tags[prefix] = locals[i].Tags
+		}
+		if locals[i].Color != "" {
+			colors[prefix] = locals[i].Color
+		}
+	}
+	return workspace.NewAggregateLoader(wsConfig, root), tags, colors, nil
+}
+
+// applyProjectRoot gives the projects found by --project-root their
+// hierarchical names and flat prefixes. A prefix set in a project's .bv.yaml,
+// saved entry or .beads/config.yaml still wins.
+func applyProjectRoot(wsConfig *workspace.Config, rootRepos map[string]workspace.RepoConfig) error {
+	for i := range wsConfig.Repos {
+		repo := &wsConfig.Repos[i]
+		found, ok := rootRepos[repo.Path]
+		if !ok {
+			continue
+		}
+		repo.Name = found.Name
+		if repo.Prefix == "" {
+			repo.Prefix = found.Prefix
+		}
+	}
+	if err := wsConfig.Validate(); err != nil {
+		return fmt.Errorf("invalid project config: %w", err)
+	}
+	return nil
+}
+
+// loadProjectsFile reads a --projects-file list ("-" for stdin). Relative
+// paths resolve against the file's directory (the working directory for
+// stdin). Paths that don't exist or have no .beads directory are reported
+// to warn and skipped.
+func loadProjectsFile(path string, warn io.Writer) ([]string, error) {
+	var r io.Reader = os.Stdin
+	baseDir, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+		if abs, err := filepath.Abs(path); err == nil {
+			baseDir = filepath.Dir(abs)
+		}
+	}
+
+	listed, err := config.ParseProjectList(r, baseDir)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, p := range listed {
+		if _, err := os.Stat(p); err != nil {
+			fmt.Fprintf(warn, "Warning: skipping project %s: %v\n", p, err)
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(p, ".beads")); err != nil {
+			fmt.Fprintf(warn, "Warning: skipping project %s: no .beads directory\n", p)
+			continue
+		}
+		paths = append(paths, p)
+	}
+	return paths, nil
+}
+
+// runServeServer serves the read-only JSON API on addr until interrupted.
+func runServeServer(addr string, load ui.IssueReloader, display *config.DisplayConfig) error {
+	listenAddr := serveListenAddr(addr)
+	server := &http.Server{
+		Addr:              listenAddr,
+		Handler:           newServeHandler(load, display),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(os.Stderr, "Serving read-only JSON on http://%s (/health, /issues, /issues/{id}, /triage, /plan)\n", listenAddr)
+	fmt.Fprintln(os.Stderr, "Press Ctrl+C to stop")
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// serveListenAddr binds to localhost unless addr names a host explicitly.
+// Accepts ":8080", "8080", or "host:port".
+func serveListenAddr(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if !strings.Contains(addr, ":") {
+		addr = ":" + addr
+	}
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || host != "" {
+		return addr
+	}
+	return net.JoinHostPort("127.0.0.1", port)
+}
+
+// newServeHandler returns the --serve API. Issues are re-read through load
+// on every request, so responses track edits to the beads files. Payloads
+// mirror the corresponding robot modes, including schema_version.
+func newServeHandler(load ui.IssueReloader, display *config.DisplayConfig) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
+		issues, err := load()
+		if err != nil {
+			writeServeJSON(w, http.StatusServiceUnavailable, struct {
+				Status string `json:"status"`
+				Error  string `json:"error"`
+			}{"error", err.Error()})
+			return
+		}
+		writeServeJSON(w, http.StatusOK, struct {
+			Status      string `json:"status"`
+			GeneratedAt string `json:"generated_at"`
+			DataHash    string `json:"data_hash"`
+			IssueCount  int    `json:"issue_count"`
+		}{
+			Status:      "ok",
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    analysis.ComputeDataHash(issues),
+			IssueCount:  len(issues),
+		})
+	})
+
+	mux.HandleFunc("GET /issues", func(w http.ResponseWriter, r *http.Request) {
+		issues, ok := loadForServe(w, load)
+		if !ok {
+			return
+		}
+		if issues == nil {
+			issues = []model.Issue{}
+		}
+		writeServeJSON(w, http.StatusOK, struct {
+			GeneratedAt string        `json:"generated_at"`
+			DataHash    string        `json:"data_hash"`
+			Count       int           `json:"count"`
+			Issues      []model.Issue `json:"issues"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    analysis.ComputeDataHash(issues),
+			Count:       len(issues),
+			Issues:      issues,
+		})
+	})
+
+	mux.HandleFunc("GET /issues/{id}", func(w http.ResponseWriter, r *http.Request) {
+		issues, ok := loadForServe(w, load)
+		if !ok {
+			return
+		}
+		ref := r.PathValue("id")
+		matches := model.ResolveIssueRef(issues, ref)
+		switch len(matches) {
+		case 0:
+			writeServeError(w, http.StatusNotFound, fmt.Sprintf("issue %q not found", ref))
+			return
+		case 1:
+		default:
+			writeServeError(w, http.StatusConflict, fmt.Sprintf("%q matches %d issues by external ID: %s", ref, len(matches), strings.Join(matches, ", ")))
+			return
+		}
+		for _, issue := range issues {
+			if issue.ID == matches[0] {
+				writeServeJSON(w, http.StatusOK, struct {
+					GeneratedAt string      `json:"generated_at"`
+					DataHash    string      `json:"data_hash"`
+					Issue       model.Issue `json:"issue"`
+				}{
+					GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+					DataHash:    analysis.ComputeDataHash(issues),
+					Issue:       issue,
+				})
+				return
+			}
+		}
+	})
+
+	mux.HandleFunc("GET /triage", func(w http.ResponseWriter, r *http.Request) {
+		issues, ok := loadForServe(w, load)
+		if !ok {
+			return
+		}
+		quickRefFields, err := quickRefFieldsFrom(display)
+		if err != nil {
+			writeServeError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		triage := analysis.ComputeTriageWithOptions(issues, analysis.TriageOptions{WaitForPhase2: true, WIPLimits: wipLimitsFrom(display), AgeBuckets: ageBucketsFrom(display), QuickRefFields: quickRefFields, MaxRecommendationAgeDays: maxRecommendationAgeFrom(display)})
+		writeServeJSON(w, http.StatusOK, struct {
+			GeneratedAt string                `json:"generated_at"`
+			DataHash    string                `json:"data_hash"`
+			Triage      analysis.TriageResult `json:"triage"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    analysis.ComputeDataHash(issues),
+			Triage:      triage,
+		})
+	})
+
+	mux.HandleFunc("GET /plan", func(w http.ResponseWriter, r *http.Request) {
+		issues, ok := loadForServe(w, load)
+		if !ok {
+			return
+		}
+		planAnalyzer := analysis.NewAnalyzer(issues)
+		planAnalyzer.SetPlanOptions(planOptionsFrom(display))
+		plan := planAnalyzer.GetExecutionPlan()
+		writeServeJSON(w, http.StatusOK, struct {
+			GeneratedAt string                 `json:"generated_at"`
+			DataHash    string                 `json:"data_hash"`
+			Plan        analysis.ExecutionPlan `json:"plan"`
+		}{
+			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+			DataHash:    analysis.ComputeDataHash(issues),
+			Plan:        plan,
+		})
+	})
+
+	return mux
+}
+
+// loadForServe re-reads issues, writing a 500 response on failure.
+func loadForServe(w http.ResponseWriter, load ui.IssueReloader) ([]model.Issue, bool) {
+	issues, err := load()
+	if err != nil {
+		writeServeError(w, http.StatusInternalServerError, fmt.Sprintf("loading issues: %v", err))
+		return nil, false
 	}
+	return issues, true
+}
 
-	return wsConfig, nil
+func writeServeError(w http.ResponseWriter, status int, msg string) {
+	writeServeJSON(w, status, struct {
+		Error string `json:"error"`
+	}{msg})
+}
+
+func writeServeJSON(w http.ResponseWriter, status int, v interface{}) {
+	var buf bytes.Buffer
+	if err := encodeRobotJSON(&buf, v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
 }
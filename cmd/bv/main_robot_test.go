@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"os"
 	"os/exec"
@@ -94,3 +95,44 @@ func buildTestBinary(t *testing.T) string {
 	}
 	return exe
 }
+
+func TestEncodeRobotJSON_PrependsSchemaVersion(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"struct", struct {
+			A string `json:"a"`
+		}{"x"}, "{\"schema_version\":\"1\",\"a\":\"x\"}\n"},
+		{"empty object", struct{}{}, "{\"schema_version\":\"1\"}\n"},
+		{"non-object untouched", []int{1}, "[1]\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := encodeRobotJSON(&buf, tt.value); err != nil {
+				t.Fatalf("encodeRobotJSON: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeRobotJSON_Pretty(t *testing.T) {
+	prettyRobotJSON = true
+	defer func() { prettyRobotJSON = false }()
+
+	var buf bytes.Buffer
+	if err := encodeRobotJSON(&buf, struct {
+		A string `json:"a"`
+	}{"x"}); err != nil {
+		t.Fatalf("encodeRobotJSON: %v", err)
+	}
+	want := "{\n  \"schema_version\": \"1\",\n  \"a\": \"x\"\n}\n"
+	if got := buf.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
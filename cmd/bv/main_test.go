@@ -1,15 +1,24 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/recipe"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
 )
 
 func TestFilterByRepo_CaseInsensitiveAndFlexibleSeparators(t *testing.T) {
@@ -268,3 +277,593 @@ func repoRoot(t *testing.T) string {
 		dir = parent
 	}
 }
+
+func serveGet(t *testing.T, h http.Handler, method, path string, v any) int {
+	t.Helper()
+	req := httptest.NewRequest(method, path, nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if v != nil {
+		if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+			t.Fatalf("%s %s: invalid JSON %q: %v", method, path, rec.Body.String(), err)
+		}
+	}
+	return rec.Code
+}
+
+func TestServeHandler(t *testing.T) {
+	loads := 0
+	issues := []model.Issue{
+		{ID: "api-1", Title: "Auth", Status: model.StatusOpen, IssueType: model.TypeTask, ExternalID: "PROJ-1"},
+		{ID: "api-2", Title: "Login", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: []*model.Dependency{
+			{IssueID: "api-2", DependsOnID: "api-1", Type: model.DepBlocks},
+		}},
+	}
+	h := newServeHandler(func() ([]model.Issue, error) {
+		loads++
+		return issues, nil
+	}, nil)
+
+	var health struct {
+		SchemaVersion string `json:"schema_version"`
+		Status        string `json:"status"`
+		IssueCount    int    `json:"issue_count"`
+	}
+	if code := serveGet(t, h, http.MethodGet, "/health", &health); code != http.StatusOK {
+		t.Fatalf("/health status = %d", code)
+	}
+	if health.Status != "ok" || health.IssueCount != 2 || health.SchemaVersion == "" {
+		t.Errorf("/health = %+v", health)
+	}
+
+	var list struct {
+		Count  int           `json:"count"`
+		Issues []model.Issue `json:"issues"`
+	}
+	serveGet(t, h, http.MethodGet, "/issues", &list)
+	if list.Count != 2 || len(list.Issues) != 2 {
+		t.Errorf("/issues = %+v", list)
+	}
+
+	var one struct {
+		Issue model.Issue `json:"issue"`
+	}
+	if code := serveGet(t, h, http.MethodGet, "/issues/PROJ-1", &one); code != http.StatusOK || one.Issue.ID != "api-1" {
+		t.Errorf("/issues/PROJ-1 = %d %+v", code, one.Issue)
+	}
+	var notFound struct {
+		Error string `json:"error"`
+	}
+	if code := serveGet(t, h, http.MethodGet, "/issues/nope", &notFound); code != http.StatusNotFound || notFound.Error == "" {
+		t.Errorf("/issues/nope = %d %+v", code, notFound)
+	}
+
+	var plan struct {
+		Plan struct {
+			Tracks []any `json:"tracks"`
+		} `json:"plan"`
+	}
+	serveGet(t, h, http.MethodGet, "/plan", &plan)
+	if len(plan.Plan.Tracks) != 1 {
+		t.Errorf("/plan tracks = %d, want 1", len(plan.Plan.Tracks))
+	}
+
+	var triage struct {
+		Triage struct {
+			QuickRef struct {
+				OpenCount int `json:"open_count"`
+			} `json:"quick_ref"`
+		} `json:"triage"`
+	}
+	serveGet(t, h, http.MethodGet, "/triage", &triage)
+	if triage.Triage.QuickRef.OpenCount != 2 {
+		t.Errorf("/triage open_count = %d, want 2", triage.Triage.QuickRef.OpenCount)
+	}
+
+	if code := serveGet(t, h, http.MethodPost, "/issues", nil); code != http.StatusMethodNotAllowed {
+		t.Errorf("POST /issues = %d, want 405", code)
+	}
+
+	// Every request re-reads issues (the rejected POST does not)
+	if loads != 6 {
+		t.Errorf("loads = %d, want 6", loads)
+	}
+}
+
+func TestServeHandler_LoadError(t *testing.T) {
+	h := newServeHandler(func() ([]model.Issue, error) {
+		return nil, errors.New("boom")
+	}, nil)
+	if code := serveGet(t, h, http.MethodGet, "/health", nil); code != http.StatusServiceUnavailable {
+		t.Errorf("/health = %d, want 503", code)
+	}
+	if code := serveGet(t, h, http.MethodGet, "/issues", nil); code != http.StatusInternalServerError {
+		t.Errorf("/issues = %d, want 500", code)
+	}
+}
+
+func TestServeListenAddr(t *testing.T) {
+	tests := map[string]string{
+		":8080":        "127.0.0.1:8080",
+		"8080":         "127.0.0.1:8080",
+		"0.0.0.0:9000": "0.0.0.0:9000",
+		"localhost:80": "localhost:80",
+	}
+	for in, want := range tests {
+		if got := serveListenAddr(in); got != want {
+			t.Errorf("serveListenAddr(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestPrunePinsDropsMissingIssuesAndSaves(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := config.DefaultDisplayConfig()
+	cfg.Pinned = []string{"api-1", "gone-2"}
+	prunePins(&cfg, []model.Issue{{ID: "api-1"}, {ID: "web-3"}})
+
+	if len(cfg.Pinned) != 1 || cfg.Pinned[0] != "api-1" {
+		t.Fatalf("pinned = %v, want [api-1]", cfg.Pinned)
+	}
+	saved, err := config.LoadDisplay()
+	if err != nil {
+		t.Fatalf("LoadDisplay: %v", err)
+	}
+	if len(saved.Pinned) != 1 || saved.Pinned[0] != "api-1" {
+		t.Errorf("saved pinned = %v, want [api-1]", saved.Pinned)
+	}
+
+	// Nothing stale: config file is left untouched
+	os.Remove(config.DisplayConfigPath())
+	prunePins(&cfg, []model.Issue{{ID: "api-1"}})
+	if _, err := os.Stat(config.DisplayConfigPath()); !os.IsNotExist(err) {
+		t.Errorf("expected no save when no pins were pruned, stat err = %v", err)
+	}
+}
+
+func TestBuildConfigFromPathsMergesProjectLocalConfig(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	root := t.TempDir()
+	api := filepath.Join(root, "api")
+	web := filepath.Join(root, "web")
+	for _, dir := range []string{api, web} {
+		if err := os.MkdirAll(filepath.Join(dir, ".beads"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	local := "prefix: svc-\ncolor: \"#111111\"\ntags: [backend]\n"
+	if err := os.WriteFile(filepath.Join(api, config.ProjectLocalFileName), []byte(local), 0644); err != nil {
+		t.Fatal(err)
+	}
+	saved := &config.ProjectsConfig{Projects: []config.ProjectEntry{{Path: api, Color: "#222222"}}}
+
+	wsConfig, locals, err := buildConfigFromPaths([]string{api, web}, saved)
+	if err != nil {
+		t.Fatalf("buildConfigFromPaths: %v", err)
+	}
+	if got := wsConfig.Repos[0].GetPrefix(); got != "svc-" {
+		t.Errorf("api prefix = %q, want svc- from .bv.yaml", got)
+	}
+	if got := wsConfig.Repos[1].GetPrefix(); got != "web-" {
+		t.Errorf("web prefix = %q, want default web-", got)
+	}
+	if locals[0].Color != "#222222" {
+		t.Errorf("api color = %q, want saved entry to win", locals[0].Color)
+	}
+	if len(locals[0].Tags) != 1 || locals[0].Tags[0] != "backend" {
+		t.Errorf("api tags = %v, want [backend]", locals[0].Tags)
+	}
+
+	if err := os.WriteFile(filepath.Join(web, config.ProjectLocalFileName), []byte("tags: [unclosed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := buildConfigFromPaths([]string{web}, nil); err == nil {
+		t.Error("expected invalid .bv.yaml to be reported")
+	}
+}
+
+func TestNewWorkspaceLoaderAppliesProjectLocalConfig(t *testing.T) {
+	root := t.TempDir()
+	for _, dir := range []string{"api", "web"} {
+		if err := os.MkdirAll(filepath.Join(root, dir, ".beads"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		record := `{"id":"1","title":"First","status":"open","priority":1,"issue_type":"task"}`
+		if err := os.WriteFile(filepath.Join(root, dir, ".beads", "beads.jsonl"), []byte(record), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	local := "prefix: svc-\ncolor: \"#111111\"\ntags: [backend]\n"
+	if err := os.WriteFile(filepath.Join(root, "api", config.ProjectLocalFileName), []byte(local), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// workspace.yaml's own prefix wins over .bv.yaml's
+	if err := os.WriteFile(filepath.Join(root, "web", config.ProjectLocalFileName), []byte("prefix: ignored-\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	wsDir := filepath.Join(root, ".bv")
+	if err := os.MkdirAll(wsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	wsYAML := "repos:\n  - path: api\n  - path: web\n    prefix: web-\n"
+	if err := os.WriteFile(filepath.Join(wsDir, "workspace.yaml"), []byte(wsYAML), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	wsLoader, tags, colors, err := newWorkspaceLoader(filepath.Join(wsDir, "workspace.yaml"))
+	if err != nil {
+		t.Fatalf("newWorkspaceLoader: %v", err)
+	}
+	issues, _, err := wsLoader.LoadAll(context.Background())
+	if err != nil {
+		t.Fatalf("LoadAll: %v", err)
+	}
+	ids := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		ids = append(ids, issue.ID)
+	}
+	sort.Strings(ids)
+	if !reflect.DeepEqual(ids, []string{"svc-1", "web-1"}) {
+		t.Errorf("ids = %v, want svc-1 and web-1", ids)
+	}
+	if !reflect.DeepEqual(tags, map[string][]string{"svc-": {"backend"}}) || colors["svc-"] != "#111111" {
+		t.Errorf("tags = %v, colors = %v", tags, colors)
+	}
+}
+
+func TestBuildConfigFromPathsLoadsSymlinkedProjectOnce(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	root := t.TempDir()
+	real := filepath.Join(root, "src", "checkout-7f3a")
+	if err := os.MkdirAll(filepath.Join(real, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	api := filepath.Join(root, "api")
+	backend := filepath.Join(root, "backend")
+	for _, link := range []string{api, backend} {
+		if err := os.Symlink(real, link); err != nil {
+			t.Skipf("symlinks unsupported: %v", err)
+		}
+	}
+
+	wsConfig, locals, err := buildConfigFromPaths([]string{api, backend, real}, nil)
+	if err != nil {
+		t.Fatalf("buildConfigFromPaths: %v", err)
+	}
+	if len(wsConfig.Repos) != 1 || len(locals) != 1 {
+		t.Fatalf("repos = %+v, want the project once", wsConfig.Repos)
+	}
+	// Named after the path the user gave, not the symlink target
+	if repo := wsConfig.Repos[0]; repo.Name != "api" || repo.GetPrefix() != "api-" || repo.Path != api {
+		t.Errorf("repo = %+v, want api at %s", repo, api)
+	}
+}
+
+func TestBuildConfigFromPathsHonorsBeadsConfigPrefix(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	root := t.TempDir()
+	var dirs []string
+	for _, name := range []string{"a/app", "b/app", "c/app", "lib"} {
+		dir := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Join(dir, ".beads"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		dirs = append(dirs, dir)
+	}
+	beadsCfg := map[string]string{
+		dirs[0]: "issue-prefix: bd\nno-db: false\n",
+		dirs[1]: "issue-prefix: \"mobile\"\n",
+		dirs[3]: "issue-prefix: bd\n", // Conflicts with dirs[0]
+	}
+	for dir, data := range beadsCfg {
+		if err := os.WriteFile(config.BeadsConfigPath(dir), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dirs[1], config.ProjectLocalFileName), []byte("prefix: ios-\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	wsConfig, _, err := buildConfigFromPaths(dirs, nil)
+	if err != nil {
+		t.Fatalf("buildConfigFromPaths: %v", err)
+	}
+	want := []string{"bd-", "ios-", "app_3-", "lib-"}
+	for i, repo := range wsConfig.Repos {
+		if got := repo.GetPrefix(); got != want[i] {
+			t.Errorf("%s prefix = %q, want %q", dirs[i], got, want[i])
+		}
+	}
+
+	if err := os.WriteFile(config.BeadsConfigPath(dirs[2]), []byte("issue-prefix: [unclosed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := buildConfigFromPaths(dirs[2:3], nil); err == nil {
+		t.Error("expected invalid .beads/config.yaml to be reported")
+	}
+}
+
+func TestResolveRepoFilter(t *testing.T) {
+	prefixes := repoPrefixesOf([]model.Issue{{ID: "api-1"}, {ID: "web-2"}, {ID: "WEBHOOKS-3"}}, []string{"lib-"})
+	if want := []string{"api", "lib", "web", "webhooks"}; !reflect.DeepEqual(prefixes, want) {
+		t.Fatalf("repoPrefixesOf = %v, want %v", prefixes, want)
+	}
+
+	tests := []struct {
+		repo    string
+		want    string
+		wantErr bool
+	}{
+		{"ap", "api", false},
+		{"API", "API", false},      // exact match is kept as given
+		{"web", "web", false},      // exact beats the fuzzy webhooks match
+		{"whk", "webhooks", false}, // subsequence match
+		{"we", "", true},           // web and webhooks
+		{"zzz", "zzz", false},      // no match falls through unchanged
+	}
+	for _, tt := range tests {
+		got, err := resolveRepoFilter(tt.repo, prefixes)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("resolveRepoFilter(%q) err = %v, wantErr %v", tt.repo, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("resolveRepoFilter(%q) = %q, want %q", tt.repo, got, tt.want)
+		}
+	}
+
+	_, err := resolveRepoFilter("we", prefixes)
+	if err == nil || !strings.Contains(err.Error(), "web, webhooks") {
+		t.Errorf("expected candidates in error, got %v", err)
+	}
+}
+
+func TestWriteSnapshot(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	dir := filepath.Join(t.TempDir(), "nested", "snap")
+	issues := []model.Issue{
+		{ID: "b-2", Title: "Second", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: []*model.Dependency{
+			{IssueID: "b-2", DependsOnID: "a-1", Type: model.DepBlocks},
+		}},
+		{ID: "a-1", Title: "First", Status: model.StatusOpen, IssueType: model.TypeTask},
+	}
+
+	files, err := writeSnapshot(dir, issues, "hash123", nil)
+	if err != nil {
+		t.Fatalf("writeSnapshot: %v", err)
+	}
+	want := []string{"issues.jsonl", "graph.dot", "graph.mmd", "triage.json", "plan.json", "health.json", "meta.json"}
+	if !reflect.DeepEqual(files, want) {
+		t.Fatalf("files = %v, want %v", files, want)
+	}
+
+	jsonl, err := os.ReadFile(filepath.Join(dir, "issues.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(jsonl)), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"id":"a-1"`) {
+		t.Errorf("issues.jsonl not sorted by ID:\n%s", jsonl)
+	}
+	if dot, _ := os.ReadFile(filepath.Join(dir, "graph.dot")); !strings.HasPrefix(string(dot), "digraph") {
+		t.Errorf("graph.dot is not DOT:\n%s", dot)
+	}
+	for _, name := range []string{"triage.json", "plan.json", "health.json"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var payload map[string]any
+		if err := json.Unmarshal(data, &payload); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if payload["data_hash"] != "hash123" || payload["schema_version"] == nil {
+			t.Errorf("%s missing envelope fields: %v", name, payload)
+		}
+		if strings.Contains(string(data), time.Now().UTC().Format("2006-01-02T")) {
+			t.Errorf("%s embeds the generation time; it belongs in meta.json:\n%s", name, data)
+		}
+	}
+	meta, err := os.ReadFile(filepath.Join(dir, "meta.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(meta), `"generated_at"`) {
+		t.Errorf("meta.json missing generated_at:\n%s", meta)
+	}
+}
+
+func TestInitBeadsDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "Demo")
+
+	created, err := initBeadsDir(dir, initOptions{Sample: true, Config: true})
+	if err != nil {
+		t.Fatalf("initBeadsDir: %v", err)
+	}
+	if len(created) != 2 {
+		t.Fatalf("created = %v; want beads.jsonl and .bv.yaml", created)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, ".beads", "beads.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sample model.Issue
+	if err := json.Unmarshal(data, &sample); err != nil {
+		t.Fatalf("sample issue is not valid JSON: %v", err)
+	}
+	if err := sample.Validate(); err != nil || sample.ID != "demo-1" {
+		t.Errorf("sample issue = %+v (validate: %v); want a valid demo-1", sample, err)
+	}
+
+	local, err := config.LoadProjectLocal(dir)
+	if err != nil || local.Prefix != "demo-" {
+		t.Errorf("LoadProjectLocal = %+v, %v; want prefix demo-", local, err)
+	}
+
+	// A second init refuses to touch the existing files...
+	if _, err := initBeadsDir(dir, initOptions{}); err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Errorf("re-init without --force: err = %v; want a hint about --force", err)
+	}
+	// ...unless forced, which resets beads.jsonl to empty.
+	if _, err := initBeadsDir(dir, initOptions{Force: true}); err != nil {
+		t.Fatalf("forced re-init: %v", err)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, ".beads", "beads.jsonl")); len(data) != 0 {
+		t.Errorf("forced re-init left %q in beads.jsonl; want empty", data)
+	}
+}
+
+func TestRunInitArgs(t *testing.T) {
+	dir := t.TempDir()
+	var stderr strings.Builder
+	if code := runInit([]string{filepath.Join(dir, "p"), "--config"}, &stderr); code != 0 {
+		t.Fatalf("runInit exit = %d; stderr:\n%s", code, stderr.String())
+	}
+	if _, err := os.Stat(filepath.Join(dir, "p", config.ProjectLocalFileName)); err != nil {
+		t.Errorf("--config after DIR was not honored: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "Next steps:") {
+		t.Errorf("expected next-step hints on stderr:\n%s", stderr.String())
+	}
+
+	if code := runInit([]string{"a", "b"}, &strings.Builder{}); code != 2 {
+		t.Errorf("two directories: exit = %d; want 2", code)
+	}
+}
+
+func TestRunNormalize(t *testing.T) {
+	dir := t.TempDir()
+	beads := filepath.Join(dir, ".beads")
+	if err := os.MkdirAll(beads, 0755); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(beads, "beads.jsonl")
+	messy := `{"title":"B","id":"b-2","status":"open","issue_type":"task"}` + "\n" + `{"id":"b-1","title":"A","status":"open","issue_type":"task"}` + "\n"
+	if err := os.WriteFile(file, []byte(messy), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var out, errOut strings.Builder
+	if code := runNormalize(dir, false, &out, &errOut); code != 0 {
+		t.Fatalf("preview exit = %d; stderr:\n%s", code, errOut.String())
+	}
+	if !strings.Contains(out.String(), "Would normalize") || !strings.Contains(out.String(), "--allow-write") {
+		t.Errorf("preview output:\n%s", out.String())
+	}
+	if data, _ := os.ReadFile(file); string(data) != messy {
+		t.Error("preview without --allow-write must not write")
+	}
+
+	out.Reset()
+	if code := runNormalize(dir, true, &out, &errOut); code != 0 {
+		t.Fatalf("normalize exit = %d; stderr:\n%s", code, errOut.String())
+	}
+	if !strings.Contains(out.String(), "sorted records by ID") || !strings.Contains(out.String(), "reformatted 1 records") {
+		t.Errorf("summary:\n%s", out.String())
+	}
+
+	out.Reset()
+	if code := runNormalize(file, true, &out, &errOut); code != 0 || !strings.Contains(out.String(), "already normalized") {
+		t.Errorf("second run: exit %d, output:\n%s", code, out.String())
+	}
+}
+
+func TestLoadProjectsFileSkipsMissing(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"api", "nobeads"} {
+		if err := os.MkdirAll(filepath.Join(dir, name), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "api", ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	list := filepath.Join(dir, "projects.txt")
+	if err := os.WriteFile(list, []byte("# projects\napi\n\nmissing\nnobeads\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var warn strings.Builder
+	got, err := loadProjectsFile(list, &warn)
+	if err != nil {
+		t.Fatalf("loadProjectsFile: %v", err)
+	}
+	if want := []string{filepath.Join(dir, "api")}; !reflect.DeepEqual(got, want) {
+		t.Errorf("paths = %v, want %v", got, want)
+	}
+	if w := warn.String(); !strings.Contains(w, "missing") || !strings.Contains(w, "nobeads: no .beads directory") {
+		t.Errorf("expected warnings for both skipped paths, got:\n%s", w)
+	}
+
+	if _, err := loadProjectsFile(filepath.Join(dir, "absent.txt"), &warn); err == nil {
+		t.Error("expected an error for a missing projects file")
+	}
+}
+
+func TestApplyProjectManagerEntries(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "projects.yaml")
+	if err := os.WriteFile(path, []byte(`projects:
+  - path: /code/api
+    name: API
+    tags: [backend]
+  - path: /code/web
+  - path: /code/old
+  - path: /code/parked
+    enabled: false
+  - path: /code/shelved
+    enabled: false
+  - path: /code/broken
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := config.LoadProjectsFrom(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The manager listed api, web and old loaded, and the disabled parked
+	// and shelved unloaded. old and shelved were removed, web toggled off,
+	// parked toggled on, and /code/docs added. broken failed to load and
+	// was never listed.
+	shown := map[string]string{"api-": "/code/api", "web-": "/code/web", "old-": "/code/old"}
+	unloaded := applyProjectManagerEntries(saved, shown, []string{"/code/parked", "/code/shelved"}, []ui.ProjectEntry{
+		{Name: "api", Path: "/code/api", Prefix: "api-", IsActive: true},
+		{Name: "web", Path: "/code/web", Prefix: "web-", IsActive: false},
+		{Name: "parked", Path: "/code/parked", IsActive: true},
+		{Name: "docs", Path: "/code/docs", IsActive: true},
+	}, config.PathModeAbsolute)
+	if !reflect.DeepEqual(unloaded, []string{"/code/parked", "/code/docs"}) {
+		t.Errorf("still unloaded = %v, want parked and docs", unloaded)
+	}
+
+	got := make(map[string]config.ProjectEntry)
+	for _, p := range saved.Projects {
+		got[p.Path] = p
+	}
+	if len(got) != 5 {
+		t.Fatalf("saved projects = %+v, want api, web, parked, broken and docs", saved.Projects)
+	}
+	for _, removed := range []string{"/code/old", "/code/shelved"} {
+		if _, ok := got[removed]; ok {
+			t.Errorf("removed project %s is still saved", removed)
+		}
+	}
+	if api := got["/code/api"]; !api.IsEnabled() || api.Name != "API" || len(api.Tags) != 1 {
+		t.Errorf("api = %+v, want it enabled with its name and tags kept", api)
+	}
+	if web := got["/code/web"]; web.IsEnabled() {
+		t.Error("web was toggled off but is still enabled")
+	}
+	if parked := got["/code/parked"]; !parked.IsEnabled() {
+		t.Error("parked was toggled on but is still disabled")
+	}
+	if broken, ok := got["/code/broken"]; !ok || !broken.IsEnabled() {
+		t.Error("a project the manager never listed should be left alone")
+	}
+	if docs, ok := got["/code/docs"]; !ok || !docs.IsEnabled() {
+		t.Errorf("docs = %+v, want it added and enabled", docs)
+	}
+}
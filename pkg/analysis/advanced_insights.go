@@ -445,7 +445,7 @@ func (a *Analyzer) computeMarginalUnblocks(issueID string, alreadyCompleted map[
 			if dep == nil {
 				continue
 			}
-			if dep.Type != model.DepBlocks {
+			if !dep.Type.IsBlocking() {
 				continue
 			}
 
@@ -488,7 +488,7 @@ func (a *Analyzer) generateCoverageSet(limit int) *CoverageSetResult {
 			continue
 		}
 		for _, dep := range issue.Dependencies {
-			if dep == nil || dep.Type != model.DepBlocks {
+			if dep == nil || !dep.Type.IsBlocking() {
 				continue
 			}
 			if target, ok := a.issueMap[dep.DependsOnID]; ok && target.Status != model.StatusClosed {
@@ -636,7 +636,7 @@ func (a *Analyzer) generateKPaths(k int, pathLengthCap int) *KPathsResult {
 	for _, node := range nodes {
 		issue := a.issueMap[node.id]
 		for _, dep := range issue.Dependencies {
-			if dep == nil || dep.Type != model.DepBlocks {
+			if dep == nil || !dep.Type.IsBlocking() {
 				continue
 			}
 			// dep.DependsOnID blocks node.id
@@ -838,7 +838,7 @@ func (a *Analyzer) generateParallelCut(limit int) *ParallelCutResult {
 			continue
 		}
 		for _, dep := range issue.Dependencies {
-			if dep == nil || dep.Type != model.DepBlocks {
+			if dep == nil || !dep.Type.IsBlocking() {
 				continue
 			}
 			if openIssues[dep.DependsOnID] {
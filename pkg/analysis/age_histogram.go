@@ -0,0 +1,127 @@
+package analysis
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DefaultAgeBuckets are the upper bounds, in days, of the age histogram
+// buckets: 0-7d, 8-30d, 31-90d, and 90d+ for anything older.
+var DefaultAgeBuckets = []int{7, 30, 90}
+
+// UndatedBucketLabel labels the bucket of issues with no creation date.
+const UndatedBucketLabel = "undated"
+
+// AgeBucket counts open issues whose age falls in [MinDays, MaxDays].
+// MaxDays is nil for the open-ended last bucket; both are nil for the
+// undated bucket.
+type AgeBucket struct {
+	Label     string         `json:"label"`
+	MinDays   *int           `json:"min_days,omitempty"`
+	MaxDays   *int           `json:"max_days,omitempty"`
+	Count     int            `json:"count"`
+	ByProject map[string]int `json:"by_project"`
+}
+
+// AgeHistogram buckets open issues by days since creation.
+type AgeHistogram struct {
+	Buckets []AgeBucket `json:"buckets"`
+}
+
+// ComputeAgeHistogram buckets non-closed issues by age in whole days as of
+// now. bounds are ascending bucket upper bounds (nil or invalid uses
+// DefaultAgeBuckets); one open-ended bucket follows them, and issues with
+// no created_at go in a final "undated" bucket, which is always present.
+// Projects come from source_repo, or the ID prefix ("api" for "api-12");
+// issues with neither count under "".
+func ComputeAgeHistogram(issues []model.Issue, bounds []int, now time.Time) AgeHistogram {
+	if !validAgeBuckets(bounds) {
+		bounds = DefaultAgeBuckets
+	}
+
+	buckets := make([]AgeBucket, 0, len(bounds)+2)
+	lo := 0
+	for _, hi := range bounds {
+		min, max := lo, hi
+		buckets = append(buckets, AgeBucket{
+			Label:     fmt.Sprintf("%d-%dd", lo, hi),
+			MinDays:   &min,
+			MaxDays:   &max,
+			ByProject: map[string]int{},
+		})
+		lo = hi + 1
+	}
+	last := bounds[len(bounds)-1]
+	older := lo
+	buckets = append(buckets,
+		AgeBucket{Label: fmt.Sprintf("%dd+", last), MinDays: &older, ByProject: map[string]int{}},
+		AgeBucket{Label: UndatedBucketLabel, ByProject: map[string]int{}},
+	)
+
+	for i := range issues {
+		issue := &issues[i]
+		if issue.Status == model.StatusClosed {
+			continue
+		}
+		idx := len(buckets) - 1 // undated
+		if !issue.CreatedAt.IsZero() {
+			days := int(now.Sub(issue.CreatedAt).Hours() / 24)
+			idx = len(bounds) // open-ended unless a bound fits
+			for b, hi := range bounds {
+				if days <= hi {
+					idx = b
+					break
+				}
+			}
+		}
+		buckets[idx].Count++
+		buckets[idx].ByProject[issueProject(issue)]++
+	}
+	return AgeHistogram{Buckets: buckets}
+}
+
+// validAgeBuckets reports whether bounds is a non-empty, strictly
+// ascending list of non-negative day counts.
+func validAgeBuckets(bounds []int) bool {
+	if len(bounds) == 0 {
+		return false
+	}
+	for i, b := range bounds {
+		if b < 0 || (i > 0 && b <= bounds[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// issueProject names the project an issue belongs to: its source_repo, or
+// failing that a short alphanumeric ID prefix before "-", ":" or "_" (the
+// same rule the TUI uses for repo badges).
+func issueProject(issue *model.Issue) string {
+	if issue.SourceRepo != "" && issue.SourceRepo != "." {
+		return strings.TrimRight(issue.SourceRepo, "-:_")
+	}
+	return idProject(issue.ID)
+}
+
+// idProject returns the project prefix of an issue ID, or "" if it has none.
+func idProject(id string) string {
+	for _, sep := range []string{"-", ":", "_"} {
+		if idx := strings.Index(id, sep); idx > 0 && idx <= 10 && isAlnum(id[:idx]) {
+			return id[:idx]
+		}
+	}
+	return ""
+}
+
+func isAlnum(s string) bool {
+	for _, r := range s {
+		if !((r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeAgeHistogram(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	ago := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+	issues := []model.Issue{
+		{ID: "api-1", Status: model.StatusOpen, CreatedAt: ago(0)},
+		{ID: "api-2", Status: model.StatusInProgress, CreatedAt: ago(7)},
+		{ID: "web-1", Status: model.StatusOpen, CreatedAt: ago(8)},
+		{ID: "web-2", Status: model.StatusBlocked, CreatedAt: ago(90)},
+		{ID: "web-3", Status: model.StatusOpen, CreatedAt: ago(91)},
+		{ID: "x", Status: model.StatusOpen, CreatedAt: ago(400), SourceRepo: "ops"},
+		{ID: "api-3", Status: model.StatusOpen}, // undated
+		{ID: "api-4", Status: model.StatusClosed, CreatedAt: ago(2)},
+	}
+
+	h := ComputeAgeHistogram(issues, nil, now)
+
+	want := []struct {
+		label     string
+		count     int
+		byProject map[string]int
+	}{
+		{"0-7d", 2, map[string]int{"api": 2}},
+		{"8-30d", 1, map[string]int{"web": 1}},
+		{"31-90d", 1, map[string]int{"web": 1}},
+		{"90d+", 2, map[string]int{"web": 1, "ops": 1}},
+		{"undated", 1, map[string]int{"api": 1}},
+	}
+	if len(h.Buckets) != len(want) {
+		t.Fatalf("got %d buckets, want %d: %+v", len(h.Buckets), len(want), h.Buckets)
+	}
+	for i, w := range want {
+		b := h.Buckets[i]
+		if b.Label != w.label || b.Count != w.count || len(b.ByProject) != len(w.byProject) {
+			t.Errorf("bucket[%d] = %s count=%d %v, want %s %d %v", i, b.Label, b.Count, b.ByProject, w.label, w.count, w.byProject)
+			continue
+		}
+		for p, n := range w.byProject {
+			if b.ByProject[p] != n {
+				t.Errorf("bucket %s by_project[%s] = %d, want %d", b.Label, p, b.ByProject[p], n)
+			}
+		}
+	}
+	if b := h.Buckets[1]; *b.MinDays != 8 || *b.MaxDays != 30 {
+		t.Errorf("8-30d bounds = %d..%d", *b.MinDays, *b.MaxDays)
+	}
+	if b := h.Buckets[3]; *b.MinDays != 91 || b.MaxDays != nil {
+		t.Errorf("open-ended bucket = %+v, want min 91 and no max", b)
+	}
+	if b := h.Buckets[4]; b.MinDays != nil || b.MaxDays != nil {
+		t.Errorf("undated bucket has bounds: %+v", b)
+	}
+}
+
+func TestComputeAgeHistogramCustomBuckets(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{{ID: "A", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -10)}}
+
+	h := ComputeAgeHistogram(issues, []int{14}, now)
+	if len(h.Buckets) != 3 || h.Buckets[0].Label != "0-14d" || h.Buckets[0].Count != 1 || h.Buckets[1].Label != "14d+" {
+		t.Errorf("custom buckets = %+v", h.Buckets)
+	}
+
+	// Out-of-order bounds fall back to the defaults
+	if h := ComputeAgeHistogram(issues, []int{30, 7}, now); len(h.Buckets) != len(DefaultAgeBuckets)+2 {
+		t.Errorf("invalid bounds gave %d buckets, want defaults", len(h.Buckets))
+	}
+}
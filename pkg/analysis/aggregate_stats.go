@@ -0,0 +1,109 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// AggregateStats is a flat set of scalar metrics for charting trends in a
+// time-series store. Every field is a number and the keys are stable, so
+// consecutive snapshots line up without schema handling.
+type AggregateStats struct {
+	Total           int     `json:"total"`
+	Open            int     `json:"open"`             // Not closed
+	Closed          int     `json:"closed"`           // Closed
+	Blocked         int     `json:"blocked"`          // Open but not actionable
+	Ready           int     `json:"ready"`            // Open with no open blockers
+	AvgAgeDays      float64 `json:"avg_age_days"`     // Mean days since creation of dated open issues
+	MedianAgeDays   float64 `json:"median_age_days"`  // Median of the same ages
+	CycleCount      int     `json:"cycle_count"`      // Dependency cycles found by the graph analysis
+	BottleneckCount int     `json:"bottleneck_count"` // Open issues with open dependents (see Bottlenecks)
+	LabelHealthAvg  float64 `json:"label_health_avg"` // Mean label health score 0-100 (0 with no labels)
+
+	// Closed split by resolution (see ResolutionOf); together these add up
+	// to Closed
+	ClosedFixed      int `json:"closed_fixed"`
+	ClosedWontfix    int `json:"closed_wontfix"`
+	ClosedDuplicate  int `json:"closed_duplicate"`
+	ClosedInvalid    int `json:"closed_invalid"`
+	ClosedOther      int `json:"closed_other"`      // Any other resolution
+	ClosedUnresolved int `json:"closed_unresolved"` // Closed without a resolution
+}
+
+// ComputeAggregateStats summarizes issues as AggregateStats. Counts split
+// open issues into blocked and ready as CompareProjects does, and ages and
+// label health are rounded to one decimal place.
+func ComputeAggregateStats(issues []model.Issue, now time.Time) AggregateStats {
+	analyzer := NewAnalyzer(issues)
+	graphStats := analyzer.Analyze()
+	actionable := analyzer.GetActionableIssues()
+	actionableSet := make(map[string]bool, len(actionable))
+	for _, a := range actionable {
+		actionableSet[a.ID] = true
+	}
+	counts := countIssues(issues, actionableSet)
+
+	s := AggregateStats{
+		Total:           counts.Total,
+		Open:            counts.Open,
+		Closed:          counts.Closed,
+		Blocked:         counts.Blocked,
+		Ready:           counts.Actionable,
+		CycleCount:      len(graphStats.Cycles()),
+		BottleneckCount: len(Bottlenecks(issues, 0)),
+	}
+	for resolution, n := range counts.ByResolution {
+		switch resolution {
+		case "fixed":
+			s.ClosedFixed = n
+		case "wontfix":
+			s.ClosedWontfix = n
+		case "duplicate":
+			s.ClosedDuplicate = n
+		case "invalid":
+			s.ClosedInvalid = n
+		case ResolutionNone:
+			s.ClosedUnresolved = n
+		default:
+			s.ClosedOther += n
+		}
+	}
+
+	var ages []float64
+	for _, issue := range issues {
+		if issue.Status == model.StatusClosed || issue.CreatedAt.IsZero() {
+			continue
+		}
+		ages = append(ages, now.Sub(issue.CreatedAt).Hours()/24)
+	}
+	if len(ages) > 0 {
+		sort.Float64s(ages)
+		sum := 0.0
+		for _, a := range ages {
+			sum += a
+		}
+		median := ages[len(ages)/2]
+		if len(ages)%2 == 0 {
+			median = (ages[len(ages)/2-1] + median) / 2
+		}
+		s.AvgAgeDays = roundTenth(sum / float64(len(ages)))
+		s.MedianAgeDays = roundTenth(median)
+	}
+
+	labels := ComputeAllLabelHealth(issues, DefaultLabelHealthConfig(), now, nil)
+	if len(labels.Labels) > 0 {
+		sum := 0
+		for _, l := range labels.Labels {
+			sum += l.Health
+		}
+		s.LabelHealthAvg = roundTenth(float64(sum) / float64(len(labels.Labels)))
+	}
+	return s
+}
+
+func roundTenth(v float64) float64 {
+	return math.Round(v*10) / 10
+}
@@ -0,0 +1,48 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeAggregateStats(t *testing.T) {
+	now := time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC)
+	days := func(n int) time.Time { return now.AddDate(0, 0, -n) }
+	blocks := func(from, to string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: from, DependsOnID: to, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen, CreatedAt: days(10), Labels: []string{"api"}},
+		{ID: "B", Status: model.StatusOpen, CreatedAt: days(2), Dependencies: blocks("B", "C")},
+		{ID: "C", Status: model.StatusOpen, CreatedAt: days(1), Dependencies: blocks("C", "B")},
+		{ID: "D", Status: model.StatusInProgress, CreatedAt: days(5)},
+		{ID: "E", Status: model.StatusClosed, CreatedAt: days(30)},
+		{ID: "G", Status: model.StatusClosed, Resolution: "Won't Fix"},
+		{ID: "H", Status: model.StatusClosed, Resolution: "fixed"},
+		{ID: "I", Status: model.StatusClosed, Resolution: "obsolete"},
+		{ID: "F", Status: model.StatusOpen}, // Undated
+	}
+
+	s := ComputeAggregateStats(issues, now)
+	want := AggregateStats{
+		Total: 9, Open: 5, Closed: 4, Blocked: 2, Ready: 3,
+		ClosedFixed: 1, ClosedWontfix: 1, ClosedOther: 1, ClosedUnresolved: 1,
+		AvgAgeDays: 4.5, MedianAgeDays: 3.5,
+		CycleCount: 1, BottleneckCount: 2,
+	}
+	got := s
+	got.LabelHealthAvg = 0
+	if got != want {
+		t.Errorf("stats = %+v, want %+v", got, want)
+	}
+	if s.LabelHealthAvg <= 0 || s.LabelHealthAvg > 100 {
+		t.Errorf("label_health_avg = %v, want a 0-100 score", s.LabelHealthAvg)
+	}
+
+	empty := ComputeAggregateStats(nil, now)
+	if empty != (AggregateStats{}) {
+		t.Errorf("empty = %+v, want zeros", empty)
+	}
+}
@@ -0,0 +1,52 @@
+package analysis
+
+import (
+	"path"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// IsAssigneeGlob reports whether an assignee filter uses glob syntax
+// (*, ? or [...]) rather than naming one person.
+func IsAssigneeGlob(pattern string) bool {
+	return strings.ContainsAny(pattern, "*?[")
+}
+
+// ValidateAssigneePattern returns path.ErrBadPattern if pattern is a
+// malformed glob. Plain names are always valid.
+func ValidateAssigneePattern(pattern string) error {
+	if !IsAssigneeGlob(pattern) {
+		return nil
+	}
+	_, err := path.Match(strings.ToLower(pattern), "")
+	return err
+}
+
+// MatchAssigneeGlob matches an assignee against a glob, ignoring case. As in
+// shell globs, * does not cross "/", so "team-backend/*" matches
+// "team-backend/ana" but not "team-backend/infra/bo". Unassigned issues
+// never match.
+func MatchAssigneeGlob(pattern, assignee string) bool {
+	if assignee == "" {
+		return false
+	}
+	ok, err := path.Match(strings.ToLower(pattern), strings.ToLower(assignee))
+	return err == nil && ok
+}
+
+// CountByAssignee returns how many of the given issue IDs each assignee
+// holds, keyed by assignee. Unassigned and unknown IDs are skipped.
+func CountByAssignee(issues []model.Issue, ids []string) map[string]int {
+	assignees := make(map[string]string, len(issues))
+	for _, issue := range issues {
+		assignees[issue.ID] = issue.Assignee
+	}
+	counts := make(map[string]int)
+	for _, id := range ids {
+		if a := assignees[id]; a != "" {
+			counts[a]++
+		}
+	}
+	return counts
+}
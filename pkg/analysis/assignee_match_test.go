@@ -0,0 +1,56 @@
+package analysis
+
+import (
+	"errors"
+	"path"
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestMatchAssigneeGlob(t *testing.T) {
+	tests := []struct {
+		pattern, assignee string
+		want              bool
+	}{
+		{"team-backend/*", "team-backend/ana", true},
+		{"team-backend/*", "Team-Backend/Ana", true},
+		{"team-backend/*", "team-backend/infra/bo", false},
+		{"team-backend/*", "team-frontend/cy", false},
+		{"*@example.com", "ana@example.com", true},
+		{"*@example.com", "ana@example.org", false},
+		{"ana?", "ana1", true},
+		{"*", "", false},
+	}
+	for _, tt := range tests {
+		if got := MatchAssigneeGlob(tt.pattern, tt.assignee); got != tt.want {
+			t.Errorf("MatchAssigneeGlob(%q, %q) = %v, want %v", tt.pattern, tt.assignee, got, tt.want)
+		}
+	}
+}
+
+func TestValidateAssigneePattern(t *testing.T) {
+	for _, ok := range []string{"", "ana", "team-*/ana", "[ab]*"} {
+		if err := ValidateAssigneePattern(ok); err != nil {
+			t.Errorf("ValidateAssigneePattern(%q) = %v, want nil", ok, err)
+		}
+	}
+	if err := ValidateAssigneePattern("team-[backend"); !errors.Is(err, path.ErrBadPattern) {
+		t.Errorf("ValidateAssigneePattern(unclosed bracket) = %v, want ErrBadPattern", err)
+	}
+}
+
+func TestCountByAssignee(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Assignee: "team-backend/ana"},
+		{ID: "B", Assignee: "team-backend/ana"},
+		{ID: "C", Assignee: "team-backend/bo"},
+		{ID: "D"},
+	}
+	got := CountByAssignee(issues, []string{"A", "B", "C", "D", "missing"})
+	want := map[string]int{"team-backend/ana": 2, "team-backend/bo": 1}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CountByAssignee = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,193 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Bottleneck is an open issue that other open work waits on, directly or
+// through a chain of blocking dependencies.
+type Bottleneck struct {
+	ID            string `json:"id"`
+	Title         string `json:"title"`
+	Status        string `json:"status"`
+	Priority      int    `json:"priority"`
+	UnblocksCount int    `json:"unblocks_count"` // Open issues that transitively depend on this one
+	DirectCount   int    `json:"direct_count"`   // Of those, issues that depend on it directly
+}
+
+// Bottlenecks ranks open issues by how many open issues transitively depend
+// on them through blocking dependencies. Finishing the top entries frees the
+// most downstream work. Traversal stops at closed issues, since their
+// dependents are no longer held up by them. IDs are matched as-is, so in a
+// multi-project workspace namespaced cross-project edges are followed.
+//
+// Issues with no open dependents are omitted. Results are ordered by
+// unblocks_count (descending), then priority, then ID. A limit <= 0 returns
+// every bottleneck.
+func Bottlenecks(issues []model.Issue, limit int) []Bottleneck {
+	open, dependents := openDependents(issues)
+
+	result := []Bottleneck{}
+	for id, issue := range open {
+		direct := dependents[id]
+		if len(direct) == 0 {
+			continue
+		}
+		result = append(result, Bottleneck{
+			ID:            id,
+			Title:         issue.Title,
+			Status:        string(issue.Status),
+			Priority:      issue.Priority,
+			UnblocksCount: len(reachable(id, dependents)),
+			DirectCount:   len(direct),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.UnblocksCount != b.UnblocksCount {
+			return a.UnblocksCount > b.UnblocksCount
+		}
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.ID < b.ID
+	})
+
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
+
+// openDependents indexes the open issues by ID and returns, for each open
+// blocker, the open issues that directly wait on it.
+func openDependents(issues []model.Issue) (map[string]*model.Issue, map[string][]string) {
+	open := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		if issues[i].Status != model.StatusClosed {
+			open[issues[i].ID] = &issues[i]
+		}
+	}
+
+	dependents := make(map[string][]string)
+	for _, issue := range open {
+		seen := make(map[string]bool)
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || seen[dep.DependsOnID] || dep.DependsOnID == issue.ID {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			if _, ok := open[dep.DependsOnID]; ok {
+				dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], issue.ID)
+			}
+		}
+	}
+	return open, dependents
+}
+
+// reachable returns the nodes reachable from start via edges, excluding start.
+// Cycles are handled by the visited set.
+func reachable(start string, edges map[string][]string) []string {
+	visited := map[string]bool{start: true}
+	stack := append([]string{}, edges[start]...)
+	var out []string
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		out = append(out, id)
+		stack = append(stack, edges[id]...)
+	}
+	return out
+}
+
+// RecommendedFocusSize is how many picks --robot-plan suggests.
+const RecommendedFocusSize = 3
+
+// FocusPick is one issue in a recommended focus set.
+type FocusPick struct {
+	ID            string     `json:"id"`
+	Title         string     `json:"title"`
+	Priority      int        `json:"priority"`
+	UnblocksCount int        `json:"unblocks_count"` // Blocked issues freed by this pick beyond the earlier picks
+	UnblocksIDs   []string   `json:"unblocks"`
+	Source        *SourceRef `json:"source,omitempty"`
+}
+
+// RecommendFocus picks up to k actionable issues that together release the
+// most blocked work. Each actionable issue covers the open issues that
+// transitively wait on it (as in Bottlenecks); the picks are chosen greedily,
+// each adding the most not-yet-covered issues, with ties broken by priority
+// then ID. Unlike Bottlenecks, two blockers holding up the same work are not
+// both picked. Selection stops early once no pick would free anything more.
+func RecommendFocus(issues []model.Issue, k int) []FocusPick {
+	open, dependents := openDependents(issues)
+
+	// Candidates are the open issues nothing open is waiting on, i.e. work
+	// that can start now.
+	blocked := make(map[string]bool)
+	for _, ids := range dependents {
+		for _, id := range ids {
+			blocked[id] = true
+		}
+	}
+	covers := make(map[string][]string)
+	var candidates []string
+	for id := range open {
+		if blocked[id] || len(dependents[id]) == 0 {
+			continue
+		}
+		candidates = append(candidates, id)
+		covers[id] = reachable(id, dependents)
+	}
+	sort.Strings(candidates)
+
+	picks := []FocusPick{}
+	covered := make(map[string]bool)
+	chosen := make(map[string]bool)
+	for len(picks) < k {
+		best, bestGain := "", 0
+		for _, id := range candidates {
+			if chosen[id] {
+				continue
+			}
+			gain := 0
+			for _, dep := range covers[id] {
+				if !covered[dep] {
+					gain++
+				}
+			}
+			if gain > bestGain || (gain == bestGain && gain > 0 && open[id].Priority < open[best].Priority) {
+				best, bestGain = id, gain
+			}
+		}
+		if best == "" {
+			break
+		}
+
+		chosen[best] = true
+		var freed []string
+		for _, dep := range covers[best] {
+			if !covered[dep] {
+				covered[dep] = true
+				freed = append(freed, dep)
+			}
+		}
+		sort.Strings(freed)
+		picks = append(picks, FocusPick{
+			ID:            best,
+			Title:         open[best].Title,
+			Priority:      open[best].Priority,
+			UnblocksCount: len(freed),
+			UnblocksIDs:   freed,
+			Source:        sourceRef(open[best]),
+		})
+	}
+	return picks
+}
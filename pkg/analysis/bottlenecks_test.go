@@ -0,0 +1,126 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestBottlenecks(t *testing.T) {
+	blocks := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	issues := []model.Issue{
+		{ID: "api-1", Title: "Auth", Status: model.StatusOpen, Priority: 2},
+		{ID: "web-1", Status: model.StatusOpen, Dependencies: blocks("api-1")}, // cross-project edge
+		{ID: "web-2", Status: model.StatusOpen, Dependencies: blocks("web-1")},
+		{ID: "web-3", Status: model.StatusInProgress, Dependencies: blocks("web-1", "web-1")}, // duplicate edge
+		{ID: "web-4", Status: model.StatusClosed, Dependencies: blocks("api-1")},              // closed dependent
+		{ID: "web-5", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "api-1", Type: model.DepRelated}}},
+		{ID: "api-2", Status: model.StatusClosed},
+		{ID: "web-6", Status: model.StatusOpen, Dependencies: blocks("api-2")}, // closed blocker
+		{ID: "ops-1", Status: model.StatusOpen, Priority: 1},
+		{ID: "ops-2", Status: model.StatusOpen, Dependencies: blocks("ops-1")},
+	}
+
+	got := Bottlenecks(issues, 0)
+
+	want := []struct {
+		id       string
+		unblocks int
+		direct   int
+	}{
+		{"api-1", 3, 1},
+		{"web-1", 2, 2},
+		{"ops-1", 1, 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d bottlenecks, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if got[i].ID != w.id || got[i].UnblocksCount != w.unblocks || got[i].DirectCount != w.direct {
+			t.Errorf("bottleneck[%d] = %s unblocks=%d direct=%d, want %s %d/%d",
+				i, got[i].ID, got[i].UnblocksCount, got[i].DirectCount, w.id, w.unblocks, w.direct)
+		}
+	}
+	if got[0].Title != "Auth" || got[0].Priority != 2 {
+		t.Errorf("expected issue fields to be copied, got %+v", got[0])
+	}
+
+	if limited := Bottlenecks(issues, 1); len(limited) != 1 || limited[0].ID != "api-1" {
+		t.Errorf("limit 1 = %+v", limited)
+	}
+}
+
+func TestBottlenecks_Cycle(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "a", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "b", Type: model.DepBlocks}}},
+		{ID: "b", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "a", Type: model.DepBlocks}}},
+	}
+	got := Bottlenecks(issues, 0)
+	if len(got) != 2 {
+		t.Fatalf("expected both cycle members, got %+v", got)
+	}
+	for _, b := range got {
+		if b.UnblocksCount != 1 {
+			t.Errorf("%s unblocks = %d, want 1 (self excluded)", b.ID, b.UnblocksCount)
+		}
+	}
+}
+
+func TestBottlenecks_NoneIsEmptySlice(t *testing.T) {
+	got := Bottlenecks([]model.Issue{{ID: "a", Status: model.StatusOpen}}, 0)
+	if got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil slice, got %#v", got)
+	}
+}
+
+func TestRecommendFocus(t *testing.T) {
+	blocks := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	issues := []model.Issue{
+		// A and B both hold up the same chain; only one is worth picking
+		{ID: "A", Title: "Schema", Status: model.StatusOpen, Priority: 1},
+		{ID: "B", Status: model.StatusOpen, Priority: 2},
+		{ID: "X1", Status: model.StatusOpen, Dependencies: blocks("A", "B")},
+		{ID: "X2", Status: model.StatusOpen, Dependencies: blocks("X1")},
+		{ID: "X3", Status: model.StatusOpen, Dependencies: blocks("X1")},
+		// C frees less than A but nothing A already covers
+		{ID: "C", Status: model.StatusOpen, Priority: 3},
+		{ID: "Y1", Status: model.StatusOpen, Dependencies: blocks("C")},
+		{ID: "Y2", Status: model.StatusInProgress, Dependencies: blocks("C")},
+		// Closed dependents and isolated issues contribute nothing
+		{ID: "D", Status: model.StatusOpen},
+		{ID: "Z", Status: model.StatusClosed, Dependencies: blocks("D")},
+	}
+
+	got := RecommendFocus(issues, RecommendedFocusSize)
+	if len(got) != 2 {
+		t.Fatalf("got %d picks, want 2 (B adds nothing after A): %+v", len(got), got)
+	}
+	if got[0].ID != "A" || got[0].UnblocksCount != 3 || got[0].Title != "Schema" {
+		t.Errorf("pick[0] = %+v, want A unblocking 3", got[0])
+	}
+	if got[1].ID != "C" || got[1].UnblocksCount != 2 {
+		t.Errorf("pick[1] = %+v, want C unblocking 2", got[1])
+	}
+	if want := []string{"Y1", "Y2"}; len(got[1].UnblocksIDs) != 2 || got[1].UnblocksIDs[0] != want[0] || got[1].UnblocksIDs[1] != want[1] {
+		t.Errorf("pick[1].unblocks = %v, want %v", got[1].UnblocksIDs, want)
+	}
+
+	if got := RecommendFocus(issues, 1); len(got) != 1 || got[0].ID != "A" {
+		t.Errorf("RecommendFocus(k=1) = %+v, want just A", got)
+	}
+	if got := RecommendFocus(nil, 3); got == nil || len(got) != 0 {
+		t.Errorf("RecommendFocus(nil) = %#v, want empty non-nil slice", got)
+	}
+}
@@ -145,12 +145,16 @@ func ComputeDataHash(issues []model.Issue) string {
 		h.Write([]byte{0})
 		h.Write([]byte(issue.Description))
 		h.Write([]byte{0})
+		h.Write([]byte(issue.Body))
+		h.Write([]byte{0})
 		h.Write([]byte(issue.Notes))
 		h.Write([]byte{0})
 		h.Write([]byte(issue.Design))
 		h.Write([]byte{0})
 		h.Write([]byte(issue.AcceptanceCriteria))
 		h.Write([]byte{0})
+		h.Write([]byte(issue.Color))
+		h.Write([]byte{0})
 		h.Write([]byte(issue.Assignee))
 		h.Write([]byte{0})
 		h.Write([]byte(issue.SourceRepo))
@@ -159,6 +163,8 @@ func ComputeDataHash(issues []model.Issue) string {
 			h.Write([]byte(*issue.ExternalRef))
 		}
 		h.Write([]byte{0})
+		h.Write([]byte(issue.ExternalID))
+		h.Write([]byte{0})
 
 		h.Write([]byte(issue.Status))
 		h.Write([]byte{0})
@@ -0,0 +1,121 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// UnnamedProject names the group of issues with no source_repo or ID prefix.
+const UnnamedProject = "(none)"
+
+// ProjectComparison summarizes one project for a side-by-side comparison.
+type ProjectComparison struct {
+	Project           string             `json:"project"`
+	Total             int                `json:"total"`
+	Open              int                `json:"open"`
+	Closed            int                `json:"closed"`
+	Blocked           int                `json:"blocked"`
+	Actionable        int                `json:"actionable"`
+	AvgAgeDays        float64            `json:"avg_age_days"` // Mean days since creation of dated open issues
+	Labels            LabelHealthSummary `json:"labels"`
+	DependencyEdges   int                `json:"dependency_edges"`    // Dependencies declared by the project's issues
+	CrossProjectEdges int                `json:"cross_project_edges"` // Of those, edges to another project's issues
+}
+
+// LabelHealthSummary condenses a project's label health into level counts.
+type LabelHealthSummary struct {
+	Total     int `json:"total"`
+	Healthy   int `json:"healthy"`
+	Warning   int `json:"warning"`
+	Critical  int `json:"critical"`
+	AvgHealth int `json:"avg_health"` // Mean label health score 0-100 (0 with no labels)
+}
+
+// CompareProjects groups issues by project (the same grouping as the triage
+// age histogram's by_project counts) and summarizes each, sorted by name.
+// Blocked and actionable counts use the whole issue set, so a blocker in
+// another project still blocks.
+func CompareProjects(issues []model.Issue, now time.Time) []ProjectComparison {
+	actionable := NewAnalyzer(issues).GetActionableIssues()
+	actionableSet := make(map[string]bool, len(actionable))
+	for _, a := range actionable {
+		actionableSet[a.ID] = true
+	}
+
+	projectOf := make(map[string]string, len(issues))
+	groups := make(map[string][]model.Issue)
+	for i := range issues {
+		name := issueProject(&issues[i])
+		if name == "" {
+			name = UnnamedProject
+		}
+		projectOf[issues[i].ID] = name
+		groups[name] = append(groups[name], issues[i])
+	}
+
+	names := make([]string, 0, len(groups))
+	for name := range groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cfg := DefaultLabelHealthConfig()
+	result := make([]ProjectComparison, 0, len(names))
+	for _, name := range names {
+		group := groups[name]
+		counts := countIssues(group, actionableSet)
+		pc := ProjectComparison{
+			Project:    name,
+			Total:      counts.Total,
+			Open:       counts.Open,
+			Closed:     counts.Closed,
+			Blocked:    counts.Blocked,
+			Actionable: counts.Actionable,
+			Labels:     summarizeLabelHealth(ComputeAllLabelHealth(group, cfg, now, nil)),
+		}
+
+		var ageSum float64
+		dated := 0
+		for _, issue := range group {
+			for _, dep := range issue.Dependencies {
+				if dep == nil {
+					continue
+				}
+				pc.DependencyEdges++
+				if target, ok := projectOf[dep.DependsOnID]; ok && target != name {
+					pc.CrossProjectEdges++
+				}
+			}
+			if issue.Status == model.StatusClosed || issue.CreatedAt.IsZero() {
+				continue
+			}
+			ageSum += now.Sub(issue.CreatedAt).Hours() / 24
+			dated++
+		}
+		if dated > 0 {
+			pc.AvgAgeDays = math.Round(ageSum/float64(dated)*10) / 10
+		}
+		result = append(result, pc)
+	}
+	return result
+}
+
+func summarizeLabelHealth(res LabelAnalysisResult) LabelHealthSummary {
+	s := LabelHealthSummary{
+		Total:    res.TotalLabels,
+		Healthy:  res.HealthyCount,
+		Warning:  res.WarningCount,
+		Critical: res.CriticalCount,
+	}
+	if len(res.Labels) > 0 {
+		sum := 0
+		for _, l := range res.Labels {
+			sum += l.Health
+		}
+		s.AvgHealth = sum / len(res.Labels)
+	}
+	return s
+}
@@ -0,0 +1,63 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestCompareProjects(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) time.Time { return now.AddDate(0, 0, -d) }
+	issues := []model.Issue{
+		{ID: "api-1", Status: model.StatusOpen, CreatedAt: daysAgo(10), Labels: []string{"backend"}},
+		{ID: "api-2", Status: model.StatusOpen, CreatedAt: daysAgo(30), Labels: []string{"backend"}},
+		{ID: "api-3", Status: model.StatusClosed, CreatedAt: daysAgo(100)},
+		{ID: "web-1", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{IssueID: "web-1", DependsOnID: "api-1", Type: model.DepBlocks},
+			{IssueID: "web-1", DependsOnID: "web-2", Type: model.DepRelated},
+		}},
+		{ID: "web-2", Status: model.StatusOpen, CreatedAt: daysAgo(4)},
+		{ID: "misc", Status: model.StatusOpen},
+	}
+
+	got := CompareProjects(issues, now)
+	if len(got) != 3 {
+		t.Fatalf("got %d projects, want 3: %+v", len(got), got)
+	}
+	byName := make(map[string]ProjectComparison)
+	names := ""
+	for _, p := range got {
+		byName[p.Project] = p
+		names += p.Project + ","
+	}
+	if names != UnnamedProject+",api,web," {
+		t.Errorf("projects = %s, want sorted by name", names)
+	}
+
+	api := byName["api"]
+	if api.Total != 3 || api.Open != 2 || api.Closed != 1 || api.Blocked != 0 {
+		t.Errorf("api counts = %+v", api)
+	}
+	if api.AvgAgeDays != 20 {
+		t.Errorf("api avg age = %v, want 20 (closed issue excluded)", api.AvgAgeDays)
+	}
+	if api.Labels.Total != 1 || api.Labels.Healthy+api.Labels.Warning+api.Labels.Critical != 1 || api.Labels.AvgHealth == 0 {
+		t.Errorf("api labels = %+v", api.Labels)
+	}
+
+	web := byName["web"]
+	if web.Blocked != 1 || web.Actionable != 1 {
+		t.Errorf("web blocked/actionable = %d/%d, want 1/1 (blocked by api-1)", web.Blocked, web.Actionable)
+	}
+	if web.DependencyEdges != 2 || web.CrossProjectEdges != 1 {
+		t.Errorf("web edges = %d (cross %d), want 2 (cross 1)", web.DependencyEdges, web.CrossProjectEdges)
+	}
+	if web.AvgAgeDays != 4 {
+		t.Errorf("web avg age = %v, want 4 (undated issue excluded)", web.AvgAgeDays)
+	}
+	if web.Labels.Total != 0 || web.Labels.AvgHealth != 0 {
+		t.Errorf("web labels = %+v, want empty", web.Labels)
+	}
+}
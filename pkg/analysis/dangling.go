@@ -0,0 +1,117 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Reasons a dependency target is missing from the loaded issues.
+const (
+	// DanglingProjectNotLoaded: the target's ID prefix names a project with
+	// no loaded issues (not loaded, or filtered out with --repo).
+	DanglingProjectNotLoaded = "project_not_loaded"
+	// DanglingNotFound: the target's project is loaded but has no such issue.
+	DanglingNotFound = "not_found"
+)
+
+// DanglingDep is a dependency whose target is not among the loaded issues.
+type DanglingDep struct {
+	IssueID       string `json:"issue_id"`
+	DependsOnID   string `json:"depends_on_id"`
+	Type          string `json:"type"`
+	TargetProject string `json:"target_project,omitempty"`
+	Reason        string `json:"reason"` // project_not_loaded or not_found
+}
+
+// FindDanglingDeps returns dependencies that point outside the loaded
+// issues, sorted by issue and target ID. A target whose ID prefix matches
+// no loaded issue's prefix or source_repo is DanglingProjectNotLoaded,
+// anything else DanglingNotFound. Returns nil when every edge resolves.
+func FindDanglingDeps(issues []model.Issue) []DanglingDep {
+	ids := make(map[string]bool, len(issues))
+	projects := make(map[string]bool)
+	for i := range issues {
+		ids[issues[i].ID] = true
+		projects[strings.ToLower(issueProject(&issues[i]))] = true
+		projects[strings.ToLower(idProject(issues[i].ID))] = true
+	}
+
+	var dangling []DanglingDep
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep == nil || dep.DependsOnID == "" || ids[dep.DependsOnID] {
+				continue
+			}
+			d := DanglingDep{
+				IssueID:       issue.ID,
+				DependsOnID:   dep.DependsOnID,
+				Type:          string(dep.Type),
+				TargetProject: idProject(dep.DependsOnID),
+				Reason:        DanglingNotFound,
+			}
+			if d.TargetProject != "" && !projects[strings.ToLower(d.TargetProject)] {
+				d.Reason = DanglingProjectNotLoaded
+			}
+			dangling = append(dangling, d)
+		}
+	}
+
+	sort.Slice(dangling, func(i, j int) bool {
+		if dangling[i].IssueID != dangling[j].IssueID {
+			return dangling[i].IssueID < dangling[j].IssueID
+		}
+		return dangling[i].DependsOnID < dangling[j].DependsOnID
+	})
+	return dangling
+}
+
+// Zombie is a dependency target that no loaded project contains even though
+// its project is loaded: an issue that was deleted or never created.
+type Zombie struct {
+	ID             string   `json:"id"`
+	Project        string   `json:"project,omitempty"` // From the ID prefix; empty for bare IDs
+	ReferencedBy   []string `json:"referenced_by"`     // Issues with a dependency on ID, sorted
+	ReferenceCount int      `json:"reference_count"`
+}
+
+// FindZombies groups the DanglingNotFound targets of FindDanglingDeps by
+// ID, most referenced first (then by ID). Targets in projects that are not
+// loaded are left out: they may well exist. Returns nil when there are none.
+func FindZombies(issues []model.Issue) []Zombie {
+	byID := make(map[string]*Zombie)
+	var zombies []*Zombie
+	for _, d := range FindDanglingDeps(issues) {
+		if d.Reason != DanglingNotFound {
+			continue
+		}
+		z, ok := byID[d.DependsOnID]
+		if !ok {
+			z = &Zombie{ID: d.DependsOnID, Project: d.TargetProject}
+			byID[d.DependsOnID] = z
+			zombies = append(zombies, z)
+		}
+		// Dangling deps are sorted by issue, so repeat edges from one
+		// issue (e.g. blocks and related) are adjacent
+		if n := len(z.ReferencedBy); n == 0 || z.ReferencedBy[n-1] != d.IssueID {
+			z.ReferencedBy = append(z.ReferencedBy, d.IssueID)
+		}
+	}
+	if len(zombies) == 0 {
+		return nil
+	}
+
+	result := make([]Zombie, len(zombies))
+	for i, z := range zombies {
+		z.ReferenceCount = len(z.ReferencedBy)
+		result[i] = *z
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ReferenceCount != result[j].ReferenceCount {
+			return result[i].ReferenceCount > result[j].ReferenceCount
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
@@ -0,0 +1,77 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestFindDanglingDeps(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "web-1", Dependencies: []*model.Dependency{
+			{IssueID: "web-1", DependsOnID: "api-API-1", Type: model.DepBlocks},
+			{IssueID: "web-1", DependsOnID: "web-2", Type: model.DepBlocks},
+			{IssueID: "web-1", DependsOnID: "web-99", Type: model.DepRelated},
+		}},
+		{ID: "web-2", Dependencies: []*model.Dependency{
+			{IssueID: "web-2", DependsOnID: "42", Type: model.DepBlocks},
+		}},
+		{ID: "lib-1", SourceRepo: "shared", Dependencies: []*model.Dependency{
+			{IssueID: "lib-1", DependsOnID: "shared-7", Type: model.DepBlocks},
+		}},
+	}
+
+	got := FindDanglingDeps(issues)
+	want := []DanglingDep{
+		{IssueID: "lib-1", DependsOnID: "shared-7", Type: "blocks", TargetProject: "shared", Reason: DanglingNotFound},
+		{IssueID: "web-1", DependsOnID: "api-API-1", Type: "blocks", TargetProject: "api", Reason: DanglingProjectNotLoaded},
+		{IssueID: "web-1", DependsOnID: "web-99", Type: "related", TargetProject: "web", Reason: DanglingNotFound},
+		{IssueID: "web-2", DependsOnID: "42", Type: "blocks", Reason: DanglingNotFound},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d dangling deps, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("dangling[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if d := FindDanglingDeps(issues[1:2]); len(d) != 1 {
+		t.Errorf("expected only the bare-ID edge, got %+v", d)
+	}
+	if d := FindDanglingDeps(nil); d != nil {
+		t.Errorf("expected nil for no issues, got %+v", d)
+	}
+}
+
+func TestFindZombies(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api-API-1"},
+		{ID: "web-1", Dependencies: []*model.Dependency{
+			{IssueID: "web-1", DependsOnID: "api-API-99", Type: model.DepBlocks},
+			{IssueID: "web-1", DependsOnID: "api-API-99", Type: model.DepRelated},
+			{IssueID: "web-1", DependsOnID: "docs-3", Type: model.DepBlocks},
+		}},
+		{ID: "web-2", Dependencies: []*model.Dependency{
+			{IssueID: "web-2", DependsOnID: "api-API-99", Type: model.DepBlocks},
+			{IssueID: "web-2", DependsOnID: "web-7", Type: model.DepBlocks},
+			{IssueID: "web-2", DependsOnID: "api-API-1", Type: model.DepBlocks},
+		}},
+	}
+
+	got := FindZombies(issues)
+	if len(got) != 2 {
+		t.Fatalf("got %d zombies, want api-API-99 and web-7 (docs is not loaded): %+v", len(got), got)
+	}
+	if z := got[0]; z.ID != "api-API-99" || z.Project != "api" || z.ReferenceCount != 2 || len(z.ReferencedBy) != 2 || z.ReferencedBy[0] != "web-1" || z.ReferencedBy[1] != "web-2" {
+		t.Errorf("zombies[0] = %+v, want api-API-99 referenced once each by web-1 and web-2", z)
+	}
+	if z := got[1]; z.ID != "web-7" || z.ReferenceCount != 1 || z.ReferencedBy[0] != "web-2" {
+		t.Errorf("zombies[1] = %+v, want web-7 referenced by web-2", z)
+	}
+
+	if z := FindZombies(issues[:1]); z != nil {
+		t.Errorf("expected nil when every edge resolves, got %+v", z)
+	}
+}
@@ -0,0 +1,148 @@
+package analysis
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Data quality score weights; they sum to 100. Timestamps and dependencies
+// weigh most because age, staleness and blocking analysis rest on them.
+const (
+	qualityWeightTimestamps   = 30
+	qualityWeightEstimates    = 20
+	qualityWeightAssignees    = 20
+	qualityWeightDependencies = 30
+)
+
+// qualityWarnThreshold is the coverage below which a DataQuality warning
+// names the signals that coverage undermines.
+const qualityWarnThreshold = 0.5
+
+// DataQualityStats summarizes how complete the data behind a report is.
+// Fractions are 0-1; with nothing to measure a fraction is 1.
+type DataQualityStats struct {
+	Score             int     `json:"score"`              // 0-100, weighted over the fractions below
+	IssueCount        int     `json:"issue_count"`        // Issues measured for timestamps and dependencies
+	OpenCount         int     `json:"open_count"`         // Non-closed issues measured for estimates and assignees
+	WithTimestamps    float64 `json:"with_timestamps"`    // Issues with both created_at and updated_at
+	WithEstimates     float64 `json:"with_estimates"`     // Open issues with an estimate
+	WithAssignees     float64 `json:"with_assignees"`     // Open issues with an assignee
+	ValidDependencies float64 `json:"valid_dependencies"` // Issues whose dependencies all resolve to other loaded issues
+}
+
+// DataQuality tells consumers how far to trust a triage: age-based
+// recommendations mean little if most issues lack timestamps.
+type DataQuality struct {
+	DataQualityStats
+	Warnings  []string                    `json:"warnings,omitempty"`
+	ByProject map[string]DataQualityStats `json:"by_project"`
+}
+
+// ComputeDataQuality measures timestamp, estimate, assignee and dependency
+// coverage across issues and per project. A dependency is valid if it names
+// another issue in the set with a known type. Projects come from
+// source_repo, or the ID prefix, as in ComputeAgeHistogram.
+func ComputeDataQuality(issues []model.Issue) DataQuality {
+	ids := make(map[string]bool, len(issues))
+	for i := range issues {
+		ids[issues[i].ID] = true
+	}
+
+	type tally struct {
+		issues, open, timestamps, estimates, assignees, validDeps int
+	}
+	var total tally
+	byProject := make(map[string]*tally)
+	for i := range issues {
+		issue := &issues[i]
+		project := issueProject(issue)
+		p := byProject[project]
+		if p == nil {
+			p = &tally{}
+			byProject[project] = p
+		}
+		for _, t := range []*tally{&total, p} {
+			t.issues++
+			if !issue.CreatedAt.IsZero() && !issue.UpdatedAt.IsZero() {
+				t.timestamps++
+			}
+			if validDependencies(issue, ids) {
+				t.validDeps++
+			}
+			if issue.Status.IsClosed() {
+				continue
+			}
+			t.open++
+			if issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0 {
+				t.estimates++
+			}
+			if issue.Assignee != "" {
+				t.assignees++
+			}
+		}
+	}
+
+	stats := func(t *tally) DataQualityStats {
+		s := DataQualityStats{
+			IssueCount:        t.issues,
+			OpenCount:         t.open,
+			WithTimestamps:    fraction(t.timestamps, t.issues),
+			WithEstimates:     fraction(t.estimates, t.open),
+			WithAssignees:     fraction(t.assignees, t.open),
+			ValidDependencies: fraction(t.validDeps, t.issues),
+		}
+		s.Score = int(math.Round(qualityWeightTimestamps*s.WithTimestamps +
+			qualityWeightEstimates*s.WithEstimates +
+			qualityWeightAssignees*s.WithAssignees +
+			qualityWeightDependencies*s.ValidDependencies))
+		return s
+	}
+
+	dq := DataQuality{DataQualityStats: stats(&total), ByProject: make(map[string]DataQualityStats, len(byProject))}
+	for project, t := range byProject {
+		dq.ByProject[project] = stats(t)
+	}
+
+	checks := []struct {
+		coverage float64
+		format   string
+	}{
+		{dq.WithTimestamps, "%d%% of issues lack timestamps; age and staleness signals are unreliable"},
+		{dq.WithEstimates, "%d%% of open issues lack estimates; effort-based signals use defaults"},
+		{dq.WithAssignees, "%d%% of open issues are unassigned; ownership signals are incomplete"},
+		{dq.ValidDependencies, "%d%% of issues have dangling or invalid dependencies; blocking analysis may be incomplete"},
+	}
+	for _, c := range checks {
+		if c.coverage < qualityWarnThreshold {
+			dq.Warnings = append(dq.Warnings, fmt.Sprintf(c.format, int(math.Round(100*(1-c.coverage)))))
+		}
+	}
+	return dq
+}
+
+// validDependencies reports whether every dependency of issue names another
+// issue in ids with a known (or empty, i.e. blocking) type.
+func validDependencies(issue *model.Issue, ids map[string]bool) bool {
+	for _, dep := range issue.Dependencies {
+		if dep == nil {
+			continue
+		}
+		if dep.DependsOnID == "" || dep.DependsOnID == issue.ID || !ids[dep.DependsOnID] {
+			return false
+		}
+		if dep.Type != "" && !dep.Type.IsValid() {
+			return false
+		}
+	}
+	return true
+}
+
+// fraction returns n/d rounded to three places, or 1 when d is 0.
+func fraction(n, d int) float64 {
+	if d == 0 {
+		return 1
+	}
+	return math.Round(float64(n)/float64(d)*1000) / 1000
+}
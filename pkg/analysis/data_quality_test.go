@@ -0,0 +1,45 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeDataQuality(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	est := 60
+	issues := []model.Issue{
+		{ID: "api-1", Status: model.StatusOpen, CreatedAt: now, UpdatedAt: now, EstimatedMinutes: &est, Assignee: "alice"},
+		{ID: "api-2", Status: model.StatusOpen, CreatedAt: now, UpdatedAt: now, Assignee: "bob",
+			Dependencies: []*model.Dependency{{IssueID: "api-2", DependsOnID: "api-1", Type: model.DepBlocks}}},
+		{ID: "web-1", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "web-1", DependsOnID: "gone-9", Type: model.DepBlocks}}},
+		{ID: "web-2", Status: model.StatusClosed, CreatedAt: now},
+	}
+
+	dq := ComputeDataQuality(issues)
+	want := DataQualityStats{
+		Score: 58, IssueCount: 4, OpenCount: 3,
+		WithTimestamps: 0.5, WithEstimates: 0.333, WithAssignees: 0.667, ValidDependencies: 0.75,
+	}
+	if dq.DataQualityStats != want {
+		t.Errorf("overall = %+v, want %+v", dq.DataQualityStats, want)
+	}
+	if len(dq.Warnings) != 1 {
+		t.Errorf("expected one warning (estimates), got %v", dq.Warnings)
+	}
+
+	if api := dq.ByProject["api"]; api.Score != 90 || api.WithEstimates != 0.5 {
+		t.Errorf("api = %+v, want score 90", api)
+	}
+	if web := dq.ByProject["web"]; web.Score != 15 || web.ValidDependencies != 0.5 || web.OpenCount != 1 {
+		t.Errorf("web = %+v, want score 15", web)
+	}
+
+	empty := ComputeDataQuality(nil)
+	if empty.Score != 100 || len(empty.Warnings) != 0 {
+		t.Errorf("empty = %+v, want a perfect score", empty)
+	}
+}
@@ -0,0 +1,157 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DefaultUnsuccessfulStatuses are the resolutions that count as closing an
+// issue without doing it, when display.yaml doesn't list its own.
+var DefaultUnsuccessfulStatuses = []string{"wontfix", "cancelled", "canceled", "out of scope"}
+
+// DeadEndBlocker is a blocker that was closed without being done.
+type DeadEndBlocker struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Status      string     `json:"status"`
+	CloseReason string     `json:"close_reason,omitempty"`
+	ClosedAt    *time.Time `json:"closed_at,omitempty"`
+}
+
+// DeadEnd is an open issue waiting on work that will never happen: one or
+// more of its blockers were closed as unsuccessful.
+type DeadEnd struct {
+	ID       string           `json:"id"`
+	Title    string           `json:"title"`
+	Status   string           `json:"status"`
+	Priority int              `json:"priority"`
+	Blockers []DeadEndBlocker `json:"blockers"`
+}
+
+// ResolutionNone is the ResolutionOf a closed issue without a resolution.
+const ResolutionNone = "none"
+
+// ClosedUnsuccessfully reports whether issue was resolved as one of the
+// unsuccessful resolutions: its status is one of them (a custom status such
+// as "wontfix"), or it is closed with that resolution or a close_reason
+// that starts with one. Comparison ignores case, spaces and punctuation, so
+// "won't fix" and "Won't fix: not needed" both match "wontfix".
+func ClosedUnsuccessfully(issue *model.Issue, unsuccessful []string) bool {
+	status := resolutionKey(string(issue.Status))
+	reason := resolutionKey(issue.CloseReason)
+	resolution := resolutionKey(issue.Resolution)
+	for _, u := range unsuccessful {
+		key := resolutionKey(u)
+		if key == "" {
+			continue
+		}
+		if status == key {
+			return true
+		}
+		if issue.Status == model.StatusClosed && (resolution == key || strings.HasPrefix(reason, key)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolutionOf returns a closed issue's resolution normalized like
+// ClosedUnsuccessfully compares them ("Won't Fix" becomes "wontfix"), or
+// ResolutionNone when it has none. Open issues return "".
+func ResolutionOf(issue *model.Issue) string {
+	if issue.Status != model.StatusClosed {
+		return ""
+	}
+	if key := resolutionKey(issue.Resolution); key != "" {
+		return key
+	}
+	return ResolutionNone
+}
+
+// HasResolution reports whether issue is closed with one of resolutions,
+// compared as ResolutionOf normalizes them; "none" matches closed issues
+// without a resolution.
+func HasResolution(issue *model.Issue, resolutions []string) bool {
+	got := ResolutionOf(issue)
+	if got == "" {
+		return false
+	}
+	for _, r := range resolutions {
+		if resolutionKey(r) == got {
+			return true
+		}
+	}
+	return false
+}
+
+// resolutionKey lowercases s and drops everything but letters and digits.
+func resolutionKey(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// FindDeadEnds lists open issues with a blocking dependency on an issue
+// that ClosedUnsuccessfully reports, so they can be re-scoped or closed.
+// Dependencies on issues that are not loaded are ignored. Results are
+// ordered by priority, then ID; blockers by ID.
+func FindDeadEnds(issues []model.Issue, unsuccessful []string) []DeadEnd {
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+
+	deadEnds := []DeadEnd{}
+	for i := range issues {
+		issue := &issues[i]
+		if issue.Status == model.StatusClosed || ClosedUnsuccessfully(issue, unsuccessful) {
+			continue
+		}
+		var blockers []DeadEndBlocker
+		seen := make(map[string]bool)
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || seen[dep.DependsOnID] {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			blocker, ok := issueMap[dep.DependsOnID]
+			if !ok || !ClosedUnsuccessfully(blocker, unsuccessful) {
+				continue
+			}
+			blockers = append(blockers, DeadEndBlocker{
+				ID:          blocker.ID,
+				Title:       blocker.Title,
+				Status:      string(blocker.Status),
+				CloseReason: blocker.CloseReason,
+				ClosedAt:    blocker.ClosedAt,
+			})
+		}
+		if len(blockers) == 0 {
+			continue
+		}
+		sort.Slice(blockers, func(a, b int) bool { return blockers[a].ID < blockers[b].ID })
+		deadEnds = append(deadEnds, DeadEnd{
+			ID:       issue.ID,
+			Title:    issue.Title,
+			Status:   string(issue.Status),
+			Priority: issue.Priority,
+			Blockers: blockers,
+		})
+	}
+
+	sort.Slice(deadEnds, func(i, j int) bool {
+		if deadEnds[i].Priority != deadEnds[j].Priority {
+			return deadEnds[i].Priority < deadEnds[j].Priority
+		}
+		return deadEnds[i].ID < deadEnds[j].ID
+	})
+	return deadEnds
+}
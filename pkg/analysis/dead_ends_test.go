@@ -0,0 +1,95 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestClosedUnsuccessfully(t *testing.T) {
+	tests := []struct {
+		status model.Status
+		reason string
+		want   bool
+	}{
+		{"wontfix", "", true},
+		{"Cancelled", "", true},
+		{model.StatusClosed, "Won't fix: not needed after the rewrite", true},
+		{model.StatusClosed, "Out of scope: handled elsewhere", true},
+		{model.StatusClosed, "Implemented and tested", false},
+		{model.StatusClosed, "", false},
+		{model.StatusOpen, "wontfix", false}, // a reason only counts once closed
+	}
+	for _, tt := range tests {
+		issue := model.Issue{ID: "x", Status: tt.status, CloseReason: tt.reason}
+		if got := ClosedUnsuccessfully(&issue, DefaultUnsuccessfulStatuses); got != tt.want {
+			t.Errorf("ClosedUnsuccessfully(%q, %q) = %v, want %v", tt.status, tt.reason, got, tt.want)
+		}
+	}
+	resolved := model.Issue{ID: "x", Status: model.StatusClosed, Resolution: "Won't Fix"}
+	if !ClosedUnsuccessfully(&resolved, DefaultUnsuccessfulStatuses) {
+		t.Error("a wontfix resolution should count as closed unsuccessfully")
+	}
+}
+
+func TestHasResolution(t *testing.T) {
+	wontfix := model.Issue{ID: "a", Status: model.StatusClosed, Resolution: "Won't Fix"}
+	bare := model.Issue{ID: "b", Status: model.StatusClosed}
+	open := model.Issue{ID: "c", Status: model.StatusOpen, Resolution: "fixed"}
+
+	if got := ResolutionOf(&wontfix); got != "wontfix" {
+		t.Errorf("ResolutionOf = %q, want wontfix", got)
+	}
+	if got := ResolutionOf(&bare); got != ResolutionNone {
+		t.Errorf("ResolutionOf(no resolution) = %q, want %q", got, ResolutionNone)
+	}
+	if !HasResolution(&wontfix, []string{"duplicate", "wont-fix"}) || HasResolution(&wontfix, []string{"fixed"}) {
+		t.Error("wontfix filter mismatch")
+	}
+	if !HasResolution(&bare, []string{"none"}) {
+		t.Error(`"none" should match a closed issue without a resolution`)
+	}
+	if HasResolution(&open, []string{"fixed"}) {
+		t.Error("open issues have no resolution to match")
+	}
+}
+
+func TestFindDeadEnds(t *testing.T) {
+	dep := func(id string, typ model.DependencyType) *model.Dependency {
+		return &model.Dependency{DependsOnID: id, Type: typ}
+	}
+	issues := []model.Issue{
+		{ID: "b", Priority: 2, Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			dep("x-2", model.DepBlocks),
+			dep("x-1", model.DepBlocks),
+			dep("done", model.DepBlocks),
+			dep("x-1", model.DepBlocks), // duplicate edge
+		}},
+		{ID: "a", Priority: 0, Status: model.StatusBlocked, Dependencies: []*model.Dependency{dep("x-1", model.DepBlocks)}},
+		{ID: "c", Priority: 0, Status: model.StatusOpen, Dependencies: []*model.Dependency{dep("x-1", model.DepRelated)}},  // not blocking
+		{ID: "d", Priority: 0, Status: model.StatusClosed, Dependencies: []*model.Dependency{dep("x-1", model.DepBlocks)}}, // closed
+		{ID: "e", Priority: 0, Status: model.StatusOpen, Dependencies: []*model.Dependency{dep("missing", model.DepBlocks)}},
+		{ID: "x-1", Status: model.StatusClosed, CloseReason: "Cancelled - replaced by a new design"},
+		{ID: "x-2", Status: "wontfix"},
+		{ID: "done", Status: model.StatusClosed, CloseReason: "Shipped"},
+	}
+
+	got := FindDeadEnds(issues, DefaultUnsuccessfulStatuses)
+	if len(got) != 2 || got[0].ID != "a" || got[1].ID != "b" {
+		t.Fatalf("dead ends = %+v, want a, b", got)
+	}
+	if len(got[1].Blockers) != 2 || got[1].Blockers[0].ID != "x-1" || got[1].Blockers[1].ID != "x-2" {
+		t.Errorf("b blockers = %+v, want x-1, x-2", got[1].Blockers)
+	}
+	if got[1].Blockers[0].CloseReason == "" || got[1].Blockers[1].Status != "wontfix" {
+		t.Errorf("blocker details missing: %+v", got[1].Blockers)
+	}
+
+	// A custom list replaces the defaults
+	if got := FindDeadEnds(issues, []string{"shipped"}); len(got) != 1 || got[0].ID != "b" || got[0].Blockers[0].ID != "done" {
+		t.Errorf("custom list: %+v", got)
+	}
+	if got := FindDeadEnds(nil, DefaultUnsuccessfulStatuses); got == nil || len(got) != 0 {
+		t.Errorf("expected empty non-nil slice, got %#v", got)
+	}
+}
@@ -0,0 +1,60 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DuplicateLink is an explicit "duplicate" dependency: IssueID was marked as
+// a duplicate of DuplicateOfID.
+type DuplicateLink struct {
+	IssueID           string       `json:"issue_id"`
+	IssueTitle        string       `json:"issue_title"`
+	IssueStatus       model.Status `json:"issue_status"`
+	DuplicateOfID     string       `json:"duplicate_of_id"`
+	DuplicateOfTitle  string       `json:"duplicate_of_title"`
+	DuplicateOfStatus model.Status `json:"duplicate_of_status"`
+	BothOpen          bool         `json:"both_open"` // Neither side is closed yet
+}
+
+// FindDuplicateLinks lists dependencies of type "duplicate" between loaded
+// issues, ordered by issue ID then target ID. Unlike the similarity-based
+// duplicate suggestions, these are links someone recorded on purpose.
+func FindDuplicateLinks(issues []model.Issue) []DuplicateLink {
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+
+	links := []DuplicateLink{}
+	for i := range issues {
+		issue := &issues[i]
+		for _, dep := range issue.Dependencies {
+			if dep == nil || dep.Type != model.DepDuplicate {
+				continue
+			}
+			target, ok := issueMap[dep.DependsOnID]
+			if !ok || target.ID == issue.ID {
+				continue
+			}
+			links = append(links, DuplicateLink{
+				IssueID:           issue.ID,
+				IssueTitle:        issue.Title,
+				IssueStatus:       issue.Status,
+				DuplicateOfID:     target.ID,
+				DuplicateOfTitle:  target.Title,
+				DuplicateOfStatus: target.Status,
+				BothOpen:          issue.Status != model.StatusClosed && target.Status != model.StatusClosed,
+			})
+		}
+	}
+
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].IssueID != links[j].IssueID {
+			return links[i].IssueID < links[j].IssueID
+		}
+		return links[i].DuplicateOfID < links[j].DuplicateOfID
+	})
+	return links
+}
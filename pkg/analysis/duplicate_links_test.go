@@ -0,0 +1,61 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestFindDuplicateLinks(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "B", Title: "Login broken", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{DependsOnID: "A", Type: model.DepDuplicate},
+			{DependsOnID: "C", Type: model.DepRelated},
+		}},
+		{ID: "A", Title: "Cannot log in", Status: model.StatusOpen},
+		{ID: "C", Title: "Auth cleanup", Status: model.StatusClosed, Dependencies: []*model.Dependency{
+			{DependsOnID: "A", Type: model.DepDuplicate},
+			{DependsOnID: "missing", Type: model.DepDuplicate},
+		}},
+	}
+
+	links := FindDuplicateLinks(issues)
+	if len(links) != 2 {
+		t.Fatalf("got %d links, want 2: %+v", len(links), links)
+	}
+	if links[0].IssueID != "B" || links[0].DuplicateOfID != "A" || !links[0].BothOpen {
+		t.Errorf("links[0] = %+v, want open B -> A", links[0])
+	}
+	if links[1].IssueID != "C" || links[1].BothOpen || links[1].DuplicateOfTitle != "Cannot log in" {
+		t.Errorf("links[1] = %+v, want closed C -> A", links[1])
+	}
+
+	if got := FindDuplicateLinks(nil); got == nil || len(got) != 0 {
+		t.Errorf("FindDuplicateLinks(nil) = %v, want empty slice", got)
+	}
+}
+
+func TestDuplicateAndRelatedDoNotBlock(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen},
+		{ID: "B", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{DependsOnID: "A", Type: model.DepDuplicate},
+		}},
+		{ID: "C", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{DependsOnID: "A", Type: model.DepRelated},
+		}},
+		{ID: "D", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{DependsOnID: "A", Type: model.DepBlockedBy},
+		}},
+	}
+	a := NewAnalyzer(issues)
+	ready := make(map[string]bool)
+	for _, issue := range a.GetActionableIssues() {
+		ready[issue.ID] = true
+	}
+	for id, want := range map[string]bool{"A": true, "B": true, "C": true, "D": false} {
+		if ready[id] != want {
+			t.Errorf("%s actionable = %v, want %v", id, ready[id], want)
+		}
+	}
+}
@@ -49,6 +49,20 @@ type DuplicateConfig struct {
 	// MaxSuggestions limits the number of duplicate suggestions
 	// Default: 20
 	MaxSuggestions int
+
+	// OpenOnly compares only issues that are not closed
+	OpenOnly bool
+
+	// CrossProjectOnly skips pairs from the same project (grouped as in
+	// CompareProjects)
+	CrossProjectOnly bool
+
+	// TitleOnly compares title words alone, keeping short words since
+	// titles are terse, instead of title and description keywords
+	TitleOnly bool
+
+	// SkipLinked skips pairs already joined by any dependency
+	SkipLinked bool
 }
 
 // DefaultDuplicateConfig returns sensible defaults
@@ -77,15 +91,82 @@ func DetectDuplicates(issues []model.Issue, config DuplicateConfig) []Suggestion
 		return nil
 	}
 
+	pairs := findDuplicatePairs(issues, config)
+
+	// Sort by similarity (highest first) and limit
+	sortPairsBySimilarity(pairs)
+	if len(pairs) > config.MaxSuggestions {
+		pairs = pairs[:config.MaxSuggestions]
+	}
+
+	// Issue lookup map for constructing suggestions
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+
+	// Convert to suggestions
+	suggestions := make([]Suggestion, 0, len(pairs))
+	for _, pair := range pairs {
+		issue1 := issueMap[pair.Issue1]
+		issue2 := issueMap[pair.Issue2]
+
+		sug := NewSuggestion(
+			SuggestionPotentialDuplicate,
+			pair.Issue1,
+			fmt.Sprintf("Potential duplicate of %s", pair.Issue2),
+			fmt.Sprintf("%.0f%% keyword similarity; common: %s",
+				pair.Similarity*100,
+				strings.Join(truncateStringSlice(pair.Keywords, 5), ", ")),
+			pair.Similarity,
+		).WithRelatedBead(pair.Issue2).WithMetadata("method", pair.Method)
+
+		// Add action command if both are open
+		if issue1.Status != model.StatusClosed && issue2.Status != model.StatusClosed {
+			sug = sug.WithAction(fmt.Sprintf("bd dep add %s %s --type=related", pair.Issue1, pair.Issue2))
+		}
+
+		suggestions = append(suggestions, sug)
+	}
+
+	return suggestions
+}
+
+// findDuplicatePairs returns every pair of issues whose keyword similarity
+// reaches config.JaccardThreshold and passes the config's filters, unsorted
+// and unlimited.
+func findDuplicatePairs(issues []model.Issue, config DuplicateConfig) []DuplicatePair {
+	candidates := make([]*model.Issue, 0, len(issues))
+	for i := range issues {
+		if config.OpenOnly && issues[i].Status == model.StatusClosed {
+			continue
+		}
+		candidates = append(candidates, &issues[i])
+	}
+
 	// 1. Extract keywords for each issue and build Inverted Index
 	// keywords[i] = unique keywords for issue i
-	keywords := make([][]string, len(issues))
+	keywords := make([][]string, len(candidates))
 	// index[word] = list of issue indices containing that word
 	index := make(map[string][]int)
-
-	for i := range issues {
-		kws := extractKeywords(issues[i].Title, issues[i].Description)
+	// linked holds pairs already joined by a dependency (SkipLinked)
+	linked := make(map[[2]string]bool)
+
+	for i, issue := range candidates {
+		var kws []string
+		if config.TitleOnly {
+			kws = titleTokens(issue.Title)
+		} else {
+			kws = extractKeywords(issue.Title, issue.Description)
+		}
 		keywords[i] = kws
+		if config.SkipLinked {
+			for _, dep := range issue.Dependencies {
+				if dep != nil {
+					linked[idPair(issue.ID, dep.DependsOnID)] = true
+				}
+			}
+		}
 
 		// Only index if enough keywords to matter
 		if len(kws) >= config.MinKeywords {
@@ -98,7 +179,7 @@ func DetectDuplicates(issues []model.Issue, config DuplicateConfig) []Suggestion
 	var pairs []DuplicatePair
 
 	// 2. Iterate through issues and find candidates
-	for i := range issues {
+	for i := range candidates {
 		// Skip if this issue doesn't have enough keywords
 		if len(keywords[i]) < config.MinKeywords {
 			continue
@@ -133,8 +214,14 @@ func DetectDuplicates(issues []model.Issue, config DuplicateConfig) []Suggestion
 				continue
 			}
 
-			issue1 := &issues[i]
-			issue2 := &issues[j]
+			issue1 := candidates[i]
+			issue2 := candidates[j]
+			if linked[idPair(issue1.ID, issue2.ID)] {
+				continue
+			}
+			if config.CrossProjectOnly && strings.EqualFold(issueProject(issue1), issueProject(issue2)) {
+				continue
+			}
 
 			// Skip closed vs open pairs if configured
 			if config.IgnoreClosedVsOpen {
@@ -155,44 +242,7 @@ func DetectDuplicates(issues []model.Issue, config DuplicateConfig) []Suggestion
 			})
 		}
 	}
-
-	// Sort by similarity (highest first) and limit
-	sortPairsBySimilarity(pairs)
-	if len(pairs) > config.MaxSuggestions {
-		pairs = pairs[:config.MaxSuggestions]
-	}
-
-	// Issue lookup map for constructing suggestions
-	issueMap := make(map[string]*model.Issue, len(issues))
-	for i := range issues {
-		issueMap[issues[i].ID] = &issues[i]
-	}
-
-	// Convert to suggestions
-	suggestions := make([]Suggestion, 0, len(pairs))
-	for _, pair := range pairs {
-		issue1 := issueMap[pair.Issue1]
-		issue2 := issueMap[pair.Issue2]
-
-		sug := NewSuggestion(
-			SuggestionPotentialDuplicate,
-			pair.Issue1,
-			fmt.Sprintf("Potential duplicate of %s", pair.Issue2),
-			fmt.Sprintf("%.0f%% keyword similarity; common: %s",
-				pair.Similarity*100,
-				strings.Join(truncateStringSlice(pair.Keywords, 5), ", ")),
-			pair.Similarity,
-		).WithRelatedBead(pair.Issue2).WithMetadata("method", pair.Method)
-
-		// Add action command if both are open
-		if issue1.Status != model.StatusClosed && issue2.Status != model.StatusClosed {
-			sug = sug.WithAction(fmt.Sprintf("bd dep add %s %s --type=related", pair.Issue1, pair.Issue2))
-		}
-
-		suggestions = append(suggestions, sug)
-	}
-
-	return suggestions
+	return pairs
 }
 
 // intersectKeywords finds common strings between two sorted/unsorted slices.
@@ -0,0 +1,186 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// IssueExplanation gathers everything bv knows about one issue: its fields,
+// whether it is ready to work on, what blocks it (directly and through other
+// issues), what depends on it, its labels' health and how old it is.
+type IssueExplanation struct {
+	Issue           model.Issue          `json:"issue"`
+	Ready           bool                 `json:"ready"`
+	ReadyReason     string               `json:"ready_reason"`
+	Blockers        []ExplainedBlocker   `json:"blockers"`   // Direct blockers first, then transitive ones by depth
+	Dependents      []ExplainedDependent `json:"dependents"` // Issues with a dependency on this one
+	Labels          []ExplainedLabel     `json:"labels"`
+	AgeDays         int                  `json:"age_days"`
+	DaysSinceUpdate int                  `json:"days_since_update"`
+	Stale           bool                 `json:"stale"` // Not closed and not updated for DefaultStaleThresholdDays
+}
+
+// ExplainedBlocker is an issue on the blocking chain of the explained issue.
+type ExplainedBlocker struct {
+	ID      string `json:"id"`
+	Title   string `json:"title,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Depth   int    `json:"depth"`             // 1 = direct blocker, 2+ = blocks a blocker
+	Via     string `json:"via,omitempty"`     // The blocker this one blocks, for depth 2+
+	Missing bool   `json:"missing,omitempty"` // Referenced but not in the loaded issues
+}
+
+// ExplainedDependent is an issue that depends on the explained issue.
+type ExplainedDependent struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	Type   string `json:"type"` // Dependency type, e.g. "blocks"
+}
+
+// ExplainedLabel is the health of one of the explained issue's labels.
+type ExplainedLabel struct {
+	Label       string `json:"label"`
+	Health      int    `json:"health"`
+	HealthLevel string `json:"health_level"`
+	OpenCount   int    `json:"open_count"`
+}
+
+// ExplainIssue builds the explanation for the issue with the given ID.
+// It returns false if no loaded issue has that ID.
+//
+// Blockers follow blocking dependencies through open issues only: a closed
+// blocker is listed but what blocked it no longer matters. Missing blockers
+// are listed as such; like GetActionableIssues, they do not make the issue
+// unready.
+func ExplainIssue(issues []model.Issue, id string, now time.Time) (IssueExplanation, bool) {
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+	issue, ok := issueMap[id]
+	if !ok {
+		return IssueExplanation{}, false
+	}
+
+	exp := IssueExplanation{
+		Issue:      *issue,
+		Blockers:   []ExplainedBlocker{},
+		Dependents: []ExplainedDependent{},
+		Labels:     []ExplainedLabel{},
+	}
+
+	// Walk the blocking chain breadth-first so each blocker gets its shortest depth.
+	seen := map[string]bool{id: true}
+	frontier := []string{id}
+	openDirect := 0
+	for depth := 1; len(frontier) > 0; depth++ {
+		var next []string
+		for _, cur := range frontier {
+			for _, depID := range blockingDepIDs(issueMap[cur]) {
+				if seen[depID] {
+					continue
+				}
+				seen[depID] = true
+				b := ExplainedBlocker{ID: depID, Depth: depth}
+				if depth > 1 {
+					b.Via = cur
+				}
+				blocker, exists := issueMap[depID]
+				if !exists {
+					b.Missing = true
+					exp.Blockers = append(exp.Blockers, b)
+					continue
+				}
+				b.Title = blocker.Title
+				b.Status = string(blocker.Status)
+				exp.Blockers = append(exp.Blockers, b)
+				if blocker.Status != model.StatusClosed {
+					if depth == 1 {
+						openDirect++
+					}
+					next = append(next, depID)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	switch {
+	case issue.Status == model.StatusClosed:
+		exp.ReadyReason = "closed"
+	case openDirect > 0:
+		exp.ReadyReason = fmt.Sprintf("waiting on %d open blocker(s)", openDirect)
+	default:
+		exp.Ready = true
+		exp.ReadyReason = "no open blockers"
+	}
+
+	for i := range issues {
+		other := &issues[i]
+		if other.ID == id {
+			continue
+		}
+		for _, dep := range other.Dependencies {
+			if dep != nil && dep.DependsOnID == id {
+				depType := dep.Type
+				if depType == "" {
+					depType = model.DepBlocks
+				}
+				exp.Dependents = append(exp.Dependents, ExplainedDependent{
+					ID:     other.ID,
+					Title:  other.Title,
+					Status: string(other.Status),
+					Type:   string(depType),
+				})
+				break
+			}
+		}
+	}
+	sort.Slice(exp.Dependents, func(i, j int) bool { return exp.Dependents[i].ID < exp.Dependents[j].ID })
+
+	if len(issue.Labels) > 0 {
+		stats := NewAnalyzer(issues).Analyze()
+		cfg := DefaultLabelHealthConfig()
+		for _, label := range issue.Labels {
+			health := ComputeLabelHealthForLabel(label, issues, cfg, now, &stats)
+			exp.Labels = append(exp.Labels, ExplainedLabel{
+				Label:       label,
+				Health:      health.Health,
+				HealthLevel: health.HealthLevel,
+				OpenCount:   health.OpenCount,
+			})
+		}
+	}
+
+	if !issue.CreatedAt.IsZero() {
+		exp.AgeDays = int(now.Sub(issue.CreatedAt).Hours() / 24)
+	}
+	updated := issue.UpdatedAt
+	if updated.IsZero() {
+		updated = issue.CreatedAt
+	}
+	if !updated.IsZero() {
+		exp.DaysSinceUpdate = int(now.Sub(updated).Hours() / 24)
+	}
+	exp.Stale = issue.Status != model.StatusClosed && !updated.IsZero() && exp.DaysSinceUpdate >= DefaultStaleThresholdDays
+
+	return exp, true
+}
+
+// blockingDepIDs returns the IDs an issue has blocking dependencies on, in order.
+func blockingDepIDs(issue *model.Issue) []string {
+	if issue == nil {
+		return nil
+	}
+	var ids []string
+	for _, dep := range issue.Dependencies {
+		if dep != nil && dep.Type.IsBlocking() && dep.DependsOnID != issue.ID {
+			ids = append(ids, dep.DependsOnID)
+		}
+	}
+	return ids
+}
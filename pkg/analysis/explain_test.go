@@ -0,0 +1,81 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestExplainIssue(t *testing.T) {
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	blocks := func(from, to string) *model.Dependency {
+		return &model.Dependency{IssueID: from, DependsOnID: to, Type: model.DepBlocks}
+	}
+	issues := []model.Issue{
+		{ID: "web-1", Title: "Login", Status: model.StatusOpen, IssueType: model.TypeFeature, Labels: []string{"frontend"},
+			CreatedAt: now.AddDate(0, 0, -30), UpdatedAt: now.AddDate(0, 0, -20),
+			Dependencies: []*model.Dependency{blocks("web-1", "api-1"), blocks("web-1", "lib-9")}},
+		{ID: "api-1", Title: "Auth", Status: model.StatusInProgress, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{blocks("api-1", "api-0"), blocks("api-1", "api-2")}},
+		{ID: "api-0", Title: "Schema", Status: model.StatusClosed, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{blocks("api-0", "api-3")}},
+		{ID: "api-2", Title: "Keys", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "api-3", Title: "Behind a closed blocker", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "web-2", Title: "Docs", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{IssueID: "web-2", DependsOnID: "web-1", Type: model.DepRelated}}},
+	}
+
+	exp, ok := ExplainIssue(issues, "web-1", now)
+	if !ok {
+		t.Fatal("web-1 should be found")
+	}
+	if exp.Ready || exp.ReadyReason != "waiting on 1 open blocker(s)" {
+		t.Errorf("ready=%v reason=%q; want not ready, waiting on 1", exp.Ready, exp.ReadyReason)
+	}
+
+	want := []ExplainedBlocker{
+		{ID: "api-1", Title: "Auth", Status: "in_progress", Depth: 1},
+		{ID: "lib-9", Depth: 1, Missing: true},
+		{ID: "api-0", Title: "Schema", Status: "closed", Depth: 2, Via: "api-1"},
+		{ID: "api-2", Title: "Keys", Status: "open", Depth: 2, Via: "api-1"},
+	}
+	if len(exp.Blockers) != len(want) {
+		t.Fatalf("blockers = %+v; want %+v", exp.Blockers, want)
+	}
+	for i := range want {
+		if exp.Blockers[i] != want[i] {
+			t.Errorf("blockers[%d] = %+v; want %+v", i, exp.Blockers[i], want[i])
+		}
+	}
+
+	if len(exp.Dependents) != 1 || exp.Dependents[0].ID != "web-2" || exp.Dependents[0].Type != "related" {
+		t.Errorf("dependents = %+v; want web-2 (related)", exp.Dependents)
+	}
+	if len(exp.Labels) != 1 || exp.Labels[0].Label != "frontend" || exp.Labels[0].HealthLevel == "" {
+		t.Errorf("labels = %+v; want frontend with a health level", exp.Labels)
+	}
+	if exp.AgeDays != 30 || exp.DaysSinceUpdate != 20 || !exp.Stale {
+		t.Errorf("age=%d updated=%d stale=%v; want 30, 20, stale", exp.AgeDays, exp.DaysSinceUpdate, exp.Stale)
+	}
+}
+
+func TestExplainIssueReadyAndMissing(t *testing.T) {
+	now := time.Now()
+	issues := []model.Issue{
+		{ID: "A", Title: "A", Status: model.StatusOpen, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{DependsOnID: "B", Type: model.DepBlocks}}},
+		{ID: "B", Title: "B", Status: model.StatusClosed, IssueType: model.TypeTask},
+	}
+
+	exp, ok := ExplainIssue(issues, "A", now)
+	if !ok || !exp.Ready || exp.ReadyReason != "no open blockers" {
+		t.Errorf("A: ok=%v ready=%v reason=%q; want ready", ok, exp.Ready, exp.ReadyReason)
+	}
+	if exp, _ := ExplainIssue(issues, "B", now); exp.Ready || exp.ReadyReason != "closed" {
+		t.Errorf("B: ready=%v reason=%q; want closed", exp.Ready, exp.ReadyReason)
+	}
+	if _, ok := ExplainIssue(issues, "missing", now); ok {
+		t.Error("unknown ID should not be found")
+	}
+}
@@ -513,6 +513,7 @@ type Analyzer struct {
 	nodeToID map[int64]string
 	issueMap map[string]model.Issue
 	config   *AnalysisConfig // Optional custom config, nil means use size-based defaults
+	planOpts PlanOptions
 }
 
 // SetConfig sets a custom analysis configuration.
@@ -521,6 +522,11 @@ func (a *Analyzer) SetConfig(config *AnalysisConfig) {
 	a.config = config
 }
 
+// SetPlanOptions tunes how GetExecutionPlan groups work into tracks.
+func (a *Analyzer) SetPlanOptions(opts PlanOptions) {
+	a.planOpts = opts
+}
+
 func NewAnalyzer(issues []model.Issue) *Analyzer {
 	g := simple.NewDirectedGraph()
 	// Pre-allocate maps for efficiency
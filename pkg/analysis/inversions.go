@@ -0,0 +1,85 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Priority thresholds for inversion detection: a high-priority issue
+// (P0/P1) waiting on a low-priority blocker (P2 or lower) is an inversion.
+const (
+	InversionHighPriorityMax = 1
+	InversionLowPriorityMin  = 2
+)
+
+// PriorityInversion is a blocking edge where the blocked issue is more
+// urgent than the issue it waits on.
+type PriorityInversion struct {
+	BlockedID         string `json:"blocked_id"`
+	BlockedTitle      string `json:"blocked_title"`
+	BlockedPriority   int    `json:"blocked_priority"`
+	BlockerID         string `json:"blocker_id"`
+	BlockerTitle      string `json:"blocker_title"`
+	BlockerPriority   int    `json:"blocker_priority"`
+	SuggestedPriority int    `json:"suggested_priority"` // Raise the blocker to match what it blocks
+}
+
+// DetectPriorityInversions lists open blocking dependencies where a P0/P1
+// issue depends on a P2-or-lower issue. Closed issues on either side are
+// ignored, as are dependencies on issues that are not loaded. IDs are
+// compared as-is, so namespaced cross-project edges are included.
+//
+// Results are ordered by blocked priority, then by the size of the gap
+// (largest first), then by IDs for determinism.
+func DetectPriorityInversions(issues []model.Issue) []PriorityInversion {
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+
+	inversions := []PriorityInversion{}
+	for i := range issues {
+		blocked := &issues[i]
+		if blocked.Status == model.StatusClosed || blocked.Priority > InversionHighPriorityMax {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, dep := range blocked.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || seen[dep.DependsOnID] {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			blocker, ok := issueMap[dep.DependsOnID]
+			if !ok || blocker.Status == model.StatusClosed || blocker.Priority < InversionLowPriorityMin {
+				continue
+			}
+			inversions = append(inversions, PriorityInversion{
+				BlockedID:         blocked.ID,
+				BlockedTitle:      blocked.Title,
+				BlockedPriority:   blocked.Priority,
+				BlockerID:         blocker.ID,
+				BlockerTitle:      blocker.Title,
+				BlockerPriority:   blocker.Priority,
+				SuggestedPriority: blocked.Priority,
+			})
+		}
+	}
+
+	sort.Slice(inversions, func(i, j int) bool {
+		a, b := inversions[i], inversions[j]
+		if a.BlockedPriority != b.BlockedPriority {
+			return a.BlockedPriority < b.BlockedPriority
+		}
+		gapA, gapB := a.BlockerPriority-a.BlockedPriority, b.BlockerPriority-b.BlockedPriority
+		if gapA != gapB {
+			return gapA > gapB
+		}
+		if a.BlockedID != b.BlockedID {
+			return a.BlockedID < b.BlockedID
+		}
+		return a.BlockerID < b.BlockerID
+	})
+
+	return inversions
+}
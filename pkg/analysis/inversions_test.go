@@ -0,0 +1,59 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestDetectPriorityInversions(t *testing.T) {
+	dep := func(id string, typ model.DependencyType) *model.Dependency {
+		return &model.Dependency{DependsOnID: id, Type: typ}
+	}
+	issues := []model.Issue{
+		{ID: "web-1", Priority: 0, Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			dep("api-1", model.DepBlocks),
+			dep("api-2", model.DepBlocks),
+			dep("api-3", model.DepRelated), // not blocking
+			dep("api-1", model.DepBlocks),  // duplicate edge
+		}},
+		{ID: "web-2", Priority: 1, Status: model.StatusInProgress, Dependencies: []*model.Dependency{dep("api-4", model.DepBlocks)}},
+		{ID: "web-3", Priority: 2, Status: model.StatusOpen, Dependencies: []*model.Dependency{dep("api-4", model.DepBlocks)}},   // not high priority
+		{ID: "web-4", Priority: 0, Status: model.StatusClosed, Dependencies: []*model.Dependency{dep("api-4", model.DepBlocks)}}, // closed
+		{ID: "web-5", Priority: 0, Status: model.StatusOpen, Dependencies: []*model.Dependency{dep("api-5", model.DepBlocks)}},   // closed blocker
+		{ID: "web-6", Priority: 1, Status: model.StatusOpen, Dependencies: []*model.Dependency{dep("missing-1", model.DepBlocks)}},
+		{ID: "api-1", Priority: 2, Status: model.StatusOpen},
+		{ID: "api-2", Priority: 3, Status: model.StatusOpen},
+		{ID: "api-3", Priority: 4, Status: model.StatusOpen},
+		{ID: "api-4", Priority: 4, Status: model.StatusBlocked},
+		{ID: "api-5", Priority: 4, Status: model.StatusClosed},
+	}
+
+	got := DetectPriorityInversions(issues)
+
+	want := []struct {
+		blocked, blocker string
+	}{
+		{"web-1", "api-2"}, // P0 <- P3 (gap 3)
+		{"web-1", "api-1"}, // P0 <- P2 (gap 2)
+		{"web-2", "api-4"}, // P1 <- P4
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d inversions, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if got[i].BlockedID != w.blocked || got[i].BlockerID != w.blocker {
+			t.Errorf("inversion[%d] = %s <- %s, want %s <- %s", i, got[i].BlockedID, got[i].BlockerID, w.blocked, w.blocker)
+		}
+		if got[i].SuggestedPriority != got[i].BlockedPriority {
+			t.Errorf("inversion[%d] suggested=%d, want blocked priority %d", i, got[i].SuggestedPriority, got[i].BlockedPriority)
+		}
+	}
+}
+
+func TestDetectPriorityInversions_NoneIsEmptySlice(t *testing.T) {
+	got := DetectPriorityInversions([]model.Issue{{ID: "a", Priority: 0, Status: model.StatusOpen}})
+	if got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil slice, got %#v", got)
+	}
+}
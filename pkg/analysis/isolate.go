@@ -0,0 +1,164 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// IsolationImpact describes what happens to the rest of a multi-project
+// workspace if one project is removed. Issues outside the removed project
+// that wait on its open work can never be unblocked once it is gone.
+type IsolationImpact struct {
+	Project       string           `json:"project"`        // Project prefix that was isolated (e.g., "api")
+	RemovedIssues int              `json:"removed_issues"` // Issues belonging to the isolated project
+	OpenRemoved   int              `json:"open_removed"`   // Of those, issues that are not closed
+	WouldBlock    []WouldBlockItem `json:"would_block"`    // Issues elsewhere that become permanently blocked
+}
+
+// WouldBlockItem is an issue that would become permanently blocked.
+type WouldBlockItem struct {
+	ID        string   `json:"id"`
+	Title     string   `json:"title"`
+	Status    string   `json:"status"`
+	Priority  int      `json:"priority"`
+	BlockedBy []string `json:"blocked_by"` // Blockers that can no longer close (removed or themselves stranded)
+	Depth     int      `json:"depth"`      // 1 = waits on the removed project directly, 2+ = via other stranded issues
+}
+
+// ComputeIsolationImpact reports which issues outside project would become
+// permanently blocked if every issue in project were removed.
+//
+// project matches issue IDs the same way --repo does: "api" matches "api-",
+// "api:" and "api_" prefixes, and a trailing separator matches literally.
+// An issue is stranded if any of its open blocking dependencies lives in the
+// removed project, or is itself stranded.
+func ComputeIsolationImpact(issues []model.Issue, project string) IsolationImpact {
+	impact := IsolationImpact{
+		Project:    project,
+		WouldBlock: []WouldBlockItem{},
+	}
+	if project == "" {
+		return impact
+	}
+
+	issueMap := make(map[string]*model.Issue, len(issues))
+	removed := make(map[string]bool)
+	for i := range issues {
+		issue := &issues[i]
+		issueMap[issue.ID] = issue
+		if IssueInProject(issue, project) {
+			removed[issue.ID] = true
+			impact.RemovedIssues++
+			if issue.Status != model.StatusClosed {
+				impact.OpenRemoved++
+			}
+		}
+	}
+
+	// dependents[blocker] lists remaining open issues waiting on blocker.
+	dependents := make(map[string][]string)
+	blockedBy := make(map[string][]string)
+	depth := make(map[string]int)
+	var queue []string
+
+	for i := range issues {
+		issue := &issues[i]
+		if removed[issue.ID] || issue.Status == model.StatusClosed {
+			continue
+		}
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			blocker, ok := issueMap[dep.DependsOnID]
+			if !ok || blocker.Status == model.StatusClosed {
+				continue
+			}
+			if removed[dep.DependsOnID] {
+				if _, seen := depth[issue.ID]; !seen {
+					depth[issue.ID] = 1
+					queue = append(queue, issue.ID)
+				}
+				blockedBy[issue.ID] = append(blockedBy[issue.ID], dep.DependsOnID)
+				continue
+			}
+			dependents[dep.DependsOnID] = append(dependents[dep.DependsOnID], issue.ID)
+		}
+	}
+
+	// Breadth-first so each issue records the shortest chain back to the
+	// removed project, and only the stranded blockers at that depth.
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, depID := range dependents[id] {
+			d, seen := depth[depID]
+			if !seen {
+				depth[depID] = depth[id] + 1
+				queue = append(queue, depID)
+			} else if d != depth[id]+1 {
+				continue
+			}
+			blockedBy[depID] = append(blockedBy[depID], id)
+		}
+	}
+
+	for id, d := range depth {
+		issue := issueMap[id]
+		blockers := blockedBy[id]
+		sort.Strings(blockers)
+		impact.WouldBlock = append(impact.WouldBlock, WouldBlockItem{
+			ID:        id,
+			Title:     issue.Title,
+			Status:    string(issue.Status),
+			Priority:  issue.Priority,
+			BlockedBy: blockers,
+			Depth:     d,
+		})
+	}
+
+	sort.Slice(impact.WouldBlock, func(i, j int) bool {
+		a, b := impact.WouldBlock[i], impact.WouldBlock[j]
+		if a.Depth != b.Depth {
+			return a.Depth < b.Depth
+		}
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.ID < b.ID
+	})
+
+	return impact
+}
+
+// IssueInProject reports whether issue belongs to the project. It is the one
+// matcher behind --repo, --isolate and saved views: the ID starts with the
+// project and a separator ("api" matches "api-", "api:" and "api_"; a
+// project given with its separator must match it), or source_repo is the
+// project or a path under it ("services" matches "services/api").
+// Comparison ignores case.
+func IssueInProject(issue *model.Issue, project string) bool {
+	projectLower := strings.ToLower(project)
+	idLower := strings.ToLower(issue.ID)
+
+	if strings.HasSuffix(projectLower, "-") ||
+		strings.HasSuffix(projectLower, ":") ||
+		strings.HasSuffix(projectLower, "_") {
+		if strings.HasPrefix(idLower, projectLower) {
+			return true
+		}
+	} else if strings.HasPrefix(idLower, projectLower+"-") ||
+		strings.HasPrefix(idLower, projectLower+":") ||
+		strings.HasPrefix(idLower, projectLower+"_") {
+		return true
+	}
+
+	if issue.SourceRepo != "" && issue.SourceRepo != "." {
+		repo := strings.ToLower(strings.TrimRight(issue.SourceRepo, "-:_"))
+		name := strings.TrimRight(projectLower, "-:_")
+		return repo == name || strings.HasPrefix(repo, name+"/")
+	}
+	return false
+}
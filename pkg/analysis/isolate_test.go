@@ -0,0 +1,116 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func isolateBlocks(id string) *model.Dependency {
+	return &model.Dependency{DependsOnID: id, Type: model.DepBlocks}
+}
+
+func TestComputeIsolationImpact(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api-1", Title: "Auth endpoint", Status: model.StatusOpen},
+		{ID: "api-2", Title: "Old endpoint", Status: model.StatusClosed},
+		{ID: "web-1", Title: "Login page", Status: model.StatusOpen, Priority: 1, Dependencies: []*model.Dependency{isolateBlocks("api-1")}},
+		{ID: "web-2", Title: "Uses closed api", Status: model.StatusOpen, Dependencies: []*model.Dependency{isolateBlocks("api-2")}},
+		{ID: "web-3", Title: "After login", Status: model.StatusOpen, Dependencies: []*model.Dependency{isolateBlocks("web-1")}},
+		{ID: "web-4", Title: "Related only", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "api-1", Type: model.DepRelated}}},
+		{ID: "web-5", Title: "Done already", Status: model.StatusClosed, Dependencies: []*model.Dependency{isolateBlocks("api-1")}},
+		{ID: "web-6", Title: "Local blocker", Status: model.StatusOpen, Dependencies: []*model.Dependency{isolateBlocks("web-2")}},
+	}
+
+	impact := ComputeIsolationImpact(issues, "api")
+
+	if impact.RemovedIssues != 2 || impact.OpenRemoved != 1 {
+		t.Fatalf("removed=%d open=%d, want 2/1", impact.RemovedIssues, impact.OpenRemoved)
+	}
+
+	var got []string
+	for _, item := range impact.WouldBlock {
+		got = append(got, item.ID)
+	}
+	want := []string{"web-1", "web-3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("would_block = %v, want %v", got, want)
+	}
+
+	if impact.WouldBlock[0].Depth != 1 || !reflect.DeepEqual(impact.WouldBlock[0].BlockedBy, []string{"api-1"}) {
+		t.Errorf("direct item = %+v", impact.WouldBlock[0])
+	}
+	if impact.WouldBlock[1].Depth != 2 || !reflect.DeepEqual(impact.WouldBlock[1].BlockedBy, []string{"web-1"}) {
+		t.Errorf("transitive item = %+v", impact.WouldBlock[1])
+	}
+}
+
+func TestComputeIsolationImpact_PrefixMatching(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api-1", Status: model.StatusOpen},
+		{ID: "apigw-1", Status: model.StatusOpen},
+		{ID: "web-1", Status: model.StatusOpen, Dependencies: []*model.Dependency{isolateBlocks("apigw-1")}},
+	}
+
+	tests := []struct {
+		project     string
+		wantRemoved int
+		wantBlocked int
+	}{
+		{"api", 1, 0},
+		{"api-", 1, 0},
+		{"API", 1, 0},
+		{"apigw", 1, 1},
+		{"", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.project, func(t *testing.T) {
+			impact := ComputeIsolationImpact(issues, tt.project)
+			if impact.RemovedIssues != tt.wantRemoved {
+				t.Errorf("removed = %d, want %d", impact.RemovedIssues, tt.wantRemoved)
+			}
+			if len(impact.WouldBlock) != tt.wantBlocked {
+				t.Errorf("would_block = %d, want %d", len(impact.WouldBlock), tt.wantBlocked)
+			}
+			if impact.WouldBlock == nil {
+				t.Error("would_block should be an empty slice, not nil")
+			}
+		})
+	}
+}
+
+func TestComputeIsolationImpact_Cycle(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api-1", Status: model.StatusOpen},
+		{ID: "web-1", Status: model.StatusOpen, Dependencies: []*model.Dependency{isolateBlocks("api-1"), isolateBlocks("web-2")}},
+		{ID: "web-2", Status: model.StatusOpen, Dependencies: []*model.Dependency{isolateBlocks("web-1")}},
+	}
+
+	impact := ComputeIsolationImpact(issues, "api")
+	if len(impact.WouldBlock) != 2 {
+		t.Fatalf("would_block = %+v, want both web issues", impact.WouldBlock)
+	}
+}
+
+func TestIssueInProject(t *testing.T) {
+	tests := []struct {
+		issue   model.Issue
+		project string
+		want    bool
+	}{
+		{model.Issue{ID: "API-1"}, "api", true},
+		{model.Issue{ID: "api:2"}, "api", true},
+		{model.Issue{ID: "api_3"}, "api-", false}, // An explicit separator must match
+		{model.Issue{ID: "apiary-1"}, "api", false},
+		{model.Issue{ID: "monolith-1"}, "mono", false},
+		{model.Issue{ID: "7", SourceRepo: "services/api"}, "services", true},
+		{model.Issue{ID: "8", SourceRepo: "services-old"}, "services", false},
+		{model.Issue{ID: "9", SourceRepo: "api-"}, "API", true},
+	}
+	for _, tt := range tests {
+		if got := IssueInProject(&tt.issue, tt.project); got != tt.want {
+			t.Errorf("IssueInProject(%s/%s, %q) = %v, want %v", tt.issue.ID, tt.issue.SourceRepo, tt.project, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ParallelSchedule packs open issues into a fixed number of lanes (people
+// or agents working in parallel), as an alternative to the unbounded tracks
+// of the execution plan.
+type ParallelSchedule struct {
+	MaxParallel  int            `json:"max_parallel"`
+	TotalMinutes int            `json:"total_minutes"`         // Makespan: when the last issue finishes
+	WorkMinutes  int            `json:"work_minutes"`          // Sum of all scheduled estimates
+	Lanes        []ScheduleLane `json:"lanes"`                 // At most MaxParallel, each in start order
+	Unscheduled  []string       `json:"unscheduled,omitempty"` // Issues in or behind a blocking cycle
+	// AssumedMinutes is the estimate used for issues without one: the
+	// median of the explicit estimates, or DefaultEstimatedMinutes if
+	// there are none (AssumedFrom is "median" or "default")
+	AssumedMinutes int    `json:"assumed_minutes"`
+	AssumedFrom    string `json:"assumed_from"`
+}
+
+// ScheduleLane is the ordered work of one parallel worker.
+type ScheduleLane struct {
+	LaneID      string           `json:"lane_id"`
+	BusyMinutes int              `json:"busy_minutes"`
+	Items       []ScheduledIssue `json:"items"`
+}
+
+// ScheduledIssue is an issue placed in a lane. Times are minutes from the
+// start of the plan.
+type ScheduledIssue struct {
+	ID               string `json:"id"`
+	Title            string `json:"title"`
+	Priority         int    `json:"priority"`
+	EstimatedMinutes int    `json:"estimated_minutes"` // Explicit estimate, else the schedule's AssumedMinutes
+	EstimateSource   string `json:"estimate_source"`   // "explicit", or the schedule's AssumedFrom
+	StartMinute      int    `json:"start_minute"`
+	EndMinute        int    `json:"end_minute"`
+}
+
+// Estimate sources for ScheduledIssue.EstimateSource.
+const (
+	EstimateSourceExplicit = "explicit" // The issue's own estimate
+	EstimateSourceMedian   = "median"   // Assumed: median of the explicit estimates
+	EstimateSourceDefault  = "default"  // Assumed: DefaultEstimatedMinutes, no issue has an estimate
+)
+
+// estimateSource attributes the estimate used for issue.
+func estimateSource(issue *model.Issue, assumedFrom string) string {
+	if hasEstimate(issue) {
+		return EstimateSourceExplicit
+	}
+	return assumedFrom
+}
+
+// ScheduleParallel runs a greedy list scheduler over the open issues with
+// at most maxParallel running at once. An issue can start once all its open
+// blockers have finished. Whenever a lane frees up it takes the ready issue
+// with the longest chain of estimated work still behind it (the critical
+// path first, which keeps the total duration short), then the highest
+// priority, then the lowest ID. Issues in or waiting on a blocking cycle
+// are reported as unscheduled.
+func ScheduleParallel(issues []model.Issue, maxParallel int) ParallelSchedule {
+	if maxParallel < 1 {
+		maxParallel = 1
+	}
+	g := newOpenBlockingGraph(issues)
+	open, blockers, dependents := g.open, g.blockers, g.dependents
+
+	median := computeMedianEstimatedMinutes(issues)
+	assumedFrom := EstimateSourceDefault
+	for i := range issues {
+		if hasEstimate(&issues[i]) {
+			assumedFrom = EstimateSourceMedian
+			break
+		}
+	}
+	duration := make(map[string]int, len(open))
+	for id, issue := range open {
+		duration[id] = median
+		if hasEstimate(issue) {
+			duration[id] = *issue.EstimatedMinutes
+		}
+	}
+
+	// The remaining work behind each schedulable issue, computed from the
+	// sinks back along the topological order
+	var order []string
+	for _, wave := range g.levels {
+		order = append(order, wave...)
+	}
+	chain := make(map[string]int, len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		id := order[i]
+		longest := 0
+		for _, d := range dependents[id] {
+			longest = max(longest, chain[d])
+		}
+		chain[id] = duration[id] + longest
+	}
+
+	schedule := ParallelSchedule{MaxParallel: maxParallel, Lanes: []ScheduleLane{}, AssumedMinutes: median, AssumedFrom: assumedFrom}
+	for id := range open {
+		if _, ok := chain[id]; !ok {
+			schedule.Unscheduled = append(schedule.Unscheduled, id)
+		}
+	}
+	sort.Strings(schedule.Unscheduled)
+
+	// ready holds issues whose blockers are all placed, with the time the
+	// last of them finishes
+	ready := make(map[string]int)
+	blockersLeft := make(map[string]int, len(order))
+	for _, id := range order {
+		blockersLeft[id] = len(blockers[id])
+		if blockersLeft[id] == 0 {
+			ready[id] = 0
+		}
+	}
+	finish := make(map[string]int, len(order))
+	laneFree := make([]int, maxParallel)
+	lanes := make([]ScheduleLane, maxParallel)
+
+	for len(ready) > 0 {
+		lane := 0
+		for l := range laneFree {
+			if laneFree[l] < laneFree[lane] {
+				lane = l
+			}
+		}
+		now := laneFree[lane]
+
+		best := ""
+		soonest := -1
+		for id, at := range ready {
+			if soonest == -1 || at < soonest {
+				soonest = at
+			}
+			if at > now {
+				continue
+			}
+			if best == "" || betterScheduleCandidate(open[id], open[best], chain) {
+				best = id
+			}
+		}
+		if best == "" {
+			// Nothing can start yet: idle this lane until the next issue is ready
+			laneFree[lane] = soonest
+			continue
+		}
+
+		delete(ready, best)
+		issue := open[best]
+		end := now + duration[best]
+		finish[best] = end
+		laneFree[lane] = end
+		lanes[lane].BusyMinutes += duration[best]
+		lanes[lane].Items = append(lanes[lane].Items, ScheduledIssue{
+			ID:               best,
+			Title:            issue.Title,
+			Priority:         issue.Priority,
+			EstimatedMinutes: duration[best],
+			EstimateSource:   estimateSource(issue, schedule.AssumedFrom),
+			StartMinute:      now,
+			EndMinute:        end,
+		})
+		schedule.WorkMinutes += duration[best]
+		schedule.TotalMinutes = max(schedule.TotalMinutes, end)
+
+		for _, d := range dependents[best] {
+			blockersLeft[d]--
+			if blockersLeft[d] == 0 {
+				at := 0
+				for _, b := range blockers[d] {
+					at = max(at, finish[b])
+				}
+				ready[d] = at
+			}
+		}
+	}
+
+	for i := range lanes {
+		if len(lanes[i].Items) == 0 {
+			continue
+		}
+		lanes[i].LaneID = fmt.Sprintf("lane-%d", len(schedule.Lanes)+1)
+		schedule.Lanes = append(schedule.Lanes, lanes[i])
+	}
+	return schedule
+}
+
+// betterScheduleCandidate reports whether a should be started before b:
+// the longer remaining chain first, then higher priority, then lower ID.
+func betterScheduleCandidate(a, b *model.Issue, chain map[string]int) bool {
+	if chain[a.ID] != chain[b.ID] {
+		return chain[a.ID] > chain[b.ID]
+	}
+	if a.Priority != b.Priority {
+		return a.Priority < b.Priority
+	}
+	return a.ID < b.ID
+}
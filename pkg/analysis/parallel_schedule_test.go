@@ -0,0 +1,99 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestScheduleParallel(t *testing.T) {
+	est := func(m int) *int { return &m }
+	blocks := func(from, to string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: from, DependsOnID: to, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		// A -> B -> C is the critical path (180m)
+		{ID: "A", Status: model.StatusOpen, Priority: 2, EstimatedMinutes: est(60)},
+		{ID: "B", Status: model.StatusOpen, Priority: 2, EstimatedMinutes: est(60), Dependencies: blocks("B", "A")},
+		{ID: "C", Status: model.StatusOpen, Priority: 2, EstimatedMinutes: est(60), Dependencies: blocks("C", "B")},
+		{ID: "D", Status: model.StatusOpen, Priority: 1, EstimatedMinutes: est(30)},
+		{ID: "E", Status: model.StatusOpen, Priority: 2, EstimatedMinutes: est(30)},
+		{ID: "F", Status: model.StatusOpen, Priority: 2, EstimatedMinutes: est(120)},
+		{ID: "G", Status: model.StatusClosed, EstimatedMinutes: est(500)},
+		{ID: "X", Status: model.StatusOpen, Dependencies: blocks("X", "Y")},
+		{ID: "Y", Status: model.StatusOpen, Dependencies: blocks("Y", "X")},
+	}
+
+	laneIDs := func(s ParallelSchedule) [][]string {
+		var out [][]string
+		for _, lane := range s.Lanes {
+			var ids []string
+			for _, item := range lane.Items {
+				ids = append(ids, item.ID)
+			}
+			out = append(out, ids)
+		}
+		return out
+	}
+
+	two := ScheduleParallel(issues, 2)
+	if want := [][]string{{"A", "B", "C"}, {"F", "D", "E"}}; !reflect.DeepEqual(laneIDs(two), want) {
+		t.Errorf("lanes = %v, want %v", laneIDs(two), want)
+	}
+	if two.TotalMinutes != 180 || two.WorkMinutes != 360 {
+		t.Errorf("total/work = %d/%d, want 180/360", two.TotalMinutes, two.WorkMinutes)
+	}
+	if c := two.Lanes[0].Items[2]; c.StartMinute != 120 || c.EndMinute != 180 {
+		t.Errorf("C runs %d-%d, want 120-180", c.StartMinute, c.EndMinute)
+	}
+	if !reflect.DeepEqual(two.Unscheduled, []string{"X", "Y"}) {
+		t.Errorf("unscheduled = %v, want [X Y]", two.Unscheduled)
+	}
+
+	// A spare lane idles until the chain frees up, and never runs ahead of it
+	three := ScheduleParallel(issues, 3)
+	if want := [][]string{{"A", "B", "C"}, {"F"}, {"D", "E"}}; !reflect.DeepEqual(laneIDs(three), want) {
+		t.Errorf("lanes = %v, want %v", laneIDs(three), want)
+	}
+	if three.TotalMinutes != 180 {
+		t.Errorf("total = %d, want 180", three.TotalMinutes)
+	}
+
+	one := ScheduleParallel(issues, 1)
+	if len(one.Lanes) != 1 || one.TotalMinutes != one.WorkMinutes {
+		t.Errorf("one lane: %d lanes, total %d, work %d; want serial", len(one.Lanes), one.TotalMinutes, one.WorkMinutes)
+	}
+	if one.Lanes[0].LaneID != "lane-1" || one.MaxParallel != 1 {
+		t.Errorf("lane id %q, max %d", one.Lanes[0].LaneID, one.MaxParallel)
+	}
+}
+
+func TestScheduleParallelAttributesAssumedEstimates(t *testing.T) {
+	est := func(m int) *int { return &m }
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen, EstimatedMinutes: est(30)},
+		{ID: "B", Status: model.StatusOpen, EstimatedMinutes: est(90)},
+		{ID: "C", Status: model.StatusOpen},
+	}
+	s := ScheduleParallel(issues, 3)
+	if s.AssumedMinutes != 60 || s.AssumedFrom != EstimateSourceMedian {
+		t.Errorf("assumed = %d from %q, want 60 from median", s.AssumedMinutes, s.AssumedFrom)
+	}
+	sources := make(map[string]string)
+	for _, lane := range s.Lanes {
+		for _, item := range lane.Items {
+			sources[item.ID] = item.EstimateSource
+		}
+	}
+	want := map[string]string{"A": EstimateSourceExplicit, "B": EstimateSourceExplicit, "C": EstimateSourceMedian}
+	if !reflect.DeepEqual(sources, want) {
+		t.Errorf("sources = %v, want %v", sources, want)
+	}
+
+	// With no estimates at all the built-in default is assumed
+	s = ScheduleParallel([]model.Issue{{ID: "A", Status: model.StatusOpen}}, 1)
+	if s.AssumedMinutes != DefaultEstimatedMinutes || s.AssumedFrom != EstimateSourceDefault || s.Lanes[0].Items[0].EstimateSource != EstimateSourceDefault {
+		t.Errorf("no estimates: %+v", s)
+	}
+}
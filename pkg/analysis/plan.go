@@ -1,33 +1,118 @@
 package analysis
 
 import (
+	"path/filepath"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
 // PlanItem represents a single actionable item in the execution plan
 type PlanItem struct {
-	ID          string   `json:"id"`
-	Title       string   `json:"title"`
-	Priority    int      `json:"priority"`
-	Status      string   `json:"status"`
-	UnblocksIDs []string `json:"unblocks"` // Issues that become actionable when this is done
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Priority    int        `json:"priority"`
+	Status      string     `json:"status"`
+	UnblocksIDs []string   `json:"unblocks"` // Issues that become actionable when this is done
+	Source      *SourceRef `json:"source,omitempty"`
+}
+
+// FrontierItem is a ready issue ranked by how much ready work closing it
+// would expose, looking one step ahead.
+type FrontierItem struct {
+	ID            string     `json:"id"`
+	Title         string     `json:"title"`
+	Priority      int        `json:"priority"`
+	Project       string     `json:"project,omitempty"`
+	UnblocksCount int        `json:"unblocks_count"` // Issues that would become ready if this closed
+	UnblocksIDs   []string   `json:"unblocks"`
+	CrossProject  int        `json:"cross_project,omitempty"` // Of those, issues in another project
+	Source        *SourceRef `json:"source,omitempty"`
+}
+
+// SourceRef points at the record an issue was parsed from, for tools that
+// open it in an editor.
+type SourceRef struct {
+	Project string `json:"project"`        // Project directory (the parent of .beads)
+	File    string `json:"file"`           // Issues file, e.g. .../.beads/beads.jsonl
+	Line    int    `json:"line,omitempty"` // 1-based line the record starts on
+}
+
+// sourceRef returns where issue was loaded from, or nil for issues not read
+// from a file.
+func sourceRef(issue *model.Issue) *SourceRef {
+	if issue.SourcePath == "" {
+		return nil
+	}
+	project := filepath.Dir(issue.SourcePath)
+	if filepath.Base(project) == ".beads" {
+		project = filepath.Dir(project)
+	}
+	return &SourceRef{Project: project, File: issue.SourcePath, Line: issue.SourceLine}
 }
 
 // ExecutionTrack represents a group of related actionable items
 type ExecutionTrack struct {
-	TrackID string     `json:"track_id"`
-	Items   []PlanItem `json:"items"`
-	Reason  string     `json:"reason"` // Why these are grouped
+	TrackID     string     `json:"track_id"`
+	Items       []PlanItem `json:"items"`
+	Reason      string     `json:"reason"`       // Why these are grouped
+	Risk        float64    `json:"risk"`         // 0-1 likelihood the track slips (higher = more fragile)
+	RiskFactors TrackRisk  `json:"risk_factors"` // Inputs to Risk
+	// Unestimated lists the track's items that have no estimate, so its
+	// durations rest partly on assumed values
+	Unestimated []string `json:"unestimated,omitempty"`
+}
+
+// TrackRisk holds the signals behind a track's risk score, measured over
+// all open issues in the track's work stream (actionable or not)
+type TrackRisk struct {
+	Blocked     int `json:"blocked"`      // Open issues waiting on an open blocker
+	Open        int `json:"open"`         // Open issues in the work stream
+	TopPriority int `json:"top_priority"` // Highest priority (lowest number) among open issues
+	StaleDays   int `json:"stale_days"`   // Days since any open issue was last updated
+}
+
+// Weights for the track risk score (total = 1.0)
+const (
+	TrackRiskWeightBlocked   = 0.4
+	TrackRiskWeightPriority  = 0.3
+	TrackRiskWeightStaleness = 0.3
+)
+
+// TrackDependency lists the tracks whose items must be done before a track
+// can be completed
+type TrackDependency struct {
+	TrackID   string   `json:"track_id"`
+	DependsOn []string `json:"depends_on"` // Prerequisite track IDs (empty = can start now)
 }
 
 // ExecutionPlan is the complete work plan with parallel tracks
 type ExecutionPlan struct {
-	Tracks          []ExecutionTrack `json:"tracks"`
-	TotalActionable int              `json:"total_actionable"`
-	TotalBlocked    int              `json:"total_blocked"`
-	Summary         PlanSummary      `json:"summary"`
+	Tracks          []ExecutionTrack  `json:"tracks"`
+	TrackOrder      []TrackDependency `json:"track_order"` // Tracks in dependency order, prerequisites first
+	TotalActionable int               `json:"total_actionable"`
+	TotalBlocked    int               `json:"total_blocked"`
+	Summary         PlanSummary       `json:"summary"`
+	// RecommendedFocus is the small set of issues that, completed together,
+	// frees the most blocked work (see RecommendFocus)
+	RecommendedFocus []FocusPick `json:"recommended_focus"`
+	// Frontier ranks the actionable issues that would unblock something if
+	// closed, most newly ready issues first (see computeFrontier)
+	Frontier []FrontierItem `json:"frontier"`
+	// StartSchedule gives every open issue its earliest start step, for
+	// batching work into waves (see ComputeStartSchedule)
+	StartSchedule StartSchedule `json:"start_schedule"`
+	// ParallelSchedule packs open issues into a limited number of lanes;
+	// set only when a lane limit is given (see ScheduleParallel)
+	ParallelSchedule *ParallelSchedule `json:"parallel_schedule,omitempty"`
+	// Unestimated lists the open issues without an estimate, by ID.
+	// Durations computed for them use an assumed estimate (see
+	// ScheduledIssue.EstimateSource), so estimate these before trusting
+	// the plan's totals.
+	Unestimated      []string `json:"unestimated"`
+	UnestimatedCount int      `json:"unestimated_count"`
 }
 
 // PlanSummary provides quick insights about the plan
@@ -35,11 +120,31 @@ type PlanSummary struct {
 	HighestImpact string `json:"highest_impact"` // Issue ID that unblocks the most
 	ImpactReason  string `json:"impact_reason"`  // Why it's highest impact
 	UnblocksCount int    `json:"unblocks_count"` // How many it unblocks
+	RiskiestTrack string `json:"riskiest_track"` // Track ID with the highest risk score
+}
+
+// PlanOptions tunes execution plan grouping.
+type PlanOptions struct {
+	// IgnoreRelated keeps "related" edges out of track grouping. By
+	// default they are soft constraints: related issues share a track and
+	// are ordered next to each other where priorities allow, but never
+	// block one another. Only blocking edges affect readiness either way.
+	IgnoreRelated bool
+	// SplitProjects gives each project its own track within a work stream
+	// that spans projects (e.g. a workspace), so blocking edges between
+	// projects become prerequisites in TrackOrder.
+	SplitProjects bool
 }
 
 // GetExecutionPlan generates a dependency-respecting execution plan
 // with parallel tracks identified for concurrent work.
 func (a *Analyzer) GetExecutionPlan() ExecutionPlan {
+	return a.GetExecutionPlanAt(time.Now())
+}
+
+// GetExecutionPlanAt generates an execution plan, measuring track
+// staleness as of now.
+func (a *Analyzer) GetExecutionPlanAt(now time.Time) ExecutionPlan {
 	actionable := a.GetActionableIssues()
 
 	// Build set of actionable IDs for quick lookup
@@ -57,27 +162,159 @@ func (a *Analyzer) GetExecutionPlan() ExecutionPlan {
 	// Find connected components among all issues (not just actionable)
 	// This groups actionable issues that belong to the same work stream
 	components := a.findConnectedComponents()
+	if a.planOpts.SplitProjects {
+		components = a.splitComponentsByProject(components)
+	}
 
 	// Build tracks from components, filtering to actionable issues only
 	tracks := a.buildTracks(components, actionableSet, unblocksMap)
+	trackOrder := a.computeTrackOrder(tracks, components)
+	a.computeTrackRisk(tracks, components, actionableSet, now)
 
 	// Calculate totals
 	totalOpen := 0
+	all := make([]model.Issue, 0, len(a.issueMap))
+	unestimated := []string{}
 	for _, issue := range a.issueMap {
 		if issue.Status != model.StatusClosed {
 			totalOpen++
+			if !hasEstimate(&issue) {
+				unestimated = append(unestimated, issue.ID)
+			}
 		}
+		all = append(all, issue)
 	}
+	sort.Strings(unestimated)
 
 	// Find highest impact issue
 	summary := a.computePlanSummary(actionable, unblocksMap)
+	summary.RiskiestTrack = riskiestTrack(tracks)
 
 	return ExecutionPlan{
-		Tracks:          tracks,
-		TotalActionable: len(actionable),
-		TotalBlocked:    totalOpen - len(actionable),
-		Summary:         summary,
+		Tracks:           tracks,
+		TrackOrder:       trackOrder,
+		TotalActionable:  len(actionable),
+		TotalBlocked:     totalOpen - len(actionable),
+		Summary:          summary,
+		RecommendedFocus: RecommendFocus(all, RecommendedFocusSize),
+		Frontier:         a.computeFrontier(actionable, unblocksMap),
+		StartSchedule:    ComputeStartSchedule(all),
+		Unestimated:      unestimated,
+		UnestimatedCount: len(unestimated),
+	}
+}
+
+// NarrowPlan keeps only the plan entries for issues in ids, for showing
+// part of a plan computed over the whole graph: blockers outside ids still
+// gate readiness and still count in unblocks. Tracks left empty are
+// dropped and the totals count only kept issues; Summary.HighestImpact and
+// the parallel schedule's timings still describe the whole graph.
+func NarrowPlan(plan ExecutionPlan, ids map[string]bool) ExecutionPlan {
+	keep := func(list []string) []string {
+		var kept []string
+		for _, id := range list {
+			if ids[id] {
+				kept = append(kept, id)
+			}
+		}
+		return kept
+	}
+
+	tracks := []ExecutionTrack{}
+	actionable := 0
+	for _, track := range plan.Tracks {
+		var items []PlanItem
+		for _, item := range track.Items {
+			if ids[item.ID] {
+				items = append(items, item)
+			}
+		}
+		if len(items) == 0 {
+			continue
+		}
+		track.Items = items
+		track.Unestimated = keep(track.Unestimated)
+		tracks = append(tracks, track)
+		actionable += len(items)
+	}
+	plan.Tracks = tracks
+	plan.Summary.RiskiestTrack = riskiestTrack(tracks)
+
+	kept := make(map[string]bool, len(tracks))
+	for _, track := range tracks {
+		kept[track.TrackID] = true
+	}
+	order := []TrackDependency{}
+	for _, entry := range plan.TrackOrder {
+		if !kept[entry.TrackID] {
+			continue
+		}
+		deps := []string{}
+		for _, dep := range entry.DependsOn {
+			if kept[dep] {
+				deps = append(deps, dep)
+			}
+		}
+		order = append(order, TrackDependency{TrackID: entry.TrackID, DependsOn: deps})
+	}
+	plan.TrackOrder = order
+
+	focus := []FocusPick{}
+	for _, pick := range plan.RecommendedFocus {
+		if ids[pick.ID] {
+			focus = append(focus, pick)
+		}
+	}
+	plan.RecommendedFocus = focus
+
+	frontier := []FrontierItem{}
+	for _, item := range plan.Frontier {
+		if ids[item.ID] {
+			frontier = append(frontier, item)
+		}
+	}
+	plan.Frontier = frontier
+
+	starts := []IssueStartStep{}
+	for _, issue := range plan.StartSchedule.Issues {
+		if ids[issue.ID] {
+			starts = append(starts, issue)
+		}
+	}
+	plan.StartSchedule.Issues = starts
+	plan.StartSchedule.Unscheduled = keep(plan.StartSchedule.Unscheduled)
+
+	if ps := plan.ParallelSchedule; ps != nil {
+		narrowed := *ps
+		narrowed.Lanes = nil
+		for _, lane := range ps.Lanes {
+			items := []ScheduledIssue{}
+			for _, item := range lane.Items {
+				if ids[item.ID] {
+					items = append(items, item)
+				}
+			}
+			lane.Items = items
+			narrowed.Lanes = append(narrowed.Lanes, lane)
+		}
+		narrowed.Unscheduled = keep(ps.Unscheduled)
+		plan.ParallelSchedule = &narrowed
 	}
+
+	plan.Unestimated = keep(plan.Unestimated)
+	if plan.Unestimated == nil {
+		plan.Unestimated = []string{}
+	}
+	plan.UnestimatedCount = len(plan.Unestimated)
+	plan.TotalActionable = actionable
+	plan.TotalBlocked = len(starts) + len(plan.StartSchedule.Unscheduled) - actionable
+	return plan
+}
+
+// hasEstimate reports whether issue has a positive estimated_minutes (story
+// points are folded into it at load time).
+func hasEstimate(issue *model.Issue) bool {
+	return issue.EstimatedMinutes != nil && *issue.EstimatedMinutes > 0
 }
 
 // computeUnblocks finds issues that would become actionable if the given issue is closed
@@ -186,12 +423,13 @@ func (a *Analyzer) findConnectedComponents() map[string][]string {
 		parent[id] = id
 	}
 
-	// Union issues connected by dependencies (ignoring direction)
+	// Union issues connected by dependencies (ignoring direction), and by
+	// related edges unless the plan options say otherwise
 	// Iterate in sorted order to ensure deterministic tree structure
 	for _, id := range ids {
 		issue := a.issueMap[id]
 		for _, dep := range issue.Dependencies {
-			if dep != nil && dep.Type.IsBlocking() {
+			if dep != nil && (dep.Type.IsBlocking() || a.groupsRelated(dep)) {
 				if _, exists := a.issueMap[dep.DependsOnID]; exists {
 					union(issue.ID, dep.DependsOnID)
 				}
@@ -209,6 +447,35 @@ func (a *Analyzer) findConnectedComponents() map[string][]string {
 	return components
 }
 
+// splitComponentsByProject splits each component into one part per
+// project (see issueProject), keyed by the part's smallest issue ID.
+// Components within a single project are kept as they are.
+func (a *Analyzer) splitComponentsByProject(components map[string][]string) map[string][]string {
+	split := make(map[string][]string, len(components))
+	for root, members := range components {
+		parts := make(map[string][]string)
+		var projects []string
+		for _, id := range members {
+			issue := a.issueMap[id]
+			project := issueProject(&issue)
+			if _, ok := parts[project]; !ok {
+				projects = append(projects, project)
+			}
+			parts[project] = append(parts[project], id)
+		}
+		if len(parts) == 1 {
+			split[root] = members
+			continue
+		}
+		for _, project := range projects {
+			part := parts[project]
+			sort.Strings(part)
+			split[part[0]] = part
+		}
+	}
+	return split
+}
+
 // buildTracks creates execution tracks from connected components
 func (a *Analyzer) buildTracks(components map[string][]string, actionableSet map[string]bool, unblocksMap map[string][]string) []ExecutionTrack {
 	var tracks []ExecutionTrack
@@ -243,16 +510,24 @@ func (a *Analyzer) buildTracks(components map[string][]string, actionableSet map
 			}
 			return actionableMembers[i].ID < actionableMembers[j].ID
 		})
+		if !a.planOpts.IgnoreRelated {
+			orderRelatedAdjacent(actionableMembers)
+		}
 
 		// Build plan items
 		items := make([]PlanItem, len(actionableMembers))
+		var unestimated []string
 		for i, issue := range actionableMembers {
+			if !hasEstimate(&issue) {
+				unestimated = append(unestimated, issue.ID)
+			}
 			items[i] = PlanItem{
 				ID:          issue.ID,
 				Title:       issue.Title,
 				Priority:    issue.Priority,
 				Status:      string(issue.Status),
 				UnblocksIDs: unblocksMap[issue.ID],
+				Source:      sourceRef(&issue),
 			}
 		}
 
@@ -265,9 +540,10 @@ func (a *Analyzer) buildTracks(components map[string][]string, actionableSet map
 		}
 
 		tracks = append(tracks, ExecutionTrack{
-			TrackID: generateTrackID(trackNum),
-			Items:   items,
-			Reason:  reason,
+			TrackID:     generateTrackID(trackNum),
+			Items:       items,
+			Reason:      reason,
+			Unestimated: unestimated,
 		})
 		trackNum++
 	}
@@ -275,6 +551,263 @@ func (a *Analyzer) buildTracks(components map[string][]string, actionableSet map
 	return tracks
 }
 
+// groupsRelated reports whether dep is a related edge that joins tracks
+func (a *Analyzer) groupsRelated(dep *model.Dependency) bool {
+	return !a.planOpts.IgnoreRelated && dep.Type == model.DepRelated
+}
+
+// orderRelatedAdjacent reorders issues, already sorted by priority then ID,
+// so that within each priority an issue related to the one before it comes
+// next. Priority order is never broken.
+func orderRelatedAdjacent(issues []model.Issue) {
+	related := make(map[string]map[string]bool)
+	link := func(x, y string) {
+		if related[x] == nil {
+			related[x] = make(map[string]bool)
+		}
+		related[x][y] = true
+	}
+	for _, issue := range issues {
+		for _, dep := range issue.Dependencies {
+			if dep != nil && dep.Type == model.DepRelated && dep.DependsOnID != issue.ID {
+				link(issue.ID, dep.DependsOnID)
+				link(dep.DependsOnID, issue.ID)
+			}
+		}
+	}
+	if len(related) == 0 {
+		return
+	}
+	for i := 1; i < len(issues); i++ {
+		prev := issues[i-1].ID
+		if related[prev][issues[i].ID] {
+			continue
+		}
+		for j := i + 1; j < len(issues) && issues[j].Priority == issues[i].Priority; j++ {
+			if related[prev][issues[j].ID] {
+				// Move j to i, keeping the rest in order
+				moved := issues[j]
+				copy(issues[i+1:j+1], issues[i:j])
+				issues[i] = moved
+				break
+			}
+		}
+	}
+}
+
+// computeTrackOrder derives inter-track ordering from blocking edges whose
+// endpoints fall in different tracks. Tracks are built from connected
+// components, so such edges only appear when grouping splits a connected
+// work stream (PlanOptions.SplitProjects); otherwise every track is listed
+// with no prerequisites. Tracks are returned topologically (prerequisites
+// first), ties in track order.
+func (a *Analyzer) computeTrackOrder(tracks []ExecutionTrack, components map[string][]string) []TrackDependency {
+	order := make([]TrackDependency, 0, len(tracks))
+	if len(tracks) == 0 {
+		return order
+	}
+
+	// Map every issue (actionable or not) to the track covering its component
+	rootOf := make(map[string]string)
+	for root, members := range components {
+		for _, id := range members {
+			rootOf[id] = root
+		}
+	}
+	trackOf := make(map[string]string)
+	trackIndex := make(map[string]int, len(tracks))
+	for i, track := range tracks {
+		trackIndex[track.TrackID] = i
+		if len(track.Items) == 0 {
+			continue
+		}
+		root := rootOf[track.Items[0].ID]
+		for _, id := range components[root] {
+			trackOf[id] = track.TrackID
+		}
+		for _, item := range track.Items {
+			trackOf[item.ID] = track.TrackID
+		}
+	}
+
+	// prereqs[t] holds tracks that t waits on
+	prereqs := make(map[string]map[string]bool)
+	for id, issue := range a.issueMap {
+		from, ok := trackOf[id]
+		if !ok || issue.Status == model.StatusClosed {
+			continue
+		}
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			to, ok := trackOf[dep.DependsOnID]
+			if !ok || to == from {
+				continue
+			}
+			if blocker, exists := a.issueMap[dep.DependsOnID]; !exists || blocker.Status == model.StatusClosed {
+				continue
+			}
+			if prereqs[from] == nil {
+				prereqs[from] = make(map[string]bool)
+			}
+			prereqs[from][to] = true
+		}
+	}
+
+	// Kahn's algorithm, choosing the earliest track among those ready
+	done := make(map[string]bool, len(tracks))
+	for len(order) < len(tracks) {
+		next := -1
+		for i, track := range tracks {
+			if done[track.TrackID] {
+				continue
+			}
+			ready := true
+			for p := range prereqs[track.TrackID] {
+				if !done[p] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			// Cycle between tracks: release the earliest remaining track
+			for i, track := range tracks {
+				if !done[track.TrackID] {
+					next = i
+					break
+				}
+			}
+		}
+
+		track := tracks[next]
+		deps := []string{}
+		for p := range prereqs[track.TrackID] {
+			deps = append(deps, p)
+		}
+		sort.Slice(deps, func(i, j int) bool { return trackIndex[deps[i]] < trackIndex[deps[j]] })
+		order = append(order, TrackDependency{TrackID: track.TrackID, DependsOn: deps})
+		done[track.TrackID] = true
+	}
+
+	return order
+}
+
+// computeTrackRisk scores each track from the share of its work stream that
+// is blocked, its top priority, and how long since the stream was touched.
+// Staleness and priority use the same scales as the impact score.
+func (a *Analyzer) computeTrackRisk(tracks []ExecutionTrack, components map[string][]string, actionableSet map[string]bool, now time.Time) {
+	rootOf := make(map[string]string)
+	for root, members := range components {
+		for _, id := range members {
+			rootOf[id] = root
+		}
+	}
+
+	for i := range tracks {
+		track := &tracks[i]
+		if len(track.Items) == 0 {
+			continue
+		}
+
+		var factors TrackRisk
+		var lastUpdate time.Time
+		factors.TopPriority = track.Items[0].Priority
+		for _, id := range components[rootOf[track.Items[0].ID]] {
+			issue := a.issueMap[id]
+			if issue.Status == model.StatusClosed {
+				continue
+			}
+			factors.Open++
+			if !actionableSet[id] {
+				factors.Blocked++
+			}
+			if issue.Priority < factors.TopPriority {
+				factors.TopPriority = issue.Priority
+			}
+			if issue.UpdatedAt.After(lastUpdate) {
+				lastUpdate = issue.UpdatedAt
+			}
+		}
+
+		blockedNorm := 0.0
+		if factors.Open > 0 {
+			blockedNorm = float64(factors.Blocked) / float64(factors.Open)
+		}
+		if !lastUpdate.IsZero() && now.After(lastUpdate) {
+			factors.StaleDays = int(now.Sub(lastUpdate).Hours() / 24)
+		}
+
+		track.Risk = blockedNorm*TrackRiskWeightBlocked +
+			computePriorityBoost(factors.TopPriority)*TrackRiskWeightPriority +
+			computeStaleness(lastUpdate, now)*TrackRiskWeightStaleness
+		track.RiskFactors = factors
+	}
+}
+
+// riskiestTrack returns the ID of the track with the highest risk, the
+// earliest track on ties, or "" when there are no tracks.
+func riskiestTrack(tracks []ExecutionTrack) string {
+	best := -1
+	for i, track := range tracks {
+		if best == -1 || track.Risk > tracks[best].Risk {
+			best = i
+		}
+	}
+	if best == -1 {
+		return ""
+	}
+	return tracks[best].TrackID
+}
+
+// computeFrontier ranks the actionable issues whose completion would make
+// other issues ready, using the same simulated close as the plan items'
+// unblocks. Each unblocked issue counts whatever project it belongs to;
+// those outside the candidate's project are also tallied in CrossProject.
+// Ties go to the higher priority, then the lower ID.
+func (a *Analyzer) computeFrontier(actionable []model.Issue, unblocksMap map[string][]string) []FrontierItem {
+	frontier := []FrontierItem{}
+	for i := range actionable {
+		issue := &actionable[i]
+		unblocks := unblocksMap[issue.ID]
+		if len(unblocks) == 0 {
+			continue
+		}
+		project := issueProject(issue)
+		cross := 0
+		for _, id := range unblocks {
+			if dependent, ok := a.issueMap[id]; ok && !strings.EqualFold(issueProject(&dependent), project) {
+				cross++
+			}
+		}
+		frontier = append(frontier, FrontierItem{
+			ID:            issue.ID,
+			Title:         issue.Title,
+			Priority:      issue.Priority,
+			Project:       project,
+			UnblocksCount: len(unblocks),
+			UnblocksIDs:   unblocks,
+			CrossProject:  cross,
+			Source:        sourceRef(issue),
+		})
+	}
+	sort.Slice(frontier, func(i, j int) bool {
+		if frontier[i].UnblocksCount != frontier[j].UnblocksCount {
+			return frontier[i].UnblocksCount > frontier[j].UnblocksCount
+		}
+		if frontier[i].Priority != frontier[j].Priority {
+			return frontier[i].Priority < frontier[j].Priority
+		}
+		return frontier[i].ID < frontier[j].ID
+	})
+	return frontier
+}
+
 // computePlanSummary finds the highest-impact actionable issue
 func (a *Analyzer) computePlanSummary(actionable []model.Issue, unblocksMap map[string][]string) PlanSummary {
 	if len(actionable) == 0 {
@@ -0,0 +1,67 @@
+package analysis
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestExecutionPlanTrackRisk(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		// Fresh P3 stream with nothing blocked
+		{ID: "A", Status: model.StatusOpen, Priority: 3, UpdatedAt: now.Add(-24 * time.Hour)},
+		// Stale P0 stream where one of two open issues is blocked
+		{ID: "B", Status: model.StatusOpen, Priority: 1, UpdatedAt: now.AddDate(0, 0, -45)},
+		{ID: "C", Status: model.StatusOpen, Priority: 0, UpdatedAt: now.AddDate(0, 0, -60), Dependencies: []*model.Dependency{
+			{DependsOnID: "B", Type: model.DepBlocks},
+		}},
+	}
+	plan := NewAnalyzer(issues).GetExecutionPlanAt(now)
+	if len(plan.Tracks) != 2 {
+		t.Fatalf("got %d tracks, want 2", len(plan.Tracks))
+	}
+
+	byFirst := make(map[string]ExecutionTrack)
+	for _, track := range plan.Tracks {
+		byFirst[track.Items[0].ID] = track
+	}
+
+	fresh := byFirst["A"]
+	if want := (TrackRisk{Blocked: 0, Open: 1, TopPriority: 3, StaleDays: 1}); fresh.RiskFactors != want {
+		t.Errorf("fresh factors = %+v, want %+v", fresh.RiskFactors, want)
+	}
+	wantFresh := 0.25*TrackRiskWeightPriority + (1.0/30)*TrackRiskWeightStaleness
+	if math.Abs(fresh.Risk-wantFresh) > 1e-9 {
+		t.Errorf("fresh risk = %v, want %v", fresh.Risk, wantFresh)
+	}
+
+	stale := byFirst["B"]
+	if want := (TrackRisk{Blocked: 1, Open: 2, TopPriority: 0, StaleDays: 45}); stale.RiskFactors != want {
+		t.Errorf("stale factors = %+v, want %+v", stale.RiskFactors, want)
+	}
+	wantStale := 0.5*TrackRiskWeightBlocked + TrackRiskWeightPriority + TrackRiskWeightStaleness
+	if math.Abs(stale.Risk-wantStale) > 1e-9 {
+		t.Errorf("stale risk = %v, want %v", stale.Risk, wantStale)
+	}
+
+	if plan.Summary.RiskiestTrack != stale.TrackID {
+		t.Errorf("riskiest_track = %q, want %q", plan.Summary.RiskiestTrack, stale.TrackID)
+	}
+}
+
+func TestRiskiestTrackTiesAndEmpty(t *testing.T) {
+	if got := riskiestTrack(nil); got != "" {
+		t.Errorf("riskiestTrack(nil) = %q, want empty", got)
+	}
+	tracks := []ExecutionTrack{
+		{TrackID: "track-A", Risk: 0.4},
+		{TrackID: "track-B", Risk: 0.7},
+		{TrackID: "track-C", Risk: 0.7},
+	}
+	if got := riskiestTrack(tracks); got != "track-B" {
+		t.Errorf("riskiestTrack = %q, want track-B", got)
+	}
+}
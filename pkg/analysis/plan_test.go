@@ -1,6 +1,8 @@
 package analysis_test
 
 import (
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
@@ -19,6 +21,78 @@ func TestGetExecutionPlanEmpty(t *testing.T) {
 	}
 }
 
+func TestGetExecutionPlanUnestimated(t *testing.T) {
+	est := func(m int) *int { return &m }
+	issues := []model.Issue{
+		{ID: "api-1", Status: model.StatusOpen, EstimatedMinutes: est(30)},
+		{ID: "api-2", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "api-2", DependsOnID: "api-1", Type: model.DepBlocks}}},
+		{ID: "web-1", Status: model.StatusOpen},
+		{ID: "web-2", Status: model.StatusClosed},
+		{ID: "web-3", Status: model.StatusOpen, EstimatedMinutes: est(0)}, // zero is no estimate
+		{ID: "lib-1", Status: model.StatusOpen, EstimatedMinutes: est(45)},
+	}
+	plan := analysis.NewAnalyzer(issues).GetExecutionPlan()
+
+	if got := strings.Join(plan.Unestimated, ","); got != "api-2,web-1,web-3" || plan.UnestimatedCount != 3 {
+		t.Errorf("unestimated = %s (%d), want api-2,web-1,web-3 (3)", got, plan.UnestimatedCount)
+	}
+	// Tracks list their own unestimated items; api-2 is blocked, so api's
+	// track has none
+	flagged := make(map[string]string)
+	for _, track := range plan.Tracks {
+		flagged[track.Items[0].ID] = strings.Join(track.Unestimated, ",")
+	}
+	if flagged["api-1"] != "" || flagged["lib-1"] != "" || flagged["web-1"] != "web-1" || flagged["web-3"] != "web-3" {
+		t.Errorf("track flags = %v", flagged)
+	}
+
+	if empty := analysis.NewAnalyzer(nil).GetExecutionPlan(); empty.Unestimated == nil || empty.UnestimatedCount != 0 {
+		t.Errorf("expected an empty non-nil unestimated list, got %#v", empty.Unestimated)
+	}
+}
+
+func TestGetExecutionPlanFrontier(t *testing.T) {
+	blocks := func(id, on string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: id, DependsOnID: on, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		{ID: "api-1", Status: model.StatusOpen, Priority: 2},
+		{ID: "api-2", Status: model.StatusOpen, Dependencies: blocks("api-2", "api-1")},
+		{ID: "web-1", Status: model.StatusOpen, Dependencies: blocks("web-1", "api-1")},
+		{ID: "web-2", Status: model.StatusOpen, Priority: 1},
+		{ID: "web-3", Status: model.StatusOpen, Dependencies: blocks("web-3", "web-2")},
+		{ID: "lib-1", Status: model.StatusOpen, Priority: 0},
+		// lib-2 also waits on web-9, so closing lib-1 alone frees nothing
+		{ID: "lib-2", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{DependsOnID: "lib-1", Type: model.DepBlocks},
+			{DependsOnID: "web-9", Type: model.DepBlocks},
+		}},
+		{ID: "web-9", Status: model.StatusOpen, Dependencies: blocks("web-9", "web-2")},
+		{ID: "ops-1", Status: model.StatusOpen},
+	}
+	plan := analysis.NewAnalyzer(issues).GetExecutionPlan()
+
+	var got []string
+	for _, f := range plan.Frontier {
+		got = append(got, f.ID)
+	}
+	// Equal counts go to the higher priority
+	if strings.Join(got, ",") != "web-2,api-1" {
+		t.Fatalf("frontier = %v, want web-2,api-1", got)
+	}
+	api := plan.Frontier[1]
+	if api.UnblocksCount != 2 || strings.Join(api.UnblocksIDs, ",") != "api-2,web-1" || api.Project != "api" || api.CrossProject != 1 {
+		t.Errorf("api-1 = %+v, want 2 unblocks, 1 in another project", api)
+	}
+	if web := plan.Frontier[0]; web.UnblocksCount != 2 || web.CrossProject != 0 {
+		t.Errorf("web-2 = %+v", web)
+	}
+
+	if empty := analysis.NewAnalyzer(nil).GetExecutionPlan(); empty.Frontier == nil {
+		t.Error("expected an empty non-nil frontier")
+	}
+}
+
 func TestGetExecutionPlanSingleIssue(t *testing.T) {
 	issues := []model.Issue{
 		{ID: "A", Title: "Task A", Status: model.StatusOpen, Priority: 1},
@@ -412,6 +486,39 @@ func TestGetExecutionPlanRelatedTypeDoesNotBlock(t *testing.T) {
 	}
 }
 
+func TestGetExecutionPlanRelatedGroupsTracks(t *testing.T) {
+	related := func(id, to string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: id, DependsOnID: to, Type: model.DepRelated}}
+	}
+	issues := []model.Issue{
+		{ID: "X", Title: "X", Status: model.StatusOpen, Priority: 2},
+		{ID: "Y", Title: "Y", Status: model.StatusOpen, Priority: 2, Dependencies: related("Y", "Z")},
+		{ID: "Z", Title: "Z", Status: model.StatusOpen, Priority: 2, Dependencies: related("Z", "X")},
+		{ID: "W", Title: "W", Status: model.StatusOpen, Priority: 1},
+	}
+
+	an := analysis.NewAnalyzer(issues)
+	plan := an.GetExecutionPlan()
+	if plan.TotalActionable != 4 {
+		t.Errorf("related edges must not block: %d actionable, want 4", plan.TotalActionable)
+	}
+	if len(plan.Tracks) != 2 {
+		t.Fatalf("expected W alone and X/Y/Z together, got %d tracks", len(plan.Tracks))
+	}
+	var ids []string
+	for _, item := range plan.Tracks[1].Items {
+		ids = append(ids, item.ID)
+	}
+	if got := strings.Join(ids, ","); got != "X,Z,Y" {
+		t.Errorf("track items = %s, want X,Z,Y (related items adjacent)", got)
+	}
+
+	an.SetPlanOptions(analysis.PlanOptions{IgnoreRelated: true})
+	if plan := an.GetExecutionPlan(); len(plan.Tracks) != 4 || plan.TotalActionable != 4 {
+		t.Errorf("ignoring related: %d tracks, %d actionable; want 4 and 4", len(plan.Tracks), plan.TotalActionable)
+	}
+}
+
 func TestGetExecutionPlanSelfReferential(t *testing.T) {
 	// A depends on itself - the underlying graph library panics on self-edges
 	// This test documents that self-referential deps should be filtered at data ingestion
@@ -470,7 +577,7 @@ func TestGetExecutionPlanLegacyDependencyGrouping(t *testing.T) {
 	// Since A depends on B, they form a connected component.
 	// Therefore, B should appear in a track that represents this component.
 	// We verify that the tracks logic respects this legacy dependency grouping.
-	
+
 	// Scenario:
 	// X -> A (legacy). X -> B (legacy).
 	// X is the common ancestor/dependent.
@@ -483,7 +590,7 @@ func TestGetExecutionPlanLegacyDependencyGrouping(t *testing.T) {
 	// We get 1 track with {A, B}.
 	// If connection logic FAILS (ignoring legacy), we get {A}, {B}, {X}.
 	// We get 2 tracks: Track 1 {A}, Track 2 {B}.
-	
+
 	issues := []model.Issue{
 		{ID: "X", Title: "Common Root", Status: model.StatusClosed, Priority: 1},
 		{ID: "A", Title: "Task A", Status: model.StatusOpen, Priority: 1, Dependencies: []*model.Dependency{
@@ -502,4 +609,33 @@ func TestGetExecutionPlanLegacyDependencyGrouping(t *testing.T) {
 	if len(plan.Tracks) != 1 {
 		t.Errorf("Expected 1 track (grouped via legacy dependency), got %d tracks", len(plan.Tracks))
 	}
-}
\ No newline at end of file
+}
+
+func TestGetExecutionPlanSourceRefs(t *testing.T) {
+	path := filepath.Join("/code", "api", ".beads", "beads.jsonl")
+	issues := []model.Issue{
+		{ID: "A", Title: "Task A", Status: model.StatusOpen, Priority: 1, SourcePath: path, SourceLine: 7},
+		{ID: "B", Title: "Task B", Status: model.StatusOpen, Priority: 2},
+	}
+
+	plan := analysis.NewAnalyzer(issues).GetExecutionPlan()
+	items := map[string]analysis.PlanItem{}
+	for _, track := range plan.Tracks {
+		for _, item := range track.Items {
+			items[item.ID] = item
+		}
+	}
+
+	src := items["A"].Source
+	if src == nil || src.Project != filepath.Join("/code", "api") || src.File != path || src.Line != 7 {
+		t.Errorf("A source = %+v, want project /code/api, %s line 7", src, path)
+	}
+	if items["B"].Source != nil {
+		t.Errorf("B has no source file, got %+v", items["B"].Source)
+	}
+	for _, pick := range plan.RecommendedFocus {
+		if pick.ID == "A" && (pick.Source == nil || pick.Source.Line != 7) {
+			t.Errorf("recommended_focus pick A source = %+v", pick.Source)
+		}
+	}
+}
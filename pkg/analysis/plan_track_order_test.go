@@ -0,0 +1,104 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestExecutionPlanTrackOrderIndependentTracks(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "A", Status: model.StatusOpen},
+		{ID: "B", Title: "B", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+			{DependsOnID: "A", Type: model.DepBlocks},
+		}},
+		{ID: "C", Title: "C", Status: model.StatusOpen},
+	}
+	plan := NewAnalyzer(issues).GetExecutionPlan()
+
+	if len(plan.TrackOrder) != len(plan.Tracks) {
+		t.Fatalf("track_order has %d entries, want %d", len(plan.TrackOrder), len(plan.Tracks))
+	}
+	for i, entry := range plan.TrackOrder {
+		if entry.TrackID != plan.Tracks[i].TrackID {
+			t.Errorf("track_order[%d] = %s, want %s", i, entry.TrackID, plan.Tracks[i].TrackID)
+		}
+		if entry.DependsOn == nil || len(entry.DependsOn) != 0 {
+			t.Errorf("track %s depends_on = %v, want empty array", entry.TrackID, entry.DependsOn)
+		}
+	}
+}
+
+func TestComputeTrackOrderCrossTrackEdges(t *testing.T) {
+	// X depends on Y, Y depends on Z, each placed in its own track
+	issues := []model.Issue{
+		{ID: "X", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "Y", Type: model.DepBlocks}}},
+		{ID: "Y", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "Z", Type: model.DepBlocks}}},
+		{ID: "Z", Status: model.StatusOpen},
+		{ID: "W", Status: model.StatusOpen, Dependencies: []*model.Dependency{{DependsOnID: "Z", Type: model.DepRelated}}},
+	}
+	a := NewAnalyzer(issues)
+	components := map[string][]string{"W": {"W"}, "X": {"X"}, "Y": {"Y"}, "Z": {"Z"}}
+	tracks := []ExecutionTrack{
+		{TrackID: "track-A", Items: []PlanItem{{ID: "X"}}},
+		{TrackID: "track-B", Items: []PlanItem{{ID: "Y"}}},
+		{TrackID: "track-C", Items: []PlanItem{{ID: "W"}}},
+		{TrackID: "track-D", Items: []PlanItem{{ID: "Z"}}},
+	}
+
+	got := a.computeTrackOrder(tracks, components)
+	want := []TrackDependency{
+		{TrackID: "track-C", DependsOn: []string{}},
+		{TrackID: "track-D", DependsOn: []string{}},
+		{TrackID: "track-B", DependsOn: []string{"track-D"}},
+		{TrackID: "track-A", DependsOn: []string{"track-B"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("computeTrackOrder = %+v, want %+v", got, want)
+	}
+}
+
+func TestExecutionPlanTrackOrderSplitProjects(t *testing.T) {
+	// web-1 waits on api-1, so the api track comes first; web-2 is
+	// web's actionable work in the same stream
+	issues := []model.Issue{
+		{ID: "api-1", Title: "Endpoint", Status: model.StatusOpen, Priority: 1},
+		{ID: "web-1", Title: "Page", Status: model.StatusOpen, Priority: 1, Dependencies: []*model.Dependency{
+			{DependsOnID: "api-1", Type: model.DepBlocks},
+			{DependsOnID: "web-2", Type: model.DepBlocks},
+		}},
+		{ID: "web-2", Title: "Layout", Status: model.StatusOpen, Priority: 2},
+	}
+
+	// One stream: a single track with nothing to order
+	plan := NewAnalyzer(issues).GetExecutionPlan()
+	if len(plan.Tracks) != 1 || len(plan.TrackOrder) != 1 || len(plan.TrackOrder[0].DependsOn) != 0 {
+		t.Fatalf("without SplitProjects: tracks=%+v order=%+v, want one independent track", plan.Tracks, plan.TrackOrder)
+	}
+
+	a := NewAnalyzer(issues)
+	a.SetPlanOptions(PlanOptions{SplitProjects: true})
+	plan = a.GetExecutionPlan()
+	if len(plan.Tracks) != 2 {
+		t.Fatalf("tracks = %+v, want one per project", plan.Tracks)
+	}
+	apiTrack, webTrack := plan.Tracks[0], plan.Tracks[1]
+	if apiTrack.Items[0].ID != "api-1" || webTrack.Items[0].ID != "web-2" {
+		t.Fatalf("tracks = %+v, want api-1 then web-2", plan.Tracks)
+	}
+	want := []TrackDependency{
+		{TrackID: apiTrack.TrackID, DependsOn: []string{}},
+		{TrackID: webTrack.TrackID, DependsOn: []string{apiTrack.TrackID}},
+	}
+	if !reflect.DeepEqual(plan.TrackOrder, want) {
+		t.Errorf("track_order = %+v, want %+v", plan.TrackOrder, want)
+	}
+
+	// Narrowing to web drops the api track from the order and as a prerequisite
+	narrowed := NarrowPlan(plan, map[string]bool{"web-1": true, "web-2": true})
+	want = []TrackDependency{{TrackID: webTrack.TrackID, DependsOn: []string{}}}
+	if !reflect.DeepEqual(narrowed.TrackOrder, want) {
+		t.Errorf("narrowed track_order = %+v, want %+v", narrowed.TrackOrder, want)
+	}
+}
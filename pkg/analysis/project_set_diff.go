@@ -0,0 +1,183 @@
+package analysis
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ProjectIssues is one project of a multi-project load: its name, its
+// resolved directory, the prefix its issue IDs are namespaced with, and its
+// namespaced issues.
+type ProjectIssues struct {
+	Name   string
+	Path   string
+	Prefix string
+	Issues []model.Issue
+}
+
+// ProjectSetDiff compares two multi-project loads, such as the projects of
+// two saved project configs.
+type ProjectSetDiff struct {
+	AddedProjects   []ProjectSetEntry     `json:"added_projects"`   // Only in To
+	RemovedProjects []ProjectSetEntry     `json:"removed_projects"` // Only in From
+	ChangedProjects []ProjectChange       `json:"changed_projects"` // In both, with issue changes
+	AddedIssues     []string              `json:"added_issues"`     // IDs only in To, from added projects too
+	RemovedIssues   []string              `json:"removed_issues"`   // IDs only in From, from removed projects too
+	Summary         ProjectSetDiffSummary `json:"summary"`
+}
+
+// ProjectSetEntry is a project that is in only one of the two sets.
+type ProjectSetEntry struct {
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	IssueCount int    `json:"issue_count"`
+}
+
+// ProjectChange is the snapshot diff of a project in both sets.
+type ProjectChange struct {
+	Name     string        `json:"name"`
+	FromName string        `json:"from_name,omitempty"` // Set when the project was renamed
+	FromPath string        `json:"from_path"`
+	ToPath   string        `json:"to_path"`
+	Diff     *SnapshotDiff `json:"diff"`
+}
+
+// ProjectSetDiffSummary counts the changes across all projects.
+type ProjectSetDiffSummary struct {
+	ProjectsAdded     int `json:"projects_added"`
+	ProjectsRemoved   int `json:"projects_removed"`
+	ProjectsChanged   int `json:"projects_changed"`
+	ProjectsUnchanged int `json:"projects_unchanged"`
+	IssuesAdded       int `json:"issues_added"`
+	IssuesRemoved     int `json:"issues_removed"`
+	IssuesClosed      int `json:"issues_closed"`
+	IssuesReopened    int `json:"issues_reopened"`
+	IssuesModified    int `json:"issues_modified"`
+}
+
+// CompareProjectSets diffs two multi-project loads. Projects are matched by
+// path, then the rest by name, so a project renamed between the sets is
+// still the same project; those in both are compared with CompareSnapshots
+// (after moving the old issues to the new prefix), and the issues of a
+// project in only one set count as added or removed.
+func CompareProjectSets(from, to []ProjectIssues) *ProjectSetDiff {
+	diff := &ProjectSetDiff{
+		AddedProjects:   []ProjectSetEntry{},
+		RemovedProjects: []ProjectSetEntry{},
+		ChangedProjects: []ProjectChange{},
+		AddedIssues:     []string{},
+		RemovedIssues:   []string{},
+	}
+
+	// match[i] is the index in from of to[i]'s project, or -1
+	match := make([]int, len(to))
+	matched := make(map[int]bool, len(from))
+	fromByPath := make(map[string]int, len(from))
+	for i, p := range from {
+		fromByPath[filepath.Clean(p.Path)] = i
+	}
+	for i, p := range to {
+		match[i] = -1
+		if j, ok := fromByPath[filepath.Clean(p.Path)]; ok && !matched[j] {
+			match[i] = j
+			matched[j] = true
+		}
+	}
+	for i, p := range to {
+		if match[i] >= 0 {
+			continue
+		}
+		for j, old := range from {
+			if !matched[j] && old.Name == p.Name {
+				match[i] = j
+				matched[j] = true
+				break
+			}
+		}
+	}
+
+	for i, p := range to {
+		if match[i] < 0 {
+			diff.AddedProjects = append(diff.AddedProjects, ProjectSetEntry{Name: p.Name, Path: p.Path, IssueCount: len(p.Issues)})
+			for _, issue := range p.Issues {
+				diff.AddedIssues = append(diff.AddedIssues, issue.ID)
+			}
+			continue
+		}
+		old := from[match[i]]
+
+		oldIssues := old.Issues
+		if old.Prefix != "" && old.Prefix != p.Prefix {
+			oldIssues = reprefixIssues(old.Issues, old.Prefix, p.Prefix)
+		}
+		pd := CompareSnapshots(NewSnapshot(oldIssues), NewSnapshot(p.Issues))
+		if pd.IsEmpty() {
+			diff.Summary.ProjectsUnchanged++
+			continue
+		}
+		change := ProjectChange{Name: p.Name, FromPath: old.Path, ToPath: p.Path, Diff: pd}
+		if old.Name != p.Name {
+			change.FromName = old.Name
+		}
+		diff.ChangedProjects = append(diff.ChangedProjects, change)
+		for _, issue := range pd.NewIssues {
+			diff.AddedIssues = append(diff.AddedIssues, issue.ID)
+		}
+		for _, issue := range pd.RemovedIssues {
+			diff.RemovedIssues = append(diff.RemovedIssues, issue.ID)
+		}
+		diff.Summary.IssuesClosed += pd.Summary.IssuesClosed
+		diff.Summary.IssuesReopened += pd.Summary.IssuesReopened
+		diff.Summary.IssuesModified += pd.Summary.IssuesModified
+	}
+	for j, p := range from {
+		if matched[j] {
+			continue
+		}
+		diff.RemovedProjects = append(diff.RemovedProjects, ProjectSetEntry{Name: p.Name, Path: p.Path, IssueCount: len(p.Issues)})
+		for _, issue := range p.Issues {
+			diff.RemovedIssues = append(diff.RemovedIssues, issue.ID)
+		}
+	}
+
+	sort.Slice(diff.AddedProjects, func(i, j int) bool { return diff.AddedProjects[i].Name < diff.AddedProjects[j].Name })
+	sort.Slice(diff.RemovedProjects, func(i, j int) bool { return diff.RemovedProjects[i].Name < diff.RemovedProjects[j].Name })
+	sort.Slice(diff.ChangedProjects, func(i, j int) bool { return diff.ChangedProjects[i].Name < diff.ChangedProjects[j].Name })
+	sort.Strings(diff.AddedIssues)
+	sort.Strings(diff.RemovedIssues)
+
+	diff.Summary.ProjectsAdded = len(diff.AddedProjects)
+	diff.Summary.ProjectsRemoved = len(diff.RemovedProjects)
+	diff.Summary.ProjectsChanged = len(diff.ChangedProjects)
+	diff.Summary.IssuesAdded = len(diff.AddedIssues)
+	diff.Summary.IssuesRemoved = len(diff.RemovedIssues)
+	return diff
+}
+
+// reprefixIssues copies issues with IDs (and dependency IDs) namespaced
+// under from moved to the to prefix, so a renamed project's issues line up
+// with their new IDs.
+func reprefixIssues(issues []model.Issue, from, to string) []model.Issue {
+	move := func(id string) string {
+		if rest, ok := strings.CutPrefix(id, from); ok {
+			return to + rest
+		}
+		return id
+	}
+	out := make([]model.Issue, len(issues))
+	for i, issue := range issues {
+		clone := issue.Clone()
+		clone.ID = move(clone.ID)
+		for _, dep := range clone.Dependencies {
+			if dep != nil {
+				dep.IssueID = move(dep.IssueID)
+				dep.DependsOnID = move(dep.DependsOnID)
+			}
+		}
+		out[i] = clone
+	}
+	return out
+}
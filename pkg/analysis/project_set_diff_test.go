@@ -0,0 +1,95 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestCompareProjectSets(t *testing.T) {
+	from := []ProjectIssues{
+		{Name: "api", Path: "/code/api", Issues: []model.Issue{
+			{ID: "api-1", Title: "Auth", Status: model.StatusOpen},
+			{ID: "api-2", Title: "Rate limit", Status: model.StatusOpen},
+		}},
+		{Name: "old", Path: "/code/old", Issues: []model.Issue{
+			{ID: "old-1", Title: "Legacy", Status: model.StatusOpen},
+		}},
+		{Name: "docs", Path: "/code/docs", Issues: []model.Issue{
+			{ID: "docs-1", Title: "Guide", Status: model.StatusOpen},
+		}},
+	}
+	to := []ProjectIssues{
+		{Name: "web", Path: "/code/web", Issues: []model.Issue{
+			{ID: "web-1", Title: "Login page", Status: model.StatusOpen},
+		}},
+		{Name: "api", Path: "/src/api", Issues: []model.Issue{
+			{ID: "api-1", Title: "Auth", Status: model.StatusClosed},
+			{ID: "api-3", Title: "Metrics", Status: model.StatusOpen},
+		}},
+		{Name: "docs", Path: "/code/docs", Issues: []model.Issue{
+			{ID: "docs-1", Title: "Guide", Status: model.StatusOpen},
+		}},
+	}
+
+	diff := CompareProjectSets(from, to)
+
+	if len(diff.AddedProjects) != 1 || diff.AddedProjects[0] != (ProjectSetEntry{Name: "web", Path: "/code/web", IssueCount: 1}) {
+		t.Errorf("added projects = %+v", diff.AddedProjects)
+	}
+	if len(diff.RemovedProjects) != 1 || diff.RemovedProjects[0].Name != "old" {
+		t.Errorf("removed projects = %+v", diff.RemovedProjects)
+	}
+	if len(diff.ChangedProjects) != 1 || diff.ChangedProjects[0].Name != "api" || diff.ChangedProjects[0].ToPath != "/src/api" {
+		t.Fatalf("changed projects = %+v", diff.ChangedProjects)
+	}
+	if !reflect.DeepEqual(diff.AddedIssues, []string{"api-3", "web-1"}) {
+		t.Errorf("added issues = %v, want [api-3 web-1]", diff.AddedIssues)
+	}
+	if !reflect.DeepEqual(diff.RemovedIssues, []string{"api-2", "old-1"}) {
+		t.Errorf("removed issues = %v, want [api-2 old-1]", diff.RemovedIssues)
+	}
+	want := ProjectSetDiffSummary{
+		ProjectsAdded: 1, ProjectsRemoved: 1, ProjectsChanged: 1, ProjectsUnchanged: 1,
+		IssuesAdded: 2, IssuesRemoved: 2, IssuesClosed: 1,
+	}
+	if diff.Summary != want {
+		t.Errorf("summary = %+v, want %+v", diff.Summary, want)
+	}
+}
+
+func TestCompareProjectSets_RenamedProjectMatchesByPath(t *testing.T) {
+	from := []ProjectIssues{
+		{Name: "svc", Path: "/code/svc", Prefix: "svc-", Issues: []model.Issue{
+			{ID: "svc-1", Title: "Auth", Status: model.StatusOpen},
+			{ID: "svc-2", Title: "Login", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+				{IssueID: "svc-2", DependsOnID: "svc-1", Type: model.DepBlocks},
+			}},
+		}},
+	}
+	to := []ProjectIssues{
+		{Name: "service", Path: "/code/svc/", Prefix: "service-", Issues: []model.Issue{
+			{ID: "service-1", Title: "Auth", Status: model.StatusClosed},
+			{ID: "service-2", Title: "Login", Status: model.StatusOpen, Dependencies: []*model.Dependency{
+				{IssueID: "service-2", DependsOnID: "service-1", Type: model.DepBlocks},
+			}},
+		}},
+	}
+
+	diff := CompareProjectSets(from, to)
+
+	if len(diff.AddedProjects) != 0 || len(diff.RemovedProjects) != 0 {
+		t.Fatalf("renamed project reported as added %+v / removed %+v", diff.AddedProjects, diff.RemovedProjects)
+	}
+	if len(diff.ChangedProjects) != 1 || diff.ChangedProjects[0].FromName != "svc" || diff.ChangedProjects[0].Name != "service" {
+		t.Fatalf("changed projects = %+v", diff.ChangedProjects)
+	}
+	want := ProjectSetDiffSummary{ProjectsChanged: 1, IssuesClosed: 1}
+	if diff.Summary != want {
+		t.Errorf("summary = %+v, want %+v", diff.Summary, want)
+	}
+	if from[0].Issues[0].ID != "svc-1" {
+		t.Errorf("input issues were modified: %s", from[0].Issues[0].ID)
+	}
+}
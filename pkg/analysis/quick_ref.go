@@ -0,0 +1,124 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Quick-ref field names accepted in TriageOptions.QuickRefFields. Each
+// selects one count in triage quick_ref; top_picks is always included.
+const (
+	QuickRefOpen       = "open"        // open_count: issues that are not closed
+	QuickRefClosed     = "closed"      // closed_count
+	QuickRefBlocked    = "blocked"     // blocked_count: open issues with an open blocker
+	QuickRefReady      = "ready"       // actionable_count: open issues with no open blocker
+	QuickRefInProgress = "in_progress" // in_progress_count
+	QuickRefStale      = "stale"       // stale_count: open issues idle for DefaultStaleThresholdDays
+	QuickRefOverdue    = "overdue"     // overdue_count: open issues past their due_date
+	QuickRefUnassigned = "unassigned"  // unassigned_count: open issues with no assignee
+)
+
+// QuickRefFieldNames lists every valid quick-ref field name.
+var QuickRefFieldNames = []string{
+	QuickRefOpen, QuickRefClosed, QuickRefBlocked, QuickRefReady,
+	QuickRefInProgress, QuickRefStale, QuickRefOverdue, QuickRefUnassigned,
+}
+
+// DefaultQuickRefFields is the quick_ref shape used when no fields are configured.
+var DefaultQuickRefFields = []string{QuickRefOpen, QuickRefReady, QuickRefBlocked, QuickRefInProgress}
+
+// ValidateQuickRefFields returns an error naming the first unknown field.
+func ValidateQuickRefFields(fields []string) error {
+	for _, f := range fields {
+		known := false
+		for _, name := range QuickRefFieldNames {
+			if f == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown quick_ref field %q (expected one of %s)", f, strings.Join(QuickRefFieldNames, ", "))
+		}
+	}
+	return nil
+}
+
+// quickRefExtras holds the counts only reported when selected.
+type quickRefExtras struct {
+	stale, overdue, unassigned int
+}
+
+// countQuickRefExtras counts stale, overdue and unassigned open issues.
+func countQuickRefExtras(issues []model.Issue, now time.Time) quickRefExtras {
+	var x quickRefExtras
+	staleCutoff := now.Add(-time.Duration(DefaultStaleThresholdDays) * 24 * time.Hour)
+	for i := range issues {
+		issue := &issues[i]
+		if issue.Status == model.StatusClosed {
+			continue
+		}
+		updated := issue.UpdatedAt
+		if updated.IsZero() {
+			updated = issue.CreatedAt
+		}
+		if !updated.IsZero() && updated.Before(staleCutoff) {
+			x.stale++
+		}
+		if issue.DueDate != nil && issue.DueDate.Before(now) {
+			x.overdue++
+		}
+		if strings.TrimSpace(issue.Assignee) == "" {
+			x.unassigned++
+		}
+	}
+	return x
+}
+
+// quickRefJSON is the wire form of QuickRef; nil counts are left out.
+type quickRefJSON struct {
+	OpenCount       *int      `json:"open_count,omitempty"`
+	ActionableCount *int      `json:"actionable_count,omitempty"`
+	BlockedCount    *int      `json:"blocked_count,omitempty"`
+	InProgressCount *int      `json:"in_progress_count,omitempty"`
+	ClosedCount     *int      `json:"closed_count,omitempty"`
+	StaleCount      *int      `json:"stale_count,omitempty"`
+	OverdueCount    *int      `json:"overdue_count,omitempty"`
+	UnassignedCount *int      `json:"unassigned_count,omitempty"`
+	TopPicks        []TopPick `json:"top_picks"`
+}
+
+// MarshalJSON emits the counts selected by Fields (DefaultQuickRefFields
+// when empty) followed by top_picks.
+func (q QuickRef) MarshalJSON() ([]byte, error) {
+	fields := q.Fields
+	if len(fields) == 0 {
+		fields = DefaultQuickRefFields
+	}
+	out := quickRefJSON{TopPicks: q.TopPicks}
+	for _, f := range fields {
+		switch f {
+		case QuickRefOpen:
+			out.OpenCount = &q.OpenCount
+		case QuickRefReady:
+			out.ActionableCount = &q.ActionableCount
+		case QuickRefBlocked:
+			out.BlockedCount = &q.BlockedCount
+		case QuickRefInProgress:
+			out.InProgressCount = &q.InProgressCount
+		case QuickRefClosed:
+			out.ClosedCount = &q.ClosedCount
+		case QuickRefStale:
+			out.StaleCount = &q.StaleCount
+		case QuickRefOverdue:
+			out.OverdueCount = &q.OverdueCount
+		case QuickRefUnassigned:
+			out.UnassignedCount = &q.UnassignedCount
+		}
+	}
+	return json.Marshal(out)
+}
@@ -0,0 +1,84 @@
+package analysis
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func quickRefKeys(t *testing.T, q QuickRef) []string {
+	t.Helper()
+	data, err := json.Marshal(q)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	var keys []string
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestQuickRefMarshalDefaultFields(t *testing.T) {
+	got := quickRefKeys(t, QuickRef{ClosedCount: 4})
+	want := []string{"actionable_count", "blocked_count", "in_progress_count", "open_count", "top_picks"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("default quick_ref keys = %v, want %v", got, want)
+	}
+}
+
+func TestQuickRefMarshalSelectedFields(t *testing.T) {
+	q := QuickRef{OpenCount: 3, ClosedCount: 0, StaleCount: 2, Fields: []string{QuickRefClosed, QuickRefStale}}
+	got := quickRefKeys(t, q)
+	want := []string{"closed_count", "stale_count", "top_picks"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("quick_ref keys = %v, want %v", got, want)
+	}
+	// Selected zero counts are still written
+	data, _ := json.Marshal(q)
+	if !strings.Contains(string(data), `"closed_count":0`) {
+		t.Errorf("expected closed_count 0 in %s", data)
+	}
+}
+
+func TestValidateQuickRefFields(t *testing.T) {
+	if err := ValidateQuickRefFields(QuickRefFieldNames); err != nil {
+		t.Errorf("all known fields: %v", err)
+	}
+	if err := ValidateQuickRefFields(nil); err != nil {
+		t.Errorf("no fields: %v", err)
+	}
+	err := ValidateQuickRefFields([]string{"open", "velocity"})
+	if err == nil || !strings.Contains(err.Error(), `"velocity"`) {
+		t.Errorf("unknown field: err = %v, want it named", err)
+	}
+}
+
+func TestTriageQuickRefExtraCounts(t *testing.T) {
+	now := time.Now()
+	past := now.AddDate(0, 0, -1)
+	issues := []model.Issue{
+		{ID: "A", Title: "Stale", Status: model.StatusOpen, IssueType: model.TypeTask, Assignee: "ana", UpdatedAt: now.AddDate(0, 0, -30)},
+		{ID: "B", Title: "Overdue", Status: model.StatusInProgress, IssueType: model.TypeTask, UpdatedAt: now, DueDate: &past},
+		{ID: "C", Title: "Done", Status: model.StatusClosed, IssueType: model.TypeTask, UpdatedAt: now.AddDate(0, 0, -30), DueDate: &past},
+	}
+
+	q := ComputeTriageWithOptions(issues, TriageOptions{QuickRefFields: []string{QuickRefClosed}}).QuickRef
+	if q.ClosedCount != 1 || q.StaleCount != 1 || q.OverdueCount != 1 || q.UnassignedCount != 1 {
+		t.Errorf("closed=%d stale=%d overdue=%d unassigned=%d; want 1 each",
+			q.ClosedCount, q.StaleCount, q.OverdueCount, q.UnassignedCount)
+	}
+	if !reflect.DeepEqual(q.Fields, []string{QuickRefClosed}) {
+		t.Errorf("Fields = %v, want [closed]", q.Fields)
+	}
+}
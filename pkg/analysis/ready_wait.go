@@ -0,0 +1,104 @@
+package analysis
+
+import (
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// Where ReadyWait.ReadySince comes from.
+const (
+	ReadySinceBlockerClosed = "blocker_closed" // The last of its blockers closed
+	ReadySinceCreated       = "created"        // It had no blockers, so since creation
+)
+
+// ReadyWait is an open issue that could be started but hasn't been, with
+// how long it has been in that state.
+type ReadyWait struct {
+	ID          string    `json:"id"`
+	Title       string    `json:"title"`
+	Priority    int       `json:"priority"`
+	Assignee    string    `json:"assignee,omitempty"`
+	ReadySince  time.Time `json:"ready_since"`
+	Source      string    `json:"source"`                 // ReadySinceBlockerClosed or ReadySinceCreated
+	LastBlocker string    `json:"last_blocker,omitempty"` // The blocker that closed last, for blocker_closed
+	WaitDays    int       `json:"wait_days"`
+}
+
+// ReadyWaits ranks the issues that have been ready to start longest: open
+// (not in progress or blocked) with no open blocking dependency. An issue
+// became ready when its last blocker closed (its closed_at, or its last
+// update when that is missing), or when it was created if that is later or
+// it never had a blocker. Dependencies on issues that are not loaded are
+// ignored, and issues with no known time are left out.
+//
+// Results are ordered by ready_since (oldest first), then priority, then
+// ID. A limit <= 0 returns every ready issue.
+func ReadyWaits(issues []model.Issue, now time.Time, limit int) []ReadyWait {
+	issueMap := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		issueMap[issues[i].ID] = &issues[i]
+	}
+
+	result := []ReadyWait{}
+	for i := range issues {
+		issue := &issues[i]
+		if issue.Status != model.StatusOpen {
+			continue
+		}
+		since, source, lastBlocker := issue.CreatedAt, ReadySinceCreated, ""
+		blocked := false
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || dep.DependsOnID == issue.ID {
+				continue
+			}
+			blocker, ok := issueMap[dep.DependsOnID]
+			if !ok {
+				continue
+			}
+			if blocker.Status != model.StatusClosed {
+				blocked = true
+				break
+			}
+			closed := blocker.UpdatedAt
+			if blocker.ClosedAt != nil {
+				closed = *blocker.ClosedAt
+			}
+			if closed.After(since) {
+				since, source, lastBlocker = closed, ReadySinceBlockerClosed, blocker.ID
+			}
+		}
+		if blocked || since.IsZero() {
+			continue
+		}
+		days := 0
+		if now.After(since) {
+			days = int(now.Sub(since).Hours() / 24)
+		}
+		result = append(result, ReadyWait{
+			ID:          issue.ID,
+			Title:       issue.Title,
+			Priority:    issue.Priority,
+			Assignee:    issue.Assignee,
+			ReadySince:  since,
+			Source:      source,
+			LastBlocker: lastBlocker,
+			WaitDays:    days,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].ReadySince.Equal(result[j].ReadySince) {
+			return result[i].ReadySince.Before(result[j].ReadySince)
+		}
+		if result[i].Priority != result[j].Priority {
+			return result[i].Priority < result[j].Priority
+		}
+		return result[i].ID < result[j].ID
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result
+}
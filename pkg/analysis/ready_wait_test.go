@@ -0,0 +1,56 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestReadyWaits(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	day := func(d int) time.Time { return now.AddDate(0, 0, -d) }
+	closedAt := day(20)
+	blocks := func(id, on string) []*model.Dependency {
+		return []*model.Dependency{{IssueID: id, DependsOnID: on, Type: model.DepBlocks}}
+	}
+	issues := []model.Issue{
+		// Old, but only startable since its blocker closed 20 days ago
+		{ID: "A", Status: model.StatusOpen, Priority: 1, CreatedAt: day(90), Dependencies: blocks("A", "done")},
+		{ID: "done", Status: model.StatusClosed, CreatedAt: day(100), ClosedAt: &closedAt},
+		// Never blocked: ready since creation
+		{ID: "B", Status: model.StatusOpen, Priority: 2, CreatedAt: day(40)},
+		// Dependency added after the blocker closed: ready since creation
+		{ID: "C", Status: model.StatusOpen, Priority: 2, CreatedAt: day(5), Dependencies: blocks("C", "done")},
+		// Still blocked, started, or waiting on an issue that isn't loaded
+		{ID: "D", Status: model.StatusOpen, CreatedAt: day(60), Dependencies: blocks("D", "B")},
+		{ID: "E", Status: model.StatusInProgress, CreatedAt: day(60)},
+		{ID: "F", Status: model.StatusOpen, Priority: 3, CreatedAt: day(40), Dependencies: blocks("F", "other-9")},
+		// No known time
+		{ID: "G", Status: model.StatusOpen},
+	}
+
+	got := ReadyWaits(issues, now, 0)
+	want := []struct {
+		id, source, last string
+		days             int
+	}{
+		{"B", ReadySinceCreated, "", 40},
+		{"F", ReadySinceCreated, "", 40},
+		{"A", ReadySinceBlockerClosed, "done", 20},
+		{"C", ReadySinceCreated, "", 5},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d ready waits, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		g := got[i]
+		if g.ID != w.id || g.Source != w.source || g.LastBlocker != w.last || g.WaitDays != w.days {
+			t.Errorf("[%d] = %s source=%s last=%s days=%d, want %+v", i, g.ID, g.Source, g.LastBlocker, g.WaitDays, w)
+		}
+	}
+
+	if top := ReadyWaits(issues, now, 1); len(top) != 1 || top[0].ID != "B" {
+		t.Errorf("limit 1 = %+v, want just B", top)
+	}
+}
@@ -0,0 +1,102 @@
+package analysis
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DefaultSimilarThreshold is the minimum title similarity reported by
+// FindSimilarTitles when no threshold is given.
+const DefaultSimilarThreshold = 0.6
+
+// SimilarPair is two open issues with similar titles that are not yet
+// linked, ordered so IssueA < IssueB.
+type SimilarPair struct {
+	IssueA      string   `json:"issue_a"`
+	IssueB      string   `json:"issue_b"`
+	TitleA      string   `json:"title_a"`
+	TitleB      string   `json:"title_b"`
+	ProjectA    string   `json:"project_a,omitempty"`
+	ProjectB    string   `json:"project_b,omitempty"`
+	SameProject bool     `json:"same_project"`
+	Score       float64  `json:"score"`         // Token-set Jaccard similarity of the titles, 0-1
+	Common      []string `json:"common_tokens"` // Title words both share
+}
+
+// FindSimilarTitles reports pairs of open issues whose titles score at least
+// threshold, highest first. The score is the Jaccard similarity of the
+// titles' word sets (lowercased, punctuation and stop words dropped), so
+// word order and repeats do not matter. Pairs already joined by any
+// dependency are skipped, and with crossProjectOnly so are pairs from the
+// same project (grouped as in CompareProjects).
+func FindSimilarTitles(issues []model.Issue, threshold float64, crossProjectOnly bool) []SimilarPair {
+	found := findDuplicatePairs(issues, DuplicateConfig{
+		JaccardThreshold: threshold,
+		MinKeywords:      1,
+		OpenOnly:         true,
+		CrossProjectOnly: crossProjectOnly,
+		TitleOnly:        true,
+		SkipLinked:       true,
+	})
+
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	pairs := make([]SimilarPair, 0, len(found))
+	for _, d := range found {
+		first, second := byID[d.Issue1], byID[d.Issue2]
+		if second.ID < first.ID {
+			first, second = second, first
+		}
+		projA, projB := issueProject(first), issueProject(second)
+		pairs = append(pairs, SimilarPair{
+			IssueA:      first.ID,
+			IssueB:      second.ID,
+			TitleA:      first.Title,
+			TitleB:      second.Title,
+			ProjectA:    projA,
+			ProjectB:    projB,
+			SameProject: strings.EqualFold(projA, projB),
+			Score:       d.Similarity,
+			Common:      d.Keywords,
+		})
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Score != pairs[j].Score {
+			return pairs[i].Score > pairs[j].Score
+		}
+		if pairs[i].IssueA != pairs[j].IssueA {
+			return pairs[i].IssueA < pairs[j].IssueA
+		}
+		return pairs[i].IssueB < pairs[j].IssueB
+	})
+	return pairs
+}
+
+// titleTokens returns the distinct words of a title, lowercased, without
+// punctuation or stop words. Short words are kept since titles are terse.
+func titleTokens(title string) []string {
+	words := strings.Fields(nonWordRegex.ReplaceAllString(strings.ToLower(title), " "))
+	seen := make(map[string]bool, len(words))
+	tokens := make([]string, 0, len(words))
+	for _, w := range words {
+		if stopWords[w] || seen[w] {
+			continue
+		}
+		seen[w] = true
+		tokens = append(tokens, w)
+	}
+	return tokens
+}
+
+// idPair returns a and b as an order-independent key.
+func idPair(a, b string) [2]string {
+	if b < a {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
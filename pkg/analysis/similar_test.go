@@ -0,0 +1,51 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestFindSimilarTitles(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "web-4", Title: "Fix login bug", Status: model.StatusOpen},
+		{ID: "api-7", Title: "Login bug: fix!", Status: model.StatusOpen},
+		{ID: "api-9", Title: "Fix the login page bug", Status: model.StatusOpen},
+		{ID: "api-2", Title: "Add rate limiting", Status: model.StatusOpen},
+		{ID: "web-1", Title: "Fix login bug", Status: model.StatusClosed},
+		// Already linked to api-7, so not suggested again
+		{ID: "ops-1", Title: "Fix login bug", Status: model.StatusOpen,
+			Dependencies: []*model.Dependency{{IssueID: "ops-1", DependsOnID: "api-7", Type: model.DepRelated}}},
+	}
+
+	pairs := FindSimilarTitles(issues, DefaultSimilarThreshold, false)
+	got := make([][2]string, len(pairs))
+	for i, p := range pairs {
+		got[i] = [2]string{p.IssueA, p.IssueB}
+	}
+	want := [][2]string{{"api-7", "web-4"}, {"ops-1", "web-4"}, {"api-7", "api-9"}, {"api-9", "ops-1"}, {"api-9", "web-4"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("pairs = %v, want %v", got, want)
+	}
+	first := pairs[0]
+	if first.Score != 1 || first.ProjectA != "api" || first.ProjectB != "web" || first.SameProject ||
+		first.TitleA != "Login bug: fix!" || !reflect.DeepEqual(first.Common, []string{"bug", "fix", "login"}) {
+		t.Errorf("first pair = %+v", first)
+	}
+	if pairs[2].Score != 0.75 || !pairs[2].SameProject {
+		t.Errorf("api-7/api-9 = %+v, want same-project score 0.75", pairs[2])
+	}
+
+	for _, p := range FindSimilarTitles(issues, DefaultSimilarThreshold, true) {
+		if p.SameProject {
+			t.Errorf("cross-project only returned %s/%s", p.IssueA, p.IssueB)
+		}
+	}
+	if n := len(FindSimilarTitles(issues, 0.9, false)); n != 2 {
+		t.Errorf("threshold 0.9: %d pairs, want 2", n)
+	}
+	if pairs := FindSimilarTitles(nil, 0.5, false); pairs == nil || len(pairs) != 0 {
+		t.Errorf("no issues: %v, want empty slice", pairs)
+	}
+}
@@ -0,0 +1,178 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// DefaultCloseAfterDays is how long an open issue must go without updates
+// before it is suggested for closing.
+const DefaultCloseAfterDays = 90
+
+// StaleOptions controls which issues count as stale and which of those are
+// suggested for closing.
+type StaleOptions struct {
+	StaleDays      int // Days without update to count as stale (<= 0 uses DefaultStaleThresholdDays)
+	CloseAfterDays int // Days without update before an open issue is a close candidate (<= 0 uses DefaultCloseAfterDays)
+	MaxDependents  int // Most open dependents a close candidate may have (0 = none)
+
+	// MaxRecommendationAgeDays, when set, lists the open issues triage
+	// leaves out of recommendations for age in AgedOut
+	MaxRecommendationAgeDays int
+
+	// CountComments makes the last comment count as an update (see
+	// model.Issue.LastCommentTime)
+	CountComments bool
+}
+
+// StaleIssue is a non-closed issue that has not been updated for a while.
+type StaleIssue struct {
+	ID         string `json:"id"`
+	Title      string `json:"title"`
+	Status     string `json:"status"`
+	Priority   int    `json:"priority"`
+	Assignee   string `json:"assignee,omitempty"`
+	DaysStale  int    `json:"days_stale"`
+	Dependents int    `json:"dependents"` // Open issues that depend on this one
+}
+
+// CloseSuggestion is a stale issue that looks safe to close. It is only a
+// suggestion: nothing is written.
+type CloseSuggestion struct {
+	ID         string `json:"id"`
+	Title      string `json:"title"`
+	DaysStale  int    `json:"days_stale"`
+	Dependents int    `json:"dependents"`
+	Reason     string `json:"reason"`
+}
+
+// AgedOutIssue is a non-closed issue too old to be recommended by triage.
+type AgedOutIssue struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Status   string `json:"status"`
+	Priority int    `json:"priority"`
+	AgeDays  int    `json:"age_days"`
+}
+
+// StaleReport lists stale issues and the subset suggested for closing.
+type StaleReport struct {
+	Stale        []StaleIssue      `json:"stale"`
+	SuggestClose []CloseSuggestion `json:"suggest_close"`
+	AgedOut      []AgedOutIssue    `json:"aged_out,omitempty"`
+}
+
+// FindStale returns the non-closed issues not updated for opts.StaleDays
+// (falling back to the creation date when there is no update time, and
+// counting the last comment as an update with opts.CountComments), most
+// stale first, then by ID. Open issues (not in progress or blocked) idle for
+// opts.CloseAfterDays with at most opts.MaxDependents open dependents are
+// also listed in SuggestClose. A dependent is an open issue that links to the
+// candidate with any dependency type except related and discovered-from, so
+// blocked work and open children keep an issue off the list. With
+// opts.MaxRecommendationAgeDays set, non-closed issues past that age are
+// listed in AgedOut, oldest first, whether or not they are stale.
+func FindStale(issues []model.Issue, opts StaleOptions, now time.Time) StaleReport {
+	if opts.StaleDays <= 0 {
+		opts.StaleDays = DefaultStaleThresholdDays
+	}
+	if opts.CloseAfterDays <= 0 {
+		opts.CloseAfterDays = DefaultCloseAfterDays
+	}
+
+	dependents := make(map[string]int)
+	for _, issue := range issues {
+		if issue.Status == model.StatusClosed {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, dep := range issue.Dependencies {
+			if dep == nil || dep.Type == model.DepRelated || dep.Type == model.DepDiscoveredFrom ||
+				dep.DependsOnID == issue.ID || seen[dep.DependsOnID] {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			dependents[dep.DependsOnID]++
+		}
+	}
+
+	report := StaleReport{Stale: []StaleIssue{}, SuggestClose: []CloseSuggestion{}}
+	for _, issue := range issues {
+		if issue.Status == model.StatusClosed {
+			continue
+		}
+		if ExceedsRecommendationAge(&issue, opts.MaxRecommendationAgeDays, now) {
+			report.AgedOut = append(report.AgedOut, AgedOutIssue{
+				ID:       issue.ID,
+				Title:    issue.Title,
+				Status:   string(issue.Status),
+				Priority: issue.Priority,
+				AgeDays:  int(now.Sub(issue.CreatedAt).Hours() / 24),
+			})
+		}
+		lastActive := issue.UpdatedAt
+		if lastActive.IsZero() {
+			lastActive = issue.CreatedAt
+		}
+		if opts.CountComments {
+			if commented := issue.LastCommentTime(); commented.After(lastActive) {
+				lastActive = commented
+			}
+		}
+		if lastActive.IsZero() {
+			continue
+		}
+		days := int(now.Sub(lastActive).Hours() / 24)
+		if days < opts.StaleDays {
+			continue
+		}
+		report.Stale = append(report.Stale, StaleIssue{
+			ID:         issue.ID,
+			Title:      issue.Title,
+			Status:     string(issue.Status),
+			Priority:   issue.Priority,
+			Assignee:   issue.Assignee,
+			DaysStale:  days,
+			Dependents: dependents[issue.ID],
+		})
+		if issue.Status == model.StatusOpen && days >= opts.CloseAfterDays && dependents[issue.ID] <= opts.MaxDependents {
+			reason := fmt.Sprintf("No updates in %d days and nothing depends on it", days)
+			if dependents[issue.ID] > 0 {
+				reason = fmt.Sprintf("No updates in %d days; %d open dependent(s)", days, dependents[issue.ID])
+			}
+			report.SuggestClose = append(report.SuggestClose, CloseSuggestion{
+				ID:         issue.ID,
+				Title:      issue.Title,
+				DaysStale:  days,
+				Dependents: dependents[issue.ID],
+				Reason:     reason,
+			})
+		}
+	}
+
+	sort.Slice(report.Stale, func(i, j int) bool {
+		a, b := report.Stale[i], report.Stale[j]
+		if a.DaysStale != b.DaysStale {
+			return a.DaysStale > b.DaysStale
+		}
+		return a.ID < b.ID
+	})
+	sort.Slice(report.SuggestClose, func(i, j int) bool {
+		a, b := report.SuggestClose[i], report.SuggestClose[j]
+		if a.DaysStale != b.DaysStale {
+			return a.DaysStale > b.DaysStale
+		}
+		return a.ID < b.ID
+	})
+	sort.Slice(report.AgedOut, func(i, j int) bool {
+		a, b := report.AgedOut[i], report.AgedOut[j]
+		if a.AgeDays != b.AgeDays {
+			return a.AgeDays > b.AgeDays
+		}
+		return a.ID < b.ID
+	})
+	return report
+}
@@ -0,0 +1,115 @@
+package analysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestFindStale(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	ago := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+	issues := []model.Issue{
+		{ID: "fresh", Status: model.StatusOpen, UpdatedAt: ago(3)},
+		{ID: "old", Title: "Forgotten", Status: model.StatusOpen, UpdatedAt: ago(200)},
+		{ID: "wip", Status: model.StatusInProgress, UpdatedAt: ago(120)}, // stale, but someone holds it
+		{ID: "needed", Status: model.StatusOpen, CreatedAt: ago(100)},    // no update time: falls back to created
+		{ID: "linked", Status: model.StatusOpen, UpdatedAt: ago(100)},    // only a related link points here
+		{ID: "recent", Status: model.StatusOpen, UpdatedAt: ago(30)},     // stale but not old enough to close
+		{ID: "done", Status: model.StatusClosed, UpdatedAt: ago(300)},    // closed issues are ignored
+		{ID: "waiter", Status: model.StatusOpen, UpdatedAt: ago(1), Dependencies: []*model.Dependency{
+			{DependsOnID: "needed", Type: model.DepBlocks},
+			{DependsOnID: "linked", Type: model.DepRelated},
+		}},
+		{ID: "closed-child", Status: model.StatusClosed, Dependencies: []*model.Dependency{
+			{DependsOnID: "old", Type: model.DepParentChild},
+		}},
+	}
+
+	report := FindStale(issues, StaleOptions{}, now)
+
+	wantStale := []string{"old", "wip", "linked", "needed", "recent"}
+	if len(report.Stale) != len(wantStale) {
+		t.Fatalf("stale = %+v, want %v", report.Stale, wantStale)
+	}
+	for i, id := range wantStale {
+		if report.Stale[i].ID != id {
+			t.Errorf("stale[%d] = %s, want %s", i, report.Stale[i].ID, id)
+		}
+	}
+	if report.Stale[0].DaysStale != 200 || report.Stale[0].Title != "Forgotten" {
+		t.Errorf("stale[0] = %+v", report.Stale[0])
+	}
+	if report.Stale[3].Dependents != 1 {
+		t.Errorf("needed dependents = %d, want 1", report.Stale[3].Dependents)
+	}
+
+	if got := suggestIDs(report); got != "old,linked" {
+		t.Errorf("suggest_close = %s, want old,linked", got)
+	}
+
+	// Loosening both conditions admits more candidates
+	report = FindStale(issues, StaleOptions{CloseAfterDays: 20, MaxDependents: 1}, now)
+	if got := suggestIDs(report); got != "old,linked,needed,recent" {
+		t.Errorf("loosened suggest_close = %s, want old,linked,needed,recent", got)
+	}
+	for _, s := range report.SuggestClose {
+		if s.ID == "needed" && s.Dependents != 1 {
+			t.Errorf("needed suggestion = %+v, want 1 dependent", s)
+		}
+	}
+}
+
+func TestFindStale_CountComments(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	ago := func(days int) time.Time { return now.AddDate(0, 0, -days) }
+	recent := ago(2)
+	issues := []model.Issue{
+		{ID: "discussed", Status: model.StatusOpen, UpdatedAt: ago(100), Comments: []*model.Comment{{CreatedAt: ago(3)}}},
+		{ID: "counted", Status: model.StatusOpen, UpdatedAt: ago(100), CommentCount: 5, LastCommentAt: &recent},
+		{ID: "quiet", Status: model.StatusOpen, UpdatedAt: ago(100), Comments: []*model.Comment{{CreatedAt: ago(120)}}},
+	}
+
+	if got := FindStale(issues, StaleOptions{}, now); len(got.Stale) != 3 {
+		t.Errorf("comments should not count by default, stale = %+v", got.Stale)
+	}
+	got := FindStale(issues, StaleOptions{CountComments: true}, now)
+	if len(got.Stale) != 1 || got.Stale[0].ID != "quiet" || got.Stale[0].DaysStale != 100 {
+		t.Errorf("with comments counted, stale = %+v, want only quiet (100 days)", got.Stale)
+	}
+	if len(got.SuggestClose) != 1 || got.SuggestClose[0].ID != "quiet" {
+		t.Errorf("suggest_close = %+v, want only quiet", got.SuggestClose)
+	}
+}
+
+func TestFindStale_AgedOut(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "old", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -400), UpdatedAt: now},
+		{ID: "older", Status: model.StatusBlocked, CreatedAt: now.AddDate(0, 0, -500), UpdatedAt: now},
+		{ID: "done", Status: model.StatusClosed, CreatedAt: now.AddDate(0, 0, -900)},
+		{ID: "new", Status: model.StatusOpen, CreatedAt: now.AddDate(0, 0, -5), UpdatedAt: now},
+	}
+	if report := FindStale(issues, StaleOptions{}, now); report.AgedOut != nil {
+		t.Errorf("aged_out without a cap = %+v", report.AgedOut)
+	}
+	report := FindStale(issues, StaleOptions{MaxRecommendationAgeDays: 365}, now)
+	if len(report.AgedOut) != 2 || report.AgedOut[0].ID != "older" || report.AgedOut[0].AgeDays != 500 || report.AgedOut[1].ID != "old" {
+		t.Errorf("aged_out = %+v, want older then old", report.AgedOut)
+	}
+	if len(report.Stale) != 0 {
+		t.Errorf("recently updated issues listed as stale: %+v", report.Stale)
+	}
+}
+
+func suggestIDs(r StaleReport) string {
+	out := ""
+	for i, s := range r.SuggestClose {
+		if i > 0 {
+			out += ","
+		}
+		out += s.ID
+	}
+	return out
+}
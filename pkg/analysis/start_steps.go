@@ -0,0 +1,168 @@
+package analysis
+
+import (
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+// StartSchedule orders open issues into waves along the blocks graph.
+type StartSchedule struct {
+	Issues      []IssueStartStep `json:"issues"`                // By start step, then priority, then ID
+	Steps       int              `json:"steps"`                 // Number of waves
+	Cycles      [][]string       `json:"cycles,omitempty"`      // Blocking cycles that cannot be scheduled
+	Unscheduled []string         `json:"unscheduled,omitempty"` // Issues in or behind a cycle
+}
+
+// IssueStartStep is the earliest wave an open issue can start in.
+type IssueStartStep struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Priority  int    `json:"priority"`
+	StartStep int    `json:"start_step"` // 0 = no open blockers; N = after blockers at steps < N
+}
+
+// ComputeStartSchedule assigns each open issue the earliest start step its
+// open blockers allow: blockers at step k put their dependents at step k+1
+// or later. Edges are matched by ID as-is, so namespaced cross-project
+// edges in a workspace take part. Issues in a blocking cycle, or waiting on
+// one, are left out and reported instead.
+func ComputeStartSchedule(issues []model.Issue) StartSchedule {
+	g := newOpenBlockingGraph(issues)
+	schedule := StartSchedule{Issues: make([]IssueStartStep, 0, len(g.open)), Steps: len(g.levels)}
+	step := make(map[string]int, len(g.open))
+	for level, wave := range g.levels {
+		for _, id := range wave {
+			step[id] = level
+		}
+	}
+
+	for id, issue := range g.open {
+		s, ok := step[id]
+		if !ok {
+			schedule.Unscheduled = append(schedule.Unscheduled, id)
+			continue
+		}
+		schedule.Issues = append(schedule.Issues, IssueStartStep{
+			ID: id, Title: issue.Title, Priority: issue.Priority, StartStep: s,
+		})
+	}
+	sort.Slice(schedule.Issues, func(i, j int) bool {
+		a, b := schedule.Issues[i], schedule.Issues[j]
+		if a.StartStep != b.StartStep {
+			return a.StartStep < b.StartStep
+		}
+		if a.Priority != b.Priority {
+			return a.Priority < b.Priority
+		}
+		return a.ID < b.ID
+	})
+	sort.Strings(schedule.Unscheduled)
+	schedule.Cycles = startCycles(schedule.Unscheduled, g.blockers)
+	return schedule
+}
+
+// openBlockingGraph is the blocks graph among open issues, shared by the
+// start schedule and the parallel schedule. Edges are matched by ID as-is;
+// self-edges, duplicates, and edges to closed or missing issues are dropped.
+type openBlockingGraph struct {
+	open       map[string]*model.Issue
+	blockers   map[string][]string // blockers[x] = open issues x waits on
+	dependents map[string][]string // the reverse of blockers
+	// levels are the waves of Kahn's algorithm, each sorted by ID: level 0
+	// has no open blockers, level k+1 waits only on levels <= k. Issues in
+	// or behind a blocking cycle are in no level.
+	levels [][]string
+}
+
+func newOpenBlockingGraph(issues []model.Issue) *openBlockingGraph {
+	g := &openBlockingGraph{
+		open:       make(map[string]*model.Issue, len(issues)),
+		blockers:   make(map[string][]string),
+		dependents: make(map[string][]string),
+	}
+	for i := range issues {
+		if issues[i].Status != model.StatusClosed {
+			g.open[issues[i].ID] = &issues[i]
+		}
+	}
+	for id, issue := range g.open {
+		seen := make(map[string]bool)
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() || dep.DependsOnID == id || seen[dep.DependsOnID] {
+				continue
+			}
+			if _, ok := g.open[dep.DependsOnID]; !ok {
+				continue
+			}
+			seen[dep.DependsOnID] = true
+			g.blockers[id] = append(g.blockers[id], dep.DependsOnID)
+			g.dependents[dep.DependsOnID] = append(g.dependents[dep.DependsOnID], id)
+		}
+	}
+
+	// Kahn's algorithm by levels
+	waiting := make(map[string]int, len(g.open))
+	var wave []string
+	for id := range g.open {
+		waiting[id] = len(g.blockers[id])
+		if waiting[id] == 0 {
+			wave = append(wave, id)
+		}
+	}
+	for len(wave) > 0 {
+		sort.Strings(wave)
+		g.levels = append(g.levels, wave)
+		var next []string
+		for _, id := range wave {
+			for _, d := range g.dependents[id] {
+				waiting[d]--
+				if waiting[d] == 0 {
+					next = append(next, d)
+				}
+			}
+		}
+		wave = next
+	}
+	return g
+}
+
+// startCycles returns the blocking cycles among the unscheduled issues, each
+// sorted by ID, ordered by their first ID.
+func startCycles(unscheduled []string, blockers map[string][]string) [][]string {
+	if len(unscheduled) == 0 {
+		return nil
+	}
+	g := simple.NewDirectedGraph()
+	nodeOf := make(map[string]int64, len(unscheduled))
+	idOf := make(map[int64]string, len(unscheduled))
+	for i, id := range unscheduled {
+		nodeOf[id] = int64(i)
+		idOf[int64(i)] = id
+		g.AddNode(simple.Node(i))
+	}
+	for _, id := range unscheduled {
+		for _, b := range blockers[id] {
+			if to, ok := nodeOf[b]; ok {
+				g.SetEdge(g.NewEdge(simple.Node(nodeOf[id]), simple.Node(to)))
+			}
+		}
+	}
+
+	var cycles [][]string
+	for _, scc := range topo.TarjanSCC(g) {
+		if len(scc) < 2 {
+			continue
+		}
+		ids := make([]string, len(scc))
+		for i, n := range scc {
+			ids[i] = idOf[n.ID()]
+		}
+		sort.Strings(ids)
+		cycles = append(cycles, ids)
+	}
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+	return cycles
+}
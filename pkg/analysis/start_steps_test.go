@@ -0,0 +1,57 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestComputeStartSchedule(t *testing.T) {
+	blocks := func(from, to string) *model.Dependency {
+		return &model.Dependency{IssueID: from, DependsOnID: to, Type: model.DepBlocks}
+	}
+	issues := []model.Issue{
+		{ID: "api-1", Title: "Schema", Status: model.StatusOpen, Priority: 2},
+		{ID: "api-2", Title: "Endpoint", Status: model.StatusOpen, Priority: 1, Dependencies: []*model.Dependency{blocks("api-2", "api-1")}},
+		// Cross-project edge, plus a closed blocker that no longer counts
+		{ID: "web-1", Title: "Page", Status: model.StatusOpen, Priority: 0,
+			Dependencies: []*model.Dependency{blocks("web-1", "api-2"), blocks("web-1", "web-0")}},
+		{ID: "web-0", Title: "Old", Status: model.StatusClosed},
+		{ID: "web-2", Title: "Docs", Status: model.StatusOpen, Priority: 0,
+			Dependencies: []*model.Dependency{{IssueID: "web-2", DependsOnID: "api-1", Type: model.DepRelated}}},
+		// X and Y block each other; Z waits on the cycle
+		{ID: "X", Status: model.StatusOpen, Dependencies: []*model.Dependency{blocks("X", "Y")}},
+		{ID: "Y", Status: model.StatusOpen, Dependencies: []*model.Dependency{blocks("Y", "X")}},
+		{ID: "Z", Status: model.StatusOpen, Dependencies: []*model.Dependency{blocks("Z", "X")}},
+	}
+
+	schedule := ComputeStartSchedule(issues)
+	got := make(map[string]int)
+	var order []string
+	for _, s := range schedule.Issues {
+		got[s.ID] = s.StartStep
+		order = append(order, s.ID)
+	}
+	want := map[string]int{"web-2": 0, "api-1": 0, "api-2": 1, "web-1": 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("start steps = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(order, []string{"web-2", "api-1", "api-2", "web-1"}) {
+		t.Errorf("order = %v; want by step, then priority", order)
+	}
+	if schedule.Steps != 3 {
+		t.Errorf("Steps = %d, want 3", schedule.Steps)
+	}
+	if !reflect.DeepEqual(schedule.Cycles, [][]string{{"X", "Y"}}) {
+		t.Errorf("Cycles = %v, want [[X Y]]", schedule.Cycles)
+	}
+	if !reflect.DeepEqual(schedule.Unscheduled, []string{"X", "Y", "Z"}) {
+		t.Errorf("Unscheduled = %v, want [X Y Z]", schedule.Unscheduled)
+	}
+
+	empty := ComputeStartSchedule(nil)
+	if empty.Issues == nil || empty.Steps != 0 || empty.Cycles != nil {
+		t.Errorf("empty schedule = %+v", empty)
+	}
+}
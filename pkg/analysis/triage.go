@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
@@ -19,6 +20,7 @@ type TriageResult struct {
 	QuickWins       []QuickWin       `json:"quick_wins"`
 	BlockersToClear []BlockerItem    `json:"blockers_to_clear"`
 	ProjectHealth   ProjectHealth    `json:"project_health"`
+	DataQuality     DataQuality      `json:"data_quality"`
 	Alerts          []Alert          `json:"alerts,omitempty"`
 	Commands        CommandHelpers   `json:"commands"`
 
@@ -35,15 +37,27 @@ type TriageMeta struct {
 	Phase2Ready   bool      `json:"phase2_ready"`
 	IssueCount    int       `json:"issue_count"`
 	ComputeTimeMs int64     `json:"compute_time_ms"`
+	// AgedOutCount is how many actionable issues MaxRecommendationAgeDays
+	// kept out of recommendations
+	AgedOutCount int `json:"aged_out_count,omitempty"`
+	// PerProjectCappedCount is how many issues that would have been
+	// recommended without PerProjectTopN it pushed out of the top N
+	PerProjectCappedCount int `json:"per_project_capped_count,omitempty"`
 }
 
-// QuickRef provides at-a-glance summary for fast decisions
+// QuickRef provides at-a-glance summary for fast decisions. Every count is
+// computed; only those named in Fields are written to JSON (see MarshalJSON).
 type QuickRef struct {
 	OpenCount       int       `json:"open_count"`
 	ActionableCount int       `json:"actionable_count"`
 	BlockedCount    int       `json:"blocked_count"`
 	InProgressCount int       `json:"in_progress_count"`
+	ClosedCount     int       `json:"closed_count"`
+	StaleCount      int       `json:"stale_count"`
+	OverdueCount    int       `json:"overdue_count"`
+	UnassignedCount int       `json:"unassigned_count"`
 	TopPicks        []TopPick `json:"top_picks"` // Top 3 recommended items
+	Fields          []string  `json:"-"`         // Quick-ref field names to output (empty = DefaultQuickRefFields)
 }
 
 // TopPick is a condensed recommendation for quick reference
@@ -69,6 +83,12 @@ type Recommendation struct {
 	Reasons     []string       `json:"reasons"`
 	UnblocksIDs []string       `json:"unblocks_ids,omitempty"`
 	BlockedBy   []string       `json:"blocked_by,omitempty"`
+
+	// Age split (needs git history for stalled/first-response):
+	// stalled_days == age_days means untouched since filed.
+	AgeDays           int  `json:"age_days"`                      // Days since created
+	StalledDays       *int `json:"stalled_days,omitempty"`        // Days since last status change
+	FirstResponseDays *int `json:"first_response_days,omitempty"` // Days from creation to first status change
 }
 
 // QuickWin represents a low-effort, high-impact item
@@ -96,6 +116,8 @@ type ProjectHealth struct {
 	Graph     GraphHealth  `json:"graph"`
 	Velocity  *Velocity    `json:"velocity,omitempty"`  // nil until labels view ready
 	Staleness *Staleness   `json:"staleness,omitempty"` // nil until history ready
+	// AgeHistogram buckets open issues by age, per project
+	AgeHistogram AgeHistogram `json:"age_histogram"`
 }
 
 // HealthCounts is basic issue statistics
@@ -108,6 +130,9 @@ type HealthCounts struct {
 	ByStatus   map[string]int `json:"by_status"`
 	ByType     map[string]int `json:"by_type"`
 	ByPriority map[int]int    `json:"by_priority"`
+	// ByResolution splits the closed count by resolution (see ResolutionOf),
+	// so dismissed work can be told apart from completed work
+	ByResolution map[string]int `json:"by_resolution"`
 }
 
 // GraphHealth summarizes dependency graph metrics
@@ -263,7 +288,7 @@ type Staleness struct {
 
 // Alert represents a proactive warning (future: from alerts engine)
 type Alert struct {
-	Type     string   `json:"type"`     // "stale", "velocity_drop", "cycle", "duplicate"
+	Type     string   `json:"type"`     // "stale", "velocity_drop", "cycle", "duplicate", "wip"
 	Severity string   `json:"severity"` // "info", "warning", "error"
 	Message  string   `json:"message"`
 	IssueID  string   `json:"issue_id,omitempty"`
@@ -294,6 +319,36 @@ type TriageOptions struct {
 	// bv-87: Track/label-aware recommendation grouping for multi-agent coordination
 	GroupByTrack bool // Group recommendations by execution track (connected component)
 	GroupByLabel bool // Group recommendations by primary label
+
+	// StatusChanges maps issue ID to chronological status-change times from
+	// git history. When nil, recommendations carry age_days only.
+	StatusChanges map[string][]time.Time
+
+	// WIPLimits adds "wip" alerts when in-progress counts exceed the limits
+	WIPLimits WIPLimits
+
+	// AgeBuckets are the age histogram bucket bounds in days (nil uses
+	// DefaultAgeBuckets)
+	AgeBuckets []int
+
+	// QuickRefFields selects the quick_ref counts to output (nil uses
+	// DefaultQuickRefFields). Callers should check ValidateQuickRefFields.
+	QuickRefFields []string
+
+	// MaxRecommendationAgeDays leaves issues created more than this many
+	// days ago out of recommendations (0 = no cap). They still count in
+	// quick_ref and project_health.
+	MaxRecommendationAgeDays int
+
+	// PerProjectTopN caps the recommendations taken from any one project
+	// (by ID prefix or source repo) before TopN applies, so one busy
+	// project cannot fill the list (0 = no cap).
+	PerProjectTopN int
+
+	// OnlyIDs limits recommendations, quick wins and blockers to these
+	// issues (nil = all). Scores, unblocks and counts still come from the
+	// whole graph.
+	OnlyIDs map[string]bool
 }
 
 // TrackRecommendationGroup groups recommendations by execution track (bv-87)
@@ -376,14 +431,60 @@ func ComputeTriageFromAnalyzer(analyzer *Analyzer, stats *GraphStats, issues []m
 	// Compute enhanced triage scores (bv-147)
 	triageScores := computeTriageScoresFromImpact(impactScores, unblocksMap, analyzer, DefaultTriageScoringOptions())
 
+	// Narrow the lists to OnlyIDs now that scoring has seen every issue
+	blockerUnblocks := unblocksMap
+	if opts.OnlyIDs != nil {
+		keptScores := make([]TriageScore, 0, len(triageScores))
+		for _, ts := range triageScores {
+			if opts.OnlyIDs[ts.IssueID] {
+				keptScores = append(keptScores, ts)
+			}
+		}
+		triageScores = keptScores
+		keptImpact := make([]ImpactScore, 0, len(impactScores))
+		for _, s := range impactScores {
+			if opts.OnlyIDs[s.IssueID] {
+				keptImpact = append(keptImpact, s)
+			}
+		}
+		impactScores = keptImpact
+		blockerUnblocks = make(map[string][]string, len(opts.OnlyIDs))
+		for id, unblocks := range unblocksMap {
+			if opts.OnlyIDs[id] {
+				blockerUnblocks[id] = unblocks
+			}
+		}
+	}
+
+	// Drop issues past the recommendation age cap before taking the top N
+	agedOut := 0
+	if opts.MaxRecommendationAgeDays > 0 {
+		kept := make([]TriageScore, 0, len(triageScores))
+		for _, ts := range triageScores {
+			if issue := analyzer.GetIssue(ts.IssueID); issue != nil && ExceedsRecommendationAge(issue, opts.MaxRecommendationAgeDays, now) {
+				agedOut++
+				continue
+			}
+			kept = append(kept, ts)
+		}
+		triageScores = kept
+	}
+
+	// Cap each project's share before the global top N
+	capped := 0
+	if opts.PerProjectTopN > 0 {
+		triageScores, capped = capScoresPerProject(triageScores, analyzer, opts.PerProjectTopN, opts.TopN)
+	}
+
 	// Build recommendations using enhanced scores (bv-148)
 	recommendations := buildRecommendationsFromTriageScores(triageScores, analyzer, unblocksMap, opts.TopN)
+	applyRecommendationAges(recommendations, analyzer, opts.StatusChanges, now)
 
 	// Build quick wins
 	quickWins := buildQuickWins(impactScores, unblocksMap, opts.QuickWinN)
 
 	// Build blockers to clear
-	blockersToClear := buildBlockersToClear(analyzer, unblocksMap, opts.BlockerN)
+	blockersToClear := buildBlockersToClear(analyzer, blockerUnblocks, opts.BlockerN)
 
 	// Build top picks for quick ref
 	topPicks := buildTopPicks(recommendations, 3)
@@ -394,6 +495,8 @@ func ComputeTriageFromAnalyzer(analyzer *Analyzer, stats *GraphStats, issues []m
 		topID = recommendations[0].ID
 	}
 
+	extras := countQuickRefExtras(issues, now)
+
 	elapsed := time.Since(start)
 	projectVelocity := ComputeProjectVelocity(issues, now.UTC(), 8)
 
@@ -409,18 +512,25 @@ func ComputeTriageFromAnalyzer(analyzer *Analyzer, stats *GraphStats, issues []m
 
 	return TriageResult{
 		Meta: TriageMeta{
-			Version:       "1.0.0",
-			GeneratedAt:   now,
-			Phase2Ready:   stats.IsPhase2Ready(),
-			IssueCount:    len(issues),
-			ComputeTimeMs: elapsed.Milliseconds(),
+			Version:               "1.0.0",
+			GeneratedAt:           now,
+			Phase2Ready:           stats.IsPhase2Ready(),
+			IssueCount:            len(issues),
+			ComputeTimeMs:         elapsed.Milliseconds(),
+			AgedOutCount:          agedOut,
+			PerProjectCappedCount: capped,
 		},
 		QuickRef: QuickRef{
 			OpenCount:       counts.Open,
 			ActionableCount: counts.Actionable,
 			BlockedCount:    counts.Blocked,
 			InProgressCount: counts.ByStatus["in_progress"],
+			ClosedCount:     counts.Closed,
+			StaleCount:      extras.stale,
+			OverdueCount:    extras.overdue,
+			UnassignedCount: extras.unassigned,
 			TopPicks:        topPicks,
+			Fields:          opts.QuickRefFields,
 		},
 		Recommendations:        recommendations,
 		QuickWins:              quickWins,
@@ -432,8 +542,52 @@ func ComputeTriageFromAnalyzer(analyzer *Analyzer, stats *GraphStats, issues []m
 			Graph:    buildGraphHealth(stats),
 			Velocity: projectVelocity,
 			// Staleness remains nil until history integration is ready
+			AgeHistogram: ComputeAgeHistogram(issues, opts.AgeBuckets, now),
 		},
-		Commands: buildCommands(topID),
+		DataQuality: ComputeDataQuality(issues),
+		Alerts:      CheckWIPLimits(issues, opts.WIPLimits),
+		Commands:    buildCommands(topID),
+	}
+}
+
+// ExceedsRecommendationAge reports whether issue was created more than
+// maxDays whole days before now. Undated issues never exceed it, and
+// maxDays <= 0 means no cap.
+func ExceedsRecommendationAge(issue *model.Issue, maxDays int, now time.Time) bool {
+	if maxDays <= 0 || issue.CreatedAt.IsZero() {
+		return false
+	}
+	return int(now.Sub(issue.CreatedAt).Hours()/24) > maxDays
+}
+
+// applyRecommendationAges fills age_days, and when status history is
+// available, stalled_days and first_response_days. An issue with no recorded
+// status change is stalled for its whole age.
+func applyRecommendationAges(recs []Recommendation, analyzer *Analyzer, statusChanges map[string][]time.Time, now time.Time) {
+	days := func(d time.Duration) int {
+		if d < 0 {
+			return 0
+		}
+		return int(d.Hours() / 24)
+	}
+	for i := range recs {
+		issue := analyzer.GetIssue(recs[i].ID)
+		if issue == nil || issue.CreatedAt.IsZero() {
+			continue
+		}
+		recs[i].AgeDays = days(now.Sub(issue.CreatedAt))
+		if statusChanges == nil {
+			continue
+		}
+
+		changes := statusChanges[recs[i].ID]
+		stalled := recs[i].AgeDays
+		if len(changes) > 0 {
+			stalled = days(now.Sub(changes[len(changes)-1]))
+			first := days(changes[0].Sub(issue.CreatedAt))
+			recs[i].FirstResponseDays = &first
+		}
+		recs[i].StalledDays = &stalled
 	}
 }
 
@@ -515,18 +669,24 @@ func buildUnblocksMap(analyzer *Analyzer, issues []model.Issue) map[string][]str
 
 // computeCounts tallies issues by various dimensions
 func computeCounts(issues []model.Issue, analyzer *Analyzer) HealthCounts {
-	counts := HealthCounts{
-		Total:      len(issues),
-		ByStatus:   make(map[string]int),
-		ByType:     make(map[string]int),
-		ByPriority: make(map[int]int),
-	}
-
 	actionable := analyzer.GetActionableIssues()
 	actionableSet := make(map[string]bool, len(actionable))
 	for _, a := range actionable {
 		actionableSet[a.ID] = true
 	}
+	return countIssues(issues, actionableSet)
+}
+
+// countIssues tallies issues by status, type, and priority; open issues not
+// in actionableSet count as blocked.
+func countIssues(issues []model.Issue, actionableSet map[string]bool) HealthCounts {
+	counts := HealthCounts{
+		Total:        len(issues),
+		ByStatus:     make(map[string]int),
+		ByType:       make(map[string]int),
+		ByPriority:   make(map[int]int),
+		ByResolution: make(map[string]int),
+	}
 
 	for _, issue := range issues {
 		counts.ByStatus[string(issue.Status)]++
@@ -535,6 +695,7 @@ func computeCounts(issues []model.Issue, analyzer *Analyzer) HealthCounts {
 
 		if issue.Status == model.StatusClosed {
 			counts.Closed++
+			counts.ByResolution[ResolutionOf(&issue)]++
 		} else {
 			counts.Open++
 			if actionableSet[issue.ID] {
@@ -548,6 +709,37 @@ func computeCounts(issues []model.Issue, analyzer *Analyzer) HealthCounts {
 	return counts
 }
 
+// capScoresPerProject keeps the first n scores of each project, in order,
+// and reports how many of the uncapped top topN it pushed out of the top
+// topN. Projects are compared ignoring case.
+func capScoresPerProject(scores []TriageScore, analyzer *Analyzer, n, topN int) ([]TriageScore, int) {
+	kept := make([]TriageScore, 0, len(scores))
+	perProject := make(map[string]int)
+	for _, ts := range scores {
+		project := ""
+		if issue := analyzer.GetIssue(ts.IssueID); issue != nil {
+			project = strings.ToLower(issueProject(issue))
+		}
+		if perProject[project] >= n {
+			continue
+		}
+		perProject[project]++
+		kept = append(kept, ts)
+	}
+
+	inTop := make(map[string]bool, topN)
+	for i := 0; i < len(kept) && i < topN; i++ {
+		inTop[kept[i].IssueID] = true
+	}
+	displaced := 0
+	for i := 0; i < len(scores) && i < topN; i++ {
+		if !inTop[scores[i].IssueID] {
+			displaced++
+		}
+	}
+	return kept, displaced
+}
+
 // buildRecommendationsFromTriageScores creates recommendations using enhanced triage scores
 func buildRecommendationsFromTriageScores(scores []TriageScore, analyzer *Analyzer, unblocksMap map[string][]string, limit int) []Recommendation {
 	if len(scores) > limit {
@@ -0,0 +1,146 @@
+package analysis
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// TriageBaseline is the part of an earlier triage run that a delta compares
+// against. It is read back from --robot-triage JSON output.
+type TriageBaseline struct {
+	GeneratedAt     time.Time
+	Recommendations []DeltaRecommendation
+	QuickRef        map[string]int // quick_ref *_count fields present in the baseline
+}
+
+// TriageDelta reports what changed in triage since a baseline run.
+type TriageDelta struct {
+	BaselineGeneratedAt     *time.Time                    `json:"baseline_generated_at,omitempty"`
+	NewRecommendations      []DeltaRecommendation         `json:"new_recommendations"`      // Recommended now, not in the baseline
+	ResolvedRecommendations []DeltaRecommendation         `json:"resolved_recommendations"` // In the baseline, no longer recommended
+	QuickRef                map[string]QuickRefCountDelta `json:"quick_ref"`                // Counts present in both runs
+}
+
+// DeltaRecommendation identifies a recommendation that appeared or went away.
+type DeltaRecommendation struct {
+	ID     string  `json:"id"`
+	Title  string  `json:"title"`
+	Score  float64 `json:"score"`
+	Status string  `json:"status,omitempty"` // Current status; empty if the issue is gone
+}
+
+// QuickRefCountDelta is one quick_ref count in the baseline and now.
+type QuickRefCountDelta struct {
+	Baseline int `json:"baseline"`
+	Current  int `json:"current"`
+	Change   int `json:"change"`
+}
+
+// triageBaselineJSON is the subset of triage JSON a baseline needs.
+type triageBaselineJSON struct {
+	Meta struct {
+		GeneratedAt time.Time `json:"generated_at"`
+	} `json:"meta"`
+	QuickRef        map[string]json.RawMessage `json:"quick_ref"`
+	Recommendations []DeltaRecommendation      `json:"recommendations"`
+}
+
+// ParseTriageBaseline reads a baseline from saved triage JSON: either the
+// full --robot-triage output or just its "triage" object.
+func ParseTriageBaseline(data []byte) (TriageBaseline, error) {
+	var envelope struct {
+		Triage *triageBaselineJSON `json:"triage"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return TriageBaseline{}, err
+	}
+	raw := envelope.Triage
+	if raw == nil {
+		raw = &triageBaselineJSON{}
+		if err := json.Unmarshal(data, raw); err != nil {
+			return TriageBaseline{}, err
+		}
+	}
+	if raw.QuickRef == nil && raw.Recommendations == nil {
+		return TriageBaseline{}, errors.New("not triage output: no quick_ref or recommendations")
+	}
+	return TriageBaseline{
+		GeneratedAt:     raw.Meta.GeneratedAt,
+		Recommendations: raw.Recommendations,
+		QuickRef:        quickRefCounts(raw.QuickRef),
+	}, nil
+}
+
+// quickRefCounts extracts the integer *_count fields of a quick_ref object.
+func quickRefCounts(fields map[string]json.RawMessage) map[string]int {
+	counts := make(map[string]int)
+	for name, raw := range fields {
+		if !strings.HasSuffix(name, "_count") {
+			continue
+		}
+		var n int
+		if json.Unmarshal(raw, &n) == nil {
+			counts[name] = n
+		}
+	}
+	return counts
+}
+
+// ComputeTriageDelta compares current triage against a baseline. Issues are
+// used to report the current status of resolved recommendations.
+func ComputeTriageDelta(baseline TriageBaseline, current TriageResult, issues []model.Issue) TriageDelta {
+	delta := TriageDelta{
+		NewRecommendations:      []DeltaRecommendation{},
+		ResolvedRecommendations: []DeltaRecommendation{},
+		QuickRef:                map[string]QuickRefCountDelta{},
+	}
+	if !baseline.GeneratedAt.IsZero() {
+		at := baseline.GeneratedAt
+		delta.BaselineGeneratedAt = &at
+	}
+
+	before := make(map[string]bool, len(baseline.Recommendations))
+	for _, rec := range baseline.Recommendations {
+		before[rec.ID] = true
+	}
+	now := make(map[string]bool, len(current.Recommendations))
+	for _, rec := range current.Recommendations {
+		now[rec.ID] = true
+		if !before[rec.ID] {
+			delta.NewRecommendations = append(delta.NewRecommendations, DeltaRecommendation{
+				ID: rec.ID, Title: rec.Title, Score: rec.Score, Status: rec.Status,
+			})
+		}
+	}
+
+	status := make(map[string]string, len(issues))
+	for i := range issues {
+		status[issues[i].ID] = string(issues[i].Status)
+	}
+	for _, rec := range baseline.Recommendations {
+		if !now[rec.ID] {
+			rec.Status = status[rec.ID]
+			delta.ResolvedRecommendations = append(delta.ResolvedRecommendations, rec)
+		}
+	}
+	sort.SliceStable(delta.ResolvedRecommendations, func(i, j int) bool {
+		return delta.ResolvedRecommendations[i].Score > delta.ResolvedRecommendations[j].Score
+	})
+
+	// Compare the counts as written, so configured quick_ref fields line up
+	var currentRef map[string]json.RawMessage
+	if data, err := json.Marshal(current.QuickRef); err == nil {
+		_ = json.Unmarshal(data, &currentRef)
+	}
+	for name, cur := range quickRefCounts(currentRef) {
+		if base, ok := baseline.QuickRef[name]; ok {
+			delta.QuickRef[name] = QuickRefCountDelta{Baseline: base, Current: cur, Change: cur - base}
+		}
+	}
+	return delta
+}
@@ -0,0 +1,75 @@
+package analysis
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestParseTriageBaseline(t *testing.T) {
+	full := `{"generated_at":"2026-03-01T00:00:00Z","triage":{"meta":{"generated_at":"2026-03-01T00:00:00Z"},` +
+		`"quick_ref":{"open_count":4,"actionable_count":2,"top_picks":[]},` +
+		`"recommendations":[{"id":"A","title":"Alpha","score":0.9}]}}`
+	bl, err := ParseTriageBaseline([]byte(full))
+	if err != nil {
+		t.Fatalf("full output: %v", err)
+	}
+	if bl.GeneratedAt.IsZero() || len(bl.Recommendations) != 1 || bl.QuickRef["open_count"] != 4 || len(bl.QuickRef) != 2 {
+		t.Errorf("full output parsed as %+v", bl)
+	}
+
+	bare := `{"quick_ref":{"blocked_count":1},"recommendations":[]}`
+	if bl, err := ParseTriageBaseline([]byte(bare)); err != nil || bl.QuickRef["blocked_count"] != 1 {
+		t.Errorf("bare triage: %+v, %v", bl, err)
+	}
+
+	for _, bad := range []string{`{"issues":[]}`, `not json`} {
+		if _, err := ParseTriageBaseline([]byte(bad)); err == nil {
+			t.Errorf("%s: expected an error", bad)
+		}
+	}
+}
+
+func TestComputeTriageDelta(t *testing.T) {
+	baseline := TriageBaseline{
+		Recommendations: []DeltaRecommendation{
+			{ID: "A", Title: "Alpha", Score: 0.5},
+			{ID: "B", Title: "Beta", Score: 0.8},
+			{ID: "C", Title: "Gamma", Score: 0.7},
+		},
+		QuickRef: map[string]int{"open_count": 5, "actionable_count": 3, "stale_count": 1},
+	}
+	current := TriageResult{
+		QuickRef: QuickRef{OpenCount: 4, ActionableCount: 3, BlockedCount: 1},
+		Recommendations: []Recommendation{
+			{ID: "A", Title: "Alpha", Score: 0.6, Status: "open"},
+			{ID: "D", Title: "Delta", Score: 0.4, Status: "open"},
+		},
+	}
+	issues := []model.Issue{{ID: "A", Status: model.StatusOpen}, {ID: "B", Status: model.StatusClosed}, {ID: "D", Status: model.StatusOpen}}
+
+	delta := ComputeTriageDelta(baseline, current, issues)
+	if len(delta.NewRecommendations) != 1 || delta.NewRecommendations[0].ID != "D" {
+		t.Errorf("new = %+v; want D", delta.NewRecommendations)
+	}
+	want := []DeltaRecommendation{{ID: "B", Title: "Beta", Score: 0.8, Status: "closed"}, {ID: "C", Title: "Gamma", Score: 0.7}}
+	if len(delta.ResolvedRecommendations) != 2 || delta.ResolvedRecommendations[0] != want[0] || delta.ResolvedRecommendations[1] != want[1] {
+		t.Errorf("resolved = %+v; want %+v", delta.ResolvedRecommendations, want)
+	}
+
+	// Only counts in both runs: stale_count is not in the default quick_ref
+	// and blocked_count is not in the baseline
+	if len(delta.QuickRef) != 2 || delta.QuickRef["open_count"] != (QuickRefCountDelta{Baseline: 5, Current: 4, Change: -1}) ||
+		delta.QuickRef["actionable_count"].Change != 0 {
+		t.Errorf("quick_ref delta = %+v", delta.QuickRef)
+	}
+
+	data, err := json.Marshal(ComputeTriageDelta(TriageBaseline{}, TriageResult{}, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"new_recommendations":[],"resolved_recommendations":[],"quick_ref":{}}` {
+		t.Errorf("empty delta JSON = %s", data)
+	}
+}
@@ -2,6 +2,8 @@ package analysis
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -166,6 +168,106 @@ func TestComputeTriageWithOptions(t *testing.T) {
 	}
 }
 
+func TestComputeTriage_MaxRecommendationAge(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "ancient", Title: "Ancient", Status: model.StatusOpen, Priority: 0, CreatedAt: now.AddDate(-2, 0, 0)},
+		{ID: "fresh", Title: "Fresh", Status: model.StatusOpen, Priority: 2, CreatedAt: now.AddDate(0, 0, -10)},
+		{ID: "undated", Title: "Undated", Status: model.StatusOpen, Priority: 3},
+	}
+
+	uncapped := ComputeTriageWithOptionsAndTime(issues, TriageOptions{}, now)
+	if len(uncapped.Recommendations) != 3 || uncapped.Meta.AgedOutCount != 0 {
+		t.Fatalf("uncapped: %d recommendations, aged out %d", len(uncapped.Recommendations), uncapped.Meta.AgedOutCount)
+	}
+
+	capped := ComputeTriageWithOptionsAndTime(issues, TriageOptions{MaxRecommendationAgeDays: 365}, now)
+	for _, rec := range capped.Recommendations {
+		if rec.ID == "ancient" {
+			t.Error("issue older than the cap was recommended")
+		}
+	}
+	if len(capped.Recommendations) != 2 || capped.Meta.AgedOutCount != 1 {
+		t.Errorf("capped: %d recommendations, aged out %d; want 2 and 1", len(capped.Recommendations), capped.Meta.AgedOutCount)
+	}
+	if capped.QuickRef.OpenCount != 3 {
+		t.Errorf("quick_ref open = %d, want 3 (aged-out issues still count)", capped.QuickRef.OpenCount)
+	}
+}
+
+func TestComputeTriage_PerProjectTopN(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	var issues []model.Issue
+	for i := 1; i <= 6; i++ {
+		issues = append(issues, model.Issue{ID: fmt.Sprintf("noisy-%d", i), Title: "Noisy", Status: model.StatusOpen, Priority: 0})
+	}
+	issues = append(issues,
+		model.Issue{ID: "quiet-1", Title: "Quiet", Status: model.StatusOpen, Priority: 3},
+		model.Issue{ID: "QUIET-2", Title: "Quiet too", Status: model.StatusOpen, Priority: 3},
+	)
+
+	uncapped := ComputeTriageWithOptionsAndTime(issues, TriageOptions{TopN: 4}, now)
+	for _, rec := range uncapped.Recommendations {
+		if !strings.HasPrefix(rec.ID, "noisy-") {
+			t.Fatalf("without a cap the noisy project should fill the top 4, got %s", rec.ID)
+		}
+	}
+
+	capped := ComputeTriageWithOptionsAndTime(issues, TriageOptions{TopN: 4, PerProjectTopN: 2}, now)
+	perProject := map[string]int{}
+	for _, rec := range capped.Recommendations {
+		perProject[strings.ToLower(rec.ID[:strings.Index(rec.ID, "-")])]++
+	}
+	if perProject["noisy"] != 2 || perProject["quiet"] != 2 {
+		t.Errorf("per project = %v, want 2 each", perProject)
+	}
+	// Only noisy-3 and noisy-4 lost a top-4 slot; noisy-5 and -6 never had one
+	if capped.Meta.PerProjectCappedCount != 2 {
+		t.Errorf("capped count = %d, want 2", capped.Meta.PerProjectCappedCount)
+	}
+
+	// The global cap still applies after the per-project caps
+	got := ComputeTriageWithOptionsAndTime(issues, TriageOptions{TopN: 3, PerProjectTopN: 2}, now)
+	if len(got.Recommendations) != 3 || got.Meta.PerProjectCappedCount != 1 {
+		t.Errorf("TopN 3: got %d recommendations, capped %d; want 3 and 1", len(got.Recommendations), got.Meta.PerProjectCappedCount)
+	}
+}
+
+func TestComputeTriage_OnlyIDs(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "base", Title: "Base", Status: model.StatusOpen, Priority: 0},
+		{ID: "top", Title: "Top", Status: model.StatusOpen, Priority: 1, Dependencies: []*model.Dependency{
+			{IssueID: "top", DependsOnID: "base", Type: model.DepBlocks},
+		}},
+		{ID: "other", Title: "Other", Status: model.StatusOpen, Priority: 2},
+	}
+
+	triage := ComputeTriageWithOptionsAndTime(issues, TriageOptions{OnlyIDs: map[string]bool{"base": true, "top": true}}, now)
+	for _, rec := range triage.Recommendations {
+		if rec.ID == "other" {
+			t.Error("issue outside OnlyIDs was recommended")
+		}
+	}
+	if len(triage.Recommendations) != 2 {
+		t.Errorf("got %d recommendations, want 2", len(triage.Recommendations))
+	}
+	if triage.QuickRef.OpenCount != 3 {
+		t.Errorf("quick_ref open = %d, want 3 (counts cover every issue)", triage.QuickRef.OpenCount)
+	}
+
+	// A blocker outside the set still blocks but is not listed
+	only := ComputeTriageWithOptionsAndTime(issues, TriageOptions{OnlyIDs: map[string]bool{"top": true}}, now)
+	if only.QuickRef.ActionableCount != 2 {
+		t.Errorf("actionable = %d, want 2", only.QuickRef.ActionableCount)
+	}
+	for _, b := range only.BlockersToClear {
+		if b.ID == "base" {
+			t.Error("blocker outside OnlyIDs was listed")
+		}
+	}
+}
+
 func TestTriageRecommendation_Action(t *testing.T) {
 	// Issue in progress for a long time should suggest review
 	issues := []model.Issue{
@@ -1276,3 +1378,45 @@ func TestComputeTriageFromAnalyzer_Empty(t *testing.T) {
 		t.Errorf("expected 0 recommendations, got %d", len(triage.Recommendations))
 	}
 }
+
+func TestComputeTriage_AgeSplit(t *testing.T) {
+	now := time.Date(2025, 6, 30, 12, 0, 0, 0, time.UTC)
+	issues := []model.Issue{
+		{ID: "fresh", Title: "Fresh", Status: model.StatusOpen, IssueType: model.TypeTask, CreatedAt: now.Add(-24 * time.Hour)},
+		{ID: "untouched", Title: "Untouched", Status: model.StatusOpen, IssueType: model.TypeTask, CreatedAt: now.Add(-30 * 24 * time.Hour)},
+		{ID: "stalled", Title: "Stalled", Status: model.StatusInProgress, IssueType: model.TypeTask, CreatedAt: now.Add(-40 * 24 * time.Hour)},
+	}
+
+	byID := func(tr TriageResult) map[string]Recommendation {
+		m := make(map[string]Recommendation)
+		for _, r := range tr.Recommendations {
+			m[r.ID] = r
+		}
+		return m
+	}
+
+	// Without history only age is known
+	recs := byID(ComputeTriageWithOptionsAndTime(issues, TriageOptions{}, now))
+	if recs["untouched"].AgeDays != 30 || recs["untouched"].StalledDays != nil {
+		t.Errorf("no-history rec = %+v", recs["untouched"])
+	}
+
+	changes := map[string][]time.Time{
+		"stalled": {now.Add(-38 * 24 * time.Hour), now.Add(-20 * 24 * time.Hour)},
+	}
+	recs = byID(ComputeTriageWithOptionsAndTime(issues, TriageOptions{StatusChanges: changes}, now))
+
+	if r := recs["fresh"]; r.AgeDays != 1 || r.StalledDays == nil || *r.StalledDays != 1 {
+		t.Errorf("fresh = age %d stalled %v, want 1/1", r.AgeDays, r.StalledDays)
+	}
+	if r := recs["untouched"]; r.StalledDays == nil || *r.StalledDays != r.AgeDays || r.FirstResponseDays != nil {
+		t.Errorf("untouched should be stalled for its whole age, got %+v", r)
+	}
+	r := recs["stalled"]
+	if r.AgeDays != 40 || r.StalledDays == nil || *r.StalledDays != 20 {
+		t.Errorf("stalled = age %d stalled %v, want 40/20", r.AgeDays, r.StalledDays)
+	}
+	if r.FirstResponseDays == nil || *r.FirstResponseDays != 2 {
+		t.Errorf("stalled first_response_days = %v, want 2", r.FirstResponseDays)
+	}
+}
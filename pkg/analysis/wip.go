@@ -0,0 +1,73 @@
+package analysis
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// WIPLimits caps how many issues may be in progress at once. Zero disables
+// a limit.
+type WIPLimits struct {
+	Global      int // Max in-progress issues overall
+	PerAssignee int // Max in-progress issues per assignee (unassigned issues are not counted)
+}
+
+// Enabled reports whether any limit is set
+func (l WIPLimits) Enabled() bool {
+	return l.Global > 0 || l.PerAssignee > 0
+}
+
+// CheckWIPLimits returns a "wip" warning alert for the global limit and for
+// each assignee over their limit, in that order (assignees by name).
+func CheckWIPLimits(issues []model.Issue, limits WIPLimits) []Alert {
+	if !limits.Enabled() {
+		return nil
+	}
+
+	var inProgress []string
+	byAssignee := make(map[string][]string)
+	for _, issue := range issues {
+		if issue.Status != model.StatusInProgress {
+			continue
+		}
+		inProgress = append(inProgress, issue.ID)
+		if issue.Assignee != "" {
+			byAssignee[issue.Assignee] = append(byAssignee[issue.Assignee], issue.ID)
+		}
+	}
+
+	var alerts []Alert
+	if limits.Global > 0 && len(inProgress) > limits.Global {
+		sort.Strings(inProgress)
+		alerts = append(alerts, Alert{
+			Type:     "wip",
+			Severity: "warning",
+			Message:  fmt.Sprintf("%d issues in progress exceeds WIP limit of %d", len(inProgress), limits.Global),
+			IssueIDs: inProgress,
+		})
+	}
+
+	if limits.PerAssignee > 0 {
+		assignees := make([]string, 0, len(byAssignee))
+		for a, ids := range byAssignee {
+			if len(ids) > limits.PerAssignee {
+				assignees = append(assignees, a)
+			}
+		}
+		sort.Strings(assignees)
+		for _, a := range assignees {
+			ids := byAssignee[a]
+			sort.Strings(ids)
+			alerts = append(alerts, Alert{
+				Type:     "wip",
+				Severity: "warning",
+				Message:  fmt.Sprintf("%s has %d issues in progress, exceeding per-assignee WIP limit of %d", a, len(ids), limits.PerAssignee),
+				IssueIDs: ids,
+			})
+		}
+	}
+
+	return alerts
+}
@@ -0,0 +1,51 @@
+package analysis
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestCheckWIPLimits(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "C", Status: model.StatusInProgress, Assignee: "bo"},
+		{ID: "A", Status: model.StatusInProgress, Assignee: "ana"},
+		{ID: "B", Status: model.StatusInProgress, Assignee: "ana"},
+		{ID: "D", Status: model.StatusInProgress},
+		{ID: "E", Status: model.StatusOpen, Assignee: "ana"},
+	}
+
+	if alerts := CheckWIPLimits(issues, WIPLimits{}); alerts != nil {
+		t.Errorf("expected no alerts without limits, got %+v", alerts)
+	}
+	if alerts := CheckWIPLimits(issues, WIPLimits{Global: 4, PerAssignee: 2}); len(alerts) != 0 {
+		t.Errorf("expected no alerts at the limit, got %+v", alerts)
+	}
+
+	alerts := CheckWIPLimits(issues, WIPLimits{Global: 3, PerAssignee: 1})
+	if len(alerts) != 2 {
+		t.Fatalf("got %d alerts, want 2: %+v", len(alerts), alerts)
+	}
+	if got := alerts[0]; got.Type != "wip" || got.Severity != "warning" || !reflect.DeepEqual(got.IssueIDs, []string{"A", "B", "C", "D"}) {
+		t.Errorf("global alert = %+v", got)
+	}
+	if got := alerts[1]; !strings.HasPrefix(got.Message, "ana has 2") || !reflect.DeepEqual(got.IssueIDs, []string{"A", "B"}) {
+		t.Errorf("assignee alert = %+v", got)
+	}
+}
+
+func TestTriageIncludesWIPAlerts(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "A", Status: model.StatusInProgress},
+		{ID: "B", Title: "B", Status: model.StatusInProgress},
+	}
+	triage := ComputeTriageWithOptions(issues, TriageOptions{WIPLimits: WIPLimits{Global: 1}})
+	if len(triage.Alerts) != 1 || triage.Alerts[0].Type != "wip" {
+		t.Fatalf("expected one wip alert, got %+v", triage.Alerts)
+	}
+	if triage := ComputeTriage(issues); len(triage.Alerts) != 0 {
+		t.Errorf("expected no alerts without limits, got %+v", triage.Alerts)
+	}
+}
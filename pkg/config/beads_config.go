@@ -0,0 +1,55 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// BeadsConfigFileName is the project config beads itself keeps in the
+// .beads directory.
+const BeadsConfigFileName = "config.yaml"
+
+// BeadsProjectConfig holds the parts of a project's .beads/config.yaml that
+// bv reads. Other keys are beads settings and are ignored.
+type BeadsProjectConfig struct {
+	// IssuePrefix is the prefix beads gives issue IDs (e.g., "bd" for
+	// "bd-a1b2").
+	IssuePrefix string `yaml:"issue-prefix,omitempty"`
+}
+
+// BeadsConfigPath returns the path of the beads config file for the
+// project in dir.
+func BeadsConfigPath(dir string) string {
+	return filepath.Join(dir, ".beads", BeadsConfigFileName)
+}
+
+// LoadBeadsProjectConfig loads the beads config for the project in dir.
+// Returns an empty config if the file doesn't exist.
+func LoadBeadsProjectConfig(dir string) (*BeadsProjectConfig, error) {
+	data, err := os.ReadFile(BeadsConfigPath(dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &BeadsProjectConfig{}, nil
+		}
+		return nil, err
+	}
+
+	var cfg BeadsProjectConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// Prefix returns the declared issue prefix in bv's namespace form, with a
+// trailing separator ("bd" becomes "bd-"), or "" if none is declared.
+func (c BeadsProjectConfig) Prefix() string {
+	p := strings.ToLower(strings.TrimSpace(c.IssuePrefix))
+	if p == "" || strings.HasSuffix(p, "-") || strings.HasSuffix(p, "_") || strings.HasSuffix(p, ":") {
+		return p
+	}
+	return p + "-"
+}
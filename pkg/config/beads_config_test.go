@@ -0,0 +1,36 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadBeadsProjectConfig(t *testing.T) {
+	dir := t.TempDir()
+	cfg, err := LoadBeadsProjectConfig(dir)
+	if err != nil || cfg.Prefix() != "" {
+		t.Fatalf("missing file: got %+v, %v; want empty config", cfg, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := "issue-prefix: BD\nsync-branch: beads-sync\n"
+	if err := os.WriteFile(BeadsConfigPath(dir), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadBeadsProjectConfig(dir)
+	if err != nil {
+		t.Fatalf("LoadBeadsProjectConfig: %v", err)
+	}
+	if cfg.IssuePrefix != "BD" || cfg.Prefix() != "bd-" {
+		t.Errorf("got %+v (prefix %q), want BD / bd-", cfg, cfg.Prefix())
+	}
+
+	for in, want := range map[string]string{"api-": "api-", "ops_": "ops_", " web ": "web-"} {
+		if got := (BeadsProjectConfig{IssuePrefix: in}).Prefix(); got != want {
+			t.Errorf("Prefix(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,561 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DisplayFileName is the name of the display config file.
+const DisplayFileName = "display.yaml"
+
+// DefaultEllipsis is the marker inserted where truncated text was removed.
+const DefaultEllipsis = "…"
+
+// TruncationMode selects which part of a string is dropped when it does not fit.
+type TruncationMode string
+
+const (
+	// TruncateLeft drops the start of the string and keeps the end.
+	TruncateLeft TruncationMode = "left"
+	// TruncateMiddle keeps the start and end and drops the middle.
+	TruncateMiddle TruncationMode = "middle"
+	// TruncateRight keeps the start of the string and drops the end.
+	TruncateRight TruncationMode = "right"
+)
+
+// IsValid returns true if the mode is a recognized value.
+func (m TruncationMode) IsValid() bool {
+	switch m {
+	case TruncateLeft, TruncateMiddle, TruncateRight:
+		return true
+	}
+	return false
+}
+
+// SortKey names an issue list sort order.
+type SortKey string
+
+const (
+	// SortDefault lists open issues first, then by priority, then newest.
+	SortDefault SortKey = "default"
+	// SortCreatedAsc orders by creation date, oldest first.
+	SortCreatedAsc SortKey = "created-asc"
+	// SortCreatedDesc orders by creation date, newest first.
+	SortCreatedDesc SortKey = "created-desc"
+	// SortPriority orders by priority only (P0 first).
+	SortPriority SortKey = "priority"
+	// SortUpdated orders by last update, newest first.
+	SortUpdated SortKey = "updated"
+)
+
+// IsValid returns true if the key is a recognized value.
+func (k SortKey) IsValid() bool {
+	switch k {
+	case SortDefault, SortCreatedAsc, SortCreatedDesc, SortPriority, SortUpdated:
+		return true
+	}
+	return false
+}
+
+// Density selects how much vertical space each issue list row takes.
+type Density string
+
+const (
+	// DensityCompact renders one line per issue, with age and assignee
+	// inline as width allows.
+	DensityCompact Density = "compact"
+	// DensityComfortable puts age, comments, assignee and labels on a second
+	// line and leaves a blank line between issues.
+	DensityComfortable Density = "comfortable"
+)
+
+// IsValid returns true if the density is a recognized value.
+func (d Density) IsValid() bool {
+	switch d {
+	case DensityCompact, DensityComfortable:
+		return true
+	}
+	return false
+}
+
+// Toggle returns the other density.
+func (d Density) Toggle() Density {
+	if d == DensityComfortable {
+		return DensityCompact
+	}
+	return DensityComfortable
+}
+
+// LabelSort selects the order of the TUI label dashboard.
+type LabelSort string
+
+const (
+	// LabelSortHealth puts critical labels first, then warning, then by
+	// blocked count and health score.
+	LabelSortHealth LabelSort = "health"
+	// LabelSortBlocked puts the labels with the most blocked issues first,
+	// whatever their size or health.
+	LabelSortBlocked LabelSort = "blocked"
+)
+
+// IsValid returns true if the label sort is a recognized value.
+func (s LabelSort) IsValid() bool {
+	switch s {
+	case LabelSortHealth, LabelSortBlocked:
+		return true
+	}
+	return false
+}
+
+// Toggle returns the other label sort.
+func (s LabelSort) Toggle() LabelSort {
+	if s == LabelSortBlocked {
+		return LabelSortHealth
+	}
+	return LabelSortBlocked
+}
+
+// SearchScoring selects how the TUI's fuzzy search ranks matching issues.
+type SearchScoring string
+
+const (
+	// SearchScoringDensity ranks issues by how tightly and how early the
+	// query's characters match, best first.
+	SearchScoringDensity SearchScoring = "density"
+	// SearchScoringSubsequence keeps every issue containing the query's
+	// characters in order and leaves them in list order.
+	SearchScoringSubsequence SearchScoring = "subsequence"
+)
+
+// IsValid returns true if the scoring mode is a recognized value.
+func (s SearchScoring) IsValid() bool {
+	switch s {
+	case SearchScoringDensity, SearchScoringSubsequence:
+		return true
+	}
+	return false
+}
+
+// SearchConfig tunes the TUI's fuzzy search.
+type SearchConfig struct {
+	// Scoring ranks matches (default: density).
+	Scoring SearchScoring `yaml:"scoring,omitempty"`
+	// HighlightColor colors the matched characters in titles (hex or ANSI
+	// number); empty uses the built-in accent.
+	HighlightColor string `yaml:"highlight_color,omitempty"`
+}
+
+// DetailSection names a foldable section of the TUI issue detail pane.
+type DetailSection string
+
+const (
+	SectionTriage       DetailSection = "triage"
+	SectionGraph        DetailSection = "graph"
+	SectionDescription  DetailSection = "description"
+	SectionAcceptance   DetailSection = "acceptance"
+	SectionNotes        DetailSection = "notes"
+	SectionDependencies DetailSection = "dependencies"
+	SectionComments     DetailSection = "comments"
+	SectionHistory      DetailSection = "history"
+)
+
+// IsValid returns true if the section is a recognized value.
+func (s DetailSection) IsValid() bool {
+	switch s {
+	case SectionTriage, SectionGraph, SectionDescription, SectionAcceptance,
+		SectionNotes, SectionDependencies, SectionComments, SectionHistory:
+		return true
+	}
+	return false
+}
+
+// ViewType names the TUI view a saved view opens in.
+type ViewType string
+
+const (
+	// ViewList is the issue list (default).
+	ViewList ViewType = "list"
+	// ViewBoard is the Kanban board.
+	ViewBoard ViewType = "board"
+	// ViewGraph is the dependency graph.
+	ViewGraph ViewType = "graph"
+	// ViewInsights is the insights dashboard.
+	ViewInsights ViewType = "insights"
+)
+
+// IsValid returns true if the view type is a recognized value.
+func (v ViewType) IsValid() bool {
+	switch v {
+	case ViewList, ViewBoard, ViewGraph, ViewInsights:
+		return true
+	}
+	return false
+}
+
+// SavedView is a named bundle of list filters and display settings, applied
+// with --view NAME or the in-TUI view picker. Empty fields do not filter.
+type SavedView struct {
+	// Repo keeps issues from one project (same matching as --repo).
+	Repo string `yaml:"repo,omitempty"`
+	// Status keeps issues with any of these statuses.
+	Status []string `yaml:"status,omitempty"`
+	// Priority keeps issues with any of these priorities.
+	Priority []int `yaml:"priority,omitempty"`
+	// Type keeps issues with any of these issue types.
+	Type []string `yaml:"type,omitempty"`
+	// Resolution keeps closed issues with any of these resolutions; "none"
+	// matches closed issues without one.
+	Resolution []string `yaml:"resolution,omitempty"`
+	// Assignee keeps issues assigned to this user.
+	Assignee string `yaml:"assignee,omitempty"`
+	// Watching keeps issues this user watches. With Assignee also set, an
+	// issue passes if either matches ("mine or watched").
+	Watching string `yaml:"watching,omitempty"`
+	// MinDeps keeps issues with at least this many blocking dependencies.
+	MinDeps int `yaml:"min_deps,omitempty"`
+	// MinDependents keeps issues that at least this many issues depend on.
+	MinDependents int `yaml:"min_dependents,omitempty"`
+	// Sort is the list sort order; empty keeps the current one.
+	Sort SortKey `yaml:"sort,omitempty"`
+	// SortReverse flips the direction of Sort.
+	SortReverse bool `yaml:"sort_reverse,omitempty"`
+	// ViewType is the view to open in; empty keeps the current one.
+	ViewType ViewType `yaml:"view_type,omitempty"`
+}
+
+// DisplayConfig holds user-level rendering preferences for the TUI.
+type DisplayConfig struct {
+	// Version is the file format version (see DisplayConfigVersion).
+	Version int `yaml:"version,omitempty"`
+	// Ellipsis is the marker used when text is truncated (e.g., "…" or "...").
+	Ellipsis string `yaml:"ellipsis,omitempty"`
+	// Truncation selects the truncation strategy per column.
+	Truncation TruncationConfig `yaml:"truncation,omitempty"`
+	// Sort is the issue list sort order (default: default).
+	Sort SortKey `yaml:"sort,omitempty"`
+	// SortReverse flips the direction of Sort.
+	SortReverse bool `yaml:"sort_reverse,omitempty"`
+	// Density is the issue list row density (default: compact).
+	Density Density `yaml:"density,omitempty"`
+	// BareIDs shows issue IDs without their project prefix ("12" rather
+	// than "api-12"). IDs of other projects' issues keep their prefix.
+	BareIDs bool `yaml:"bare_ids,omitempty"`
+	// HideDetail shows the issue list full width even on terminals wide
+	// enough for the list/detail split view.
+	HideDetail bool `yaml:"hide_detail,omitempty"`
+	// AutosaveProjects saves the project list each time a project is
+	// toggled, added or removed in the Project Manager, instead of only
+	// applying the selection on enter.
+	AutosaveProjects bool `yaml:"autosave_projects,omitempty"`
+	// LabelSort orders the label dashboard (default: health).
+	LabelSort LabelSort `yaml:"label_sort,omitempty"`
+	// FoldedSections are the detail pane sections folded when the TUI
+	// starts (e.g. [history, graph]). Unknown names are dropped.
+	FoldedSections []DetailSection `yaml:"folded_sections,omitempty"`
+	// Views are the saved views, keyed by name.
+	Views map[string]SavedView `yaml:"views,omitempty"`
+	// Pinned lists issue IDs (namespaced in workspace mode) that always sort
+	// to the top of the issue list.
+	Pinned []string `yaml:"pinned,omitempty"`
+	// WIPLimit caps in-progress work; exceeding it is flagged in triage
+	// output and on the board (zero = no limit).
+	WIPLimit WIPLimit `yaml:"wip_limit,omitempty"`
+	// AgeBuckets are the ascending upper bounds, in days, of the triage age
+	// histogram buckets (e.g. [7, 30, 90]). Empty or invalid uses the
+	// built-in buckets.
+	AgeBuckets []int `yaml:"age_buckets,omitempty"`
+	// IgnoreStatuses and IgnoreLabels exclude matching issues (any listed
+	// status, or any listed label) from the TUI and all analysis unless
+	// --show-ignored is given. Matching is case-insensitive.
+	IgnoreStatuses []string `yaml:"ignore_statuses,omitempty"`
+	IgnoreLabels   []string `yaml:"ignore_labels,omitempty"`
+	// UnsuccessfulStatuses are the resolutions that mean an issue was closed
+	// without being done (e.g. "wontfix"), matched against its status or
+	// the start of its close_reason by --robot-deadends. Empty uses the
+	// built-in list.
+	UnsuccessfulStatuses []string `yaml:"unsuccessful_statuses,omitempty"`
+	// DefaultPriority (0-4) is given to issues loaded with a missing or
+	// invalid priority, which --validate then flags. Unset keeps the
+	// built-in behavior: a missing priority reads as P0.
+	DefaultPriority *int `yaml:"default_priority,omitempty"`
+	// MinutesPerStoryPoint converts story_points to an estimate for issues
+	// that have no estimated_minutes. Zero uses
+	// model.DefaultMinutesPerStoryPoint.
+	MinutesPerStoryPoint int `yaml:"minutes_per_story_point,omitempty"`
+	// EmptyState replaces the onboarding hints shown when no issues are
+	// loaded (in the TUI, and when bv finds no .beads directory), e.g. to
+	// point at a team's setup docs. Empty uses the built-in hints.
+	EmptyState string `yaml:"empty_state,omitempty"`
+	// Search tunes fuzzy search ranking and match highlighting.
+	Search SearchConfig `yaml:"search,omitempty"`
+	// Triage tunes robot triage output.
+	Triage TriageConfig `yaml:"triage,omitempty"`
+	// Stale tunes what counts as activity for --robot-stale.
+	Stale StaleConfig `yaml:"stale,omitempty"`
+	// Plan tunes execution plan tracks (--robot-plan and the actionable view).
+	Plan PlanConfig `yaml:"plan,omitempty"`
+}
+
+// PlanConfig tunes execution plan tracks.
+type PlanConfig struct {
+	// GroupRelated lets "related" dependencies pull issues into the same
+	// track, next to each other where priorities allow (default: true).
+	// Related edges never block either way.
+	GroupRelated *bool `yaml:"group_related,omitempty"`
+	// SplitProjects gives each project its own track when a work stream
+	// spans projects, so track_order shows which project waits on which.
+	SplitProjects bool `yaml:"split_projects,omitempty"`
+}
+
+// GroupsRelated returns whether related dependencies shape tracks.
+func (c PlanConfig) GroupsRelated() bool {
+	if c.GroupRelated == nil {
+		return true
+	}
+	return *c.GroupRelated
+}
+
+// TriageConfig tunes robot triage output.
+type TriageConfig struct {
+	// QuickRefFields selects the counts in quick_ref: open, closed,
+	// blocked, ready, in_progress, stale, overdue, unassigned. Empty keeps
+	// the default set (open, ready, blocked, in_progress).
+	QuickRefFields []string `yaml:"quick_ref_fields,omitempty"`
+	// MaxRecommendationAgeDays leaves issues created more than this many
+	// days ago out of triage recommendations; they still count in
+	// quick_ref and are listed under aged_out by --robot-stale. Zero
+	// means no cap.
+	MaxRecommendationAgeDays int `yaml:"max_recommendation_age_days,omitempty"`
+}
+
+// StaleConfig tunes stale issue detection.
+type StaleConfig struct {
+	// CountComments treats a comment as activity, so an issue commented on
+	// recently is not stale even if its fields have not changed. Off by
+	// default: only updated_at counts.
+	CountComments bool `yaml:"count_comments,omitempty"`
+}
+
+// WIPLimit caps the number of in-progress issues.
+type WIPLimit struct {
+	// Global is the maximum number of in-progress issues overall.
+	Global int `yaml:"global,omitempty"`
+	// PerAssignee is the maximum number of in-progress issues per assignee.
+	PerAssignee int `yaml:"per_assignee,omitempty"`
+}
+
+// ViewNames returns the saved view names in alphabetical order.
+func (c *DisplayConfig) ViewNames() []string {
+	names := make([]string, 0, len(c.Views))
+	for name := range c.Views {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SaveView stores v under name, replacing any existing view with that name.
+func (c *DisplayConfig) SaveView(name string, v SavedView) {
+	if c.Views == nil {
+		c.Views = make(map[string]SavedView)
+	}
+	c.Views[name] = v
+}
+
+// IsPinned reports whether id is pinned.
+func (c *DisplayConfig) IsPinned(id string) bool {
+	for _, p := range c.Pinned {
+		if p == id {
+			return true
+		}
+	}
+	return false
+}
+
+// TogglePin pins id if it is not pinned and unpins it otherwise. It returns
+// true when id is pinned afterwards.
+func (c *DisplayConfig) TogglePin(id string) bool {
+	for i, p := range c.Pinned {
+		if p == id {
+			c.Pinned = append(c.Pinned[:i:i], c.Pinned[i+1:]...)
+			return false
+		}
+	}
+	c.Pinned = append(c.Pinned, id)
+	return true
+}
+
+// PrunePinned drops pins whose issue no longer exists and reports the
+// removed IDs.
+func (c *DisplayConfig) PrunePinned(exists func(id string) bool) []string {
+	var kept, removed []string
+	for _, p := range c.Pinned {
+		if exists(p) {
+			kept = append(kept, p)
+		} else {
+			removed = append(removed, p)
+		}
+	}
+	if len(removed) > 0 {
+		c.Pinned = kept
+	}
+	return removed
+}
+
+// Ignores reports whether an issue with this status and these labels is
+// excluded by IgnoreStatuses or IgnoreLabels.
+func (c *DisplayConfig) Ignores(status string, labels []string) bool {
+	for _, s := range c.IgnoreStatuses {
+		if strings.EqualFold(s, status) {
+			return true
+		}
+	}
+	for _, ignored := range c.IgnoreLabels {
+		for _, l := range labels {
+			if strings.EqualFold(ignored, l) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TruncationConfig selects the truncation strategy for each column.
+type TruncationConfig struct {
+	// Title applies to issue titles in the list (default: right).
+	Title TruncationMode `yaml:"title,omitempty"`
+	// ID applies to issue IDs in the list (default: right).
+	ID TruncationMode `yaml:"id,omitempty"`
+	// Path applies to filesystem paths, e.g. in the project manager (default: middle).
+	Path TruncationMode `yaml:"path,omitempty"`
+}
+
+// DefaultDisplayConfig returns the built-in display settings.
+func DefaultDisplayConfig() DisplayConfig {
+	return DisplayConfig{
+		Version:  DisplayConfigVersion,
+		Ellipsis: DefaultEllipsis,
+		Truncation: TruncationConfig{
+			Title: TruncateRight,
+			ID:    TruncateRight,
+			Path:  TruncateMiddle,
+		},
+		Sort:      SortDefault,
+		Density:   DensityCompact,
+		LabelSort: LabelSortHealth,
+		Search:    SearchConfig{Scoring: SearchScoringDensity},
+	}
+}
+
+// applyDefaults fills unset or invalid fields from DefaultDisplayConfig.
+func (c *DisplayConfig) applyDefaults() {
+	def := DefaultDisplayConfig()
+	if c.Ellipsis == "" {
+		c.Ellipsis = def.Ellipsis
+	}
+	if !c.Truncation.Title.IsValid() {
+		c.Truncation.Title = def.Truncation.Title
+	}
+	if !c.Truncation.ID.IsValid() {
+		c.Truncation.ID = def.Truncation.ID
+	}
+	if !c.Truncation.Path.IsValid() {
+		c.Truncation.Path = def.Truncation.Path
+	}
+	if !c.Sort.IsValid() {
+		c.Sort = def.Sort
+	}
+	if !c.Density.IsValid() {
+		c.Density = def.Density
+	}
+	if !c.LabelSort.IsValid() {
+		c.LabelSort = def.LabelSort
+	}
+	if !c.Search.Scoring.IsValid() {
+		c.Search.Scoring = def.Search.Scoring
+	}
+	if p := c.DefaultPriority; p != nil && (*p < 0 || *p > 4) {
+		c.DefaultPriority = nil
+	}
+	if c.MinutesPerStoryPoint < 0 {
+		c.MinutesPerStoryPoint = 0
+	}
+	if c.WIPLimit.Global < 0 {
+		c.WIPLimit.Global = 0
+	}
+	if c.WIPLimit.PerAssignee < 0 {
+		c.WIPLimit.PerAssignee = 0
+	}
+	for i, b := range c.AgeBuckets {
+		if b < 0 || (i > 0 && b <= c.AgeBuckets[i-1]) {
+			c.AgeBuckets = nil
+			break
+		}
+	}
+	folded := c.FoldedSections[:0]
+	for _, s := range c.FoldedSections {
+		if s.IsValid() {
+			folded = append(folded, s)
+		}
+	}
+	c.FoldedSections = folded
+}
+
+// DisplayConfigPath returns the full path to the display config file.
+func DisplayConfigPath() string {
+	return filepath.Join(DefaultConfigDir(), DisplayFileName)
+}
+
+// LoadDisplay loads the display config from the default location.
+// Returns the default config if the file doesn't exist.
+func LoadDisplay() (*DisplayConfig, error) {
+	return LoadDisplayFrom(DisplayConfigPath())
+}
+
+// LoadDisplayFrom loads the display config from a specific path.
+// Missing or invalid fields fall back to their defaults.
+func LoadDisplayFrom(path string) (*DisplayConfig, error) {
+	config := DefaultDisplayConfig()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &config, nil
+		}
+		return nil, err
+	}
+
+	if err := decodeVersioned(data, path, displayMigrations, &config); err != nil {
+		return nil, err
+	}
+	config.applyDefaults()
+	return &config, nil
+}
+
+// SaveDisplay saves the display config to the default location.
+func SaveDisplay(config *DisplayConfig) error {
+	return SaveDisplayTo(config, DisplayConfigPath())
+}
+
+// SaveDisplayTo saves the display config to a specific path, written at
+// the current version.
+func SaveDisplayTo(config *DisplayConfig, path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	out := *config
+	if out.Version < DisplayConfigVersion {
+		out.Version = DisplayConfigVersion
+	}
+	data, err := yaml.Marshal(&out)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,336 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadDisplayFrom_MissingFileReturnsDefaults(t *testing.T) {
+	cfg, err := LoadDisplayFrom(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if !reflect.DeepEqual(*cfg, DefaultDisplayConfig()) {
+		t.Fatalf("got %+v, want defaults %+v", *cfg, DefaultDisplayConfig())
+	}
+}
+
+func TestLoadDisplayFrom_PartialOverride(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	data := "ellipsis: \"...\"\ntruncation:\n  title: middle\n  path: bogus\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if cfg.Ellipsis != "..." {
+		t.Errorf("Ellipsis = %q, want %q", cfg.Ellipsis, "...")
+	}
+	if cfg.Truncation.Title != TruncateMiddle {
+		t.Errorf("Title = %q, want %q", cfg.Truncation.Title, TruncateMiddle)
+	}
+	if cfg.Truncation.ID != TruncateRight {
+		t.Errorf("ID = %q, want default %q", cfg.Truncation.ID, TruncateRight)
+	}
+	if cfg.Truncation.Path != TruncateMiddle {
+		t.Errorf("invalid Path should fall back to %q, got %q", TruncateMiddle, cfg.Truncation.Path)
+	}
+}
+
+func TestLoadDisplayFrom_InvalidYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	if err := os.WriteFile(path, []byte("truncation: [unterminated"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadDisplayFrom(path); err == nil {
+		t.Fatal("expected error for malformed YAML")
+	}
+}
+
+func TestSaveDisplayTo_SortRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bv", DisplayFileName)
+	cfg := DefaultDisplayConfig()
+	cfg.Sort = SortUpdated
+	cfg.SortReverse = true
+	if err := SaveDisplayTo(&cfg, path); err != nil {
+		t.Fatalf("SaveDisplayTo: %v", err)
+	}
+
+	loaded, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if !reflect.DeepEqual(*loaded, cfg) {
+		t.Fatalf("got %+v, want %+v", *loaded, cfg)
+	}
+}
+
+func TestLoadDisplayFrom_InvalidSortFallsBack(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	if err := os.WriteFile(path, []byte("sort: sideways\nsort_reverse: true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if cfg.Sort != SortDefault || !cfg.SortReverse {
+		t.Errorf("got sort=%q reverse=%v, want %q/true", cfg.Sort, cfg.SortReverse, SortDefault)
+	}
+}
+
+func TestLoadDisplayFrom_Search(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	if err := os.WriteFile(path, []byte("search:\n  scoring: subsequence\n  highlight_color: \"#FF5F87\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if cfg.Search.Scoring != SearchScoringSubsequence || cfg.Search.HighlightColor != "#FF5F87" {
+		t.Errorf("got search %+v", cfg.Search)
+	}
+
+	if err := os.WriteFile(path, []byte("search:\n  scoring: loudest\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err = LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if cfg.Search.Scoring != SearchScoringDensity {
+		t.Errorf("invalid scoring = %q, want %q", cfg.Search.Scoring, SearchScoringDensity)
+	}
+}
+
+func TestSaveDisplayTo_ViewsRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	cfg := DefaultDisplayConfig()
+	cfg.SaveView("my-bugs", SavedView{
+		Repo:     "api",
+		Status:   []string{"open", "in_progress"},
+		Priority: []int{0, 1},
+		Type:     []string{"bug"},
+		Assignee: "ana",
+		Sort:     SortPriority,
+		ViewType: ViewBoard,
+	})
+	cfg.SaveView("all", SavedView{})
+	if err := SaveDisplayTo(&cfg, path); err != nil {
+		t.Fatalf("SaveDisplayTo: %v", err)
+	}
+
+	loaded, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Views, cfg.Views) {
+		t.Fatalf("views = %+v, want %+v", loaded.Views, cfg.Views)
+	}
+	if got := loaded.ViewNames(); !reflect.DeepEqual(got, []string{"all", "my-bugs"}) {
+		t.Errorf("ViewNames = %v", got)
+	}
+}
+
+func TestDisplayConfig_PinsRoundTripAndPrune(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	cfg := DefaultDisplayConfig()
+	if !cfg.TogglePin("api-1") || !cfg.TogglePin("web-2") || !cfg.TogglePin("gone-3") {
+		t.Fatal("expected TogglePin to pin new IDs")
+	}
+	if cfg.TogglePin("web-2") {
+		t.Fatal("expected second TogglePin to unpin")
+	}
+	cfg.TogglePin("web-2")
+	if err := SaveDisplayTo(&cfg, path); err != nil {
+		t.Fatalf("SaveDisplayTo: %v", err)
+	}
+
+	loaded, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if want := []string{"api-1", "gone-3", "web-2"}; !reflect.DeepEqual(loaded.Pinned, want) {
+		t.Fatalf("pinned = %v, want %v", loaded.Pinned, want)
+	}
+
+	removed := loaded.PrunePinned(func(id string) bool { return id != "gone-3" })
+	if !reflect.DeepEqual(removed, []string{"gone-3"}) {
+		t.Errorf("removed = %v, want [gone-3]", removed)
+	}
+	if want := []string{"api-1", "web-2"}; !reflect.DeepEqual(loaded.Pinned, want) {
+		t.Errorf("pinned after prune = %v, want %v", loaded.Pinned, want)
+	}
+	if !loaded.IsPinned("api-1") || loaded.IsPinned("gone-3") {
+		t.Error("IsPinned disagrees with Pinned")
+	}
+	if removed := loaded.PrunePinned(func(string) bool { return true }); removed != nil {
+		t.Errorf("expected nothing removed, got %v", removed)
+	}
+}
+
+func TestLoadDisplayFrom_AgeBuckets(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	for _, tt := range []struct {
+		yaml string
+		want []int
+	}{
+		{"age_buckets: [3, 14, 60, 180]\n", []int{3, 14, 60, 180}},
+		{"age_buckets: [30, 7]\n", nil}, // not ascending
+		{"age_buckets: [-1, 7]\n", nil}, // negative
+	} {
+		if err := os.WriteFile(path, []byte(tt.yaml), 0644); err != nil {
+			t.Fatal(err)
+		}
+		cfg, err := LoadDisplayFrom(path)
+		if err != nil {
+			t.Fatalf("LoadDisplayFrom(%q): %v", tt.yaml, err)
+		}
+		if !reflect.DeepEqual(cfg.AgeBuckets, tt.want) {
+			t.Errorf("%q: AgeBuckets = %v, want %v", tt.yaml, cfg.AgeBuckets, tt.want)
+		}
+	}
+}
+
+func TestLoadDisplayFrom_DefaultPriority(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	for _, tt := range []struct {
+		yaml string
+		want int // -1 = unset
+	}{
+		{"default_priority: 0\n", 0},
+		{"default_priority: 3\n", 3},
+		{"default_priority: 7\n", -1}, // out of range
+		{"sort: priority\n", -1},
+	} {
+		if err := os.WriteFile(path, []byte(tt.yaml), 0644); err != nil {
+			t.Fatal(err)
+		}
+		cfg, err := LoadDisplayFrom(path)
+		if err != nil {
+			t.Fatalf("LoadDisplayFrom(%q): %v", tt.yaml, err)
+		}
+		got := -1
+		if cfg.DefaultPriority != nil {
+			got = *cfg.DefaultPriority
+		}
+		if got != tt.want {
+			t.Errorf("%q: DefaultPriority = %d, want %d", tt.yaml, got, tt.want)
+		}
+	}
+}
+
+func TestLoadDisplayFrom_TriageQuickRefFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	if err := os.WriteFile(path, []byte("triage:\n  quick_ref_fields: [open, stale, unassigned]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if got := cfg.Triage.QuickRefFields; !reflect.DeepEqual(got, []string{"open", "stale", "unassigned"}) {
+		t.Errorf("QuickRefFields = %v, want [open stale unassigned]", got)
+	}
+}
+
+func TestLoadDisplayFrom_IgnoreRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	content := "ignore_statuses: [wontfix, icebox]\nignore_labels: [ignore]\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	for _, tt := range []struct {
+		status string
+		labels []string
+		want   bool
+	}{
+		{"wontfix", nil, true},
+		{"Icebox", nil, true},
+		{"open", []string{"backend", "IGNORE"}, true},
+		{"open", []string{"backend"}, false},
+		{"closed", nil, false},
+	} {
+		if got := cfg.Ignores(tt.status, tt.labels); got != tt.want {
+			t.Errorf("Ignores(%q, %v) = %v, want %v", tt.status, tt.labels, got, tt.want)
+		}
+	}
+
+	var empty DisplayConfig
+	if empty.Ignores("wontfix", []string{"ignore"}) {
+		t.Error("empty config should ignore nothing")
+	}
+}
+
+func TestLoadDisplayFrom_UnversionedGetsVersion1(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	data := "wip_limit:\n  global: 3\ntruncation:\n  title: middle\n  id: middle\n  path: middle\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if cfg.Version != DisplayConfigVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, DisplayConfigVersion)
+	}
+	if cfg.WIPLimit != (WIPLimit{Global: 3}) {
+		t.Errorf("WIPLimit = %+v, want global 3", cfg.WIPLimit)
+	}
+	want := TruncationConfig{Title: TruncateMiddle, ID: TruncateMiddle, Path: TruncateMiddle}
+	if cfg.Truncation != want {
+		t.Errorf("Truncation = %+v, want %+v", cfg.Truncation, want)
+	}
+
+	if err := SaveDisplayTo(cfg, path); err != nil {
+		t.Fatalf("SaveDisplayTo: %v", err)
+	}
+	reloaded, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("reload: %v", err)
+	}
+	if !reflect.DeepEqual(reloaded, cfg) {
+		t.Errorf("round trip = %+v, want %+v", reloaded, cfg)
+	}
+}
+
+func TestLoadDisplayFrom_NewerVersionWarns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DisplayFileName)
+	if err := os.WriteFile(path, []byte("version: 99\nellipsis: \"~\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	var warnings []string
+	WarningHandler = func(msg string) { warnings = append(warnings, msg) }
+	defer func() { WarningHandler = nil }()
+
+	cfg, err := LoadDisplayFrom(path)
+	if err != nil {
+		t.Fatalf("LoadDisplayFrom: %v", err)
+	}
+	if cfg.Ellipsis != "~" || cfg.Version != 99 {
+		t.Errorf("got ellipsis %q version %d; want best-effort parse", cfg.Ellipsis, cfg.Version)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "version 99") {
+		t.Errorf("warnings = %v, want one about version 99", warnings)
+	}
+
+	if err := os.WriteFile(path, []byte("version: two\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadDisplayFrom(path); err == nil {
+		t.Error("expected an error for a non-integer version")
+	}
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Current config file versions. A file without a version field is version
+// 0. Loading an older file upgrades it in memory; saving writes the current
+// version.
+const (
+	ProjectsConfigVersion = 1
+	DisplayConfigVersion  = 1
+)
+
+// WarningHandler receives non-fatal config problems, such as a file written
+// by a newer bv. Nil prints to stderr unless BV_ROBOT=1.
+var WarningHandler func(msg string)
+
+func warn(msg string) {
+	if WarningHandler != nil {
+		WarningHandler(msg)
+		return
+	}
+	if os.Getenv("BV_ROBOT") != "1" {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", msg)
+	}
+}
+
+// migration upgrades a decoded config document by one version.
+type migration func(doc map[string]any)
+
+// projectsMigrations[v] upgrades a projects.yaml document from version v.
+// Version 1 only added the version field, so files from before it load
+// unchanged.
+var projectsMigrations = []migration{
+	addVersionField,
+}
+
+// displayMigrations[v] upgrades a display.yaml document from version v.
+// As for projects.yaml, version 1 only added the version field.
+var displayMigrations = []migration{
+	addVersionField,
+}
+
+// addVersionField is the 0 to 1 migration: the format did not change, and
+// decodeVersioned sets the version itself.
+func addVersionField(map[string]any) {}
+
+// decodeVersioned unmarshals a versioned YAML config into out, first
+// running the migrations for versions older than len(migrations). A file
+// from a newer version is decoded as-is with a warning, since its fields
+// may have changed meaning.
+func decodeVersioned(data []byte, path string, migrations []migration, out any) error {
+	var doc map[string]any
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if doc == nil {
+		return nil
+	}
+
+	version := 0
+	if v, ok := doc["version"]; ok {
+		n, ok := v.(int)
+		if !ok || n < 0 {
+			return fmt.Errorf("%s: version must be a non-negative integer, got %v", path, v)
+		}
+		version = n
+	}
+	current := len(migrations)
+	switch {
+	case version > current:
+		warn(fmt.Sprintf("%s is version %d, newer than this bv understands (%d); unknown settings are ignored", path, version, current))
+	case version < current:
+		for _, migrate := range migrations[version:] {
+			migrate(doc)
+		}
+		doc["version"] = current
+	}
+
+	upgraded, err := yaml.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(upgraded, out)
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectLocalFileName is the name of the per-project config file, read from
+// the project directory (next to .beads).
+const ProjectLocalFileName = ".bv.yaml"
+
+// ProjectLocalConfig holds settings checked into a project directory.
+// Matching fields on the user's projects.yaml entry take precedence.
+type ProjectLocalConfig struct {
+	// Prefix is the issue ID prefix used when the project is loaded
+	// alongside others (e.g., "api-").
+	Prefix string `yaml:"prefix,omitempty"`
+	// Color is the repo badge color, as a hex value ("#4ECDC4") or an ANSI
+	// color number.
+	Color string `yaml:"color,omitempty"`
+	// Tags are free-form labels shown in the project manager.
+	Tags []string `yaml:"tags,omitempty"`
+	// Filters is the view the TUI opens with when this is the only project
+	// loaded. --view and filter flags override it.
+	Filters SavedView `yaml:"filters,omitempty"`
+}
+
+// ProjectLocalConfigPath returns the path of the per-project config file in dir.
+func ProjectLocalConfigPath(dir string) string {
+	return filepath.Join(dir, ProjectLocalFileName)
+}
+
+// LoadProjectLocal loads the per-project config from dir.
+// Returns an empty config if the file doesn't exist.
+func LoadProjectLocal(dir string) (*ProjectLocalConfig, error) {
+	data, err := os.ReadFile(ProjectLocalConfigPath(dir))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &ProjectLocalConfig{}, nil
+		}
+		return nil, err
+	}
+
+	var cfg ProjectLocalConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// WithOverrides returns a copy of c with the non-empty settings of the
+// user's saved entry for the same project applied on top.
+func (c ProjectLocalConfig) WithOverrides(user ProjectEntry) ProjectLocalConfig {
+	if user.Prefix != "" {
+		c.Prefix = user.Prefix
+	}
+	if user.Color != "" {
+		c.Color = user.Color
+	}
+	if len(user.Tags) > 0 {
+		c.Tags = user.Tags
+	}
+	return c
+}
+
+// HasFilters reports whether the config sets any default filter.
+func (c ProjectLocalConfig) HasFilters() bool {
+	f := c.Filters
+	return f.Repo != "" || len(f.Status) > 0 || len(f.Priority) > 0 || len(f.Type) > 0 || len(f.Resolution) > 0 ||
+		f.Assignee != "" || f.MinDeps > 0 || f.MinDependents > 0 || f.Sort != "" || f.SortReverse || f.ViewType != ""
+}
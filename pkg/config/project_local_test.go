@@ -0,0 +1,79 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestLoadProjectLocal_MissingFileReturnsEmpty(t *testing.T) {
+	cfg, err := LoadProjectLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadProjectLocal: %v", err)
+	}
+	if !reflect.DeepEqual(*cfg, ProjectLocalConfig{}) || cfg.HasFilters() {
+		t.Fatalf("got %+v, want empty config", *cfg)
+	}
+}
+
+func TestLoadProjectLocal_ParsesAllFields(t *testing.T) {
+	dir := t.TempDir()
+	data := `prefix: api-
+color: "#4ECDC4"
+tags: [backend, go]
+filters:
+  status: [open, in_progress]
+  priority: [0, 1]
+  view_type: board
+`
+	if err := os.WriteFile(filepath.Join(dir, ProjectLocalFileName), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadProjectLocal(dir)
+	if err != nil {
+		t.Fatalf("LoadProjectLocal: %v", err)
+	}
+	want := ProjectLocalConfig{
+		Prefix: "api-",
+		Color:  "#4ECDC4",
+		Tags:   []string{"backend", "go"},
+		Filters: SavedView{
+			Status:   []string{"open", "in_progress"},
+			Priority: []int{0, 1},
+			ViewType: ViewBoard,
+		},
+	}
+	if !reflect.DeepEqual(*cfg, want) {
+		t.Errorf("got %+v, want %+v", *cfg, want)
+	}
+	if !cfg.HasFilters() {
+		t.Error("expected HasFilters to be true")
+	}
+}
+
+func TestLoadProjectLocal_InvalidYAML(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ProjectLocalFileName), []byte("tags: [unclosed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadProjectLocal(dir); err == nil {
+		t.Error("expected parse error")
+	}
+}
+
+func TestProjectLocalWithOverrides_UserWins(t *testing.T) {
+	local := ProjectLocalConfig{Prefix: "api-", Color: "#111111", Tags: []string{"backend"}}
+
+	got := local.WithOverrides(ProjectEntry{Path: "/code/api", Color: "#222222"})
+	want := ProjectLocalConfig{Prefix: "api-", Color: "#222222", Tags: []string{"backend"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	got = local.WithOverrides(ProjectEntry{Prefix: "svc-", Tags: []string{"core"}})
+	if got.Prefix != "svc-" || !reflect.DeepEqual(got.Tags, []string{"core"}) || got.Color != "#111111" {
+		t.Errorf("got %+v, want prefix and tags overridden", got)
+	}
+}
@@ -2,8 +2,14 @@
 package config
 
 import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -11,20 +17,55 @@ import (
 // ProjectsFileName is the name of the projects config file.
 const ProjectsFileName = "projects.yaml"
 
+// ProfilesDirName is the config subdirectory holding named project
+// profiles, one <name>.yaml per profile in the projects.yaml format.
+const ProfilesDirName = "profiles"
+
 // ProjectsConfig holds the user's saved project list.
 type ProjectsConfig struct {
+	// Version is the file format version (see ProjectsConfigVersion).
+	Version int `yaml:"version,omitempty"`
 	// Projects is the list of saved projects.
 	Projects []ProjectEntry `yaml:"projects"`
+
+	dir string // Directory of the file this was loaded from
 }
 
 // ProjectEntry represents a single project in the saved config.
 type ProjectEntry struct {
 	// Name is an optional display name for the project.
 	Name string `yaml:"name,omitempty"`
-	// Path is the absolute path to the project directory.
+	// Path is the project directory, percent-decoded on load ("My%20Projects"
+	// reads as "My Projects"). It is absolute by default, but may be
+	// relative to the config file's directory or start with "~/" for $HOME.
 	Path string `yaml:"path"`
 	// Enabled indicates whether this project should be loaded (default: true).
 	Enabled *bool `yaml:"enabled,omitempty"`
+	// Prefix, Color and Tags override the project's own .bv.yaml settings.
+	Prefix string   `yaml:"prefix,omitempty"`
+	Color  string   `yaml:"color,omitempty"`
+	Tags   []string `yaml:"tags,omitempty"`
+}
+
+// PathMode selects how project paths are written to projects.yaml.
+type PathMode string
+
+const (
+	// PathModeAbsolute stores absolute paths (the default).
+	PathModeAbsolute PathMode = "absolute"
+	// PathModeConfig stores paths relative to the config file's directory.
+	PathModeConfig PathMode = "config"
+	// PathModeHome stores paths relative to $HOME, written as "~/...".
+	PathModeHome PathMode = "home"
+)
+
+// IsValid returns true if the mode is a recognized value.
+func (m PathMode) IsValid() bool {
+	switch m {
+	case PathModeAbsolute, PathModeConfig, PathModeHome:
+		return true
+	}
+	return false
 }
 
 // IsEnabled returns whether the project is enabled.
@@ -56,36 +97,149 @@ func LoadProjects() (*ProjectsConfig, error) {
 	return LoadProjectsFrom(ProjectsConfigPath())
 }
 
+// ValidateProfileName reports whether name can be used as a profile name.
+// Names are file names under the profiles directory, so they must not be
+// empty, hidden, or contain path separators.
+func ValidateProfileName(name string) error {
+	if name == "" {
+		return fmt.Errorf("profile name is empty")
+	}
+	if strings.HasPrefix(name, ".") || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid profile name %q", name)
+	}
+	return nil
+}
+
+// ProfilePath returns the path of the named project profile. An empty name
+// is the default profile, projects.yaml.
+func ProfilePath(name string) string {
+	if name == "" {
+		return ProjectsConfigPath()
+	}
+	return filepath.Join(DefaultConfigDir(), ProfilesDirName, name+".yaml")
+}
+
+// LoadProjectsProfile loads the named project profile, or projects.yaml
+// for an empty name. Returns an empty config if the file doesn't exist.
+func LoadProjectsProfile(name string) (*ProjectsConfig, error) {
+	if name != "" {
+		if err := ValidateProfileName(name); err != nil {
+			return nil, err
+		}
+	}
+	return LoadProjectsFrom(ProfilePath(name))
+}
+
+// SaveProjectsProfile saves the projects config as the named profile, or
+// to projects.yaml for an empty name.
+func SaveProjectsProfile(config *ProjectsConfig, name string) error {
+	if name != "" {
+		if err := ValidateProfileName(name); err != nil {
+			return err
+		}
+	}
+	return SaveProjectsTo(config, ProfilePath(name))
+}
+
+// ListProfiles returns the names of the saved project profiles, sorted.
+// Returns nil if the profiles directory doesn't exist.
+func ListProfiles() ([]string, error) {
+	entries, err := os.ReadDir(filepath.Join(DefaultConfigDir(), ProfilesDirName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".yaml")
+		if e.IsDir() || !ok || ValidateProfileName(name) != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
 // LoadProjectsFrom loads the projects config from a specific path.
 func LoadProjectsFrom(path string) (*ProjectsConfig, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return &ProjectsConfig{}, nil
+			return &ProjectsConfig{dir: filepath.Dir(path)}, nil
 		}
 		return nil, err
 	}
 
 	var config ProjectsConfig
-	if err := yaml.Unmarshal(data, &config); err != nil {
+	if err := decodeVersioned(data, path, projectsMigrations, &config); err != nil {
 		return nil, err
 	}
+	config.dir = filepath.Dir(path)
+	for i := range config.Projects {
+		config.Projects[i].Path = decodeProjectPath(config.Projects[i].Path)
+	}
 	return &config, nil
 }
 
+// decodeProjectPath percent-decodes a path from projects.yaml, so awkward
+// characters can be written as escapes. Paths that are not valid escapes,
+// such as a literal "100%", are returned unchanged.
+func decodeProjectPath(path string) string {
+	if !strings.Contains(path, "%") {
+		return path
+	}
+	decoded, err := url.PathUnescape(path)
+	if err != nil {
+		return path
+	}
+	return decoded
+}
+
+// encodeProjectPath escapes "%" as "%25", the only character
+// decodeProjectPath would read differently, so saved paths load back
+// unchanged.
+func encodeProjectPath(path string) string {
+	return strings.ReplaceAll(path, "%", "%25")
+}
+
+// BaseDir returns the directory that relative project paths in the config
+// resolve against: the directory of the file it was loaded from, or the
+// default config directory.
+func (c *ProjectsConfig) BaseDir() string {
+	if c == nil || c.dir == "" {
+		return filepath.Dir(ProjectsConfigPath())
+	}
+	return c.dir
+}
+
 // SaveProjects saves the projects config to the default location.
 func SaveProjects(config *ProjectsConfig) error {
 	return SaveProjectsTo(config, ProjectsConfigPath())
 }
 
-// SaveProjectsTo saves the projects config to a specific path.
+// SaveProjectsTo saves the projects config to a specific path, written at
+// the current version.
 func SaveProjectsTo(config *ProjectsConfig, path string) error {
 	dir := filepath.Dir(path)
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return err
 	}
 
-	data, err := yaml.Marshal(config)
+	out := *config
+	if out.Version < ProjectsConfigVersion {
+		out.Version = ProjectsConfigVersion
+	}
+	// Paths are written double-quoted, so spaces and other characters stay
+	// readable and unambiguous, with only "%" escaped.
+	var doc yaml.Node
+	if err := doc.Encode(&out); err != nil {
+		return err
+	}
+	quoteProjectPaths(&doc)
+	data, err := yaml.Marshal(&doc)
 	if err != nil {
 		return err
 	}
@@ -93,10 +247,38 @@ func SaveProjectsTo(config *ProjectsConfig, path string) error {
 	return os.WriteFile(path, data, 0644)
 }
 
+// quoteProjectPaths sets double-quoted style on every "path" value under
+// the top-level "projects" sequence of an encoded ProjectsConfig.
+func quoteProjectPaths(doc *yaml.Node) {
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value != "projects" {
+			continue
+		}
+		for _, entry := range doc.Content[i+1].Content {
+			for j := 0; j+1 < len(entry.Content); j += 2 {
+				if entry.Content[j].Value == "path" {
+					entry.Content[j+1].Value = encodeProjectPath(entry.Content[j+1].Value)
+					entry.Content[j+1].Style = yaml.DoubleQuotedStyle
+				}
+			}
+		}
+	}
+}
+
 // ClearProjects removes the projects config file.
 func ClearProjects() error {
-	path := ProjectsConfigPath()
-	err := os.Remove(path)
+	return ClearProjectsProfile("")
+}
+
+// ClearProjectsProfile removes the named project profile, or projects.yaml
+// for an empty name.
+func ClearProjectsProfile(name string) error {
+	if name != "" {
+		if err := ValidateProfileName(name); err != nil {
+			return err
+		}
+	}
+	err := os.Remove(ProfilePath(name))
 	if os.IsNotExist(err) {
 		return nil
 	}
@@ -104,8 +286,11 @@ func ClearProjects() error {
 }
 
 // AddProject adds a project to the config if it doesn't already exist.
+// The path is stored according to mode; baseDir is the directory containing
+// the config file and is used for PathModeConfig and for comparing entries.
+// Paths that cannot be expressed in the requested mode are stored absolute.
 // Returns true if the project was added, false if it already existed.
-func (c *ProjectsConfig) AddProject(path string) bool {
+func (c *ProjectsConfig) AddProject(path string, mode PathMode, baseDir string) bool {
 	absPath, err := filepath.Abs(path)
 	if err != nil {
 		absPath = path
@@ -113,28 +298,28 @@ func (c *ProjectsConfig) AddProject(path string) bool {
 
 	// Check if already exists
 	for _, p := range c.Projects {
-		if p.Path == absPath {
+		if ResolveProjectPath(p.Path, baseDir) == absPath {
 			return false
 		}
 	}
 
 	c.Projects = append(c.Projects, ProjectEntry{
 		Name: filepath.Base(absPath),
-		Path: absPath,
+		Path: storedProjectPath(absPath, mode, baseDir),
 	})
 	return true
 }
 
 // RemoveProject removes a project from the config by path.
 // Returns true if the project was removed, false if it wasn't found.
-func (c *ProjectsConfig) RemoveProject(path string) bool {
+func (c *ProjectsConfig) RemoveProject(path string, baseDir string) bool {
 	absPath, err := filepath.Abs(path)
 	if err != nil {
 		absPath = path
 	}
 
 	for i, p := range c.Projects {
-		if p.Path == absPath {
+		if ResolveProjectPath(p.Path, baseDir) == absPath {
 			c.Projects = append(c.Projects[:i], c.Projects[i+1:]...)
 			return true
 		}
@@ -142,13 +327,88 @@ func (c *ProjectsConfig) RemoveProject(path string) bool {
 	return false
 }
 
-// EnabledPaths returns the paths of all enabled projects.
-func (c *ProjectsConfig) EnabledPaths() []string {
+// EnabledPaths returns the paths of all enabled projects, with relative
+// entries resolved against baseDir.
+func (c *ProjectsConfig) EnabledPaths(baseDir string) []string {
 	var paths []string
 	for _, p := range c.Projects {
 		if p.IsEnabled() {
-			paths = append(paths, p.Path)
+			paths = append(paths, ResolveProjectPath(p.Path, baseDir))
 		}
 	}
 	return paths
 }
+
+// Find returns the saved entry whose path resolves to path, or nil.
+func (c *ProjectsConfig) Find(path string, baseDir string) *ProjectEntry {
+	if c == nil {
+		return nil
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		absPath = path
+	}
+	for i := range c.Projects {
+		if ResolveProjectPath(c.Projects[i].Path, baseDir) == absPath {
+			return &c.Projects[i]
+		}
+	}
+	return nil
+}
+
+// ResolveProjectPath expands a stored project path to an absolute path.
+// "~/" is expanded to $HOME and other relative paths are joined to baseDir.
+func ResolveProjectPath(path string, baseDir string) string {
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, filepath.FromSlash(strings.TrimPrefix(path, "~")))
+		}
+		return path
+	}
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	return filepath.Join(baseDir, filepath.FromSlash(path))
+}
+
+// storedProjectPath converts an absolute path to its on-disk form for mode.
+func storedProjectPath(absPath string, mode PathMode, baseDir string) string {
+	switch mode {
+	case PathModeHome:
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return absPath
+		}
+		rel, err := filepath.Rel(home, absPath)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return absPath
+		}
+		if rel == "." {
+			return "~"
+		}
+		return "~/" + filepath.ToSlash(rel)
+	case PathModeConfig:
+		rel, err := filepath.Rel(baseDir, absPath)
+		if err != nil {
+			return absPath
+		}
+		return filepath.ToSlash(rel)
+	}
+	return absPath
+}
+
+// ParseProjectList reads a plain project list: one path per line, blank
+// lines and lines starting with "#" ignored. Paths are resolved with
+// ResolveProjectPath against baseDir, in file order.
+func ParseProjectList(r io.Reader, baseDir string) ([]string, error) {
+	var paths []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		paths = append(paths, ResolveProjectPath(line, baseDir))
+	}
+	return paths, scanner.Err()
+}
@@ -0,0 +1,281 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAddProject_PathModes(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	baseDir := filepath.Join(home, ".config", "bv")
+	project := filepath.Join(home, "code", "api")
+	outside := filepath.Join(filepath.Dir(home), "elsewhere")
+
+	tests := []struct {
+		name string
+		path string
+		mode PathMode
+		want string
+	}{
+		{"absolute", project, PathModeAbsolute, project},
+		{"config", project, PathModeConfig, "../../code/api"},
+		{"home", project, PathModeHome, "~/code/api"},
+		{"home outside falls back", outside, PathModeHome, outside},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg ProjectsConfig
+			if !cfg.AddProject(tt.path, tt.mode, baseDir) {
+				t.Fatal("expected project to be added")
+			}
+			if got := cfg.Projects[0].Path; got != tt.want {
+				t.Errorf("stored path = %q, want %q", got, tt.want)
+			}
+			if got := cfg.EnabledPaths(baseDir); !reflect.DeepEqual(got, []string{tt.path}) {
+				t.Errorf("EnabledPaths = %v, want [%s]", got, tt.path)
+			}
+		})
+	}
+}
+
+func TestAddProject_DedupesAcrossModes(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	baseDir := filepath.Join(home, ".config", "bv")
+	project := filepath.Join(home, "code", "web")
+
+	var cfg ProjectsConfig
+	cfg.AddProject(project, PathModeHome, baseDir)
+	if cfg.AddProject(project, PathModeAbsolute, baseDir) {
+		t.Error("expected duplicate to be rejected")
+	}
+	if cfg.AddProject(project, PathModeConfig, baseDir) {
+		t.Error("expected duplicate to be rejected")
+	}
+	if !cfg.RemoveProject(project, baseDir) || len(cfg.Projects) != 0 {
+		t.Errorf("expected relative entry to be removed, got %+v", cfg.Projects)
+	}
+}
+
+func TestLoadProjectsFrom_RelativeRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "bv", ProjectsFileName)
+	project := filepath.Join(dir, "code", "api")
+
+	cfg := &ProjectsConfig{}
+	cfg.AddProject(project, PathModeConfig, filepath.Dir(path))
+	if err := SaveProjectsTo(cfg, path); err != nil {
+		t.Fatalf("SaveProjectsTo: %v", err)
+	}
+
+	// Moving the whole tree keeps relative entries valid
+	moved := t.TempDir()
+	if err := os.Rename(filepath.Join(dir, "bv"), filepath.Join(moved, "bv")); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadProjectsFrom(filepath.Join(moved, "bv", ProjectsFileName))
+	if err != nil {
+		t.Fatalf("LoadProjectsFrom: %v", err)
+	}
+	want := []string{filepath.Join(moved, "code", "api")}
+	if got := loaded.EnabledPaths(filepath.Join(moved, "bv")); !reflect.DeepEqual(got, want) {
+		t.Errorf("EnabledPaths = %v, want %v", got, want)
+	}
+}
+
+func TestProjectsPercentEncodedPaths(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ProjectsFileName)
+	project := filepath.Join(dir, "My Projects", "café app")
+
+	// Escapes decode on load; a stray "%" is kept as written
+	yamlData := "projects:\n" +
+		"  - path: " + filepath.ToSlash(filepath.Join(dir, "My%20Projects", "caf%C3%A9%20app")) + "\n" +
+		"  - path: /srv/100%\n"
+	if err := os.WriteFile(path, []byte(yamlData), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadProjectsFrom(path)
+	if err != nil {
+		t.Fatalf("LoadProjectsFrom: %v", err)
+	}
+	if got := loaded.Projects[0].Path; got != filepath.ToSlash(project) {
+		t.Errorf("decoded path = %q, want %q", got, filepath.ToSlash(project))
+	}
+	if got := loaded.Projects[1].Path; got != "/srv/100%" {
+		t.Errorf("invalid escape path = %q, want it unchanged", got)
+	}
+
+	// Saved paths are decoded and quoted, and load back the same
+	cfg := &ProjectsConfig{}
+	cfg.AddProject(project, PathModeAbsolute, dir)
+	if err := SaveProjectsTo(cfg, path); err != nil {
+		t.Fatalf("SaveProjectsTo: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `path: "` + project + `"`; !strings.Contains(string(data), want) {
+		t.Errorf("saved file missing %s:\n%s", want, data)
+	}
+	loaded, err = LoadProjectsFrom(path)
+	if err != nil {
+		t.Fatalf("LoadProjectsFrom: %v", err)
+	}
+	if got := loaded.EnabledPaths(dir); !reflect.DeepEqual(got, []string{project}) {
+		t.Errorf("round trip = %v, want [%s]", got, project)
+	}
+
+	// A literal "%" is escaped on save, including ones that look like escapes
+	for _, literal := range []string{"/srv/100%", "/srv/a%20b"} {
+		cfg := &ProjectsConfig{Projects: []ProjectEntry{{Path: literal}}}
+		if err := SaveProjectsTo(cfg, path); err != nil {
+			t.Fatalf("SaveProjectsTo: %v", err)
+		}
+		loaded, err := LoadProjectsFrom(path)
+		if err != nil {
+			t.Fatalf("LoadProjectsFrom: %v", err)
+		}
+		if got := loaded.Projects[0].Path; got != literal {
+			t.Errorf("round trip of %q = %q", literal, got)
+		}
+	}
+}
+
+func TestProjectsProfiles(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	if names, err := ListProfiles(); err != nil || names != nil {
+		t.Fatalf("ListProfiles with no profiles = %v, %v", names, err)
+	}
+	if ProfilePath("") != ProjectsConfigPath() {
+		t.Errorf("empty profile should be projects.yaml, got %s", ProfilePath(""))
+	}
+
+	project := filepath.Join(t.TempDir(), "api")
+	for _, name := range []string{"work", "oss"} {
+		cfg := &ProjectsConfig{}
+		cfg.AddProject(project, PathModeConfig, filepath.Dir(ProfilePath(name)))
+		if err := SaveProjectsProfile(cfg, name); err != nil {
+			t.Fatalf("SaveProjectsProfile(%s): %v", name, err)
+		}
+	}
+	names, err := ListProfiles()
+	if err != nil || !reflect.DeepEqual(names, []string{"oss", "work"}) {
+		t.Errorf("ListProfiles = %v, %v", names, err)
+	}
+
+	// Relative paths resolve against the profiles directory
+	loaded, err := LoadProjectsProfile("work")
+	if err != nil {
+		t.Fatalf("LoadProjectsProfile: %v", err)
+	}
+	if got := loaded.EnabledPaths(loaded.BaseDir()); !reflect.DeepEqual(got, []string{project}) {
+		t.Errorf("EnabledPaths = %v, want %v", got, []string{project})
+	}
+	if _, err := os.Stat(ProjectsConfigPath()); !os.IsNotExist(err) {
+		t.Error("saving a profile should not write projects.yaml")
+	}
+
+	if err := ClearProjectsProfile("work"); err != nil {
+		t.Fatalf("ClearProjectsProfile: %v", err)
+	}
+	if names, _ := ListProfiles(); !reflect.DeepEqual(names, []string{"oss"}) {
+		t.Errorf("after clear, ListProfiles = %v", names)
+	}
+
+	for _, bad := range []string{"", ".hidden", "a/b", "../x"} {
+		if err := ValidateProfileName(bad); err == nil {
+			t.Errorf("ValidateProfileName(%q) should fail", bad)
+		}
+		if _, err := LoadProjectsProfile(bad); bad != "" && err == nil {
+			t.Errorf("LoadProjectsProfile(%q) should fail", bad)
+		}
+	}
+}
+
+func TestPathMode_IsValid(t *testing.T) {
+	for _, m := range []PathMode{PathModeAbsolute, PathModeConfig, PathModeHome} {
+		if !m.IsValid() {
+			t.Errorf("%q should be valid", m)
+		}
+	}
+	if PathMode("relative").IsValid() {
+		t.Error("unknown mode should be invalid")
+	}
+}
+
+func TestFindProject(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	baseDir := filepath.Join(home, ".config", "bv")
+	project := filepath.Join(home, "code", "api")
+
+	var cfg ProjectsConfig
+	cfg.AddProject(project, PathModeHome, baseDir)
+	cfg.Projects[0].Prefix = "svc-"
+
+	entry := cfg.Find(project, baseDir)
+	if entry == nil || entry.Prefix != "svc-" {
+		t.Fatalf("Find = %+v, want entry with prefix svc-", entry)
+	}
+	if cfg.Find(filepath.Join(home, "code", "web"), baseDir) != nil {
+		t.Error("expected no entry for unknown path")
+	}
+	var nilCfg *ProjectsConfig
+	if nilCfg.Find(project, baseDir) != nil {
+		t.Error("expected nil config to find nothing")
+	}
+}
+
+func TestParseProjectList(t *testing.T) {
+	input := "# generated by find\n\n  api  \n/abs/web\n\t# indented comment\nlibs/util\n"
+	got, err := ParseProjectList(strings.NewReader(input), "/base")
+	if err != nil {
+		t.Fatalf("ParseProjectList: %v", err)
+	}
+	want := []string{
+		filepath.Join("/base", "api"),
+		filepath.Clean("/abs/web"),
+		filepath.Join("/base", "libs", "util"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseProjectList = %v, want %v", got, want)
+	}
+}
+
+func TestLoadProjectsFrom_UnversionedGetsVersion1(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ProjectsFileName)
+	data := "projects:\n  - path: /code/api\n  - name: web\n    path: /code/web\n"
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadProjectsFrom(path)
+	if err != nil {
+		t.Fatalf("LoadProjectsFrom: %v", err)
+	}
+	if cfg.Version != ProjectsConfigVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, ProjectsConfigVersion)
+	}
+	want := []ProjectEntry{{Path: "/code/api"}, {Name: "web", Path: "/code/web"}}
+	if !reflect.DeepEqual(cfg.Projects, want) {
+		t.Errorf("Projects = %+v, want %+v", cfg.Projects, want)
+	}
+
+	if err := SaveProjectsTo(cfg, path); err != nil {
+		t.Fatalf("SaveProjectsTo: %v", err)
+	}
+	saved, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(saved), "version: 1\n") || !strings.Contains(string(saved), `path: "/code/api"`) {
+		t.Errorf("saved file not rewritten at the current version:\n%s", saved)
+	}
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ThemeFileName is the name of the custom theme file.
+const ThemeFileName = "theme.yaml"
+
+// ThemeFilePath returns the path of the custom theme file.
+func ThemeFilePath() string {
+	return filepath.Join(DefaultConfigDir(), ThemeFileName)
+}
+
+// LoadThemeFile reads a theme file: a flat map from color role (Primary,
+// Blocked, Healthy, ...) to a hex color. Which roles exist is up to the UI.
+// A missing file is an error, so callers decide whether it is optional.
+func LoadThemeFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	colors := map[string]string{}
+	if err := yaml.Unmarshal(data, &colors); err != nil {
+		return nil, err
+	}
+	return colors, nil
+}
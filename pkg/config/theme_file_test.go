@@ -0,0 +1,32 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadThemeFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ThemeFileName)
+	content := "Primary: \"#112233\"\nBlocked: \"#FF0000\"\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	colors, err := LoadThemeFile(path)
+	if err != nil {
+		t.Fatalf("LoadThemeFile: %v", err)
+	}
+	if colors["Primary"] != "#112233" || colors["Blocked"] != "#FF0000" || len(colors) != 2 {
+		t.Errorf("colors = %v", colors)
+	}
+
+	if _, err := LoadThemeFile(filepath.Join(t.TempDir(), "missing.yaml")); !os.IsNotExist(err) {
+		t.Errorf("missing file err = %v, want not-exist", err)
+	}
+	if err := os.WriteFile(path, []byte("- not\n- a map\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadThemeFile(path); err == nil {
+		t.Error("expected error for a theme file that is not a map")
+	}
+}
@@ -369,6 +369,7 @@ func (e *Extractor) parseDiff(diffData []byte, info commitInfo, filterBeadID str
 			// Check for status change
 			if oldSnap.Status != newSnap.Status {
 				event.EventType = determineStatusEvent(oldSnap.Status, newSnap.Status)
+				event.StatusChanged = true
 				events = append(events, event)
 			} else {
 				// Other modification (title, etc.)
@@ -506,6 +506,19 @@ func TestParseDiff(t *testing.T) {
 		}
 	})
 
+	t.Run("status change reported as modified", func(t *testing.T) {
+		diffData := []byte(`diff --git a/.beads/beads.jsonl b/.beads/beads.jsonl
+-{"id":"bv-123","title":"Task","status":"open"}
++{"id":"bv-123","title":"Task","status":"blocked"}
+`)
+
+		events := e.parseDiff(diffData, info, "")
+
+		if len(events) != 1 || events[0].EventType != EventModified || !events[0].StatusChanged {
+			t.Fatalf("Expected one status-changing EventModified, got %+v", events)
+		}
+	})
+
 	t.Run("empty diff", func(t *testing.T) {
 		diffData := []byte(`diff --git a/.beads/beads.jsonl b/.beads/beads.jsonl
 `)
@@ -45,6 +45,21 @@ type BeadEvent struct {
 	CommitMsg   string    `json:"commit_message"`
 	Author      string    `json:"author"`
 	AuthorEmail string    `json:"author_email"`
+	// StatusChanged is set when the commit changed the bead's status, including
+	// transitions reported as EventModified (e.g. open -> blocked)
+	StatusChanged bool `json:"status_changed,omitempty"`
+}
+
+// StatusChangeTimes groups the timestamps of status-changing events by bead
+// ID, in the order given (chronological for Extractor.Extract output).
+func StatusChangeTimes(events []BeadEvent) map[string][]time.Time {
+	changes := make(map[string][]time.Time)
+	for _, e := range events {
+		if e.StatusChanged {
+			changes[e.BeadID] = append(changes[e.BeadID], e.Timestamp)
+		}
+	}
+	return changes
 }
 
 // CorrelationMethod describes how a commit was linked to a bead
@@ -367,3 +367,23 @@ func TestFileChange_JSONRoundtrip(t *testing.T) {
 		t.Errorf("FileChange mismatch: got %+v, want %+v", decoded, original)
 	}
 }
+
+func TestStatusChangeTimes(t *testing.T) {
+	t1 := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(48 * time.Hour)
+	events := []BeadEvent{
+		{BeadID: "a", EventType: EventCreated, Timestamp: t1},
+		{BeadID: "a", EventType: EventClaimed, Timestamp: t1, StatusChanged: true},
+		{BeadID: "a", EventType: EventModified, Timestamp: t2},
+		{BeadID: "a", EventType: EventModified, Timestamp: t2, StatusChanged: true},
+		{BeadID: "b", EventType: EventCreated, Timestamp: t1},
+	}
+
+	got := StatusChangeTimes(events)
+	if len(got["a"]) != 2 || !got["a"][0].Equal(t1) || !got["a"][1].Equal(t2) {
+		t.Errorf("a changes = %v, want [%v %v]", got["a"], t1, t2)
+	}
+	if _, ok := got["b"]; ok {
+		t.Errorf("b has no status changes, got %v", got["b"])
+	}
+}
@@ -0,0 +1,154 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// GitHubImportIssue is one entry of a GitHub issue import payload.
+// GitHub has no dependency field, so dependencies are rendered into Body.
+type GitHubImportIssue struct {
+	Title     string                `json:"title"`
+	Body      string                `json:"body"`
+	Labels    []string              `json:"labels"`
+	State     string                `json:"state"` // "open" or "closed"
+	CreatedAt time.Time             `json:"created_at"`
+	UpdatedAt time.Time             `json:"updated_at"`
+	ClosedAt  *time.Time            `json:"closed_at,omitempty"`
+	Assignee  string                `json:"assignee,omitempty"`
+	Comments  []GitHubImportComment `json:"comments"`
+}
+
+// GitHubImportComment is a comment attached to an imported issue.
+type GitHubImportComment struct {
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GenerateGitHubImport converts issues into GitHub issue import entries,
+// ordered by creation time so GitHub numbers them in the original order.
+// The first comment on each issue records its beads ID and project.
+func GenerateGitHubImport(issues []model.Issue) []GitHubImportIssue {
+	statusByID := make(map[string]model.Status, len(issues))
+	for _, issue := range issues {
+		statusByID[issue.ID] = issue.Status
+	}
+
+	sorted := make([]model.Issue, len(issues))
+	copy(sorted, issues)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].CreatedAt.Equal(sorted[j].CreatedAt) {
+			return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	result := make([]GitHubImportIssue, 0, len(sorted))
+	for _, issue := range sorted {
+		state := "open"
+		var closedAt *time.Time
+		if issue.Status == model.StatusClosed {
+			state = "closed"
+			if issue.ClosedAt != nil {
+				t := *issue.ClosedAt
+				closedAt = &t
+			}
+		}
+
+		labels := append([]string{}, issue.Labels...)
+
+		origin := fmt.Sprintf("Imported from beads issue `%s`", issue.ID)
+		if project := githubImportProject(issue); project != "" {
+			origin += fmt.Sprintf(" (project `%s`)", project)
+		}
+		comments := []GitHubImportComment{{Body: origin + ".", CreatedAt: issue.CreatedAt}}
+		for _, c := range issue.Comments {
+			if c == nil || strings.TrimSpace(c.Text) == "" {
+				continue
+			}
+			body := c.Text
+			if c.Author != "" {
+				body = fmt.Sprintf("**%s** wrote:\n\n%s", c.Author, c.Text)
+			}
+			comments = append(comments, GitHubImportComment{Body: body, CreatedAt: c.CreatedAt})
+		}
+
+		result = append(result, GitHubImportIssue{
+			Title:     issue.Title,
+			Body:      githubImportBody(issue, statusByID),
+			Labels:    labels,
+			State:     state,
+			CreatedAt: issue.CreatedAt,
+			UpdatedAt: issue.UpdatedAt,
+			ClosedAt:  closedAt,
+			Assignee:  issue.Assignee,
+			Comments:  comments,
+		})
+	}
+	return result
+}
+
+// WriteGitHubImport writes the GitHub import payload as an indented JSON array.
+func WriteGitHubImport(w io.Writer, issues []model.Issue) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(GenerateGitHubImport(issues))
+}
+
+// githubImportBody renders the issue text plus a dependency checklist.
+// Dependencies that are closed in the exported set are checked off.
+func githubImportBody(issue model.Issue, statusByID map[string]model.Status) string {
+	var sb strings.Builder
+	sb.WriteString(issue.MarkdownBody())
+
+	section := func(title, text string) {
+		text = strings.TrimSpace(text)
+		if text == "" {
+			return
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n\n")
+		}
+		sb.WriteString("## " + title + "\n\n" + text)
+	}
+	section("Design", issue.Design)
+	section("Acceptance Criteria", issue.AcceptanceCriteria)
+	section("Notes", issue.Notes)
+
+	var deps []string
+	for _, dep := range issue.Dependencies {
+		if dep == nil || dep.DependsOnID == "" {
+			continue
+		}
+		check := " "
+		if statusByID[dep.DependsOnID] == model.StatusClosed {
+			check = "x"
+		}
+		depType := string(dep.Type)
+		if depType == "" {
+			depType = string(model.DepBlocks)
+		}
+		deps = append(deps, fmt.Sprintf("- [%s] `%s` (%s)", check, dep.DependsOnID, depType))
+	}
+	section("Dependencies", strings.Join(deps, "\n"))
+
+	return sb.String()
+}
+
+// githubImportProject names the project an issue came from: its source repo
+// in workspace mode, otherwise the prefix of a namespaced ID ("api-12" -> "api").
+func githubImportProject(issue model.Issue) string {
+	if issue.SourceRepo != "" && issue.SourceRepo != "." {
+		return issue.SourceRepo
+	}
+	if idx := strings.IndexAny(issue.ID, "-:_"); idx > 0 {
+		return issue.ID[:idx]
+	}
+	return ""
+}
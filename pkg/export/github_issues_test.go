@@ -0,0 +1,97 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestGenerateGitHubImport(t *testing.T) {
+	base := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	closedAt := base.Add(48 * time.Hour)
+	issues := []model.Issue{
+		{
+			ID: "web-2", Title: "Login page", Description: "Build the form.",
+			AcceptanceCriteria: "Users can sign in", Status: model.StatusOpen,
+			Labels: []string{"frontend"}, CreatedAt: base.Add(time.Hour),
+			Dependencies: []*model.Dependency{
+				{DependsOnID: "api-1", Type: model.DepBlocks},
+				{DependsOnID: "web-9", Type: model.DepRelated},
+			},
+			Comments: []*model.Comment{{Author: "ana", Text: "Needs design review", CreatedAt: base.Add(2 * time.Hour)}},
+		},
+		{
+			ID: "api-1", Title: "Auth endpoint", Status: model.StatusClosed,
+			CreatedAt: base, ClosedAt: &closedAt, SourceRepo: "services/api",
+		},
+	}
+
+	got := GenerateGitHubImport(issues)
+	if len(got) != 2 {
+		t.Fatalf("got %d entries, want 2", len(got))
+	}
+
+	// Ordered by creation time
+	api, web := got[0], got[1]
+	if api.Title != "Auth endpoint" || web.Title != "Login page" {
+		t.Fatalf("unexpected order: %q, %q", api.Title, web.Title)
+	}
+
+	if api.State != "closed" || api.ClosedAt == nil || !api.ClosedAt.Equal(closedAt) {
+		t.Errorf("closed issue state=%q closed_at=%v", api.State, api.ClosedAt)
+	}
+	if web.State != "open" || web.ClosedAt != nil {
+		t.Errorf("open issue state=%q closed_at=%v", web.State, web.ClosedAt)
+	}
+
+	if want := "Imported from beads issue `api-1` (project `services/api`)."; api.Comments[0].Body != want {
+		t.Errorf("api origin comment = %q, want %q", api.Comments[0].Body, want)
+	}
+	if want := "Imported from beads issue `web-2` (project `web`)."; web.Comments[0].Body != want {
+		t.Errorf("web origin comment = %q, want %q", web.Comments[0].Body, want)
+	}
+	if len(web.Comments) != 2 || !strings.Contains(web.Comments[1].Body, "**ana** wrote") {
+		t.Errorf("expected beads comment to be carried over, got %+v", web.Comments)
+	}
+
+	for _, want := range []string{
+		"Build the form.",
+		"## Acceptance Criteria\n\nUsers can sign in",
+		"## Dependencies\n\n- [x] `api-1` (blocks)\n- [ ] `web-9` (related)",
+	} {
+		if !strings.Contains(web.Body, want) {
+			t.Errorf("body missing %q:\n%s", want, web.Body)
+		}
+	}
+	if strings.Contains(api.Body, "## Dependencies") {
+		t.Errorf("issue without deps should have no checklist:\n%s", api.Body)
+	}
+}
+
+func TestWriteGitHubImport_EmptyIsArray(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteGitHubImport(&buf, nil); err != nil {
+		t.Fatalf("WriteGitHubImport: %v", err)
+	}
+	var decoded []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("invalid JSON %q: %v", buf.String(), err)
+	}
+	if decoded == nil || len(decoded) != 0 {
+		t.Fatalf("expected empty array, got %q", buf.String())
+	}
+}
+
+func TestWriteGitHubImport_LabelsNeverNull(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteGitHubImport(&buf, []model.Issue{{ID: "x", Title: "No labels", Status: model.StatusOpen}}); err != nil {
+		t.Fatalf("WriteGitHubImport: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"labels": []`) {
+		t.Errorf("expected empty labels array, got:\n%s", buf.String())
+	}
+}
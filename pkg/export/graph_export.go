@@ -275,8 +275,11 @@ func generateDOT(issues []model.Issue, issueIDs map[string]bool, stats *analysis
 		escapedID := strings.ReplaceAll(i.ID, "\\", "\\\\")
 		escapedID = strings.ReplaceAll(escapedID, "\"", "\\\"")
 
-		// Status color
+		// Status color, unless the issue sets its own
 		color := dotStatusColor(i.Status)
+		if c := i.DisplayColor(); c != "" {
+			color = c
+		}
 
 		// Label with ID, title, priority
 		label := fmt.Sprintf("%s\\n%s\\nP%d %s", escapedID, title, i.Priority, i.Status)
@@ -317,7 +320,7 @@ func generateDOT(issues []model.Issue, issueIDs map[string]bool, stats *analysis
 
 			style := "dashed"
 			color := "#999999"
-			if dep.Type == model.DepBlocks {
+			if dep.Type.IsBlocking() {
 				style = "bold"
 				color = "#E53935" // Red for blocking
 			}
@@ -423,6 +426,9 @@ func generateMermaid(issues []model.Issue, issueIDs map[string]bool) string {
 			class = "closed"
 		}
 		sb.WriteString(fmt.Sprintf("    class %s %s\n", safeID, class))
+		if c := i.DisplayColor(); c != "" {
+			sb.WriteString(fmt.Sprintf("    style %s fill:%s\n", safeID, c))
+		}
 	}
 
 	sb.WriteString("\n")
@@ -451,7 +457,7 @@ func generateMermaid(issues []model.Issue, issueIDs map[string]bool) string {
 			safeToID := getSafeID(dep.DependsOnID)
 
 			linkStyle := "-.->" // Dashed for related
-			if dep.Type == model.DepBlocks {
+			if dep.Type.IsBlocking() {
 				linkStyle = "==>" // Bold for blockers
 			}
 
@@ -517,7 +523,7 @@ func generateAdjacency(issues []model.Issue, issueIDs map[string]bool, stats *an
 			}
 
 			edgeType := "related"
-			if dep.Type == model.DepBlocks {
+			if dep.Type.IsBlocking() {
 				edgeType = "blocks"
 			}
 
@@ -164,6 +164,42 @@ func TestExportGraph_Mermaid(t *testing.T) {
 	}
 }
 
+func TestExportGraph_ColorOverride(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "bv-1", Title: "Release blocker", Status: model.StatusOpen, Color: "red"},
+		{ID: "bv-2", Title: "Bad color", Status: model.StatusOpen, Color: "not-a-color"},
+	}
+	analyzer := analysis.NewAnalyzer(issues)
+	stats := analyzer.Analyze()
+
+	dot, err := ExportGraph(issues, &stats, GraphExportConfig{Format: GraphFormatDOT})
+	if err != nil {
+		t.Fatalf("ExportGraph DOT failed: %v", err)
+	}
+	if !strings.Contains(dot.Graph, `fillcolor="#e53935"`) {
+		t.Errorf("DOT output should use the issue color:\n%s", dot.Graph)
+	}
+	if strings.Count(dot.Graph, `fillcolor="#C8E6C9"`) != 1 {
+		t.Errorf("invalid color should fall back to the status color:\n%s", dot.Graph)
+	}
+
+	mermaid, err := ExportGraph(issues, &stats, GraphExportConfig{Format: GraphFormatMermaid})
+	if err != nil {
+		t.Fatalf("ExportGraph Mermaid failed: %v", err)
+	}
+	if !strings.Contains(mermaid.Graph, "style bv-1 fill:#e53935") {
+		t.Errorf("Mermaid output should style the colored node:\n%s", mermaid.Graph)
+	}
+	if strings.Contains(mermaid.Graph, "style bv-2") {
+		t.Errorf("invalid color should not emit a style line:\n%s", mermaid.Graph)
+	}
+
+	md := GenerateMermaidGraph(issues, map[string]bool{"bv-1": true, "bv-2": true}, MermaidConfig{})
+	if !strings.Contains(md, "style bv-1 fill:#e53935") {
+		t.Errorf("markdown Mermaid should style the colored node:\n%s", md)
+	}
+}
+
 func TestExportGraph_LabelFilter(t *testing.T) {
 	issues := []model.Issue{
 		{ID: "bv-1", Title: "API Issue", Status: model.StatusOpen, Labels: []string{"api"}},
@@ -221,7 +221,7 @@ func buildLayout(opts GraphSnapshotOptions) layoutResult {
 	var edges []layoutEdge
 	for _, iss := range opts.Issues {
 		for _, dep := range iss.Dependencies {
-			if dep == nil || dep.Type != model.DepBlocks {
+			if dep == nil || !dep.Type.IsBlocking() {
 				continue
 			}
 			if !nodeIDs[dep.DependsOnID] {
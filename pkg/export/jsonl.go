@@ -0,0 +1,43 @@
+package export
+
+import (
+	"errors"
+	"io"
+	"syscall"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// lineFlusher is implemented by buffered writers such as *bufio.Writer.
+type lineFlusher interface {
+	Flush() error
+}
+
+// WriteJSONL writes one issue per line in beads JSONL form. Every line, the
+// last included, ends in "\n", and each line is handed to w in a single Write
+// (and flushed, if w buffers) before the next is encoded, so a streaming
+// reader sees whole records as soon as they are produced.
+func WriteJSONL(w io.Writer, issues []model.Issue) error {
+	flusher, _ := w.(lineFlusher)
+	for i := range issues {
+		line, err := model.MarshalJSONL(issues[i : i+1])
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+		if flusher != nil {
+			if err := flusher.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IsBrokenPipe reports whether err means the reader went away (EPIPE), as
+// when output is piped into `head`.
+func IsBrokenPipe(err error) bool {
+	return errors.Is(err, syscall.EPIPE)
+}
@@ -0,0 +1,75 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// countingWriter records the individual Write calls it receives.
+type countingWriter struct {
+	writes []string
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.writes = append(c.writes, string(p))
+	return len(p), nil
+}
+
+func TestWriteJSONLOneWritePerLine(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "First", Status: model.StatusOpen},
+		{ID: "B", Title: "Second\nline", Status: model.StatusClosed},
+	}
+	var w countingWriter
+	if err := WriteJSONL(&w, issues); err != nil {
+		t.Fatalf("WriteJSONL: %v", err)
+	}
+	if len(w.writes) != 2 {
+		t.Fatalf("got %d writes, want 2: %q", len(w.writes), w.writes)
+	}
+	for i, line := range w.writes {
+		if !strings.HasSuffix(line, "\n") || strings.Count(line, "\n") != 1 {
+			t.Errorf("write %d = %q, want exactly one trailing newline", i, line)
+		}
+		var got model.Issue
+		if err := json.Unmarshal([]byte(line), &got); err != nil || got.ID != issues[i].ID {
+			t.Errorf("write %d decodes to %q (err %v), want %s", i, got.ID, err, issues[i].ID)
+		}
+	}
+}
+
+func TestWriteJSONLFlushesBufferedWriter(t *testing.T) {
+	var out bytes.Buffer
+	bw := bufio.NewWriterSize(&out, 4096)
+	if err := WriteJSONL(bw, []model.Issue{{ID: "A", Title: "First", Status: model.StatusOpen}}); err != nil {
+		t.Fatalf("WriteJSONL: %v", err)
+	}
+	if bw.Buffered() != 0 || !strings.HasSuffix(out.String(), "\n") {
+		t.Errorf("buffered=%d out=%q, want everything flushed and newline-terminated", bw.Buffered(), out.String())
+	}
+}
+
+type failingWriter struct{ err error }
+
+func (f failingWriter) Write([]byte) (int, error) { return 0, f.err }
+
+func TestWriteJSONLBrokenPipe(t *testing.T) {
+	err := WriteJSONL(failingWriter{&os.PathError{Op: "write", Path: "/dev/stdout", Err: syscall.EPIPE}},
+		[]model.Issue{{ID: "A", Title: "First", Status: model.StatusOpen}})
+	if !IsBrokenPipe(err) {
+		t.Errorf("IsBrokenPipe(%v) = false, want true", err)
+	}
+	if IsBrokenPipe(io.ErrShortWrite) || IsBrokenPipe(fmt.Errorf("wrapped: %w", errors.New("other"))) {
+		t.Error("IsBrokenPipe matched a non-EPIPE error")
+	}
+}
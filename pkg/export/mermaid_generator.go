@@ -86,6 +86,9 @@ func GenerateMermaidGraph(issues []model.Issue, issueIDs map[string]bool, config
 			class = "closed"
 		}
 		sb.WriteString(fmt.Sprintf("    class %s %s\n", safeID, class))
+		if c := i.DisplayColor(); c != "" {
+			sb.WriteString(fmt.Sprintf("    style %s fill:%s\n", safeID, c))
+		}
 	}
 
 	sb.WriteString("\n")
@@ -114,7 +117,7 @@ func GenerateMermaidGraph(issues []model.Issue, issueIDs map[string]bool, config
 			safeToID := getSafeID(dep.DependsOnID)
 
 			linkStyle := "-.->" // Dashed for related
-			if dep.Type == model.DepBlocks {
+			if dep.Type.IsBlocking() {
 				linkStyle = "==>" // Bold for blockers
 			}
 
@@ -0,0 +1,161 @@
+package loader_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+)
+
+func TestParseIssuesWithOptions_DetectsJSONArray(t *testing.T) {
+	content := "\xEF\xBB\xBF  \n[\n" +
+		`  {"id":"A","title":"First","status":"open","priority":1,"issue_type":"task"},` + "\n" +
+		`  {"id":"","title":"No ID","status":"open","priority":1,"issue_type":"task"},` + "\n" +
+		`  {"id":"B","title":"Second","status":"closed","priority":2,"issue_type":"bug"}` + "\n]\n"
+
+	var format loader.Format
+	var warnings []string
+	issues, err := loader.ParseIssuesWithOptions(strings.NewReader(content), loader.ParseOptions{
+		FormatHandler:  func(f loader.Format) { format = f },
+		WarningHandler: func(msg string) { warnings = append(warnings, msg) },
+	})
+	if err != nil {
+		t.Fatalf("ParseIssuesWithOptions: %v", err)
+	}
+	if format != loader.FormatJSONArray {
+		t.Errorf("format = %q, want %q", format, loader.FormatJSONArray)
+	}
+	if len(issues) != 2 || issues[0].ID != "A" || issues[1].ID != "B" {
+		t.Fatalf("issues = %+v, want A and B", issues)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "array item 2") {
+		t.Errorf("warnings = %q, want one about array item 2", warnings)
+	}
+}
+
+func TestParseIssuesWithOptions_ReportsJSONL(t *testing.T) {
+	var format loader.Format
+	_, err := loader.ParseIssuesWithOptions(strings.NewReader(`{"id":"A","title":"First","status":"open","priority":1,"issue_type":"task"}`+"\n"),
+		loader.ParseOptions{FormatHandler: func(f loader.Format) { format = f }})
+	if err != nil {
+		t.Fatalf("ParseIssuesWithOptions: %v", err)
+	}
+	if format != loader.FormatJSONL {
+		t.Errorf("format = %q, want %q", format, loader.FormatJSONL)
+	}
+}
+
+func TestParseIssues_MalformedJSONArray(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"truncated", `[{"id":"A","title":"First","status":"open","priority":1,"issue_type":"task"},`, "malformed JSON array"},
+		{"bad syntax", `[{"id":"A",,}]`, "malformed JSON array at item 1"},
+		{"missing bracket", `[{"id":"A","title":"First","status":"open","priority":1,"issue_type":"task"}`, "unexpected end of JSON input"},
+		{"trailing data", `[] {"id":"B"}`, `unexpected data after closing "]"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loader.ParseIssuesWithOptions(strings.NewReader(tt.content), loader.ParseOptions{
+				WarningHandler: func(string) {},
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("err = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindJSONLPath_FallsBackToJSONArrayFile(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "metadata.json"), []byte(`{}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	array := filepath.Join(dir, "issues.json")
+	if err := os.WriteFile(array, []byte(`[{"id":"A","title":"First","status":"open","priority":1,"issue_type":"task"}]`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := loader.FindJSONLPath(dir)
+	if err != nil || path != array {
+		t.Fatalf("FindJSONLPath = %q, %v; want %q", path, err, array)
+	}
+	if format, err := loader.DetectFileFormat(path); err != nil || format != loader.FormatJSONArray {
+		t.Errorf("DetectFileFormat = %q, %v; want json-array", format, err)
+	}
+	issues, err := loader.LoadIssuesFromFile(path)
+	if err != nil || len(issues) != 1 || issues[0].ID != "A" {
+		t.Errorf("LoadIssuesFromFile = %+v, %v", issues, err)
+	}
+
+	// A JSONL file still wins when both exist
+	jsonl := filepath.Join(dir, "beads.jsonl")
+	if err := os.WriteFile(jsonl, []byte(`{"id":"B","title":"Second","status":"open","priority":1,"issue_type":"task"}`+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if path, _ := loader.FindJSONLPath(dir); path != jsonl {
+		t.Errorf("FindJSONLPath = %q, want %q", path, jsonl)
+	}
+}
+
+func TestSetIssuePriority_RejectsJSONArray(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "issues.json")
+	content := `[{"id":"A","title":"First","status":"open","priority":1,"issue_type":"task"}]`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := loader.SetIssuePriority(path, "A", 0); !errors.Is(err, loader.ErrUnsupportedFormat) {
+		t.Errorf("SetIssuePriority = %v, want ErrUnsupportedFormat", err)
+	}
+	if got, _ := os.ReadFile(path); string(got) != content {
+		t.Errorf("file changed: %s", got)
+	}
+}
+
+func TestLoadIssuesFromFile_RecordsSourceLocation(t *testing.T) {
+	dir := t.TempDir()
+	jsonl := filepath.Join(dir, "beads.jsonl")
+	content := `{"id":"A","title":"First","status":"open","priority":1,"issue_type":"task"}` + "\n\n" +
+		"not json\n" +
+		`{"id":"B","title":"Second","status":"open","priority":1,"issue_type":"task"}` + "\n"
+	if err := os.WriteFile(jsonl, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	array := filepath.Join(dir, "issues.json")
+	content = "[\n" +
+		`  {"id":"C","title":"Third","status":"open","priority":1,"issue_type":"task"},` + "\n" +
+		`  {"id":"",` + "\n" + `   "title":"No ID","status":"open","priority":1,"issue_type":"task"},` + "\n" +
+		`  {` + "\n" + `    "id":"D","title":"Fourth","status":"open","priority":1,"issue_type":"task"}` + "\n]\n"
+	if err := os.WriteFile(array, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		path  string
+		lines map[string]int
+	}{
+		{jsonl, map[string]int{"A": 1, "B": 4}},
+		{array, map[string]int{"C": 2, "D": 5}},
+	} {
+		issues, err := loader.LoadIssuesFromFileWithOptions(tt.path, loader.ParseOptions{WarningHandler: func(string) {}})
+		if err != nil {
+			t.Fatalf("load %s: %v", tt.path, err)
+		}
+		if len(issues) != len(tt.lines) {
+			t.Fatalf("%s: got %d issues, want %d", tt.path, len(issues), len(tt.lines))
+		}
+		for _, issue := range issues {
+			if issue.SourcePath != tt.path {
+				t.Errorf("%s: SourcePath = %q, want %q", issue.ID, issue.SourcePath, tt.path)
+			}
+			if issue.SourceLine != tt.lines[issue.ID] {
+				t.Errorf("%s: SourceLine = %d, want %d", issue.ID, issue.SourceLine, tt.lines[issue.ID])
+			}
+		}
+	}
+}
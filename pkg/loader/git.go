@@ -3,8 +3,10 @@ package loader
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os/exec"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +18,7 @@ import (
 type GitLoader struct {
 	repoPath string
 	cache    *revisionCache
+	opts     ParseOptions
 }
 
 // revisionCache caches loaded issues by their resolved commit SHA
@@ -54,6 +57,13 @@ func NewGitLoaderWithCacheTTL(repoPath string, cacheTTL time.Duration) *GitLoade
 	}
 }
 
+// SetParseOptions sets the options historical beads files are parsed
+// with. Set them before loading: revisions already cached keep the issues
+// they were parsed into.
+func (g *GitLoader) SetParseOptions(opts ParseOptions) {
+	g.opts = opts
+}
+
 // LoadAt loads issues from a specific git revision
 // revision can be: SHA, branch name, tag name, HEAD~N, or date expression
 func (g *GitLoader) LoadAt(revision string) ([]model.Issue, error) {
@@ -232,7 +242,7 @@ func (g *GitLoader) loadFileFromGit(sha, path string) ([]model.Issue, error) {
 		return nil, fmt.Errorf("git show %s:%s failed: %w", sha, path, err)
 	}
 
-	return ParseIssues(bytes.NewReader(out))
+	return ParseIssuesWithOptions(bytes.NewReader(out), g.opts)
 }
 
 // Cache methods
@@ -364,6 +374,61 @@ func (g *GitLoader) GetCommitsBetween(fromRev, toRev string) ([]RevisionInfo, er
 	return revisions, nil
 }
 
+// ChangedIssueIDs returns the IDs, sorted, of issues whose lines in the
+// beads file differ between revision and the working tree: issues added,
+// edited or removed since then. Uncommitted edits count, so it answers
+// "what changed on this branch" before the branch is committed.
+func (g *GitLoader) ChangedIssueIDs(revision string) ([]string, error) {
+	sha, err := g.resolveRevision(revision)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{"diff", "--no-color", "--no-ext-diff", "--unified=0", sha, "--"}
+	for _, name := range PreferredJSONLNames {
+		args = append(args, ".beads/"+name)
+	}
+	cmd := exec.Command("git", args...)
+	cmd.Dir = g.repoPath
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff %s failed: %w", sha, err)
+	}
+	return changedIssueIDs(out), nil
+}
+
+// changedIssueIDs extracts the issue IDs from the added and removed lines
+// of a unified diff of JSONL files. Lines that aren't JSON objects with an
+// id are skipped.
+func changedIssueIDs(diff []byte) []string {
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(diff))
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "+++") || strings.HasPrefix(line, "---") {
+			continue
+		}
+		if !strings.HasPrefix(line, "+") && !strings.HasPrefix(line, "-") {
+			continue
+		}
+		var issue struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal([]byte(line[1:]), &issue); err != nil || issue.ID == "" {
+			continue
+		}
+		seen[issue.ID] = true
+	}
+
+	ids := make([]string, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
 // HasBeadsAtRevision checks if beads files exist at a given revision
 func (g *GitLoader) HasBeadsAtRevision(revision string) (bool, error) {
 	sha, err := g.resolveRevision(revision)
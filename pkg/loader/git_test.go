@@ -347,6 +347,33 @@ func TestGitLoader_HasBeadsAtRevision(t *testing.T) {
 	}
 }
 
+func TestGitLoader_ChangedIssueIDs(t *testing.T) {
+	repoDir, cleanup := setupTestGitRepo(t)
+	defer cleanup()
+
+	// Edit ISSUE-1 without committing
+	current := `{"id":"ISSUE-1","title":"First issue","status":"in_progress","priority":1,"issue_type":"task"}
+{"id":"ISSUE-2","title":"Second issue","status":"open","priority":2,"issue_type":"task"}
+{"id":"ISSUE-3","title":"Third issue","status":"open","priority":3,"issue_type":"task"}
+`
+	if err := os.WriteFile(filepath.Join(repoDir, ".beads", "beads.base.jsonl"), []byte(current), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loader := NewGitLoader(repoDir)
+	ids, err := loader.ChangedIssueIDs("HEAD~1")
+	if err != nil {
+		t.Fatalf("ChangedIssueIDs failed: %v", err)
+	}
+	if strings.Join(ids, ",") != "ISSUE-1,ISSUE-3" {
+		t.Errorf("since HEAD~1 = %v, want ISSUE-1, ISSUE-3", ids)
+	}
+
+	if _, err := NewGitLoader(t.TempDir()).ChangedIssueIDs("HEAD"); err == nil {
+		t.Error("expected an error outside a git repo")
+	}
+}
+
 func TestGitLoader_InvalidRevision(t *testing.T) {
 	repoDir, cleanup := setupTestGitRepo(t)
 	defer cleanup()
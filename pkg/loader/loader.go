@@ -4,8 +4,10 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
@@ -19,6 +21,41 @@ const BeadsDirEnvVar = "BEADS_DIR"
 // PreferredJSONLNames defines the priority order for looking up beads data files.
 var PreferredJSONLNames = []string{"issues.jsonl", "beads.jsonl", "beads.base.jsonl"}
 
+// NewIssuesFileName is the issues file bv creates in a beads directory
+// that has none yet.
+const NewIssuesFileName = "beads.jsonl"
+
+// ErrNoIssuesFile is returned (wrapped) by FindJSONLPath when the beads
+// directory exists but holds no issues file or database.
+var ErrNoIssuesFile = errors.New("no beads JSONL file found")
+
+// JSONArrayNames are the data files tried, in order, when a beads directory
+// has no JSONL file: projects that keep their issues as one JSON array.
+var JSONArrayNames = []string{"issues.json", "beads.json"}
+
+// Format is the layout of an issues file, detected from its content.
+type Format string
+
+const (
+	// FormatJSONL is one JSON object per line.
+	FormatJSONL Format = "jsonl"
+	// FormatJSONArray is a single JSON array of issue objects.
+	FormatJSONArray Format = "json-array"
+	// FormatSQLite is a beads SQLite database (see ReadSQLite).
+	FormatSQLite Format = "sqlite"
+)
+
+// String returns a human-readable name for the format.
+func (f Format) String() string {
+	switch f {
+	case FormatJSONArray:
+		return "JSON array"
+	case FormatSQLite:
+		return "SQLite"
+	}
+	return "JSONL"
+}
+
 // GetBeadsDir returns the beads directory path, respecting BEADS_DIR env var.
 // If BEADS_DIR is set, it is used directly.
 // Otherwise, falls back to .beads in the given repoPath (or cwd if empty).
@@ -42,7 +79,8 @@ func GetBeadsDir(repoPath string) (string, error) {
 
 // FindJSONLPath locates the beads JSONL file in the given directory.
 // Prefers issues.jsonl (canonical per beads upstream) over beads.jsonl (backward compat).
-// Skips backup files and merge artifacts.
+// Skips backup files and merge artifacts. A beads.db SQLite database is
+// returned only when there is no issues file and bv was built with cgo.
 func FindJSONLPath(beadsDir string) (string, error) {
 	return FindJSONLPathWithWarnings(beadsDir, nil)
 }
@@ -50,6 +88,26 @@ func FindJSONLPath(beadsDir string) (string, error) {
 // FindJSONLPathWithWarnings is like FindJSONLPath but optionally reports warnings
 // about detected merge artifacts via the provided callback.
 func FindJSONLPathWithWarnings(beadsDir string, warnFunc func(msg string)) (string, error) {
+	path, err := findIssuesFile(beadsDir, warnFunc)
+	if err == nil {
+		if _, err := os.Stat(path); err != nil {
+			return "", err
+		}
+		return path, nil
+	}
+
+	db := filepath.Join(beadsDir, SQLiteName)
+	if info, dbErr := os.Stat(db); dbErr == nil && !info.IsDir() && isSQLiteFile(db) {
+		if !sqliteSupported {
+			return "", fmt.Errorf("%w; %s needs a bv built with cgo", err, db)
+		}
+		return db, nil
+	}
+	return "", err
+}
+
+// findIssuesFile picks the JSONL (or JSON array) file in beadsDir.
+func findIssuesFile(beadsDir string, warnFunc func(msg string)) (string, error) {
 	entries, err := os.ReadDir(beadsDir)
 	if err != nil {
 		return "", fmt.Errorf("failed to read beads directory: %w", err)
@@ -93,7 +151,13 @@ func FindJSONLPathWithWarnings(beadsDir string, warnFunc func(msg string)) (stri
 	}
 
 	if len(candidates) == 0 {
-		return "", fmt.Errorf("no beads JSONL file found in %s", beadsDir)
+		for _, name := range JSONArrayNames {
+			path := filepath.Join(beadsDir, name)
+			if info, err := os.Stat(path); err == nil && !info.IsDir() {
+				return path, nil
+			}
+		}
+		return "", fmt.Errorf("%w in %s", ErrNoIssuesFile, beadsDir)
 	}
 
 	// Priority order for beads files per beads upstream:
@@ -131,6 +195,11 @@ func FindJSONLPathWithWarnings(beadsDir string, warnFunc func(msg string)) (stri
 // Respects BEADS_DIR environment variable, otherwise uses .beads in repoPath.
 // Automatically finds the correct JSONL file (issues.jsonl preferred, beads.jsonl fallback).
 func LoadIssues(repoPath string) ([]model.Issue, error) {
+	return LoadIssuesWithOptions(repoPath, ParseOptions{})
+}
+
+// LoadIssuesWithOptions is LoadIssues with custom parse options.
+func LoadIssuesWithOptions(repoPath string, opts ParseOptions) ([]model.Issue, error) {
 	beadsDir, err := GetBeadsDir(repoPath)
 	if err != nil {
 		return nil, err
@@ -141,7 +210,7 @@ func LoadIssues(repoPath string) ([]model.Issue, error) {
 		return nil, err
 	}
 
-	return LoadIssuesFromFile(jsonlPath)
+	return LoadIssuesFromFileWithOptions(jsonlPath, opts)
 }
 
 // DefaultMaxBufferSize is the default buffer size for the scanner (10MB).
@@ -157,14 +226,40 @@ type ParseOptions struct {
 	// Lines longer than this are skipped with a warning.
 	// If 0, uses DefaultMaxBufferSize (10MB).
 	BufferSize int
+
+	// FormatHandler, if set, is called once with the detected file format
+	// before any issue is parsed.
+	FormatHandler func(Format)
+
+	// DefaultPriority, if set, is given to issues whose priority is
+	// missing or outside 0-4 (see model.Issue.ApplyDefaultPriority).
+	DefaultPriority *int
+
+	// MinutesPerStoryPoint converts story_points to an estimate for issues
+	// without estimated_minutes. If 0, uses
+	// model.DefaultMinutesPerStoryPoint.
+	MinutesPerStoryPoint int
+
+	// Statuses lists statuses accepted beyond the built-in ones (e.g. a
+	// configured "wontfix"), so issues using them load instead of being
+	// skipped.
+	Statuses []model.Status
 }
 
-// LoadIssuesFromFileWithOptions reads issues from a file with custom options.
+// LoadIssuesFromFileWithOptions reads issues from a file with custom
+// options. SQLite databases are recognized by their header and read with
+// ReadSQLite; BufferSize does not apply to them.
 func LoadIssuesFromFileWithOptions(path string, opts ParseOptions) ([]model.Issue, error) {
 	// Check if file exists
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return nil, fmt.Errorf("no beads issues found at %s", path)
 	}
+	if isSQLiteFile(path) {
+		if opts.FormatHandler != nil {
+			opts.FormatHandler(FormatSQLite)
+		}
+		return readSQLite(path, opts)
+	}
 
 	file, err := os.Open(path)
 	if err != nil {
@@ -172,7 +267,32 @@ func LoadIssuesFromFileWithOptions(path string, opts ParseOptions) ([]model.Issu
 	}
 	defer file.Close()
 
-	return ParseIssuesWithOptions(file, opts)
+	issues, err := ParseIssuesWithOptions(file, opts)
+	if err != nil {
+		return nil, err
+	}
+	source := path
+	if abs, err := filepath.Abs(path); err == nil {
+		source = abs
+	}
+	for i := range issues {
+		issues[i].SourcePath = source
+	}
+	return issues, nil
+}
+
+// DetectFileFormat reports the format of an issues file without parsing it.
+func DetectFileFormat(path string) (Format, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to open issues file: %w", err)
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+	if header, _ := reader.Peek(len(sqliteMagic)); bytes.Equal(header, sqliteMagic) {
+		return FormatSQLite, nil
+	}
+	return detectFormat(reader), nil
 }
 
 // LoadIssuesFromFile reads issues directly from a specific JSONL file path.
@@ -180,13 +300,16 @@ func LoadIssuesFromFile(path string) ([]model.Issue, error) {
 	return LoadIssuesFromFileWithOptions(path, ParseOptions{})
 }
 
-// ParseIssues parses JSONL content from a reader into issues.
-// Handles UTF-8 BOM stripping, large lines, and validation.
+// ParseIssues parses issues from a reader: JSONL, or a JSON array when the
+// content starts with "[". Handles UTF-8 BOM stripping, large lines, and
+// validation.
 func ParseIssues(r io.Reader) ([]model.Issue, error) {
 	return ParseIssuesWithOptions(r, ParseOptions{})
 }
 
-// ParseIssuesWithOptions parses JSONL content with custom options.
+// ParseIssuesWithOptions parses issues with custom options. The format is
+// detected from the first non-whitespace byte: "[" means a JSON array,
+// anything else is read line by line as JSONL.
 func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, error) {
 	var issues []model.Issue
 
@@ -198,16 +321,20 @@ func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, erro
 
 	reader := bufio.NewReaderSize(r, maxCapacity)
 
-	// Default warning handler prints to stderr (suppressed in robot mode).
+	if opts.WarningHandler == nil {
+		opts.WarningHandler = defaultWarn()
+	}
 	warn := opts.WarningHandler
-	if warn == nil {
-		if os.Getenv("BV_ROBOT") == "1" {
-			warn = func(string) {}
-		} else {
-			warn = func(msg string) {
-				fmt.Fprintf(os.Stderr, "Warning: %s\n", msg)
-			}
+
+	format := detectFormat(reader)
+	if opts.FormatHandler != nil {
+		opts.FormatHandler(format)
+	}
+	if format == FormatJSONArray {
+		if hasBOM(reader) {
+			_, _ = reader.Discard(3)
 		}
+		return parseJSONArray(reader, opts)
 	}
 
 	lineNum := 0
@@ -248,26 +375,163 @@ func ParseIssuesWithOptions(r io.Reader, opts ParseOptions) ([]model.Issue, erro
 			line = stripBOM(line)
 		}
 
-		var issue model.Issue
+		issue := model.Issue{Priority: priorityUnset}
 		if err := json.Unmarshal(line, &issue); err != nil {
 			// Skip malformed lines but warn
 			warn(fmt.Sprintf("skipping malformed JSON on line %d: %v", lineNum, err))
 			continue
 		}
 
-		// Validate issue
-		if err := issue.Validate(); err != nil {
-			// Skip invalid issues
-			warn(fmt.Sprintf("skipping invalid issue on line %d: %v", lineNum, err))
-			continue
+		if checkIssue(&issue, fmt.Sprintf("line %d", lineNum), opts) {
+			issue.SourceLine = lineNum
+			issues = append(issues, issue)
 		}
+	}
 
-		issues = append(issues, issue)
+	return issues, nil
+}
+
+// parseJSONArray reads a JSON array of issues. Elements that are not valid
+// issues are skipped with a warning; a broken array (bad syntax, missing
+// "]") is an error, since where it breaks can't be recovered from. Each
+// issue's SourceLine is the line its object starts on. opts.WarningHandler
+// must be set.
+func parseJSONArray(r io.Reader, opts ParseOptions) ([]model.Issue, error) {
+	warn := opts.WarningHandler
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading issues stream: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	line, counted := 1, 0
+	lineAt := func(offset int) int {
+		line += bytes.Count(data[counted:offset], []byte("\n"))
+		counted = offset
+		return line
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("malformed JSON array: %w", err)
 	}
 
+	issues := []model.Issue{}
+	for item := 1; dec.More(); item++ {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("malformed JSON array at item %d (byte %d): %w", item, dec.InputOffset(), err)
+		}
+		issue := model.Issue{Priority: priorityUnset}
+		if err := json.Unmarshal(raw, &issue); err != nil {
+			warn(fmt.Sprintf("skipping malformed issue at array item %d: %v", item, err))
+			continue
+		}
+		if checkIssue(&issue, fmt.Sprintf("array item %d", item), opts) {
+			issue.SourceLine = lineAt(int(dec.InputOffset()) - len(raw))
+			issues = append(issues, issue)
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("malformed JSON array: missing closing \"]\": %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("malformed JSON array: unexpected data after closing \"]\"")
+	}
 	return issues, nil
 }
 
+// defaultWarn prints warnings to stderr, or discards them in robot mode.
+func defaultWarn() func(string) {
+	if os.Getenv("BV_ROBOT") == "1" {
+		return func(string) {}
+	}
+	return func(msg string) {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", msg)
+	}
+}
+
+// priorityUnset is decoded into so a record without a priority (or with a
+// null one) can be told apart from one at P0.
+const priorityUnset = math.MinInt
+
+// checkIssue validates a parsed issue and normalizes its dependencies,
+// warning about problems found at the given location ("line 3"). Issues
+// with a missing or invalid priority get opts.DefaultPriority, and
+// warnings go to opts.WarningHandler, which must be set. Returns false if
+// the issue is invalid and should be skipped.
+func checkIssue(issue *model.Issue, where string, opts ParseOptions) bool {
+	warn := opts.WarningHandler
+	missing := issue.Priority == priorityUnset
+	if missing {
+		issue.Priority = 0
+		issue.PriorityMissing = true
+	}
+	issue.ApplyDefaultPriority(opts.DefaultPriority, missing)
+
+	for _, verr := range issue.Validate(opts.Statuses...) {
+		if !verr.Warning {
+			warn(fmt.Sprintf("skipping invalid issue on %s: %v", where, verr))
+			return false
+		}
+	}
+
+	if issue.ApplyEstimateAlias(opts.MinutesPerStoryPoint) {
+		warn(fmt.Sprintf("both estimated_minutes and story_points set on %s: %s (using estimated_minutes)", where, issue.ID))
+	}
+
+	// Unknown dependency types load as non-blocking edges
+	for _, dep := range issue.Dependencies {
+		if dep != nil && dep.Type != "" && !dep.Type.IsValid() {
+			warn(fmt.Sprintf("unknown dependency type %q on %s: %s -> %s (treated as non-blocking)",
+				dep.Type, where, issue.ID, dep.DependsOnID))
+		}
+	}
+
+	// Collapse repeated dependency targets so graph counts stay accurate
+	for _, d := range issue.DedupeDependencies() {
+		warn(fmt.Sprintf("merged %d duplicate dependencies on %s: %s -> %s (%s)",
+			d.Count, where, d.IssueID, d.DependsOnID, joinDepTypes(d.Types)))
+	}
+	return true
+}
+
+// detectFormat peeks past a UTF-8 BOM and leading whitespace without
+// consuming input. A leading "[" means a JSON array; anything else,
+// including empty input, is JSONL.
+func detectFormat(r *bufio.Reader) Format {
+	start := 1
+	if hasBOM(r) {
+		start = 4
+	}
+	for n := start; ; n++ {
+		b, err := r.Peek(n)
+		if len(b) < n || err != nil {
+			return FormatJSONL
+		}
+		switch b[n-1] {
+		case ' ', '\t', '\r', '\n':
+			continue
+		case '[':
+			return FormatJSONArray
+		default:
+			return FormatJSONL
+		}
+	}
+}
+
+// joinDepTypes formats dependency types as "blocks, related"
+func joinDepTypes(types []model.DependencyType) string {
+	parts := make([]string, len(types))
+	for i, t := range types {
+		parts[i] = string(t)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// hasBOM reports whether the reader's next bytes are a UTF-8 BOM.
+func hasBOM(r *bufio.Reader) bool {
+	b, err := r.Peek(3)
+	return err == nil && bytes.Equal(b, []byte{0xEF, 0xBB, 0xBF})
+}
+
 // stripBOM removes the UTF-8 Byte Order Mark if present
 func stripBOM(b []byte) []byte {
 	if bytes.HasPrefix(b, []byte{0xEF, 0xBB, 0xBF}) {
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
 func TestParseIssuesWithOptions_LineTooLong(t *testing.T) {
@@ -51,3 +52,156 @@ func TestParseIssuesWithOptions_LineTooLong(t *testing.T) {
 		t.Errorf("Expected warning containing %q, got: %v", expectedWarning, warnings)
 	}
 }
+
+func TestParseIssuesWithOptions_DedupesDependencies(t *testing.T) {
+	input := `{"id":"a","title":"A","status":"open","issue_type":"task","dependencies":[` +
+		`{"issue_id":"a","depends_on_id":"b","type":"related"},` +
+		`{"issue_id":"a","depends_on_id":"c","type":"blocks"},` +
+		`{"issue_id":"a","depends_on_id":"b","type":"blocks"},` +
+		`{"issue_id":"a","depends_on_id":"c","type":"blocks"}]}`
+
+	var warnings []string
+	issues, err := loader.ParseIssuesWithOptions(strings.NewReader(input), loader.ParseOptions{
+		WarningHandler: func(msg string) { warnings = append(warnings, msg) },
+	})
+	if err != nil {
+		t.Fatalf("ParseIssuesWithOptions: %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(issues))
+	}
+
+	deps := issues[0].Dependencies
+	if len(deps) != 2 {
+		t.Fatalf("expected 2 deduped dependencies, got %d", len(deps))
+	}
+	if deps[0].DependsOnID != "b" || deps[0].Type != "blocks" || len(deps[0].Types) != 2 {
+		t.Errorf("a->b = %+v, want blocking edge with merged types", deps[0])
+	}
+	if deps[1].DependsOnID != "c" || deps[1].Types != nil {
+		t.Errorf("a->c = %+v, want single-type edge", deps[1])
+	}
+
+	if len(warnings) != 2 ||
+		!strings.Contains(warnings[0], "a -> b (related, blocks)") ||
+		!strings.Contains(warnings[1], "a -> c (blocks)") {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
+
+func TestParseIssuesWithOptions_WarnsOnUnknownDependencyType(t *testing.T) {
+	input := `{"id":"a","title":"A","status":"open","issue_type":"task","dependencies":[` +
+		`{"issue_id":"a","depends_on_id":"b","type":"duplicate"},` +
+		`{"issue_id":"a","depends_on_id":"c","type":"causes"}]}`
+
+	var warnings []string
+	issues, err := loader.ParseIssuesWithOptions(strings.NewReader(input), loader.ParseOptions{
+		WarningHandler: func(msg string) { warnings = append(warnings, msg) },
+	})
+	if err != nil {
+		t.Fatalf("ParseIssuesWithOptions: %v", err)
+	}
+	if len(issues) != 1 || len(issues[0].Dependencies) != 2 {
+		t.Fatalf("expected the issue to load with both dependencies, got %+v", issues)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `unknown dependency type "causes"`) ||
+		!strings.Contains(warnings[0], "a -> c") {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
+
+func TestParseIssues_DefaultPriority(t *testing.T) {
+	input := `{"id":"a","title":"A","status":"open","issue_type":"task"}` + "\n" +
+		`{"id":"b","title":"B","status":"open","issue_type":"task","priority":0}` + "\n" +
+		`{"id":"c","title":"C","status":"open","issue_type":"task","priority":9}` + "\n" +
+		`{"id":"d","title":"D","status":"open","issue_type":"task","priority":null}` + "\n"
+
+	// Without a default, a missing priority reads as P0 as before
+	issues, err := loader.ParseIssues(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseIssues: %v", err)
+	}
+	if issues[0].Priority != 0 || issues[0].PriorityDefaulted {
+		t.Errorf("a without default = P%d (defaulted %v), want P0", issues[0].Priority, issues[0].PriorityDefaulted)
+	}
+	// --validate still flags it
+	if errs := issues[0].Validate(); len(errs) != 1 || !strings.Contains(errs[0].Message, "missing priority (read as P0)") {
+		t.Errorf("missing priority without a default should be flagged, got %v", errs)
+	}
+	if errs := issues[1].Validate(); len(errs) != 0 {
+		t.Errorf("explicit P0 should not be flagged, got %v", errs)
+	}
+
+	backlog := 4
+	issues, err = loader.ParseIssuesWithOptions(strings.NewReader(input), loader.ParseOptions{DefaultPriority: &backlog})
+	if err != nil {
+		t.Fatalf("ParseIssuesWithOptions: %v", err)
+	}
+	want := map[string]int{"a": 4, "b": 0, "c": 4, "d": 4}
+	for _, issue := range issues {
+		if issue.Priority != want[issue.ID] {
+			t.Errorf("%s priority = P%d, want P%d", issue.ID, issue.Priority, want[issue.ID])
+		}
+		if defaulted := issue.ID != "b"; issue.PriorityDefaulted != defaulted {
+			t.Errorf("%s PriorityDefaulted = %v, want %v", issue.ID, issue.PriorityDefaulted, defaulted)
+		}
+	}
+	if errs := issues[0].Validate(); len(errs) != 1 || !strings.Contains(errs[0].Message, "using default P4") {
+		t.Errorf("defaulted issue should be flagged, got %v", errs)
+	}
+}
+
+func TestParseIssuesWithOptions_Statuses(t *testing.T) {
+	input := `{"id":"a","title":"A","status":"wontfix","issue_type":"task"}` + "\n" +
+		`{"id":"b","title":"B","status":"icebox","issue_type":"task"}` + "\n"
+
+	var warnings []string
+	issues, err := loader.ParseIssuesWithOptions(strings.NewReader(input), loader.ParseOptions{
+		WarningHandler: func(msg string) { warnings = append(warnings, msg) },
+		Statuses:       []model.Status{"wontfix"},
+	})
+	if err != nil {
+		t.Fatalf("ParseIssuesWithOptions: %v", err)
+	}
+	if len(issues) != 1 || issues[0].ID != "a" {
+		t.Fatalf("issues = %+v, want only a (an allowed status)", issues)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "invalid status: icebox") {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
+
+func TestParseIssuesWithOptions_StoryPointsAlias(t *testing.T) {
+	input := `{"id":"a","title":"A","status":"open","issue_type":"task","story_points":5}` + "\n" +
+		`{"id":"b","title":"B","status":"open","issue_type":"task","story_points":3,"estimated_minutes":90}` + "\n"
+
+	var warnings []string
+	issues, err := loader.ParseIssuesWithOptions(strings.NewReader(input), loader.ParseOptions{
+		WarningHandler: func(msg string) { warnings = append(warnings, msg) },
+	})
+	if err != nil {
+		t.Fatalf("ParseIssuesWithOptions: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues, got %d", len(issues))
+	}
+	if e := issues[0].EstimatedMinutes; e == nil || *e != 5*model.DefaultMinutesPerStoryPoint {
+		t.Errorf("a estimate = %v, want 5 story_points in minutes", e)
+	}
+	if e := issues[1].EstimatedMinutes; e == nil || *e != 90 {
+		t.Errorf("b estimate = %v, want estimated_minutes 90 kept", e)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "line 2: b (using estimated_minutes)") {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+	issues, err = loader.ParseIssuesWithOptions(strings.NewReader(input), loader.ParseOptions{
+		WarningHandler:       func(string) {},
+		MinutesPerStoryPoint: 30,
+	})
+	if err != nil {
+		t.Fatalf("ParseIssuesWithOptions: %v", err)
+	}
+	if e := issues[0].EstimatedMinutes; e == nil || *e != 150 {
+		t.Errorf("a estimate = %v, want 150 (5 points at 30 minutes)", e)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 )
 
 // =============================================================================
@@ -599,6 +601,55 @@ func TestLoadIssuesFromFile_Unicode(t *testing.T) {
 	}
 }
 
+func TestLoadIssuesFromFile_MarshalJSONLRoundTrip(t *testing.T) {
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	titles := []string{
+		`Say "hello" to the parser`,
+		`C:\path\to\file and a trailing \`,
+		"First line\nsecond line\r\nthird",
+		"Emoji 🐛, CJK 中文, RTL عربي",
+		`{"id":"fake","title":"injected"}`,
+	}
+	var want []model.Issue
+	for i, title := range titles {
+		want = append(want, model.Issue{
+			ID:          fmt.Sprintf("rt-%d", i+1),
+			Title:       title,
+			Description: title + "\n\t" + title,
+			Status:      model.StatusOpen,
+			IssueType:   model.TypeTask,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+	}
+
+	data, err := model.MarshalJSONL(want)
+	if err != nil {
+		t.Fatalf("MarshalJSONL: %v", err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != len(want) {
+		t.Fatalf("expected %d lines, got %d:\n%s", len(want), lines, data)
+	}
+	path := filepath.Join(t.TempDir(), "roundtrip.jsonl")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := loader.LoadIssuesFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadIssuesFromFile: %v", err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d issues, got %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i].ID != want[i].ID || got[i].Title != want[i].Title || got[i].Description != want[i].Description {
+			t.Errorf("issue %d = {%q %q %q}, want {%q %q %q}", i,
+				got[i].ID, got[i].Title, got[i].Description, want[i].ID, want[i].Title, want[i].Description)
+		}
+	}
+}
+
 func TestLoadIssuesFromFile_LargeLine(t *testing.T) {
 	dir := t.TempDir()
 	path := filepath.Join(dir, "large.jsonl")
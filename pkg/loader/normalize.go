@@ -0,0 +1,312 @@
+package loader
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// NormalizeSummary describes what NormalizeFile changed, or would change.
+type NormalizeSummary struct {
+	Path           string `json:"path"`
+	Records        int    `json:"records"`
+	Sorted         bool   `json:"sorted"`          // Records were moved into ID order
+	Reformatted    int    `json:"reformatted"`     // Records whose field order or spacing changed
+	DuplicateEdges int    `json:"duplicate_edges"` // Dependency entries merged into an earlier one
+	SelfEdges      int    `json:"self_edges"`      // Dependencies of an issue on itself, dropped
+	Changed        bool   `json:"changed"`         // The normalized file differs from the original
+}
+
+// NormalizeFile rewrites a beads JSONL file into a stable, diff-friendly
+// form: records sorted by ID, one per line, compact, with their fields in
+// the order bv writes them (unknown fields follow, alphabetically, and are
+// kept). Dependency entries that repeat a target are merged the way the
+// loader merges them, and self-dependencies are dropped. Line endings
+// become "\n" and a leading BOM is removed. With write false, or when the
+// file is already normalized, nothing is written. A line that is not a
+// JSON object is an error, since normalizing must not lose data.
+func NormalizeFile(path string, write bool) (NormalizeSummary, error) {
+	summary := NormalizeSummary{Path: path}
+	if isSQLiteFile(path) {
+		return summary, fmt.Errorf("%w: %s is a SQLite database", ErrUnsupportedFormat, path)
+	}
+	original, err := os.ReadFile(path)
+	if err != nil {
+		return summary, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return summary, err
+	}
+	if detectFormat(bufio.NewReader(bytes.NewReader(original))) != FormatJSONL {
+		return summary, fmt.Errorf("%w: %s is a JSON array", ErrUnsupportedFormat, path)
+	}
+
+	type record struct {
+		id   string
+		line []byte
+	}
+	var records []record
+	reader := bufio.NewReader(bytes.NewReader(original))
+	for lineNum := 1; ; lineNum++ {
+		raw, readErr := reader.ReadBytes('\n')
+		content, _ := splitLineEnding(raw)
+		content = bytes.TrimSpace(stripBOM(content))
+		if len(content) > 0 {
+			id, line, err := normalizeRecord(content, &summary)
+			if err != nil {
+				return summary, fmt.Errorf("%s line %d: %w", path, lineNum, err)
+			}
+			if !bytes.Equal(line, content) {
+				summary.Reformatted++
+			}
+			records = append(records, record{id, line})
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			return summary, readErr
+		}
+	}
+	summary.Records = len(records)
+
+	sorted := sort.SliceIsSorted(records, func(i, j int) bool { return records[i].id < records[j].id })
+	if !sorted {
+		sort.SliceStable(records, func(i, j int) bool { return records[i].id < records[j].id })
+		summary.Sorted = true
+	}
+
+	var out bytes.Buffer
+	for _, r := range records {
+		out.Write(r.line)
+		out.WriteByte('\n')
+	}
+	summary.Changed = !bytes.Equal(out.Bytes(), original)
+	if !summary.Changed || !write {
+		return summary, nil
+	}
+	return summary, writeFileAtomic(path, out.Bytes(), info.Mode().Perm())
+}
+
+// Canonical key orders, taken from the JSON tags of the model types.
+var (
+	issueKeyOrder      = jsonKeyOrder(reflect.TypeOf(model.Issue{}))
+	dependencyKeyOrder = jsonKeyOrder(reflect.TypeOf(model.Dependency{}))
+)
+
+// member is one key/value pair of a JSON object, in source order.
+type member struct {
+	key   string
+	value json.RawMessage
+}
+
+// normalizeRecord returns a record's ID and its canonical encoding,
+// counting the dependency fixes in summary.
+func normalizeRecord(content []byte, summary *NormalizeSummary) (string, []byte, error) {
+	members, err := decodeMembers(content)
+	if err != nil {
+		return "", nil, err
+	}
+	var id string
+	for _, m := range members {
+		if m.key == "id" {
+			_ = json.Unmarshal(m.value, &id)
+		}
+	}
+	for i, m := range members {
+		if m.key != "dependencies" {
+			continue
+		}
+		deps, err := normalizeDependencies(id, m.value, summary)
+		if err != nil {
+			return "", nil, fmt.Errorf("dependencies: %w", err)
+		}
+		members[i].value = deps
+	}
+	line, err := encodeMembers(members, issueKeyOrder)
+	return id, line, err
+}
+
+// normalizeDependencies drops self-dependencies and merges entries that
+// name the same target, as model.Issue.DedupeDependencies does: the first
+// entry is kept and becomes blocking if any duplicate was. A value that is
+// not an array of objects is left alone.
+func normalizeDependencies(id string, raw json.RawMessage, summary *NormalizeSummary) (json.RawMessage, error) {
+	var entries []json.RawMessage
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return compactJSON(raw)
+	}
+
+	type dep struct {
+		members []member
+		target  string
+		depType model.DependencyType
+	}
+	var kept []*dep
+	byTarget := make(map[string]*dep)
+	for _, entry := range entries {
+		members, err := decodeMembers(entry)
+		if err != nil {
+			return compactJSON(raw)
+		}
+		d := &dep{members: members}
+		for _, m := range members {
+			switch m.key {
+			case "depends_on_id":
+				_ = json.Unmarshal(m.value, &d.target)
+			case "type":
+				_ = json.Unmarshal(m.value, &d.depType)
+			}
+		}
+		if d.target != "" && d.target == id {
+			summary.SelfEdges++
+			continue
+		}
+		if first, ok := byTarget[d.target]; ok && d.target != "" {
+			summary.DuplicateEdges++
+			if d.depType.IsBlocking() && !first.depType.IsBlocking() {
+				first.depType = model.DepBlocks
+				setMember(&first.members, "type", json.RawMessage(`"blocks"`))
+			}
+			continue
+		}
+		byTarget[d.target] = d
+		kept = append(kept, d)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, d := range kept {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		obj, err := encodeMembers(d.members, dependencyKeyOrder)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(obj)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// decodeMembers splits a JSON object into its members, in source order.
+func decodeMembers(obj []byte) ([]member, error) {
+	dec := json.NewDecoder(bytes.NewReader(obj))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, errors.New("record is not a JSON object")
+	}
+	var members []member
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, errors.New("invalid object key")
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+		members = append(members, member{key, raw})
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("trailing data after record")
+	}
+	return members, nil
+}
+
+// encodeMembers writes members as a compact object: keys in order first,
+// then any others alphabetically. A repeated key keeps its last value, as
+// encoding/json would when decoding.
+func encodeMembers(members []member, order []string) ([]byte, error) {
+	values := make(map[string]json.RawMessage, len(members))
+	var keys []string
+	for _, m := range members {
+		if _, seen := values[m.key]; !seen {
+			keys = append(keys, m.key)
+		}
+		values[m.key] = m.value
+	}
+	rank := make(map[string]int, len(order))
+	for i, k := range order {
+		rank[k] = i
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		ri, iok := rank[keys[i]]
+		rj, jok := rank[keys[j]]
+		switch {
+		case iok && jok:
+			return ri < rj
+		case iok != jok:
+			return iok
+		}
+		return keys[i] < keys[j]
+	})
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(k)
+		buf.Write(name)
+		buf.WriteByte(':')
+		value, err := compactJSON(values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// setMember replaces the value of key, or appends it.
+func setMember(members *[]member, key string, value json.RawMessage) {
+	for i := range *members {
+		if (*members)[i].key == key {
+			(*members)[i].value = value
+			return
+		}
+	}
+	*members = append(*members, member{key, value})
+}
+
+// compactJSON removes insignificant whitespace from a JSON value.
+func compactJSON(raw json.RawMessage) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// jsonKeyOrder lists the JSON names of a struct's serialized fields in
+// declaration order.
+func jsonKeyOrder(t reflect.Type) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			keys = append(keys, name)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,82 @@
+package loader_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+)
+
+func TestNormalizeFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.jsonl")
+	input := "\ufeff" + `{"status":"open", "title":"Second","id":"B","x_custom":1,"issue_type":"task","dependencies":[{"type":"related","depends_on_id":"A"},{"depends_on_id":"A","type":"blocks"},{"depends_on_id":"B","type":"blocks"}]}` + "\r\n" +
+		"\n" +
+		`{"id":"A","title":"First","status":"open","issue_type":"task"}` + "\n"
+	if err := os.WriteFile(path, []byte(input), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Preview leaves the file alone
+	preview, err := loader.NormalizeFile(path, false)
+	if err != nil {
+		t.Fatalf("NormalizeFile preview: %v", err)
+	}
+	if !preview.Changed {
+		t.Fatal("expected preview to report changes")
+	}
+	if data, _ := os.ReadFile(path); string(data) != input {
+		t.Error("preview must not write")
+	}
+
+	summary, err := loader.NormalizeFile(path, true)
+	if err != nil {
+		t.Fatalf("NormalizeFile: %v", err)
+	}
+	want := loader.NormalizeSummary{
+		Path: path, Records: 2, Sorted: true, Reformatted: 1,
+		DuplicateEdges: 1, SelfEdges: 1, Changed: true,
+	}
+	if summary != want {
+		t.Errorf("summary = %+v, want %+v", summary, want)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantFile := `{"id":"A","title":"First","status":"open","issue_type":"task"}` + "\n" +
+		`{"id":"B","title":"Second","status":"open","issue_type":"task","dependencies":[{"depends_on_id":"A","type":"blocks"}],"x_custom":1}` + "\n"
+	if string(data) != wantFile {
+		t.Errorf("normalized file:\n%s\nwant:\n%s", data, wantFile)
+	}
+
+	// Normalizing again is a no-op
+	again, err := loader.NormalizeFile(path, true)
+	if err != nil {
+		t.Fatalf("second NormalizeFile: %v", err)
+	}
+	if again.Changed || again.Reformatted != 0 || again.Sorted {
+		t.Errorf("second pass changed the file: %+v", again)
+	}
+}
+
+func TestNormalizeFileRejectsBadRecords(t *testing.T) {
+	dir := t.TempDir()
+	bad := filepath.Join(dir, "bad.jsonl")
+	if err := os.WriteFile(bad, []byte(`{"id":"A"}`+"\n"+`{"id":`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loader.NormalizeFile(bad, true); err == nil {
+		t.Error("expected an error for a malformed line")
+	}
+
+	array := filepath.Join(dir, "array.jsonl")
+	if err := os.WriteFile(array, []byte(`[{"id":"A"}]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loader.NormalizeFile(array, true); !errors.Is(err, loader.ErrUnsupportedFormat) {
+		t.Errorf("JSON array: err = %v, want ErrUnsupportedFormat", err)
+	}
+}
@@ -0,0 +1,331 @@
+package loader
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// SQLiteName is the database file of beads backends that keep issues in
+// SQLite rather than JSONL.
+const SQLiteName = "beads.db"
+
+// sqliteMagic starts every SQLite 3 database file.
+var sqliteMagic = []byte("SQLite format 3\x00")
+
+// ReadSQLite reads issues from a beads SQLite database. Columns are mapped
+// by name, so databases from older or newer beads versions load as long as
+// the issues table has an id column; labels, dependencies and comments are
+// read from their tables when present. Deleted issues (tombstones) are
+// skipped, and records that fail validation are skipped with a warning, as
+// in JSONL files.
+func ReadSQLite(path string) ([]model.Issue, error) {
+	return readSQLite(path, ParseOptions{})
+}
+
+func readSQLite(path string, opts ParseOptions) ([]model.Issue, error) {
+	if opts.WarningHandler == nil {
+		opts.WarningHandler = defaultWarn()
+	}
+	if !sqliteSupported {
+		return nil, fmt.Errorf("reading %s: SQLite databases need a bv built with cgo", path)
+	}
+	source := path
+	if abs, err := filepath.Abs(path); err == nil {
+		source = abs
+	}
+	if _, err := os.Stat(source); err != nil {
+		return nil, fmt.Errorf("no beads issues found at %s", path)
+	}
+	uriPath := strings.NewReplacer("%", "%25", "?", "%3f", "#", "%23").Replace(filepath.ToSlash(source))
+	db, err := sql.Open("sqlite3", "file:"+uriPath+"?mode=ro")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open issues database: %w", err)
+	}
+	defer db.Close()
+
+	cols, err := tableColumns(db, "issues")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	if !cols["id"] {
+		return nil, fmt.Errorf("reading %s: no issues table with an id column", path)
+	}
+
+	// Column -> setter, for the columns this database has
+	issueColumns := []struct {
+		name string
+		set  func(*model.Issue, any)
+	}{
+		{"id", func(i *model.Issue, v any) { i.ID = sqlString(v) }},
+		{"title", func(i *model.Issue, v any) { i.Title = sqlString(v) }},
+		{"description", func(i *model.Issue, v any) { i.Description = sqlString(v) }},
+		{"design", func(i *model.Issue, v any) { i.Design = sqlString(v) }},
+		{"acceptance_criteria", func(i *model.Issue, v any) { i.AcceptanceCriteria = sqlString(v) }},
+		{"notes", func(i *model.Issue, v any) { i.Notes = sqlString(v) }},
+		{"status", func(i *model.Issue, v any) { i.Status = model.Status(sqlString(v)) }},
+		{"priority", func(i *model.Issue, v any) { i.Priority, _ = sqlInt(v) }},
+		{"issue_type", func(i *model.Issue, v any) { i.IssueType = model.IssueType(sqlString(v)) }},
+		{"assignee", func(i *model.Issue, v any) { i.Assignee = sqlString(v) }},
+		{"estimated_minutes", func(i *model.Issue, v any) {
+			if n, ok := sqlInt(v); ok {
+				i.EstimatedMinutes = &n
+			}
+		}},
+		{"created_at", func(i *model.Issue, v any) { i.CreatedAt, _ = sqlTime(v) }},
+		{"updated_at", func(i *model.Issue, v any) { i.UpdatedAt, _ = sqlTime(v) }},
+		{"due_date", func(i *model.Issue, v any) { i.DueDate = sqlTimePtr(v) }},
+		{"closed_at", func(i *model.Issue, v any) { i.ClosedAt = sqlTimePtr(v) }},
+		{"close_reason", func(i *model.Issue, v any) { i.CloseReason = sqlString(v) }},
+		{"resolution", func(i *model.Issue, v any) { i.Resolution = sqlString(v) }},
+		{"external_ref", func(i *model.Issue, v any) {
+			if s := sqlString(v); s != "" {
+				i.ExternalRef = &s
+			}
+		}},
+		{"compaction_level", func(i *model.Issue, v any) { i.CompactionLevel, _ = sqlInt(v) }},
+		{"compacted_at", func(i *model.Issue, v any) { i.CompactedAt = sqlTimePtr(v) }},
+		{"compacted_at_commit", func(i *model.Issue, v any) {
+			if s := sqlString(v); s != "" {
+				i.CompactedAtCommit = &s
+			}
+		}},
+		{"original_size", func(i *model.Issue, v any) { i.OriginalSize, _ = sqlInt(v) }},
+		{"source_repo", func(i *model.Issue, v any) { i.SourceRepo = sqlString(v) }},
+	}
+	var names []string
+	var setters []func(*model.Issue, any)
+	for _, c := range issueColumns {
+		if cols[c.name] {
+			names = append(names, c.name)
+			setters = append(setters, c.set)
+		}
+	}
+	where := ""
+	if cols["deleted_at"] {
+		where = " WHERE deleted_at IS NULL"
+	}
+
+	rows, err := db.Query("SELECT " + strings.Join(names, ", ") + " FROM issues" + where + " ORDER BY id")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	var raw []model.Issue
+	values := make([]any, len(names))
+	ptrs := make([]any, len(names))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(ptrs...); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		var issue model.Issue
+		for i, set := range setters {
+			set(&issue, values[i])
+		}
+		if issue.Status == "tombstone" {
+			continue
+		}
+		raw = append(raw, issue)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	index := make(map[string]int, len(raw))
+	for i := range raw {
+		index[raw[i].ID] = i
+	}
+	if err := readSQLiteRelations(db, raw, index); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	issues := make([]model.Issue, 0, len(raw))
+	for _, issue := range raw {
+		if checkIssue(&issue, "row "+strconv.Quote(issue.ID), opts) {
+			issue.SourcePath = source
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
+}
+
+// readSQLiteRelations attaches labels, dependencies and comments to the
+// issues, reading each table only if the database has it.
+func readSQLiteRelations(db *sql.DB, issues []model.Issue, index map[string]int) error {
+	relations := []struct {
+		table   string
+		columns []string // issue_id first
+		order   string   // Sort within an issue
+		add     func(issue *model.Issue, v []any)
+	}{
+		{"labels", []string{"issue_id", "label"}, "label", func(issue *model.Issue, v []any) {
+			issue.Labels = append(issue.Labels, sqlString(v[1]))
+		}},
+		{"dependencies", []string{"issue_id", "depends_on_id", "type", "created_at", "created_by"}, "depends_on_id", func(issue *model.Issue, v []any) {
+			dep := &model.Dependency{
+				IssueID:     issue.ID,
+				DependsOnID: sqlString(v[1]),
+				Type:        model.DependencyType(sqlString(v[2])),
+				CreatedBy:   sqlString(v[4]),
+			}
+			dep.CreatedAt, _ = sqlTime(v[3])
+			issue.Dependencies = append(issue.Dependencies, dep)
+		}},
+		{"comments", []string{"issue_id", "id", "author", "text", "created_at"}, "id", func(issue *model.Issue, v []any) {
+			c := &model.Comment{IssueID: issue.ID, Author: sqlString(v[2]), Text: sqlString(v[3])}
+			if id, ok := sqlInt(v[1]); ok {
+				c.ID = int64(id)
+			}
+			c.CreatedAt, _ = sqlTime(v[4])
+			issue.Comments = append(issue.Comments, c)
+		}},
+	}
+
+	for _, rel := range relations {
+		cols, err := tableColumns(db, rel.table)
+		if err != nil {
+			return err
+		}
+		if !cols["issue_id"] {
+			continue
+		}
+		order := "issue_id"
+		if cols[rel.order] {
+			order += ", " + rel.order
+		}
+		// Missing optional columns read as NULL
+		selects := make([]string, len(rel.columns))
+		for i, c := range rel.columns {
+			selects[i] = "NULL"
+			if cols[c] {
+				selects[i] = c
+			}
+		}
+		rows, err := db.Query("SELECT " + strings.Join(selects, ", ") + " FROM " + rel.table + " ORDER BY " + order)
+		if err != nil {
+			return err
+		}
+		values := make([]any, len(rel.columns))
+		ptrs := make([]any, len(values))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		for rows.Next() {
+			if err := rows.Scan(ptrs...); err != nil {
+				rows.Close()
+				return err
+			}
+			if i, ok := index[sqlString(values[0])]; ok {
+				rel.add(&issues[i], values)
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tableColumns returns the column names of table, or an empty set if the
+// table does not exist.
+func tableColumns(db *sql.DB, table string) (map[string]bool, error) {
+	rows, err := db.Query("SELECT name FROM pragma_table_info(?)", table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	cols := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		cols[strings.ToLower(name)] = true
+	}
+	return cols, rows.Err()
+}
+
+// isSQLiteFile reports whether the file at path is a SQLite database.
+func isSQLiteFile(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	header := make([]byte, len(sqliteMagic))
+	n, _ := f.Read(header)
+	return bytes.Equal(header[:n], sqliteMagic)
+}
+
+func sqlString(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case []byte:
+		return string(x)
+	case time.Time:
+		return x.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(x)
+	}
+}
+
+func sqlInt(v any) (int, bool) {
+	switch x := v.(type) {
+	case int64:
+		return int(x), true
+	case float64:
+		return int(x), true
+	case nil:
+		return 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(sqlString(v)))
+	return n, err == nil
+}
+
+// sqliteTimeLayouts are the text timestamp forms beads databases use.
+var sqliteTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+func sqlTime(v any) (time.Time, bool) {
+	if t, ok := v.(time.Time); ok {
+		return t, !t.IsZero()
+	}
+	s := strings.TrimSpace(sqlString(v))
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range sqliteTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func sqlTimePtr(v any) *time.Time {
+	if t, ok := sqlTime(v); ok {
+		return &t
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+//go:build cgo
+
+package loader
+
+import _ "github.com/mattn/go-sqlite3"
+
+// sqliteSupported reports whether this build can open SQLite databases;
+// the go-sqlite3 driver needs cgo.
+const sqliteSupported = true
@@ -0,0 +1,7 @@
+//go:build !cgo
+
+package loader
+
+// sqliteSupported reports whether this build can open SQLite databases;
+// the go-sqlite3 driver needs cgo, so CGO_ENABLED=0 builds read JSONL only.
+const sqliteSupported = false
@@ -0,0 +1,133 @@
+//go:build cgo
+
+package loader_test
+
+import (
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// createBeadsDB writes a database with the beads SQLite schema (trimmed to
+// the columns bv reads, plus one it ignores).
+func createBeadsDB(t *testing.T, path string) {
+	t.Helper()
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	stmts := []string{
+		`CREATE TABLE issues (id TEXT PRIMARY KEY, content_hash TEXT, title TEXT NOT NULL, description TEXT NOT NULL DEFAULT '',
+			status TEXT NOT NULL DEFAULT 'open', priority INTEGER NOT NULL DEFAULT 2, issue_type TEXT NOT NULL DEFAULT 'task',
+			assignee TEXT, estimated_minutes INTEGER, created_at DATETIME NOT NULL, updated_at DATETIME NOT NULL,
+			closed_at DATETIME, external_ref TEXT, deleted_at DATETIME)`,
+		`CREATE TABLE dependencies (issue_id TEXT NOT NULL, depends_on_id TEXT NOT NULL, type TEXT NOT NULL DEFAULT 'blocks',
+			created_at DATETIME NOT NULL, created_by TEXT NOT NULL, PRIMARY KEY (issue_id, depends_on_id))`,
+		`CREATE TABLE labels (issue_id TEXT NOT NULL, label TEXT NOT NULL, PRIMARY KEY (issue_id, label))`,
+		`CREATE TABLE comments (id INTEGER PRIMARY KEY AUTOINCREMENT, issue_id TEXT NOT NULL, author TEXT NOT NULL, text TEXT NOT NULL, created_at DATETIME NOT NULL)`,
+		`INSERT INTO issues (id, title, description, status, priority, issue_type, assignee, estimated_minutes, created_at, updated_at, closed_at)
+			VALUES ('bd-1', 'Schema', 'Set up "tables"', 'closed', 1, 'task', 'alice', 90, '2025-01-02 03:04:05', '2025-01-03T00:00:00Z', '2025-01-03T00:00:00Z')`,
+		`INSERT INTO issues (id, title, status, priority, issue_type, created_at, updated_at)
+			VALUES ('bd-2', 'API', 'open', 0, 'feature', '2025-01-04 00:00:00', '2025-01-04 00:00:00')`,
+		`INSERT INTO issues (id, title, status, issue_type, created_at, updated_at, deleted_at)
+			VALUES ('bd-3', 'Deleted', 'open', 'task', '2025-01-04 00:00:00', '2025-01-04 00:00:00', '2025-01-05 00:00:00')`,
+		`INSERT INTO issues (id, title, status, issue_type, created_at, updated_at)
+			VALUES ('bd-4', 'Bad', 'bogus', 'task', '2025-01-04 00:00:00', '2025-01-04 00:00:00')`,
+		`INSERT INTO dependencies VALUES ('bd-2', 'bd-1', 'blocks', '2025-01-04 00:00:00', 'alice')`,
+		`INSERT INTO labels VALUES ('bd-2', 'backend'), ('bd-2', 'api')`,
+		`INSERT INTO comments (issue_id, author, text, created_at) VALUES ('bd-2', 'bob', 'Looks good', '2025-01-04 12:00:00')`,
+	}
+	for _, stmt := range stmts {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("%s: %v", stmt, err)
+		}
+	}
+}
+
+func TestReadSQLite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), loader.SQLiteName)
+	createBeadsDB(t, path)
+
+	var warnings []string
+	issues, err := loader.LoadIssuesFromFileWithOptions(path, loader.ParseOptions{
+		WarningHandler: func(msg string) { warnings = append(warnings, msg) },
+	})
+	if err != nil {
+		t.Fatalf("LoadIssuesFromFileWithOptions: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues (deleted and invalid skipped), got %d: %+v", len(issues), issues)
+	}
+	if len(warnings) != 1 {
+		t.Errorf("expected one warning for the invalid row, got %v", warnings)
+	}
+
+	first, second := issues[0], issues[1]
+	if first.ID != "bd-1" || first.Description != `Set up "tables"` || first.Status != model.StatusClosed || first.Assignee != "alice" {
+		t.Errorf("bd-1 = %+v", first)
+	}
+	if first.EstimatedMinutes == nil || *first.EstimatedMinutes != 90 {
+		t.Errorf("bd-1 estimate = %v, want 90", first.EstimatedMinutes)
+	}
+	if want := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC); !first.CreatedAt.Equal(want) {
+		t.Errorf("bd-1 created_at = %v, want %v", first.CreatedAt, want)
+	}
+	if first.ClosedAt == nil {
+		t.Error("bd-1 closed_at not read")
+	}
+	if second.Priority != 0 || second.IssueType != model.TypeFeature {
+		t.Errorf("bd-2 = %+v", second)
+	}
+	if len(second.Dependencies) != 1 || second.Dependencies[0].DependsOnID != "bd-1" || second.Dependencies[0].Type != model.DepBlocks {
+		t.Errorf("bd-2 dependencies = %+v", second.Dependencies)
+	}
+	if len(second.Labels) != 2 || second.Labels[0] != "api" || second.Labels[1] != "backend" {
+		t.Errorf("bd-2 labels = %v", second.Labels)
+	}
+	if len(second.Comments) != 1 || second.Comments[0].Author != "bob" || second.Comments[0].Text != "Looks good" {
+		t.Errorf("bd-2 comments = %+v", second.Comments)
+	}
+	if abs, _ := filepath.Abs(path); second.SourcePath != abs {
+		t.Errorf("source path = %q, want %q", second.SourcePath, abs)
+	}
+
+	if format, err := loader.DetectFileFormat(path); err != nil || format != loader.FormatSQLite {
+		t.Errorf("DetectFileFormat = %v, %v; want sqlite", format, err)
+	}
+	if err := loader.SetIssuePriority(path, "bd-2", 1); !errors.Is(err, loader.ErrUnsupportedFormat) {
+		t.Errorf("SetIssuePriority on a database: err = %v, want ErrUnsupportedFormat", err)
+	}
+}
+
+func TestFindJSONLPath_SQLiteOnlyWithoutJSONL(t *testing.T) {
+	dir := t.TempDir()
+	db := filepath.Join(dir, loader.SQLiteName)
+	createBeadsDB(t, db)
+
+	// Only a database
+	if got, err := loader.FindJSONLPath(dir); err != nil || got != db {
+		t.Errorf("database only: got %q, %v; want %q", got, err, db)
+	}
+
+	// Any JSONL file wins, even one older than the database
+	jsonl := filepath.Join(dir, "beads.jsonl")
+	if err := os.WriteFile(jsonl, []byte(`{"id":"x","title":"X","status":"open","issue_type":"task"}`+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	older := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(jsonl, older, older); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := loader.FindJSONLPath(dir); err != nil || got != jsonl {
+		t.Errorf("older JSONL: got %q, %v; want %q", got, err, jsonl)
+	}
+}
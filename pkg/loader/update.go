@@ -0,0 +1,306 @@
+package loader
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+// ErrIssueNotFound is returned when an issue ID has no record in the file.
+var ErrIssueNotFound = errors.New("issue not found")
+
+// ErrUnsupportedFormat is returned when asked to edit a file that is not JSONL.
+var ErrUnsupportedFormat = errors.New("editing is only supported for JSONL files")
+
+// SetIssuePriority rewrites the priority of one issue in a beads JSONL file.
+// Only the matching record changes: its other fields keep their values and
+// order, every other line is copied byte for byte, and the file is replaced
+// atomically (temp file + rename). Returns ErrIssueNotFound if no record has
+// the given ID.
+func SetIssuePriority(path, id string, priority int) error {
+	return updateIssueFields(path, id, issueField{name: "priority", value: json.RawMessage(strconv.Itoa(priority))})
+}
+
+// CloseIssue marks one issue in a beads JSONL file as closed at the given
+// time, setting status, closed_at and updated_at. The file is rewritten the
+// same way as SetIssuePriority.
+func CloseIssue(path, id string, at time.Time) error {
+	ts, err := json.Marshal(at)
+	if err != nil {
+		return err
+	}
+	return updateIssueFields(path, id,
+		issueField{name: "status", value: json.RawMessage(`"closed"`)},
+		issueField{name: "closed_at", value: ts},
+		issueField{name: "updated_at", value: ts},
+	)
+}
+
+// ErrDependencyExists is returned when adding a dependency the issue already has.
+var ErrDependencyExists = errors.New("dependency already exists")
+
+// ErrDependencyNotFound is returned when removing a dependency the issue does not have.
+var ErrDependencyNotFound = errors.New("dependency not found")
+
+// AddDependency appends dep to the dependencies of one issue in a beads
+// JSONL file. The file is rewritten the same way as SetIssuePriority.
+// Returns ErrDependencyExists if the issue already depends on
+// dep.DependsOnID.
+func AddDependency(path, id string, dep model.Dependency) error {
+	entry, err := json.Marshal(dep)
+	if err != nil {
+		return err
+	}
+	return updateIssueFields(path, id, issueField{name: "dependencies", edit: func(old json.RawMessage) (json.RawMessage, error) {
+		deps, err := decodeDependencies(old)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range deps {
+			if dependsOnID(d) == dep.DependsOnID {
+				return nil, fmt.Errorf("%w: %s on %s", ErrDependencyExists, id, dep.DependsOnID)
+			}
+		}
+		return json.Marshal(append(deps, entry))
+	}})
+}
+
+// RemoveDependency drops the dependencies of one issue in a beads JSONL
+// file that point at any of targets (an ID can be written with or without
+// its workspace prefix). The file is rewritten the same way as
+// SetIssuePriority. Returns ErrDependencyNotFound if none match.
+func RemoveDependency(path, id string, targets ...string) error {
+	return updateIssueFields(path, id, issueField{name: "dependencies", edit: func(old json.RawMessage) (json.RawMessage, error) {
+		deps, err := decodeDependencies(old)
+		if err != nil {
+			return nil, err
+		}
+		kept := make([]json.RawMessage, 0, len(deps))
+		for _, d := range deps {
+			if !containsString(targets, dependsOnID(d)) {
+				kept = append(kept, d)
+			}
+		}
+		if len(kept) == len(deps) {
+			return nil, fmt.Errorf("%w: %s on %s", ErrDependencyNotFound, id, strings.Join(targets, " or "))
+		}
+		return json.Marshal(kept)
+	}})
+}
+
+// decodeDependencies splits a raw "dependencies" array into its entries,
+// keeping each one's bytes. A missing or null field is an empty list.
+func decodeDependencies(raw json.RawMessage) ([]json.RawMessage, error) {
+	var deps []json.RawMessage
+	if len(raw) == 0 {
+		return deps, nil
+	}
+	if err := json.Unmarshal(raw, &deps); err != nil {
+		return nil, fmt.Errorf("dependencies: %w", err)
+	}
+	return deps, nil
+}
+
+// dependsOnID returns the "depends_on_id" of a raw dependency entry.
+func dependsOnID(raw json.RawMessage) string {
+	var dep struct {
+		DependsOnID string `json:"depends_on_id"`
+	}
+	_ = json.Unmarshal(raw, &dep)
+	return dep.DependsOnID
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// issueField is one top-level field to set on a record: either a fixed
+// value, or an edit of the current value (nil when the field is absent).
+type issueField struct {
+	name  string
+	value json.RawMessage
+	edit  func(old json.RawMessage) (json.RawMessage, error)
+}
+
+// updateIssueFields sets top-level fields on the record with the given ID.
+func updateIssueFields(path, id string, fields ...issueField) error {
+	if isSQLiteFile(path) {
+		return fmt.Errorf("%w: %s is a SQLite database", ErrUnsupportedFormat, path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	reader := bufio.NewReader(f)
+	if detectFormat(reader) != FormatJSONL {
+		f.Close()
+		return fmt.Errorf("%w: %s is a JSON array", ErrUnsupportedFormat, path)
+	}
+
+	var out bytes.Buffer
+	found := false
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			content, ending := splitLineEnding(line)
+			if !found && recordID(content) == id {
+				for _, field := range fields {
+					edit := field.edit
+					if edit == nil {
+						value := field.value
+						edit = func(json.RawMessage) (json.RawMessage, error) { return value, nil }
+					}
+					updated, uerr := editJSONField(content, field.name, edit)
+					if uerr != nil {
+						f.Close()
+						return fmt.Errorf("rewriting %s: %w", id, uerr)
+					}
+					content = updated
+				}
+				found = true
+			}
+			out.Write(content)
+			out.Write(ending)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			f.Close()
+			return fmt.Errorf("reading %s: %w", path, err)
+		}
+	}
+	f.Close()
+
+	if !found {
+		return fmt.Errorf("%w: %s in %s", ErrIssueNotFound, id, path)
+	}
+	return writeFileAtomic(path, out.Bytes(), info.Mode().Perm())
+}
+
+// splitLineEnding separates a line from its "\n" or "\r\n" terminator.
+func splitLineEnding(line []byte) ([]byte, []byte) {
+	if bytes.HasSuffix(line, []byte("\r\n")) {
+		return line[:len(line)-2], line[len(line)-2:]
+	}
+	if bytes.HasSuffix(line, []byte("\n")) {
+		return line[:len(line)-1], line[len(line)-1:]
+	}
+	return line, nil
+}
+
+// recordID returns the "id" of a JSONL record, or "" if it cannot be parsed.
+func recordID(line []byte) string {
+	var rec struct {
+		ID string `json:"id"`
+	}
+	if json.Unmarshal(stripBOM(line), &rec) != nil {
+		return ""
+	}
+	return rec.ID
+}
+
+// editJSONField re-encodes a JSON object with field set to edit's result,
+// keeping the original key order and the raw bytes of every other value.
+// The field is appended if the object does not have it.
+func editJSONField(obj []byte, field string, edit func(old json.RawMessage) (json.RawMessage, error)) ([]byte, error) {
+	bom := obj[:len(obj)-len(stripBOM(obj))]
+	dec := json.NewDecoder(bytes.NewReader(obj[len(bom):]))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, errors.New("record is not a JSON object")
+	}
+
+	var buf bytes.Buffer
+	buf.Write(bom)
+	buf.WriteByte('{')
+	writeMember := func(key string, raw json.RawMessage) {
+		if buf.Len() > len(bom)+1 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(key)
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(raw)
+	}
+
+	replaced := false
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return nil, errors.New("invalid object key")
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+		if key == field {
+			if raw, err = edit(raw); err != nil {
+				return nil, err
+			}
+			replaced = true
+		}
+		writeMember(key, raw)
+	}
+	if !replaced {
+		value, err := edit(nil)
+		if err != nil {
+			return nil, err
+		}
+		writeMember(field, value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// writeFileAtomic replaces path with data via a temp file in the same
+// directory, so watchers and readers never see a partial file.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %w", err)
+	}
+	tmpName := tmp.Name()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to close temp file: %w", err)
+	}
+	if err := os.Chmod(tmpName, perm); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to set permissions: %w", err)
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		_ = os.Remove(tmpName)
+		return fmt.Errorf("failed to rename temp file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package loader_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+)
+
+func TestSetIssuePriority_RewritesOnlyTargetRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.jsonl")
+	original := "{\"id\":\"a\",\"title\":\"A\",\"status\":\"open\",\"priority\":2,\"issue_type\":\"task\"}\n" +
+		"{\"title\":\"B\",  \"id\":\"b\", \"priority\": 3, \"custom\": {\"x\": [1, 2]}}\r\n" +
+		"not json\n" +
+		"{\"id\":\"c\",\"title\":\"C\"}"
+	if err := os.WriteFile(path, []byte(original), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := loader.SetIssuePriority(path, "b", 1); err != nil {
+		t.Fatalf("SetIssuePriority(b): %v", err)
+	}
+	if err := loader.SetIssuePriority(path, "c", 0); err != nil {
+		t.Fatalf("SetIssuePriority(c): %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "{\"id\":\"a\",\"title\":\"A\",\"status\":\"open\",\"priority\":2,\"issue_type\":\"task\"}\n" +
+		"{\"title\":\"B\",\"id\":\"b\",\"priority\":1,\"custom\":{\"x\": [1, 2]}}\r\n" +
+		"not json\n" +
+		"{\"id\":\"c\",\"title\":\"C\",\"priority\":0}"
+	if string(got) != want {
+		t.Errorf("file after update:\n%q\nwant:\n%q", got, want)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("mode = %v, want 0600 preserved", info.Mode().Perm())
+	}
+	if matches, _ := filepath.Glob(path + ".tmp-*"); len(matches) != 0 {
+		t.Errorf("temp files left behind: %v", matches)
+	}
+}
+
+func TestSetIssuePriority_NotFound(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.jsonl")
+	original := "{\"id\":\"a\",\"title\":\"A\",\"priority\":2}\n"
+	if err := os.WriteFile(path, []byte(original), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := loader.SetIssuePriority(path, "missing", 1)
+	if !errors.Is(err, loader.ErrIssueNotFound) {
+		t.Fatalf("err = %v, want ErrIssueNotFound", err)
+	}
+	if got, _ := os.ReadFile(path); string(got) != original {
+		t.Errorf("file changed on failed update: %q", got)
+	}
+}
+
+func TestCloseIssue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.jsonl")
+	original := "{\"id\":\"a\",\"title\":\"A\",\"status\":\"open\",\"priority\":2}\n" +
+		"{\"id\":\"b\",\"title\":\"B\",\"status\":\"open\",\"updated_at\":\"2026-01-01T00:00:00Z\"}\n"
+	if err := os.WriteFile(path, []byte(original), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	at := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	if err := loader.CloseIssue(path, "b", at); err != nil {
+		t.Fatalf("CloseIssue(b): %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "{\"id\":\"a\",\"title\":\"A\",\"status\":\"open\",\"priority\":2}\n" +
+		"{\"id\":\"b\",\"title\":\"B\",\"status\":\"closed\",\"updated_at\":\"2026-03-04T05:06:07Z\",\"closed_at\":\"2026-03-04T05:06:07Z\"}\n"
+	if string(got) != want {
+		t.Errorf("file after close:\n%q\nwant:\n%q", got, want)
+	}
+
+	if err := loader.CloseIssue(path, "missing", at); !errors.Is(err, loader.ErrIssueNotFound) {
+		t.Errorf("err = %v, want ErrIssueNotFound", err)
+	}
+}
+
+func TestAddRemoveDependency(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.jsonl")
+	original := "{\"id\":\"a\",\"title\":\"A\"}\n" +
+		"{\"id\":\"b\",\"title\":\"B\",\"dependencies\":[{\"issue_id\":\"b\", \"depends_on_id\":\"a\",\"type\":\"blocks\"}],\"priority\":1}\n"
+	if err := os.WriteFile(path, []byte(original), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	at := time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+	dep := model.Dependency{IssueID: "a", DependsOnID: "web-c", Type: model.DepRelated, CreatedAt: at, CreatedBy: "bv"}
+	if err := loader.AddDependency(path, "a", dep); err != nil {
+		t.Fatalf("AddDependency(a): %v", err)
+	}
+	dep = model.Dependency{IssueID: "b", DependsOnID: "c", Type: model.DepBlocks, CreatedAt: at, CreatedBy: "bv"}
+	if err := loader.AddDependency(path, "b", dep); err != nil {
+		t.Fatalf("AddDependency(b): %v", err)
+	}
+	dep.DependsOnID = "a"
+	if err := loader.AddDependency(path, "b", dep); !errors.Is(err, loader.ErrDependencyExists) {
+		t.Errorf("duplicate add: err = %v, want ErrDependencyExists", err)
+	}
+
+	got, _ := os.ReadFile(path)
+	// The edited array is re-encoded compactly
+	want := "{\"id\":\"a\",\"title\":\"A\",\"dependencies\":[{\"issue_id\":\"a\",\"depends_on_id\":\"web-c\",\"type\":\"related\",\"created_at\":\"2026-03-04T05:06:07Z\",\"created_by\":\"bv\"}]}\n" +
+		"{\"id\":\"b\",\"title\":\"B\",\"dependencies\":[{\"issue_id\":\"b\",\"depends_on_id\":\"a\",\"type\":\"blocks\"},{\"issue_id\":\"b\",\"depends_on_id\":\"c\",\"type\":\"blocks\",\"created_at\":\"2026-03-04T05:06:07Z\",\"created_by\":\"bv\"}],\"priority\":1}\n"
+	if string(got) != want {
+		t.Errorf("file after add:\n%q\nwant:\n%q", got, want)
+	}
+
+	// Either spelling of the target matches
+	if err := loader.RemoveDependency(path, "b", "proj-a", "a"); err != nil {
+		t.Fatalf("RemoveDependency(b): %v", err)
+	}
+	if err := loader.RemoveDependency(path, "b", "a"); !errors.Is(err, loader.ErrDependencyNotFound) {
+		t.Errorf("second remove: err = %v, want ErrDependencyNotFound", err)
+	}
+	if err := loader.RemoveDependency(path, "a", "web-c"); err != nil {
+		t.Fatalf("RemoveDependency(a): %v", err)
+	}
+	got, _ = os.ReadFile(path)
+	want = "{\"id\":\"a\",\"title\":\"A\",\"dependencies\":[]}\n" +
+		"{\"id\":\"b\",\"title\":\"B\",\"dependencies\":[{\"issue_id\":\"b\",\"depends_on_id\":\"c\",\"type\":\"blocks\",\"created_at\":\"2026-03-04T05:06:07Z\",\"created_by\":\"bv\"}],\"priority\":1}\n"
+	if string(got) != want {
+		t.Errorf("file after remove:\n%q\nwant:\n%q", got, want)
+	}
+}
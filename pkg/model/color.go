@@ -0,0 +1,58 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// namedColors maps the color names accepted in an issue's color field to hex.
+var namedColors = map[string]string{
+	"black":   "#000000",
+	"blue":    "#1e88e5",
+	"brown":   "#8d6e63",
+	"cyan":    "#00acc1",
+	"gray":    "#9e9e9e",
+	"green":   "#43a047",
+	"grey":    "#9e9e9e",
+	"magenta": "#d81b60",
+	"orange":  "#fb8c00",
+	"pink":    "#f06292",
+	"purple":  "#8e24aa",
+	"red":     "#e53935",
+	"teal":    "#00897b",
+	"white":   "#ffffff",
+	"yellow":  "#fdd835",
+}
+
+// NormalizeColor converts a hex ("#f00", "#FF0000", "ff0000") or named
+// ("red") color to lowercase "#rrggbb". It returns "" for empty or
+// unrecognized values so callers can fall back to their default coloring.
+func NormalizeColor(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return ""
+	}
+	if hex, ok := namedColors[s]; ok {
+		return hex
+	}
+
+	hex := strings.TrimPrefix(s, "#")
+	for _, r := range hex {
+		if !((r >= '0' && r <= '9') || (r >= 'a' && r <= 'f')) {
+			return ""
+		}
+	}
+	switch len(hex) {
+	case 3:
+		return fmt.Sprintf("#%c%c%c%c%c%c", hex[0], hex[0], hex[1], hex[1], hex[2], hex[2])
+	case 6:
+		return "#" + hex
+	}
+	return ""
+}
+
+// DisplayColor returns the issue's color override as "#rrggbb", or "" when
+// unset or invalid.
+func (i Issue) DisplayColor() string {
+	return NormalizeColor(i.Color)
+}
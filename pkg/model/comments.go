@@ -0,0 +1,71 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// UnmarshalJSON decodes an issue, also accepting the comment shapes other
+// exporters write: "comments" as a number (read into CommentCount) and
+// "comment_history" as the comment list when "comments" is absent. Fields
+// already set on i, such as a sentinel priority, are kept when the record
+// omits them.
+func (i *Issue) UnmarshalJSON(data []byte) error {
+	type issueFields Issue // Without this method, so decoding doesn't recurse
+	record := struct {
+		*issueFields
+		Comments       json.RawMessage `json:"comments"`
+		CommentHistory []*Comment      `json:"comment_history"`
+	}{issueFields: (*issueFields)(i)}
+	if err := json.Unmarshal(data, &record); err != nil {
+		return err
+	}
+
+	raw := bytes.TrimSpace(record.Comments)
+	switch {
+	case len(raw) == 0 || bytes.Equal(raw, []byte("null")):
+		i.Comments = record.CommentHistory
+	case raw[0] == '[':
+		if err := json.Unmarshal(raw, &i.Comments); err != nil {
+			return fmt.Errorf("comments: %w", err)
+		}
+	default:
+		var n int
+		if err := json.Unmarshal(raw, &n); err != nil {
+			return fmt.Errorf("comments: expected a list or a count: %w", err)
+		}
+		if n > i.CommentCount {
+			i.CommentCount = n
+		}
+		i.Comments = record.CommentHistory
+	}
+	return nil
+}
+
+// NumComments returns how many comments the issue has: the loaded comments,
+// or the recorded count when that is higher (records that carry only a
+// count, or a trimmed history).
+func (i Issue) NumComments() int {
+	if i.CommentCount > len(i.Comments) {
+		return i.CommentCount
+	}
+	return len(i.Comments)
+}
+
+// LastCommentTime returns when the issue was last commented on: the latest
+// loaded comment or LastCommentAt, whichever is later. It is zero when
+// neither is known.
+func (i Issue) LastCommentTime() time.Time {
+	var last time.Time
+	if i.LastCommentAt != nil {
+		last = *i.LastCommentAt
+	}
+	for _, c := range i.Comments {
+		if c != nil && c.CreatedAt.After(last) {
+			last = c.CreatedAt
+		}
+	}
+	return last
+}
@@ -0,0 +1,89 @@
+package model
+
+// DuplicateDependency describes dependency entries on one issue that named
+// the same target and were merged into a single edge.
+type DuplicateDependency struct {
+	IssueID     string           `json:"issue_id"`
+	DependsOnID string           `json:"depends_on_id"`
+	Count       int              `json:"count"` // Entries in the source record
+	Types       []DependencyType `json:"types"` // Distinct types, in first-seen order
+}
+
+// DedupeDependencies collapses dependency entries that share a target so the
+// graph has at most one edge per (issue, depends_on_id) pair. The first entry
+// is kept; if any duplicate is blocking, the kept edge becomes blocking, and
+// when the entries disagree on type, Types records the full set. The kept
+// edge's Merged counts the entries. Returns the merged duplicates, or nil if
+// there were none.
+func (i *Issue) DedupeDependencies() []DuplicateDependency {
+	if len(i.Dependencies) < 2 {
+		return nil
+	}
+
+	kept := make(map[string]*Dependency, len(i.Dependencies))
+	var dupes []DuplicateDependency
+	dupeIndex := make(map[string]int)
+	deps := i.Dependencies[:0]
+	for _, dep := range i.Dependencies {
+		if dep == nil || dep.DependsOnID == "" {
+			deps = append(deps, dep)
+			continue
+		}
+		first, seen := kept[dep.DependsOnID]
+		if !seen {
+			kept[dep.DependsOnID] = dep
+			deps = append(deps, dep)
+			continue
+		}
+
+		idx, tracked := dupeIndex[dep.DependsOnID]
+		if !tracked {
+			idx = len(dupes)
+			dupeIndex[dep.DependsOnID] = idx
+			dupes = append(dupes, DuplicateDependency{
+				IssueID:     i.ID,
+				DependsOnID: dep.DependsOnID,
+				Count:       1,
+				Types:       []DependencyType{normalizedDepType(first.Type)},
+			})
+		}
+		d := &dupes[idx]
+		d.Count++
+		if t := normalizedDepType(dep.Type); !containsDepType(d.Types, t) {
+			d.Types = append(d.Types, t)
+		}
+		if dep.Type.IsBlocking() && !first.Type.IsBlocking() {
+			first.Type = DepBlocks
+		}
+	}
+	// Clear the tail so dropped entries can be collected
+	for j := len(deps); j < len(i.Dependencies); j++ {
+		i.Dependencies[j] = nil
+	}
+	i.Dependencies = deps
+
+	for _, d := range dupes {
+		kept[d.DependsOnID].Merged = d.Count
+		if len(d.Types) > 1 {
+			kept[d.DependsOnID].Types = append([]DependencyType(nil), d.Types...)
+		}
+	}
+	return dupes
+}
+
+// normalizedDepType maps the legacy empty type to DepBlocks
+func normalizedDepType(t DependencyType) DependencyType {
+	if t == "" {
+		return DepBlocks
+	}
+	return t
+}
+
+func containsDepType(types []DependencyType, t DependencyType) bool {
+	for _, existing := range types {
+		if existing == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSONL encodes issues in beads JSONL form: one JSON object per
+// line, each line (the last included) ending in "\n". Quotes, backslashes
+// and newlines in field values are escaped by encoding/json, so every
+// record stays on its own line. Build test fixtures with this rather than
+// by concatenating strings.
+func MarshalJSONL(issues []Issue) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, issue := range issues {
+		line, err := json.Marshal(issue)
+		if err != nil {
+			return nil, fmt.Errorf("encoding issue %s: %w", issue.ID, err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,25 @@
+package model
+
+import "strings"
+
+// ResolveIssueRef returns the IDs of issues that ref refers to. An exact
+// beads ID match wins; otherwise ref is compared case-insensitively against
+// external IDs (e.g. Jira keys). More than one result means ref is ambiguous.
+func ResolveIssueRef(issues []Issue, ref string) []string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return nil
+	}
+	for _, issue := range issues {
+		if issue.ID == ref {
+			return []string{issue.ID}
+		}
+	}
+	var matches []string
+	for _, issue := range issues {
+		if issue.ExternalID != "" && strings.EqualFold(issue.ExternalID, ref) {
+			matches = append(matches, issue.ID)
+		}
+	}
+	return matches
+}
@@ -2,6 +2,9 @@ package model
 
 import (
 	"fmt"
+	"math"
+	"slices"
+	"strings"
 	"time"
 )
 
@@ -11,6 +14,7 @@ type Issue struct {
 	ContentHash        string        `json:"-"`
 	Title              string        `json:"title"`
 	Description        string        `json:"description"`
+	Body               string        `json:"body,omitempty"`
 	Design             string        `json:"design,omitempty"`
 	AcceptanceCriteria string        `json:"acceptance_criteria,omitempty"`
 	Notes              string        `json:"notes,omitempty"`
@@ -18,12 +22,17 @@ type Issue struct {
 	Priority           int           `json:"priority"`
 	IssueType          IssueType     `json:"issue_type"`
 	Assignee           string        `json:"assignee,omitempty"`
+	Watchers           []string      `json:"watchers,omitempty"` // Users following the issue without owning it
 	EstimatedMinutes   *int          `json:"estimated_minutes,omitempty"`
+	StoryPoints        *float64      `json:"story_points,omitempty"` // Scrum name for the estimate; see ApplyEstimateAlias
 	CreatedAt          time.Time     `json:"created_at"`
 	UpdatedAt          time.Time     `json:"updated_at"`
 	DueDate            *time.Time    `json:"due_date,omitempty"`
 	ClosedAt           *time.Time    `json:"closed_at,omitempty"`
+	CloseReason        string        `json:"close_reason,omitempty"` // Why the issue was closed, as recorded by bd close --reason
+	Resolution         string        `json:"resolution,omitempty"`   // How a closed issue ended: fixed, wontfix, duplicate, invalid, ...
 	ExternalRef        *string       `json:"external_ref,omitempty"`
+	ExternalID         string        `json:"external_id,omitempty"` // Key in an external tracker (e.g. Jira "PROJ-123")
 	CompactionLevel    int           `json:"compaction_level,omitempty"`
 	CompactedAt        *time.Time    `json:"compacted_at,omitempty"`
 	CompactedAtCommit  *string       `json:"compacted_at_commit,omitempty"`
@@ -31,7 +40,86 @@ type Issue struct {
 	Labels             []string      `json:"labels,omitempty"`
 	Dependencies       []*Dependency `json:"dependencies,omitempty"`
 	Comments           []*Comment    `json:"comments,omitempty"`
+	CommentCount       int           `json:"comment_count,omitempty"`   // From records that carry a count instead of the comments; see NumComments
+	LastCommentAt      *time.Time    `json:"last_comment_at,omitempty"` // From records without the comments; see LastCommentTime
 	SourceRepo         string        `json:"source_repo,omitempty"`
+	Color              string        `json:"color,omitempty"` // Hex or named color overriding status/priority coloring
+
+	// Where the loader parsed the issue from; not serialized. SourcePath is
+	// empty for issues not read from a file (e.g. git history), SourceLine
+	// is 1-based and 0 when unknown.
+	SourcePath string `json:"-"`
+	SourceLine int    `json:"-"`
+	// PriorityDefaulted is set when the record had a missing or invalid
+	// priority and was given the configured default (see
+	// ApplyDefaultPriority); not serialized.
+	PriorityDefaulted bool `json:"-"`
+	// PriorityMissing is set when the record had no priority (or a null
+	// one), whether or not a default replaced it; not serialized.
+	PriorityMissing bool `json:"-"`
+}
+
+// MarkdownBody returns the issue's long-form markdown text. Description is
+// the native beads field; Body is accepted from importers that use that name.
+// When both are set and differ, Body follows Description.
+func (i Issue) MarkdownBody() string {
+	desc := strings.TrimSpace(i.Description)
+	body := strings.TrimSpace(i.Body)
+	switch {
+	case body == "" || body == desc:
+		return desc
+	case desc == "":
+		return body
+	}
+	return desc + "\n\n" + body
+}
+
+// IsWatchedBy reports whether user is among the issue's watchers, ignoring
+// case.
+func (i Issue) IsWatchedBy(user string) bool {
+	for _, w := range i.Watchers {
+		if strings.EqualFold(strings.TrimSpace(w), user) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultMinutesPerStoryPoint is how many minutes of estimate one story
+// point stands for unless configured otherwise.
+const DefaultMinutesPerStoryPoint = 60
+
+// ApplyEstimateAlias fills EstimatedMinutes from StoryPoints, converted at
+// minutesPerPoint and rounded up, when only story_points is set, so
+// rollups, plan durations and burndown use it like any other estimate.
+// minutesPerPoint <= 0 means DefaultMinutesPerStoryPoint. It returns true
+// when both fields are set; estimated_minutes is kept in that case.
+func (i *Issue) ApplyEstimateAlias(minutesPerPoint int) bool {
+	if i.StoryPoints == nil {
+		return false
+	}
+	if i.EstimatedMinutes != nil {
+		return true
+	}
+	if minutesPerPoint <= 0 {
+		minutesPerPoint = DefaultMinutesPerStoryPoint
+	}
+	v := int(math.Ceil(*i.StoryPoints * float64(minutesPerPoint)))
+	i.EstimatedMinutes = &v
+	return false
+}
+
+// ApplyDefaultPriority gives the issue priority def when missing is true
+// or its priority is outside 0-4, and marks it PriorityDefaulted. A nil def
+// keeps what was read (P0 when the field is missing). It returns true when
+// the default was applied.
+func (i *Issue) ApplyDefaultPriority(def *int, missing bool) bool {
+	if def == nil || (!missing && i.Priority >= 0 && i.Priority <= 4) {
+		return false
+	}
+	i.Priority = *def
+	i.PriorityDefaulted = true
+	return true
 }
 
 // Clone creates a deep copy of the issue
@@ -42,6 +130,10 @@ func (i Issue) Clone() Issue {
 		v := *i.EstimatedMinutes
 		clone.EstimatedMinutes = &v
 	}
+	if i.StoryPoints != nil {
+		v := *i.StoryPoints
+		clone.StoryPoints = &v
+	}
 	if i.ClosedAt != nil {
 		v := *i.ClosedAt
 		clone.ClosedAt = &v
@@ -62,17 +154,27 @@ func (i Issue) Clone() Issue {
 		v := *i.CompactedAtCommit
 		clone.CompactedAtCommit = &v
 	}
+	if i.LastCommentAt != nil {
+		v := *i.LastCommentAt
+		clone.LastCommentAt = &v
+	}
 
 	if i.Labels != nil {
 		clone.Labels = make([]string, len(i.Labels))
 		copy(clone.Labels, i.Labels)
 	}
+	if i.Watchers != nil {
+		clone.Watchers = append([]string(nil), i.Watchers...)
+	}
 
 	if i.Dependencies != nil {
 		clone.Dependencies = make([]*Dependency, len(i.Dependencies))
 		for idx, dep := range i.Dependencies {
 			if dep != nil {
 				v := *dep
+				if dep.Types != nil {
+					v.Types = append([]DependencyType(nil), dep.Types...)
+				}
 				clone.Dependencies[idx] = &v
 			}
 		}
@@ -91,24 +193,61 @@ func (i Issue) Clone() Issue {
 	return clone
 }
 
-// Validate checks if the issue data is logically valid
-func (i *Issue) Validate() error {
+// ValidationError is one problem Issue.Validate found.
+type ValidationError struct {
+	Field   string `json:"field"` // JSON name of the offending field, e.g. "status"
+	Message string `json:"message"`
+	// Warning marks problems an issue can still load with, such as an
+	// out-of-range priority; the loader skips issues with any other error.
+	Warning bool `json:"warning,omitempty"`
+}
+
+// Error returns the message, so a ValidationError can be used as an error.
+func (e ValidationError) Error() string {
+	return e.Message
+}
+
+// Validate checks the issue for missing required fields, unknown
+// status/priority/type values, timestamps out of order and dependencies on
+// itself. Statuses in extraStatuses are accepted along with the built-in
+// ones (e.g. a configured "wontfix"). It returns every problem found, or
+// nil if the issue is valid.
+func (i *Issue) Validate(extraStatuses ...Status) []ValidationError {
+	var errs []ValidationError
+	add := func(field string, warning bool, format string, args ...any) {
+		errs = append(errs, ValidationError{Field: field, Message: fmt.Sprintf(format, args...), Warning: warning})
+	}
+
 	if i.ID == "" {
-		return fmt.Errorf("issue ID cannot be empty")
+		add("id", false, "issue ID cannot be empty")
 	}
 	if i.Title == "" {
-		return fmt.Errorf("issue title cannot be empty")
+		add("title", false, "issue title cannot be empty")
+	}
+	if !i.Status.IsValid() && !slices.Contains(extraStatuses, i.Status) {
+		add("status", false, "invalid status: %s", i.Status)
 	}
-	if !i.Status.IsValid() {
-		return fmt.Errorf("invalid status: %s", i.Status)
+	// bd priorities run from 0 (critical) to 4 (backlog)
+	if i.Priority < 0 || i.Priority > 4 {
+		add("priority", true, "invalid priority: %d (must be 0-4)", i.Priority)
+	}
+	if i.PriorityDefaulted {
+		add("priority", true, "missing or invalid priority (using default P%d)", i.Priority)
+	} else if i.PriorityMissing {
+		add("priority", true, "missing priority (read as P%d)", i.Priority)
 	}
 	if !i.IssueType.IsValid() {
-		return fmt.Errorf("invalid issue type: %s", i.IssueType)
+		add("issue_type", false, "invalid issue type: %s", i.IssueType)
 	}
 	if !i.UpdatedAt.IsZero() && !i.CreatedAt.IsZero() && i.UpdatedAt.Before(i.CreatedAt) {
-		return fmt.Errorf("updated_at (%v) cannot be before created_at (%v)", i.UpdatedAt, i.CreatedAt)
+		add("updated_at", false, "updated_at (%v) cannot be before created_at (%v)", i.UpdatedAt, i.CreatedAt)
 	}
-	return nil
+	for _, dep := range i.Dependencies {
+		if dep != nil && i.ID != "" && dep.DependsOnID == i.ID {
+			add("dependencies", true, "issue depends on itself (%s)", dep.Type)
+		}
+	}
+	return errs
 }
 
 // Status represents the current state of an issue
@@ -162,11 +301,16 @@ func (t IssueType) IsValid() bool {
 
 // Dependency represents a relationship between issues
 type Dependency struct {
-	IssueID     string         `json:"issue_id"`
-	DependsOnID string         `json:"depends_on_id"`
-	Type        DependencyType `json:"type"`
-	CreatedAt   time.Time      `json:"created_at"`
-	CreatedBy   string         `json:"created_by"`
+	IssueID     string           `json:"issue_id"`
+	DependsOnID string           `json:"depends_on_id"`
+	Type        DependencyType   `json:"type"`
+	Types       []DependencyType `json:"types,omitempty"` // Set when duplicate entries with differing types were merged on load
+	CreatedAt   time.Time        `json:"created_at"`
+	CreatedBy   string           `json:"created_by"`
+	// Merged is how many entries in the source record were collapsed into
+	// this edge (see Issue.DedupeDependencies), 0 when there was only one;
+	// not serialized.
+	Merged int `json:"-"`
 }
 
 // IssueMetrics holds computed metrics for export/robot consumers.
@@ -184,7 +328,10 @@ type DependencyType string
 
 const (
 	DepBlocks         DependencyType = "blocks"
+	DepBlockedBy      DependencyType = "blocked_by" // Same edge as blocks: the issue waits on DependsOnID
 	DepRelated        DependencyType = "related"
+	DepDuplicate      DependencyType = "duplicate" // The issue duplicates DependsOnID
+	DepParent         DependencyType = "parent"    // Alias of parent-child
 	DepParentChild    DependencyType = "parent-child"
 	DepDiscoveredFrom DependencyType = "discovered-from"
 )
@@ -192,15 +339,16 @@ const (
 // IsValid returns true if the dependency type is a recognized value
 func (d DependencyType) IsValid() bool {
 	switch d {
-	case DepBlocks, DepRelated, DepParentChild, DepDiscoveredFrom:
+	case DepBlocks, DepBlockedBy, DepRelated, DepDuplicate, DepParent, DepParentChild, DepDiscoveredFrom:
 		return true
 	}
 	return false
 }
 
-// IsBlocking returns true if this dependency type represents a blocking relationship
+// IsBlocking returns true if this dependency type represents a blocking relationship.
+// Only blocking edges affect readiness and planning.
 func (d DependencyType) IsBlocking() bool {
-	return d == "" || d == DepBlocks
+	return d == "" || d == DepBlocks || d == DepBlockedBy
 }
 
 // Comment represents a comment on an issue
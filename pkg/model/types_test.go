@@ -2,6 +2,7 @@ package model
 
 import (
 	"encoding/json"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -29,6 +30,23 @@ func TestStatus_IsValid(t *testing.T) {
 	}
 }
 
+func TestIssue_ValidateExtraStatuses(t *testing.T) {
+	issue := Issue{ID: "x", Title: "t", Status: "wontfix", IssueType: TypeTask}
+	if errs := issue.Validate(); len(errs) != 1 || errs[0].Field != "status" || errs[0].Warning {
+		t.Fatalf("wontfix should be an invalid status unless allowed, got %v", errs)
+	}
+	if errs := issue.Validate("wontfix"); len(errs) != 0 {
+		t.Errorf("wontfix should be valid once allowed, got %v", errs)
+	}
+	issue.Status = "icebox"
+	if errs := issue.Validate("wontfix"); len(errs) != 1 {
+		t.Errorf("only the allowed status should become valid, got %v", errs)
+	}
+	if Status("wontfix").IsValid() {
+		t.Error("allowing a status in Validate should not change Status.IsValid")
+	}
+}
+
 func TestStatus_IsClosed(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -99,7 +117,10 @@ func TestDependencyType_IsValid(t *testing.T) {
 		want    bool
 	}{
 		{"Blocks", DepBlocks, true},
+		{"BlockedBy", DepBlockedBy, true},
 		{"Related", DepRelated, true},
+		{"Duplicate", DepDuplicate, true},
+		{"Parent", DepParent, true},
 		{"ParentChild", DepParentChild, true},
 		{"DiscoveredFrom", DepDiscoveredFrom, true},
 		{"Invalid", "causes", false},
@@ -121,7 +142,10 @@ func TestDependencyType_IsBlocking(t *testing.T) {
 		want    bool
 	}{
 		{"Blocks", DepBlocks, true},
+		{"BlockedBy", DepBlockedBy, true},
 		{"Related", DepRelated, false},
+		{"Duplicate", DepDuplicate, false},
+		{"Parent", DepParent, false},
 		{"ParentChild", DepParentChild, false},
 		{"Legacy (Empty)", "", true},
 	}
@@ -288,6 +312,37 @@ func TestIssue_Validate(t *testing.T) {
 	}
 }
 
+func TestIssue_ValidateReportsEveryProblem(t *testing.T) {
+	issue := Issue{
+		ID:        "TEST-1",
+		Status:    "done",
+		Priority:  7,
+		IssueType: TypeTask,
+		Dependencies: []*Dependency{
+			{IssueID: "TEST-1", DependsOnID: "TEST-2", Type: DepBlocks},
+			{IssueID: "TEST-1", DependsOnID: "TEST-1", Type: DepBlocks},
+		},
+	}
+	errs := issue.Validate()
+
+	var fields []string
+	for _, e := range errs {
+		fields = append(fields, e.Field)
+	}
+	if got := strings.Join(fields, ","); got != "title,status,priority,dependencies" {
+		t.Fatalf("fields = %s, want title,status,priority,dependencies (%+v)", got, errs)
+	}
+	// The loader keeps issues with only warnings
+	for _, e := range errs {
+		if wantWarning := e.Field == "priority" || e.Field == "dependencies"; e.Warning != wantWarning {
+			t.Errorf("%s: warning = %v, want %v", e.Field, e.Warning, wantWarning)
+		}
+	}
+	if errs[1].Error() != "invalid status: done" {
+		t.Errorf("Error() = %q", errs[1].Error())
+	}
+}
+
 func TestForecast_Validate(t *testing.T) {
 	now := time.Date(2025, 1, 15, 10, 0, 0, 0, time.UTC)
 
@@ -669,3 +724,218 @@ func TestIssue_Clone_NilFields(t *testing.T) {
 		t.Errorf("Comments should be nil")
 	}
 }
+
+func TestIssue_MarkdownBody(t *testing.T) {
+	tests := []struct {
+		name string
+		desc string
+		body string
+		want string
+	}{
+		{"empty", "", "", ""},
+		{"description only", "desc", "", "desc"},
+		{"body only", "", "  **body**\n", "**body**"},
+		{"identical", "same", "same", "same"},
+		{"both", "desc", "body", "desc\n\nbody"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := Issue{Description: tt.desc, Body: tt.body}
+			if got := issue.MarkdownBody(); got != tt.want {
+				t.Errorf("MarkdownBody() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssue_IsWatchedBy(t *testing.T) {
+	var issue Issue
+	if err := json.Unmarshal([]byte(`{"id":"x","title":"t","watchers":["Ana"," bo "]}`), &issue); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	for user, want := range map[string]bool{"ana": true, "bo": true, "cy": false, "": false} {
+		if got := issue.IsWatchedBy(user); got != want {
+			t.Errorf("IsWatchedBy(%q) = %v, want %v", user, got, want)
+		}
+	}
+	clone := issue.Clone()
+	clone.Watchers[0] = "modified"
+	if issue.Watchers[0] != "Ana" {
+		t.Error("modifying clone affected original Watchers")
+	}
+}
+
+func TestIssue_BodyJSON(t *testing.T) {
+	var issue Issue
+	if err := json.Unmarshal([]byte(`{"id":"x","title":"t","body":"# Heading"}`), &issue); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if issue.Body != "# Heading" {
+		t.Errorf("Body = %q, want %q", issue.Body, "# Heading")
+	}
+}
+
+func TestIssue_CommentsJSON(t *testing.T) {
+	decode := func(record string) Issue {
+		t.Helper()
+		issue := Issue{Priority: -1} // Kept when the record has no priority
+		if err := json.Unmarshal([]byte(record), &issue); err != nil {
+			t.Fatalf("unmarshal %s: %v", record, err)
+		}
+		return issue
+	}
+
+	listed := decode(`{"id":"x","title":"t","comments":[{"id":1,"text":"hi","created_at":"2025-03-01T00:00:00Z"},{"id":2,"text":"again","created_at":"2025-03-05T00:00:00Z"}]}`)
+	if len(listed.Comments) != 2 || listed.NumComments() != 2 || listed.Priority != -1 {
+		t.Errorf("comment list: %d comments, NumComments %d, priority %d", len(listed.Comments), listed.NumComments(), listed.Priority)
+	}
+	if got := listed.LastCommentTime(); !got.Equal(time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("LastCommentTime = %v, want the latest comment", got)
+	}
+
+	counted := decode(`{"id":"x","title":"t","comments":4,"last_comment_at":"2025-04-01T12:00:00Z"}`)
+	if len(counted.Comments) != 0 || counted.CommentCount != 4 || counted.NumComments() != 4 {
+		t.Errorf("comment count: %d comments, CommentCount %d", len(counted.Comments), counted.CommentCount)
+	}
+	if got := counted.LastCommentTime(); !got.Equal(time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("LastCommentTime = %v, want last_comment_at", got)
+	}
+
+	history := decode(`{"id":"x","title":"t","comment_history":[{"id":1,"text":"hi"}],"comment_count":3}`)
+	if len(history.Comments) != 1 || history.NumComments() != 3 {
+		t.Errorf("comment_history: %d comments, NumComments %d, want 1 and 3", len(history.Comments), history.NumComments())
+	}
+
+	if none := decode(`{"id":"x","title":"t"}`); none.NumComments() != 0 || !none.LastCommentTime().IsZero() {
+		t.Errorf("no comments: NumComments %d, LastCommentTime %v", none.NumComments(), none.LastCommentTime())
+	}
+
+	var bad Issue
+	if err := json.Unmarshal([]byte(`{"id":"x","comments":"many"}`), &bad); err == nil {
+		t.Error("expected an error for comments that are neither a list nor a count")
+	}
+}
+
+func TestIssue_ApplyEstimateAlias(t *testing.T) {
+	var issue Issue
+	if err := json.Unmarshal([]byte(`{"id":"x","title":"t","story_points":2.5}`), &issue); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if issue.ApplyEstimateAlias(0) {
+		t.Error("story_points alone should not report a conflict")
+	}
+	if issue.EstimatedMinutes == nil || *issue.EstimatedMinutes != 150 {
+		t.Errorf("EstimatedMinutes = %v, want 150 (2.5 points at the default 60 minutes each)", issue.EstimatedMinutes)
+	}
+
+	issue.EstimatedMinutes = nil
+	issue.ApplyEstimateAlias(25)
+	if issue.EstimatedMinutes == nil || *issue.EstimatedMinutes != 63 {
+		t.Errorf("EstimatedMinutes = %v, want 63 (2.5 points at 25 minutes, rounded up)", issue.EstimatedMinutes)
+	}
+
+	minutes, points := 60, 5.0
+	both := Issue{EstimatedMinutes: &minutes, StoryPoints: &points}
+	if !both.ApplyEstimateAlias(0) {
+		t.Error("both fields set should report a conflict")
+	}
+	if *both.EstimatedMinutes != 60 {
+		t.Errorf("EstimatedMinutes = %d, want 60 kept", *both.EstimatedMinutes)
+	}
+
+	var none Issue
+	if none.ApplyEstimateAlias(0) || none.EstimatedMinutes != nil {
+		t.Error("no story_points should leave the estimate unset")
+	}
+}
+
+func TestNormalizeColor(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"", ""},
+		{"#FF0000", "#ff0000"},
+		{"ff8800", "#ff8800"},
+		{"#0f0", "#00ff00"},
+		{" Red ", "#e53935"},
+		{"grey", "#9e9e9e"},
+		{"#12345", ""},
+		{"#gggggg", ""},
+		{"chartreuse-ish", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeColor(tt.in); got != tt.want {
+			t.Errorf("NormalizeColor(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestIssue_ColorJSON(t *testing.T) {
+	var issue Issue
+	if err := json.Unmarshal([]byte(`{"id":"x","title":"t","color":"#ABC"}`), &issue); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if issue.Color != "#ABC" || issue.DisplayColor() != "#aabbcc" {
+		t.Errorf("Color = %q, DisplayColor = %q", issue.Color, issue.DisplayColor())
+	}
+}
+
+func TestResolveIssueRef(t *testing.T) {
+	issues := []Issue{
+		{ID: "bv-1", ExternalID: "PROJ-12"},
+		{ID: "bv-2", ExternalID: "PROJ-30"},
+		{ID: "api-7", ExternalID: "PROJ-30"},
+		{ID: "PROJ-99"},
+		{ID: "bv-3", ExternalID: "PROJ-99"},
+	}
+	tests := []struct {
+		ref  string
+		want []string
+	}{
+		{"bv-1", []string{"bv-1"}},
+		{"proj-12", []string{"bv-1"}},
+		{"PROJ-30", []string{"bv-2", "api-7"}},
+		{"PROJ-99", []string{"PROJ-99"}}, // beads ID wins over external ID
+		{"nope", nil},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := ResolveIssueRef(issues, tt.ref); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ResolveIssueRef(%q) = %v, want %v", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestIssue_DedupeDependencies(t *testing.T) {
+	issue := Issue{ID: "x", Dependencies: []*Dependency{
+		{DependsOnID: "y", Type: DepParentChild},
+		nil,
+		{DependsOnID: "z"},
+		{DependsOnID: "y", Type: DepParentChild},
+		{DependsOnID: "z", Type: DepBlocks},
+	}}
+
+	dupes := issue.DedupeDependencies()
+	want := []DuplicateDependency{
+		{IssueID: "x", DependsOnID: "y", Count: 2, Types: []DependencyType{DepParentChild}},
+		{IssueID: "x", DependsOnID: "z", Count: 2, Types: []DependencyType{DepBlocks}},
+	}
+	if !reflect.DeepEqual(dupes, want) {
+		t.Errorf("dupes = %+v, want %+v", dupes, want)
+	}
+	if len(issue.Dependencies) != 3 || issue.Dependencies[1] != nil {
+		t.Fatalf("deps = %+v", issue.Dependencies)
+	}
+	if issue.Dependencies[0].Type != DepParentChild || issue.Dependencies[0].Types != nil {
+		t.Errorf("x->y = %+v, want unchanged parent-child edge", issue.Dependencies[0])
+	}
+	if issue.Dependencies[0].Merged != 2 || issue.Dependencies[2].Merged != 2 {
+		t.Errorf("merged counts = %d, %d, want 2, 2", issue.Dependencies[0].Merged, issue.Dependencies[2].Merged)
+	}
+
+	// No duplicates: no-op
+	clean := Issue{ID: "x", Dependencies: []*Dependency{{DependsOnID: "y"}, {DependsOnID: "z"}}}
+	if dupes := clean.DedupeDependencies(); dupes != nil || len(clean.Dependencies) != 2 {
+		t.Errorf("clean issue changed: dupes=%v deps=%d", dupes, len(clean.Dependencies))
+	}
+}
@@ -10,7 +10,7 @@ import (
 // For bv-9gf.3 we intentionally keep this minimal (title + description) for predictability.
 func IssueDocument(issue model.Issue) string {
 	title := strings.TrimSpace(issue.Title)
-	desc := strings.TrimSpace(issue.Description)
+	desc := issue.MarkdownBody()
 	if title == "" {
 		return desc
 	}
@@ -3,7 +3,6 @@
 package testutil
 
 import (
-	"encoding/json"
 	"fmt"
 	"math/rand"
 	"strings"
@@ -518,13 +517,12 @@ func (g *Generator) ToIssues(gf GraphFixture) []model.Issue {
 // ToJSONL converts issues to JSONL format (one JSON object per line).
 func ToJSONL(issues []model.Issue) string {
 	var sb strings.Builder
-	for _, issue := range issues {
-		data, err := json.Marshal(issue)
+	for i := range issues {
+		data, err := model.MarshalJSONL(issues[i : i+1])
 		if err != nil {
 			continue
 		}
 		sb.Write(data)
-		sb.WriteByte('\n')
 	}
 	return sb.String()
 }
@@ -459,15 +459,22 @@ func TestQuickFunctions(t *testing.T) {
 			}
 
 			// Verify all issues are valid
-			for i, issue := range issues {
-				if err := issue.Validate(); err != nil {
-					t.Errorf("%s issue %d invalid: %v", tt.name, i, err)
-				}
-			}
+			AssertAllValid(t, issues)
 		})
 	}
 }
 
+func TestAssertAllValidIgnoresWarnings(t *testing.T) {
+	// A self-dependency and a defaulted priority are warnings the loader
+	// still loads the issue with
+	issue := model.Issue{ID: "a", Title: "A", Status: model.StatusOpen, IssueType: model.TypeTask, PriorityDefaulted: true,
+		Dependencies: []*model.Dependency{{IssueID: "a", DependsOnID: "a", Type: model.DepBlocks}}}
+	if len(issue.Validate()) == 0 {
+		t.Fatal("expected the issue to carry validation warnings")
+	}
+	AssertAllValid(t, []model.Issue{issue})
+}
+
 func TestDeterminism(t *testing.T) {
 	// Generate twice with same config
 	cfg := DefaultConfig()
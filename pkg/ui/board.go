@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"github.com/charmbracelet/lipgloss"
@@ -17,6 +18,7 @@ type BoardModel struct {
 	focusedCol   int    // Index into activeColIdx
 	selectedRow  [4]int // Store selection for each column
 	theme        Theme
+	wipLimits    analysis.WIPLimits // In-progress column header turns red when exceeded
 }
 
 // Column indices for the Kanban board
@@ -128,6 +130,16 @@ func (b *BoardModel) SetIssues(issues []model.Issue) {
 	b.updateActiveColumns()
 }
 
+// SetWIPLimits sets the in-progress limits checked against the IN PROGRESS column
+func (b *BoardModel) SetWIPLimits(limits analysis.WIPLimits) {
+	b.wipLimits = limits
+}
+
+// WIPExceeded reports whether the IN PROGRESS column breaks a WIP limit
+func (b *BoardModel) WIPExceeded() bool {
+	return len(analysis.CheckWIPLimits(b.columns[ColInProgress], b.wipLimits)) > 0
+}
+
 // actualFocusedCol returns the actual column index (0-3) being focused
 func (b *BoardModel) actualFocusedCol() int {
 	if len(b.activeColIdx) == 0 {
@@ -281,17 +293,36 @@ func (b BoardModel) View(width, height int) string {
 
 		// Header with emoji, title, and count
 		headerText := fmt.Sprintf("%s %s (%d)", columnEmoji[colIdx], columnTitles[colIdx], issueCount)
+		wipExceeded := false
+		if colIdx == ColInProgress && b.wipLimits.Enabled() {
+			// Show count against the WIP limit, e.g. "(5/3)"
+			if b.wipLimits.Global > 0 {
+				headerText = fmt.Sprintf("%s %s (%d/%d)", columnEmoji[colIdx], columnTitles[colIdx], issueCount, b.wipLimits.Global)
+			}
+			if wipExceeded = b.WIPExceeded(); wipExceeded {
+				headerText += " ⚠"
+			}
+		}
 		headerStyle := t.Renderer.NewStyle().
 			Width(baseWidth).
 			Align(lipgloss.Center).
 			Bold(true).
 			Padding(0, 1)
 
-		if isFocused {
+		switch {
+		case wipExceeded && isFocused:
+			headerStyle = headerStyle.
+				Background(ColorDanger).
+				Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#1a1a1a"})
+		case wipExceeded:
+			headerStyle = headerStyle.
+				Background(lipgloss.AdaptiveColor{Light: "#E0E0E0", Dark: "#2a2a2a"}).
+				Foreground(ColorDanger)
+		case isFocused:
 			headerStyle = headerStyle.
 				Background(columnColors[colIdx]).
 				Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#1a1a1a"})
-		} else {
+		default:
 			headerStyle = headerStyle.
 				Background(lipgloss.AdaptiveColor{Light: "#E0E0E0", Dark: "#2a2a2a"}).
 				Foreground(columnColors[colIdx])
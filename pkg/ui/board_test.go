@@ -3,9 +3,11 @@ package ui_test
 import (
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/ui"
 
@@ -368,3 +370,31 @@ func TestViewRendering(t *testing.T) {
 		})
 	}
 }
+
+// TestBoardWIPLimitHeader verifies the IN PROGRESS header shows the limit
+// and flags a breach
+func TestBoardWIPLimitHeader(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "1", Status: model.StatusInProgress, Assignee: "ana", CreatedAt: createTime(0)},
+		{ID: "2", Status: model.StatusInProgress, Assignee: "ana", CreatedAt: createTime(1)},
+		{ID: "3", Status: model.StatusOpen, CreatedAt: createTime(2)},
+	}
+	b := ui.NewBoardModel(issues, createTheme())
+
+	b.SetWIPLimits(analysis.WIPLimits{Global: 3})
+	if b.WIPExceeded() {
+		t.Error("2 in progress should not exceed a global limit of 3")
+	}
+	view := b.View(120, 30)
+	if !strings.Contains(view, "IN PROGRESS (2/3)") || strings.Contains(view, "⚠") {
+		t.Errorf("expected count against limit without warning, got:\n%s", view)
+	}
+
+	b.SetWIPLimits(analysis.WIPLimits{Global: 3, PerAssignee: 1})
+	if !b.WIPExceeded() {
+		t.Error("ana has 2 in progress, expected per-assignee limit of 1 to be exceeded")
+	}
+	if view := b.View(120, 30); !strings.Contains(view, "(2/3) ⚠") {
+		t.Errorf("expected WIP warning in header, got:\n%s", view)
+	}
+}
@@ -6,25 +6,51 @@ import (
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// watchedMarker flags rows watched by the active view's watching user.
+const watchedMarker = "👁"
+
 // IssueDelegate renders issue items in the list
 type IssueDelegate struct {
 	Theme             Theme
 	ShowPriorityHints bool
 	PriorityHints     map[string]*analysis.PriorityRecommendation
-	WorkspaceMode     bool // When true, shows repo prefix badges
+	WorkspaceMode     bool                 // When true, shows repo prefix badges
+	ShowPins          bool                 // When true, reserves the pin indicator column
+	Display           config.DisplayConfig // Truncation strategy and ellipsis (zero value = defaults)
+}
+
+// truncateColumn truncates a column value using the configured ellipsis and strategy.
+func (d IssueDelegate) truncateColumn(s string, width int, mode config.TruncationMode) string {
+	ellipsis := d.Display.Ellipsis
+	if ellipsis == "" {
+		ellipsis = config.DefaultEllipsis
+	}
+	return truncateWithMode(s, width, mode, ellipsis)
+}
+
+// comfortable reports whether rows use the two-line comfortable density.
+func (d IssueDelegate) comfortable() bool {
+	return d.Display.Density == config.DensityComfortable
 }
 
 func (d IssueDelegate) Height() int {
+	if d.comfortable() {
+		return 2
+	}
 	return 1
 }
 
 func (d IssueDelegate) Spacing() int {
+	if d.comfortable() {
+		return 1
+	}
 	return 0
 }
 
@@ -50,15 +76,18 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 
 	// ══════════════════════════════════════════════════════════════════════════
 	// POLISHED ROW LAYOUT - Stripe-level visual hierarchy
-	// Layout: [sel] [type] [prio-badge] [status-badge] [ID] [title...] [meta]
+	// Layout: [sel] [pin] [type] [prio-badge] [status-badge] [ID] [title...] [meta]
 	// ══════════════════════════════════════════════════════════════════════════
 
 	// Get all the data
 	icon, iconColor := t.GetTypeIcon(string(i.Issue.IssueType))
 	idStr := i.Issue.ID
+	if d.Display.BareIDs {
+		idStr = BareID(idStr)
+	}
 	title := i.Issue.Title
 	ageStr := FormatTimeRel(i.Issue.CreatedAt)
-	commentCount := len(i.Issue.Comments)
+	commentCount := i.Issue.NumComments()
 
 	// Measure actual icon display width (emojis vary: 1-2 cells)
 	iconDisplayWidth := lipgloss.Width(icon)
@@ -67,8 +96,25 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	rightWidth := 0
 	var rightParts []string
 
+	// Comfortable density moves age, comments, assignee and labels to a
+	// second line; compact shows them inline as width allows
+	comfortable := d.comfortable()
+	var details []string
+	if comfortable {
+		details = append(details, t.Renderer.NewStyle().Foreground(ColorMuted).Render(ageStr))
+		if commentCount > 0 {
+			details = append(details, t.Renderer.NewStyle().Foreground(ColorInfo).Render(fmt.Sprintf("💬%d", commentCount)))
+		}
+		if i.Issue.Assignee != "" {
+			details = append(details, t.Renderer.NewStyle().Foreground(ColorSecondary).Render("@"+i.Issue.Assignee))
+		}
+		if len(i.Issue.Labels) > 0 {
+			details = append(details, t.Renderer.NewStyle().Foreground(ColorPrimary).Render(strings.Join(i.Issue.Labels, ", ")))
+		}
+	}
+
 	// Show Age and Comments only if we have reasonable width
-	if width > 60 {
+	if width > 60 && !comfortable {
 		// Age - with subtle styling
 		ageStyle := t.Renderer.NewStyle().Foreground(ColorMuted)
 		rightParts = append(rightParts, ageStyle.Render(fmt.Sprintf("%8s", ageStr)))
@@ -96,15 +142,15 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	}
 
 	// Assignee (if present and we have room)
-	if width > 100 && i.Issue.Assignee != "" {
+	if width > 100 && i.Issue.Assignee != "" && !comfortable {
 		assignee := truncateRunesHelper(i.Issue.Assignee, 12, "…")
 		assigneeStyle := t.Renderer.NewStyle().Foreground(ColorSecondary)
-		rightParts = append(rightParts, assigneeStyle.Render(fmt.Sprintf("@%-12s", assignee)))
+		rightParts = append(rightParts, assigneeStyle.Render("@"+padRight(assignee, 12)))
 		rightWidth += 14
 	}
 
 	// Labels (if present and we have room) - render as mini tags
-	if width > 140 && len(i.Issue.Labels) > 0 {
+	if width > 140 && len(i.Issue.Labels) > 0 && !comfortable {
 		labelStr := truncateRunesHelper(strings.Join(i.Issue.Labels, ","), 20, "…")
 		labelStyle := t.Renderer.NewStyle().
 			Foreground(ColorPrimary).
@@ -115,10 +161,15 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	}
 
 	// Left side fixed columns with polished badges
-	// [selector 2] [repo-badge 0-6] [icon 1-2] [prio-badge 3] [hint 1-2] [status-badge 6] [id dynamic] [space]
+	// [selector 2] [pin 0-3] [repo-badge 0-6] [icon 1-2] [prio-badge 3] [hint 1-2] [status-badge 6] [id dynamic] [space]
 	// Use measured iconDisplayWidth instead of hardcoded value for proper alignment
 	leftFixedWidth := 2 + iconDisplayWidth + 1 // selector(2) + icon(measured) + space(1)
 
+	// Pin indicator column, only reserved while something is pinned
+	if d.ShowPins {
+		leftFixedWidth += lipgloss.Width("📌") + 1
+	}
+
 	// Repo badge width (workspace mode)
 	var repoBadge string
 	if d.WorkspaceMode && i.RepoPrefix != "" {
@@ -146,6 +197,11 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 		leftFixedWidth += lipgloss.Width(fmt.Sprintf("↪%d", i.UnblocksCount)) + 1 // arrow+count + space
 	}
 
+	// Watched indicator
+	if i.IsWatched {
+		leftFixedWidth += lipgloss.Width(watchedMarker) + 1
+	}
+
 	// Status badge (polished)
 	statusBadge := RenderStatusBadge(string(i.Issue.Status))
 	statusBadgeWidth := lipgloss.Width(statusBadge)
@@ -155,7 +211,7 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	idWidth := lipgloss.Width(idStr)
 	if idWidth > 35 {
 		idWidth = 35
-		idStr = truncateRunesHelper(idStr, 35, "…")
+		idStr = d.truncateColumn(idStr, 35, d.Display.Truncation.ID)
 	}
 	leftFixedWidth += idWidth + 1
 
@@ -171,8 +227,8 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	}
 
 	// Truncate title if needed
-	title = truncateRunesHelper(title, titleWidth, "…")
-	
+	title = d.truncateColumn(title, titleWidth, d.Display.Truncation.Title)
+
 	// Pad title to fill space
 	currentWidth := lipgloss.Width(title)
 	if currentWidth < titleWidth {
@@ -184,11 +240,26 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	// ══════════════════════════════════════════════════════════════════════════
 	var leftSide strings.Builder
 
-	// Selection indicator with accent color
+	// Selection indicator with accent color, then the bulk-action mark
 	if isSelected {
-		leftSide.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ "))
+		leftSide.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸"))
 	} else {
-		leftSide.WriteString("  ")
+		leftSide.WriteString(" ")
+	}
+	if i.IsMarked {
+		leftSide.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("✓"))
+	} else {
+		leftSide.WriteString(" ")
+	}
+
+	// Pin indicator
+	if d.ShowPins {
+		if i.IsPinned {
+			leftSide.WriteString("📌")
+		} else {
+			leftSide.WriteString(strings.Repeat(" ", lipgloss.Width("📌")))
+		}
+		leftSide.WriteString(" ")
 	}
 
 	// Repo badge (workspace mode)
@@ -233,12 +304,24 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 		leftSide.WriteString(" ")
 	}
 
+	// Watched indicator, so "mine or watched" views tell the two apart
+	if i.IsWatched {
+		leftSide.WriteString(t.Renderer.NewStyle().Foreground(ColorInfo).Render(watchedMarker))
+		leftSide.WriteString(" ")
+	}
+
 	// Status badge (polished)
 	leftSide.WriteString(statusBadge)
 	leftSide.WriteString(" ")
 
+	// Per-issue color override (issue.color) replaces ID/title coloring
+	override := i.Issue.DisplayColor()
+
 	// ID with secondary styling
 	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	if override != "" {
+		idStyle = idStyle.Foreground(lipgloss.Color(override))
+	}
 	if isSelected {
 		idStyle = idStyle.Bold(true)
 	}
@@ -253,12 +336,19 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 
 	// Title with emphasis when selected
 	titleStyle := t.Renderer.NewStyle()
-	if isSelected {
+	switch {
+	case override != "":
+		titleStyle = titleStyle.Foreground(lipgloss.Color(override)).Bold(isSelected)
+	case isSelected:
 		titleStyle = titleStyle.Foreground(t.Primary).Bold(true)
-	} else {
+	default:
 		titleStyle = titleStyle.Foreground(lipgloss.AdaptiveColor{Light: "#333333", Dark: "#E8E8E8"})
 	}
-	leftSide.WriteString(titleStyle.Render(title))
+	var matches []int
+	if m.FilterState() != list.Unfiltered {
+		matches = m.MatchesForItem(index)
+	}
+	leftSide.WriteString(highlightMatches(title, i.Issue.Title, matches, titleStyle, searchHighlightStyle(t, d.Display)))
 
 	// Right side
 	rightSide := strings.Join(rightParts, " ")
@@ -277,9 +367,17 @@ func (d IssueDelegate) Render(w io.Writer, m list.Model, index int, listItem lis
 	// Apply row background for selection and clamp width
 	rowStyle := t.Renderer.NewStyle().Width(width).MaxWidth(width)
 	if isSelected {
-		row = rowStyle.Background(t.Highlight).Render(row)
-	} else {
-		row = rowStyle.Render(row)
+		rowStyle = rowStyle.Background(t.Highlight)
+	}
+	row = rowStyle.Render(row)
+
+	if comfortable {
+		// Indent the details past the selector and pin columns
+		indent := 2
+		if d.ShowPins {
+			indent += lipgloss.Width("📌") + 1
+		}
+		row += "\n" + rowStyle.Render(strings.Repeat(" ", indent)+strings.Join(details, " · "))
 	}
 
 	fmt.Fprint(w, row)
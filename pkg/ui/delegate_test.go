@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 
 	"github.com/charmbracelet/bubbles/list"
@@ -139,3 +140,36 @@ func TestIssueDelegate_RenderNarrow(t *testing.T) {
 		t.Fatalf("narrow output should hide comments count: %q", out)
 	}
 }
+
+func TestIssueDelegate_ComfortableDensityMovesDetailsToSecondLine(t *testing.T) {
+	item := newTestIssueItem("TASK-9")
+	theme := DefaultTheme(lipgloss.NewRenderer(os.Stdout))
+	delegate := IssueDelegate{Theme: theme, Display: config.DisplayConfig{Density: config.DensityComfortable}}
+	if delegate.Height() != 2 || delegate.Spacing() != 1 {
+		t.Fatalf("comfortable height/spacing = %d/%d, want 2/1", delegate.Height(), delegate.Spacing())
+	}
+
+	l := list.New([]list.Item{item}, delegate, 0, 0)
+	l.SetWidth(160)
+	var buf bytes.Buffer
+	delegate.Render(&buf, l, 0, item)
+	lines := strings.Split(buf.String(), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %q", len(lines), buf.String())
+	}
+	if strings.Contains(lines[0], "@alice") || !strings.Contains(lines[0], "TASK-9") {
+		t.Errorf("first line should hold the ID and title only: %q", lines[0])
+	}
+	for _, want := range []string{"@alice", "💬1", "one, two"} {
+		if !strings.Contains(lines[1], want) {
+			t.Errorf("second line missing %q: %q", want, lines[1])
+		}
+	}
+
+	compact := IssueDelegate{Theme: theme}
+	buf.Reset()
+	compact.Render(&buf, l, 0, item)
+	if strings.Contains(buf.String(), "\n") || !strings.Contains(buf.String(), "@alice") {
+		t.Errorf("compact row should be one line with the assignee inline: %q", buf.String())
+	}
+}
@@ -0,0 +1,483 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// depEditorTypes are the dependency types the editor can create, in the
+// order tab cycles through them
+var depEditorTypes = []model.DependencyType{
+	model.DepBlocks,
+	model.DepRelated,
+	model.DepParentChild,
+	model.DepDiscoveredFrom,
+}
+
+// depEditorMaxMatches caps the target candidates shown while adding
+const depEditorMaxMatches = 8
+
+// DependencyEditorModel is the overlay for adding and removing the
+// selected issue's dependencies. It lists the current edges; in add mode a
+// fuzzy search over ID and title picks the target. The editor only
+// collects the choice: Model writes it.
+type DependencyEditorModel struct {
+	issue         model.Issue
+	blocked       bool          // Whether the issue is blocked, for reporting changes
+	candidates    []model.Issue // Every issue but the edited one
+	matches       []model.Issue
+	input         textinput.Model
+	adding        bool
+	typeIndex     int
+	selectedIndex int
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewDependencyEditorModel creates an editor for issue's dependencies,
+// with targets drawn from issues.
+func NewDependencyEditorModel(issue model.Issue, issues []model.Issue, theme Theme) DependencyEditorModel {
+	ti := textinput.New()
+	ti.Placeholder = "target issue ID or title..."
+	ti.CharLimit = 80
+	ti.Width = 40
+
+	m := DependencyEditorModel{issue: issue, input: ti, theme: theme}
+	m.blocked = blockedIssueIDs(issues)[issue.ID]
+	for _, other := range issues {
+		if other.ID != issue.ID {
+			m.candidates = append(m.candidates, other)
+		}
+	}
+	return m
+}
+
+// SetSize updates the editor dimensions
+func (m *DependencyEditorModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// IssueID returns the ID of the issue being edited
+func (m *DependencyEditorModel) IssueID() string {
+	return m.issue.ID
+}
+
+// IsAddMode reports whether the editor is picking a new target
+func (m *DependencyEditorModel) IsAddMode() bool {
+	return m.adding
+}
+
+// StartAdd switches to add mode with an empty search
+func (m *DependencyEditorModel) StartAdd() {
+	m.adding = true
+	m.selectedIndex = 0
+	m.input.SetValue("")
+	m.input.Focus()
+	m.filterMatches()
+}
+
+// CancelAdd returns to the list of current dependencies
+func (m *DependencyEditorModel) CancelAdd() {
+	m.adding = false
+	m.selectedIndex = 0
+	m.input.Blur()
+}
+
+// SetDependencies replaces the edited issue's dependencies after a write
+func (m *DependencyEditorModel) SetDependencies(deps []*model.Dependency) {
+	m.issue.Dependencies = deps
+	if m.selectedIndex >= len(m.rows()) {
+		m.selectedIndex = len(m.rows()) - 1
+	}
+	if m.selectedIndex < 0 {
+		m.selectedIndex = 0
+	}
+}
+
+// MoveUp moves the cursor up
+func (m *DependencyEditorModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves the cursor down
+func (m *DependencyEditorModel) MoveDown() {
+	n := len(m.rows())
+	if m.adding {
+		n = len(m.matches)
+	}
+	if m.selectedIndex < n-1 {
+		m.selectedIndex++
+	}
+}
+
+// CycleType moves to the next dependency type for new edges
+func (m *DependencyEditorModel) CycleType() {
+	m.typeIndex = (m.typeIndex + 1) % len(depEditorTypes)
+}
+
+// DepType returns the type new edges are created with
+func (m *DependencyEditorModel) DepType() model.DependencyType {
+	return depEditorTypes[m.typeIndex]
+}
+
+// SelectedTarget returns the highlighted target issue ID in add mode
+func (m *DependencyEditorModel) SelectedTarget() string {
+	if !m.adding || m.selectedIndex >= len(m.matches) {
+		return ""
+	}
+	return m.matches[m.selectedIndex].ID
+}
+
+// SelectedDependency returns the highlighted current dependency, or nil
+func (m *DependencyEditorModel) SelectedDependency() *model.Dependency {
+	deps := m.rows()
+	if m.adding || m.selectedIndex >= len(deps) {
+		return nil
+	}
+	return deps[m.selectedIndex]
+}
+
+// UpdateInput processes a key message for the search input
+func (m *DependencyEditorModel) UpdateInput(msg interface{}) {
+	m.input, _ = m.input.Update(msg)
+	m.filterMatches()
+}
+
+// rows returns the non-nil current dependencies
+func (m *DependencyEditorModel) rows() []*model.Dependency {
+	if m.adding {
+		return nil
+	}
+	var deps []*model.Dependency
+	for _, dep := range m.issue.Dependencies {
+		if dep != nil {
+			deps = append(deps, dep)
+		}
+	}
+	return deps
+}
+
+// filterMatches ranks candidates by the better of their ID and title
+// scores, skipping issues the edited one already depends on.
+func (m *DependencyEditorModel) filterMatches() {
+	existing := make(map[string]bool, len(m.issue.Dependencies))
+	for _, dep := range m.issue.Dependencies {
+		if dep != nil {
+			existing[dep.DependsOnID] = true
+		}
+	}
+	query := strings.TrimSpace(m.input.Value())
+
+	type scored struct {
+		issue model.Issue
+		score int
+	}
+	var ranked []scored
+	for _, c := range m.candidates {
+		if existing[c.ID] {
+			continue
+		}
+		score := 1
+		if query != "" {
+			score = fuzzyScore(c.ID, query)
+			if s := fuzzyScore(c.Title, query); s > score {
+				score = s
+			}
+		}
+		if score > 0 {
+			ranked = append(ranked, scored{c, score})
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score > ranked[j].score
+	})
+	if len(ranked) > depEditorMaxMatches {
+		ranked = ranked[:depEditorMaxMatches]
+	}
+	m.matches = m.matches[:0]
+	for _, r := range ranked {
+		m.matches = append(m.matches, r.issue)
+	}
+	if m.selectedIndex >= len(m.matches) {
+		m.selectedIndex = 0
+	}
+}
+
+// View renders the dependency editor overlay
+func (m *DependencyEditorModel) View() string {
+	if m.width == 0 {
+		m.width = 60
+	}
+	if m.height == 0 {
+		m.height = 20
+	}
+
+	t := m.theme
+
+	boxWidth := 60
+	if m.width < 70 {
+		boxWidth = m.width - 10
+	}
+	if boxWidth < 30 {
+		boxWidth = 30
+	}
+
+	var lines []string
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true).
+		MarginBottom(1)
+	lines = append(lines, titleStyle.Render("Dependencies of "+m.issue.ID))
+	lines = append(lines, "")
+
+	dimStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+	row := func(isCursor bool, text string) string {
+		style := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+		prefix := "  "
+		if isCursor {
+			style = style.Foreground(t.Primary).Bold(true)
+			prefix = "▸ "
+		}
+		return style.Render(prefix + truncateRunesHelper(text, boxWidth-8, "..."))
+	}
+
+	var footer string
+	if m.adding {
+		inputStyle := t.Renderer.NewStyle().
+			Border(lipgloss.NormalBorder()).
+			BorderForeground(t.Secondary).
+			Padding(0, 1).
+			Width(boxWidth - 6)
+		lines = append(lines, inputStyle.Render(m.input.View()))
+		lines = append(lines, fmt.Sprintf("Type: %s", t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render(string(m.DepType()))))
+		lines = append(lines, "")
+		if len(m.matches) == 0 {
+			lines = append(lines, dimStyle.Render("  No matching issues"))
+		}
+		for i, c := range m.matches {
+			lines = append(lines, row(i == m.selectedIndex, c.ID+"  "+c.Title))
+		}
+		footer = "type to search • ↑/↓: navigate • tab: type • enter: add • esc: back"
+	} else {
+		deps := m.rows()
+		if len(deps) == 0 {
+			lines = append(lines, dimStyle.Render("  No dependencies"))
+		}
+		for i, dep := range deps {
+			depType := dep.Type
+			if depType == "" {
+				depType = model.DepBlocks
+			}
+			lines = append(lines, row(i == m.selectedIndex, fmt.Sprintf("%-16s %s", depType, dep.DependsOnID)))
+		}
+		footer = "j/k: navigate • a: add • x: remove • esc: close"
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, dimStyle.Render(footer))
+
+	content := strings.Join(lines, "\n")
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content),
+	)
+}
+
+// openDependencyEditor opens the editor for the selected issue. Requires
+// --allow-write.
+func (m *Model) openDependencyEditor() {
+	item, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		return
+	}
+	issue, ok := m.issueMap[item.Issue.ID]
+	if !ok {
+		return
+	}
+	m.statusIsError = true
+	if !m.allowWrite {
+		m.statusMsg = "Read-only: restart with --allow-write to edit dependencies"
+		return
+	}
+	if m.timeTravelMode {
+		m.statusMsg = "Cannot edit dependencies while time-traveling"
+		return
+	}
+	m.statusIsError = false
+	m.depEditor = NewDependencyEditorModel(*issue, m.issues, m.theme)
+	m.depEditor.SetSize(m.width, m.height-1)
+	m.showDepEditor = true
+	m.focused = focusDependencyEditor
+}
+
+// handleDependencyEditorKeys handles keyboard input when the dependency
+// editor is focused. Each add or remove is written immediately.
+func (m Model) handleDependencyEditorKeys(msg tea.KeyMsg) (Model, tea.Cmd) {
+	if m.depEditor.IsAddMode() {
+		switch msg.String() {
+		case "esc":
+			m.depEditor.CancelAdd()
+		case "down", "ctrl+n":
+			m.depEditor.MoveDown()
+		case "up", "ctrl+p":
+			m.depEditor.MoveUp()
+		case "tab":
+			m.depEditor.CycleType()
+		case "enter":
+			return m, tea.Batch(m.addDependency(m.depEditor.SelectedTarget(), m.depEditor.DepType())...)
+		default:
+			m.depEditor.UpdateInput(msg)
+		}
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "j", "down":
+		m.depEditor.MoveDown()
+	case "k", "up":
+		m.depEditor.MoveUp()
+	case "a":
+		m.depEditor.StartAdd()
+	case "x", "d", "delete":
+		if dep := m.depEditor.SelectedDependency(); dep != nil {
+			return m, tea.Batch(m.removeDependency(dep.DependsOnID)...)
+		}
+	case "esc", "q", "D":
+		m.showDepEditor = false
+		m.focused = focusList
+	}
+	return m, nil
+}
+
+// addDependency makes the edited issue depend on targetID and writes the
+// edge to the issue's beads file. A target in another project is written
+// with its namespaced ID.
+func (m *Model) addDependency(targetID string, depType model.DependencyType) []tea.Cmd {
+	fromID := m.depEditor.IssueID()
+	issue, ok := m.issueMap[fromID]
+	if !ok || targetID == "" {
+		return nil
+	}
+	stored := m.dependencyTargetID(fromID, targetID)
+	now := time.Now().UTC()
+	actor := dependencyActor()
+	err := m.writeIssue(fromID, func(path, id string) error {
+		return loader.AddDependency(path, id, model.Dependency{
+			IssueID: id, DependsOnID: stored, Type: depType, CreatedAt: now, CreatedBy: actor,
+		})
+	})
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("Failed to add dependency: %v", err)
+		m.statusIsError = true
+		return nil
+	}
+	deps := append([]*model.Dependency(nil), issue.Dependencies...)
+	issue.Dependencies = append(deps, &model.Dependency{
+		IssueID: fromID, DependsOnID: targetID, Type: depType, CreatedAt: now, CreatedBy: actor,
+	})
+	m.depEditor.CancelAdd()
+	return m.afterDependencyEdit(fmt.Sprintf("Added %s → %s (%s)", fromID, targetID, depType))
+}
+
+// removeDependency drops the edited issue's dependencies on targetID,
+// matching it in the beads file with or without its workspace prefix.
+func (m *Model) removeDependency(targetID string) []tea.Cmd {
+	fromID := m.depEditor.IssueID()
+	issue, ok := m.issueMap[fromID]
+	if !ok {
+		return nil
+	}
+	_, localTarget := m.issueSourcePath(targetID)
+	err := m.writeIssue(fromID, func(path, id string) error {
+		return loader.RemoveDependency(path, id, targetID, localTarget)
+	})
+	if err != nil {
+		m.statusMsg = fmt.Sprintf("Failed to remove dependency: %v", err)
+		m.statusIsError = true
+		return nil
+	}
+	var kept []*model.Dependency
+	for _, dep := range issue.Dependencies {
+		if dep != nil && dep.DependsOnID != targetID {
+			kept = append(kept, dep)
+		}
+	}
+	issue.Dependencies = kept
+	return m.afterDependencyEdit(fmt.Sprintf("Removed %s → %s", fromID, targetID))
+}
+
+// afterDependencyEdit recomputes the graph, counts and list for the edited
+// issues, so readiness and dependents update without waiting for the file
+// watcher, and reports the change (noting a readiness flip).
+func (m *Model) afterDependencyEdit(summary string) []tea.Cmd {
+	fromID := m.depEditor.IssueID()
+	wasBlocked := m.depEditor.blocked
+	_, cmds := m.rebuildIssues(append([]model.Issue(nil), m.issues...))
+	if m.activeRecipe == nil {
+		m.applyFilter()
+	}
+	if issue, ok := m.issueMap[fromID]; ok {
+		m.depEditor.SetDependencies(issue.Dependencies)
+	}
+	m.depEditor.blocked = blockedIssueIDs(m.issues)[fromID]
+
+	m.statusMsg = summary
+	m.statusIsError = false
+	switch {
+	case wasBlocked && !m.depEditor.blocked:
+		m.statusMsg += fmt.Sprintf(" • %s is no longer blocked", fromID)
+	case !wasBlocked && m.depEditor.blocked:
+		m.statusMsg += fmt.Sprintf(" • %s is now blocked", fromID)
+	}
+	return cmds
+}
+
+// dependencyTargetID returns targetID as fromID's beads file stores it:
+// the local ID when both issues live in the same file, otherwise the
+// fully namespaced ID.
+func (m *Model) dependencyTargetID(fromID, targetID string) string {
+	fromPath, _ := m.issueSourcePath(fromID)
+	targetPath, localTarget := m.issueSourcePath(targetID)
+	if fromPath != "" && fromPath == targetPath {
+		return localTarget
+	}
+	return targetID
+}
+
+// dependencyActor names the author of new edges the way beads does:
+// $BD_ACTOR, falling back to $USER.
+func dependencyActor() string {
+	for _, env := range []string{"BD_ACTOR", "USER"} {
+		if v := os.Getenv(env); v != "" {
+			return v
+		}
+	}
+	return "bv"
+}
@@ -0,0 +1,92 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestDependencyEditorWritesEdges(t *testing.T) {
+	t.Setenv("BD_ACTOR", "ana")
+	path := filepath.Join(t.TempDir(), "beads.jsonl")
+	lines := []string{
+		`{"id":"A","title":"Alpha","status":"open","priority":1,"issue_type":"task"}`,
+		`{"id":"B","title":"Beta","status":"open","priority":2,"issue_type":"task"}`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 1},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Priority: 2},
+	}
+	m := NewModel(issues, nil, path)
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = updated.(Model)
+	press := func(keys ...tea.KeyMsg) {
+		for _, k := range keys {
+			updated, _ := m.Update(k)
+			m = updated.(Model)
+		}
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+	m.list.Select(0) // A
+
+	// Read-only by default
+	press(runes("D"))
+	if m.showDepEditor || !strings.Contains(m.statusMsg, "--allow-write") {
+		t.Fatalf("expected read-only hint, got show=%v status=%q", m.showDepEditor, m.statusMsg)
+	}
+
+	m.SetAllowWrite(true)
+	press(runes("D"), runes("a"), runes("bet"))
+	if !m.showDepEditor || m.depEditor.SelectedTarget() != "B" {
+		t.Fatalf("expected B as the fuzzy target, got %q", m.depEditor.SelectedTarget())
+	}
+	press(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(m.statusMsg, "A is now blocked") {
+		t.Errorf("status = %q, want a readiness change", m.statusMsg)
+	}
+	if m.countReady != 1 || len(m.analyzer.GetBlockers("A")) != 1 {
+		t.Errorf("expected A blocked immediately: ready=%d", m.countReady)
+	}
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), `"dependencies":[{"issue_id":"A","depends_on_id":"B","type":"blocks"`) ||
+		!strings.Contains(string(data), `"created_by":"ana"`) {
+		t.Errorf("edge not written:\n%s", data)
+	}
+
+	// Removing it unblocks A again
+	press(runes("x"))
+	if m.countReady != 2 || !strings.Contains(m.statusMsg, "no longer blocked") {
+		t.Errorf("after remove: ready=%d status=%q", m.countReady, m.statusMsg)
+	}
+	data, _ = os.ReadFile(path)
+	if !strings.Contains(string(data), `"dependencies":[]`) {
+		t.Errorf("edge not removed:\n%s", data)
+	}
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showDepEditor || m.focused != focusList {
+		t.Error("expected esc to close the editor")
+	}
+}
+
+func TestDependencyTargetIDWorkspace(t *testing.T) {
+	m := NewModel(nil, nil, "")
+	m.EnableWorkspaceMode(WorkspaceInfo{
+		Enabled:      true,
+		RepoPrefixes: []string{"api-", "web-"},
+		ProjectPaths: map[string]string{"api-": "/code/api/.beads/beads.jsonl", "web-": "/code/web/.beads/beads.jsonl"},
+	})
+	if got := m.dependencyTargetID("api-1", "api-2"); got != "2" {
+		t.Errorf("same project: got %q, want the local ID", got)
+	}
+	if got := m.dependencyTargetID("api-1", "web-7"); got != "web-7" {
+		t.Errorf("cross project: got %q, want the namespaced ID", got)
+	}
+}
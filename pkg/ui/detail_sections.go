@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+)
+
+// detailSectionWriter writes the foldable sections of the detail pane. Each
+// section gets a number (its position among the sections shown for this
+// issue) that the 1-9 keys use to fold and unfold it; a folded section
+// keeps its header so it can be found again.
+type detailSectionWriter struct {
+	sb     *strings.Builder
+	folded map[config.DetailSection]bool
+	shown  []config.DetailSection
+}
+
+// section writes one section: a "### " header built from title, then body
+// unless the section is folded.
+func (w *detailSectionWriter) section(kind config.DetailSection, title, body string) {
+	w.shown = append(w.shown, kind)
+	n := len(w.shown)
+	if w.folded[kind] {
+		fmt.Fprintf(w.sb, "### %d ▸ %s …\n\n", n, title)
+		return
+	}
+	fmt.Fprintf(w.sb, "### %d ▾ %s\n", n, title)
+	w.sb.WriteString(body)
+}
+
+// newDetailFolds returns the starting fold state from the display config.
+func newDetailFolds(cfg config.DisplayConfig) map[config.DetailSection]bool {
+	folded := make(map[config.DetailSection]bool, len(cfg.FoldedSections))
+	for _, s := range cfg.FoldedSections {
+		folded[s] = true
+	}
+	return folded
+}
+
+// toggleDetailFold handles the detail pane fold keys: 1-9 fold or unfold
+// that section, z folds every section shown (or unfolds them all when all
+// are folded). The state is kept per section, not per issue, so it carries
+// over as the selection moves. It reports whether key was a fold key.
+func (m *Model) toggleDetailFold(key string) bool {
+	if m.detailFolded == nil {
+		m.detailFolded = make(map[config.DetailSection]bool)
+	}
+	switch {
+	case key == "z":
+		if len(m.detailShown) == 0 {
+			return true
+		}
+		allFolded := true
+		for _, s := range m.detailShown {
+			if !m.detailFolded[s] {
+				allFolded = false
+				break
+			}
+		}
+		for _, s := range m.detailShown {
+			m.detailFolded[s] = !allFolded
+		}
+	case len(key) == 1 && key[0] >= '1' && key[0] <= '9':
+		i := int(key[0] - '1')
+		if i >= len(m.detailShown) {
+			return true
+		}
+		s := m.detailShown[i]
+		m.detailFolded[s] = !m.detailFolded[s]
+	default:
+		return false
+	}
+	m.updateViewportContent()
+	return true
+}
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+	xansi "github.com/charmbracelet/x/ansi"
+)
+
+func TestDetailSectionFoldsPersistAcrossIssues(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Description: "alpha body text", Notes: "alpha notes text"},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Description: "beta body text", Notes: "beta notes text"},
+	}
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+	cfg := config.DefaultDisplayConfig()
+	cfg.FoldedSections = []config.DetailSection{config.SectionNotes}
+	m.SetDisplayConfig(cfg)
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 60})
+	m = updated.(Model)
+	m.list.Select(0)
+	m.updateViewportContent()
+	detail := func() string { return xansi.Strip(m.viewport.View()) }
+
+	// Notes are folded by config
+	if got := strings.Join(sectionNames(m.detailShown), ","); got != "triage,graph,description,notes" {
+		t.Fatalf("sections = %s", got)
+	}
+	if !strings.Contains(detail(), "alpha body text") || strings.Contains(detail(), "alpha notes text") {
+		t.Fatalf("expected description shown and notes folded:\n%s", detail())
+	}
+
+	m.toggleDetailFold("3")
+	if strings.Contains(detail(), "alpha body text") {
+		t.Error("key 3 should fold the description")
+	}
+
+	// Folds carry over to the next issue
+	m.list.Select(1)
+	m.updateViewportContent()
+	if strings.Contains(detail(), "beta body text") || strings.Contains(detail(), "beta notes text") {
+		t.Errorf("folds should persist across issues:\n%s", detail())
+	}
+
+	m.toggleDetailFold("z")
+	if strings.Contains(detail(), "Impact Depth") || !strings.Contains(detail(), "Graph Analysis") {
+		t.Errorf("z should fold every section, keeping headers:\n%s", detail())
+	}
+	m.toggleDetailFold("z")
+	if !strings.Contains(detail(), "beta body text") || !strings.Contains(detail(), "beta notes text") {
+		t.Errorf("z should unfold everything once all are folded:\n%s", detail())
+	}
+	if m.toggleDetailFold("x") || !m.toggleDetailFold("9") {
+		t.Error("only z and 1-9 are fold keys")
+	}
+}
+
+func sectionNames(sections []config.DetailSection) []string {
+	names := make([]string, len(sections))
+	for i, s := range sections {
+		names[i] = string(s)
+	}
+	return names
+}
+
+func TestDetailShowsCommentCountWithoutComments(t *testing.T) {
+	last := time.Now().Add(-3 * time.Hour)
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, CommentCount: 4, LastCommentAt: &last,
+			Comments: []*model.Comment{{Author: "ana", Text: "kept comment", CreatedAt: last}}},
+	}
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 90, Height: 60})
+	m = updated.(Model)
+	m.list.Select(0)
+	m.updateViewportContent()
+
+	detail := xansi.Strip(m.viewport.View())
+	for _, want := range []string{"Comments (4)", "kept comment", "3 comment(s) not included in this record", "last comment 3h ago"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q:\n%s", want, detail)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// onboardingHint is one built-in way to get issues on screen.
+type onboardingHint struct {
+	desc, cmd string
+}
+
+var onboardingHints = []onboardingHint{
+	{"Create the first issue", `bd create "Title"`},
+	{"Set up .beads in this directory", "bv init"},
+	{"Open other projects", "bv --project ~/code/api --project ~/code/web"},
+}
+
+// OnboardingHints returns the plain-text onboarding hints: the display
+// config's empty_state text when set, otherwise the built-in ones.
+func OnboardingHints(display config.DisplayConfig) string {
+	if text := strings.TrimSpace(display.EmptyState); text != "" {
+		return text
+	}
+	lines := make([]string, len(onboardingHints))
+	for i, h := range onboardingHints {
+		lines[i] = "  " + padRight(h.desc+":", 34) + h.cmd
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderEmptyState renders the screen shown in place of the issue list when
+// no issues are loaded.
+func (m Model) renderEmptyState() string {
+	t := m.theme
+
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	textStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	descStyle := t.Renderer.NewStyle().Foreground(t.Subtext)
+	cmdStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+
+	var lines []string
+	lines = append(lines, titleStyle.Render("No issues here yet"), "")
+	if text := strings.TrimSpace(m.display.EmptyState); text != "" {
+		for _, line := range strings.Split(text, "\n") {
+			lines = append(lines, textStyle.Render(line))
+		}
+	} else {
+		hints := onboardingHints
+		if m.workspaceMode {
+			hints = append(hints[:len(hints):len(hints)], onboardingHint{"Add a project in the Project Manager", "P, then a"})
+		}
+		for _, h := range hints {
+			lines = append(lines, descStyle.Render(padRight(h.desc, 38))+cmdStyle.Render(h.cmd))
+		}
+	}
+
+	box := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 3).
+		Render(strings.Join(lines, "\n"))
+	return lipgloss.Place(m.width, m.height-1, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,62 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestEmptyStateShowsOnboardingHints(t *testing.T) {
+	m := NewModel(nil, nil, "")
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m = updated.(Model)
+
+	view := m.View()
+	for _, want := range []string{"No issues here yet", "bv init", "bv --project"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("empty state missing %q:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "Project Manager") {
+		t.Error("Project Manager hint should only show in multi-project mode")
+	}
+
+	m.EnableWorkspaceMode(WorkspaceInfo{Enabled: true, RepoCount: 1, RepoPrefixes: []string{"api"}})
+	if view := m.View(); !strings.Contains(view, "P, then a") {
+		t.Errorf("workspace empty state should mention the Project Manager:\n%s", view)
+	}
+
+	cfg := config.DefaultDisplayConfig()
+	cfg.EmptyState = "See docs/beads.md for setup\nAsk the tools team for access"
+	m.SetDisplayConfig(cfg)
+	view = m.View()
+	if !strings.Contains(view, "See docs/beads.md for setup") || !strings.Contains(view, "Ask the tools team") || strings.Contains(view, "bv init") {
+		t.Errorf("custom empty_state should replace the built-in hints:\n%s", view)
+	}
+}
+
+func TestEmptyStateHiddenWithIssues(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "A", Title: "Alpha", Status: model.StatusOpen}}, nil, "")
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m = updated.(Model)
+	if strings.Contains(m.View(), "No issues here yet") {
+		t.Error("empty state shown with issues loaded")
+	}
+}
+
+func TestOnboardingHints(t *testing.T) {
+	if got := OnboardingHints(config.DefaultDisplayConfig()); !strings.Contains(got, "bv init") || !strings.Contains(got, `bd create "Title"`) {
+		t.Errorf("default hints = %q", got)
+	}
+	cfg := config.DefaultDisplayConfig()
+	cfg.EmptyState = "  Run ./scripts/setup-beads.sh\n"
+	if got := OnboardingHints(cfg); got != "Run ./scripts/setup-beads.sh" {
+		t.Errorf("custom hints = %q", got)
+	}
+}
@@ -0,0 +1,298 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// filterGroup is one section of the filter menu
+type filterGroup int
+
+const (
+	filterGroupStatus filterGroup = iota
+	filterGroupPriority
+	filterGroupType
+	filterGroupCount
+)
+
+var filterGroupTitles = [filterGroupCount]string{"Status", "Priority", "Type"}
+
+// filterOption is one toggleable value of a filter group
+type filterOption struct {
+	group filterGroup
+	value string // Lowercase status or type, or the priority as a number
+	label string
+}
+
+// key identifies the option by group and value
+func (o filterOption) key() filterKey {
+	return filterKey{o.group, o.value}
+}
+
+type filterKey struct {
+	group filterGroup
+	value string
+}
+
+// filterMenuOptions lists the menu rows, grouped in section order
+var filterMenuOptions = []filterOption{
+	{filterGroupStatus, string(model.StatusOpen), "Open"},
+	{filterGroupStatus, string(model.StatusInProgress), "In progress"},
+	{filterGroupStatus, string(model.StatusBlocked), "Blocked"},
+	{filterGroupStatus, string(model.StatusClosed), "Closed"},
+	{filterGroupPriority, "0", "P0"},
+	{filterGroupPriority, "1", "P1"},
+	{filterGroupPriority, "2", "P2"},
+	{filterGroupPriority, "3", "P3"},
+	{filterGroupPriority, "4", "P4"},
+	{filterGroupType, string(model.TypeBug), "Bug"},
+	{filterGroupType, string(model.TypeFeature), "Feature"},
+	{filterGroupType, string(model.TypeTask), "Task"},
+	{filterGroupType, string(model.TypeEpic), "Epic"},
+	{filterGroupType, string(model.TypeChore), "Chore"},
+}
+
+// FilterMenuModel is the status/priority/type filter overlay. Each option
+// shows how many issues it would match given the selections in the other
+// groups (within a group, selections are alternatives), and the counts
+// update as options are toggled. Nothing changes until ApplyTo.
+type FilterMenuModel struct {
+	issues        []model.Issue
+	selected      map[filterOption]bool
+	counts        map[filterKey]int
+	selectedIndex int
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewFilterMenuModel creates a filter menu over issues, the candidates
+// left by every other active filter, with v's status, priority and type
+// filters preselected.
+func NewFilterMenuModel(issues []model.Issue, v config.SavedView, theme Theme) FilterMenuModel {
+	m := FilterMenuModel{
+		issues:   issues,
+		selected: make(map[filterOption]bool),
+		theme:    theme,
+	}
+	for _, opt := range filterMenuOptions {
+		switch opt.group {
+		case filterGroupStatus:
+			m.selected[opt] = containsFold(v.Status, opt.value)
+		case filterGroupType:
+			m.selected[opt] = containsFold(v.Type, opt.value)
+		case filterGroupPriority:
+			for _, p := range v.Priority {
+				if strconv.Itoa(p) == opt.value {
+					m.selected[opt] = true
+				}
+			}
+		}
+	}
+	m.recount()
+	return m
+}
+
+// SetSize updates the menu dimensions
+func (m *FilterMenuModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// MoveUp moves the cursor up
+func (m *FilterMenuModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves the cursor down
+func (m *FilterMenuModel) MoveDown() {
+	if m.selectedIndex < len(filterMenuOptions)-1 {
+		m.selectedIndex++
+	}
+}
+
+// ToggleSelected toggles the option under the cursor and refreshes the counts
+func (m *FilterMenuModel) ToggleSelected() {
+	opt := filterMenuOptions[m.selectedIndex]
+	m.selected[opt] = !m.selected[opt]
+	m.recount()
+}
+
+// ClearAll deselects every option
+func (m *FilterMenuModel) ClearAll() {
+	for opt := range m.selected {
+		m.selected[opt] = false
+	}
+	m.recount()
+}
+
+// Count returns how many issues the option at index i would match
+func (m *FilterMenuModel) Count(i int) int {
+	return m.counts[filterMenuOptions[i].key()]
+}
+
+// MatchCount returns how many issues the current selections match
+func (m *FilterMenuModel) MatchCount() int {
+	n := 0
+	for i := range m.issues {
+		if m.matchesExcept(&m.issues[i], filterGroupCount) {
+			n++
+		}
+	}
+	return n
+}
+
+// ApplyTo replaces v's status, priority and type filters with the
+// menu's selections.
+func (m *FilterMenuModel) ApplyTo(v *config.SavedView) {
+	v.Status, v.Priority, v.Type = nil, nil, nil
+	for _, opt := range filterMenuOptions {
+		if !m.selected[opt] {
+			continue
+		}
+		switch opt.group {
+		case filterGroupStatus:
+			v.Status = append(v.Status, opt.value)
+		case filterGroupPriority:
+			p, _ := strconv.Atoi(opt.value)
+			v.Priority = append(v.Priority, p)
+		case filterGroupType:
+			v.Type = append(v.Type, opt.value)
+		}
+	}
+}
+
+// recount recomputes every option's count in one pass: an issue counts
+// toward its own value in a group if it passes the other groups.
+func (m *FilterMenuModel) recount() {
+	m.counts = make(map[filterKey]int, len(filterMenuOptions))
+	for i := range m.issues {
+		issue := &m.issues[i]
+		for g := filterGroup(0); g < filterGroupCount; g++ {
+			if m.matchesExcept(issue, g) {
+				m.counts[filterKey{g, filterValue(issue, g)}]++
+			}
+		}
+	}
+}
+
+// matchesExcept reports whether issue passes the selections of every
+// group but skip; a group with nothing selected passes everything.
+func (m *FilterMenuModel) matchesExcept(issue *model.Issue, skip filterGroup) bool {
+	for g := filterGroup(0); g < filterGroupCount; g++ {
+		if g == skip {
+			continue
+		}
+		active, hit := false, false
+		value := filterValue(issue, g)
+		for _, opt := range filterMenuOptions {
+			if opt.group != g || !m.selected[opt] {
+				continue
+			}
+			active = true
+			if opt.value == value {
+				hit = true
+			}
+		}
+		if active && !hit {
+			return false
+		}
+	}
+	return true
+}
+
+// filterValue is the issue's value in group, in filterOption form
+func filterValue(issue *model.Issue, g filterGroup) string {
+	switch g {
+	case filterGroupStatus:
+		return strings.ToLower(string(issue.Status))
+	case filterGroupPriority:
+		return strconv.Itoa(issue.Priority)
+	default:
+		return strings.ToLower(string(issue.IssueType))
+	}
+}
+
+// View renders the filter menu overlay
+func (m *FilterMenuModel) View() string {
+	if m.width == 0 {
+		m.width = 60
+	}
+	if m.height == 0 {
+		m.height = 20
+	}
+
+	t := m.theme
+
+	boxWidth := 44
+	if m.width < 54 {
+		boxWidth = m.width - 10
+	}
+	if boxWidth < 30 {
+		boxWidth = 30
+	}
+
+	var lines []string
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true).
+		MarginBottom(1)
+	lines = append(lines, titleStyle.Render(fmt.Sprintf("Filters (%d matching)", m.MatchCount())))
+
+	groupStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Bold(true)
+	countStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+	group := filterGroup(-1)
+	for i, opt := range filterMenuOptions {
+		if opt.group != group {
+			group = opt.group
+			lines = append(lines, "", groupStyle.Render(filterGroupTitles[group]))
+		}
+		isCursor := i == m.selectedIndex
+
+		nameStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+		if isCursor {
+			nameStyle = nameStyle.Foreground(t.Primary).Bold(true)
+		}
+
+		prefix := "  "
+		if isCursor {
+			prefix = "▸ "
+		}
+		check := "[ ]"
+		if m.selected[opt] {
+			check = "[x]"
+		}
+		lines = append(lines, nameStyle.Render(prefix+check+" "+opt.label)+" "+countStyle.Render(fmt.Sprintf("(%d)", m.Count(i))))
+	}
+
+	lines = append(lines, "")
+	footerStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+	lines = append(lines, footerStyle.Render("j/k: navigate • space: toggle • x: clear • enter: apply • esc: cancel"))
+
+	content := strings.Join(lines, "\n")
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content),
+	)
+}
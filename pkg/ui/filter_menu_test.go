@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// filterMenuIndex returns the row index of the option with the given label
+func filterMenuIndex(t *testing.T, label string) int {
+	t.Helper()
+	for i, opt := range filterMenuOptions {
+		if opt.label == label {
+			return i
+		}
+	}
+	t.Fatalf("no filter option %q", label)
+	return -1
+}
+
+func TestFilterMenuCounts(t *testing.T) {
+	m := viewTestModel()
+	menu := NewFilterMenuModel(m.issues, config.SavedView{}, m.theme)
+	count := func(label string) int { return menu.Count(filterMenuIndex(t, label)) }
+
+	if count("Open") != 2 || count("In progress") != 1 || count("Bug") != 2 || count("P0") != 1 {
+		t.Errorf("initial counts: open=%d in_progress=%d bug=%d p0=%d", count("Open"), count("In progress"), count("Bug"), count("P0"))
+	}
+
+	// Selecting a status narrows the other groups but not its own
+	menu.selectedIndex = filterMenuIndex(t, "Open")
+	menu.ToggleSelected()
+	if count("Feature") != 0 || count("Bug") != 2 || count("In progress") != 1 {
+		t.Errorf("with open: feature=%d bug=%d in_progress=%d", count("Feature"), count("Bug"), count("In progress"))
+	}
+	menu.selectedIndex = filterMenuIndex(t, "P1")
+	menu.ToggleSelected()
+	if count("Bug") != 1 || count("Open") != 1 || count("In progress") != 0 || menu.MatchCount() != 1 {
+		t.Errorf("with open+P1: bug=%d open=%d in_progress=%d match=%d", count("Bug"), count("Open"), count("In progress"), menu.MatchCount())
+	}
+
+	var v config.SavedView
+	menu.ApplyTo(&v)
+	if len(v.Status) != 1 || v.Status[0] != "open" || len(v.Priority) != 1 || v.Priority[0] != 1 || v.Type != nil {
+		t.Errorf("ApplyTo = %+v", v)
+	}
+
+	// Preselects from a view
+	menu = NewFilterMenuModel(m.issues, config.SavedView{Type: []string{"Feature"}}, m.theme)
+	if !strings.Contains(menu.View(), "[x] Feature") || menu.MatchCount() != 1 {
+		t.Errorf("expected Feature preselected, match=%d", menu.MatchCount())
+	}
+}
+
+func TestFilterMenuKeys(t *testing.T) {
+	m := viewTestModel()
+	press := func(keys ...tea.KeyMsg) {
+		for _, k := range keys {
+			updated, _ := m.Update(k)
+			m = updated.(Model)
+		}
+	}
+	runes := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	press(runes("F"))
+	if !m.showFilterMenu || m.focused != focusFilterMenu {
+		t.Fatal("expected filter menu to open")
+	}
+	if !strings.Contains(m.View(), "Blocked (0)") {
+		t.Error("expected counts in the menu")
+	}
+
+	// Toggle Bug and apply
+	for i := 0; i < filterMenuIndex(t, "Bug"); i++ {
+		press(runes("j"))
+	}
+	press(runes(" "), tea.KeyMsg{Type: tea.KeyEnter})
+	if m.showFilterMenu || m.activeView == nil || m.activeViewName != "" {
+		t.Fatalf("expected an unnamed view, got show=%v view=%v name=%q", m.showFilterMenu, m.activeView, m.activeViewName)
+	}
+	if got := listIDs(m); got != "web-1,api-1" && got != "api-1,web-1" {
+		t.Errorf("list = %s, want the two bugs", got)
+	}
+
+	// Clearing every option removes the view
+	press(runes("F"), runes("x"), tea.KeyMsg{Type: tea.KeyEnter})
+	if m.activeView != nil || len(m.list.Items()) != 3 {
+		t.Errorf("expected view cleared, got %+v with %d items", m.activeView, len(m.list.Items()))
+	}
+
+	// Esc leaves the filters alone
+	m.ApplySavedView("mine", config.SavedView{Assignee: "ana"})
+	press(runes("F"), runes(" "), tea.KeyMsg{Type: tea.KeyEsc})
+	if m.showFilterMenu || m.activeViewName != "mine" {
+		t.Errorf("esc changed the view: show=%v name=%q", m.showFilterMenu, m.activeViewName)
+	}
+}
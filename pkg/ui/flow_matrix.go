@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/mattn/go-runewidth"
 )
 
 // FlowMatrixView renders a simple label->label dependency matrix
@@ -16,8 +17,8 @@ func FlowMatrixView(flow analysis.CrossLabelFlow, width int) string {
 	labels := flow.Labels
 	maxLabel := 0
 	for _, l := range labels {
-		if len(l) > maxLabel {
-			maxLabel = len(l)
+		if w := runewidth.StringWidth(l); w > maxLabel {
+			maxLabel = w
 		}
 	}
 	cellWidth := 4
@@ -27,34 +28,25 @@ func FlowMatrixView(flow analysis.CrossLabelFlow, width int) string {
 	}
 	// header
 	var b strings.Builder
-	pad := func(s string, w int) string {
-		if len(s) >= w {
-			return s
-		}
-		return s + strings.Repeat(" ", w-len(s))
-	}
 	truncate := func(s string, w int) string {
-		if len(s) <= w {
-			return s
-		}
 		if w <= 1 {
-			return s[:w]
+			return truncateRunesHelper(s, w, "")
 		}
-		return s[:w-1] + "…"
+		return truncateRunesHelper(s, w, "…")
 	}
 
 	// header row
-	b.WriteString(pad("", leftWidth))
+	b.WriteString(padRight("", leftWidth))
 	b.WriteString(" | ")
 	for _, l := range labels {
-		b.WriteString(pad(truncate(l, cellWidth), cellWidth))
+		b.WriteString(padRight(truncate(l, cellWidth), cellWidth))
 	}
 	b.WriteString("\n")
 	b.WriteString(strings.Repeat("-", leftWidth+3+cellWidth*len(labels)))
 	b.WriteString("\n")
 
 	for i, row := range flow.FlowMatrix {
-		b.WriteString(pad(truncate(labels[i], leftWidth), leftWidth))
+		b.WriteString(padRight(truncate(labels[i], leftWidth), leftWidth))
 		b.WriteString(" | ")
 		for _, v := range row {
 			b.WriteString(fmt.Sprintf("%*d", cellWidth, v))
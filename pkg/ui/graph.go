@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"hash/fnv"
 	"sort"
 	"strings"
 
@@ -38,6 +39,35 @@ type GraphModel struct {
 	rankCriticalPath map[string]int
 	rankInDegree     map[string]int
 	rankOutDegree    map[string]int
+
+	bareIDs bool // Show IDs without their project prefix (see BareID)
+
+	// Layouts of recently shown issue sets, by graphLayoutKey. Entries are
+	// dropped when the analysis they were ranked against is replaced.
+	layouts     map[uint64]*graphLayout
+	layoutStats *analysis.GraphStats
+}
+
+// graphLayoutCacheSize bounds the number of cached layouts; toggling
+// between a few filters stays cached.
+const graphLayoutCacheSize = 8
+
+// graphLayout is the derived part of the graph view that does not depend
+// on issue content: adjacency, node order and metric ranks. It is never
+// modified once built, so copies of the model can share it.
+type graphLayout struct {
+	blockers   map[string][]string
+	dependents map[string][]string
+	sortedIDs  []string
+
+	rankPageRank     map[string]int
+	rankBetweenness  map[string]int
+	rankEigenvector  map[string]int
+	rankHubs         map[string]int
+	rankAuthorities  map[string]int
+	rankCriticalPath map[string]int
+	rankInDegree     map[string]int
+	rankOutDegree    map[string]int
 }
 
 // NewGraphModel creates a new graph view from issues
@@ -51,6 +81,20 @@ func NewGraphModel(issues []model.Issue, insights *analysis.Insights, theme Them
 	return g
 }
 
+// SetBareIDs switches between namespaced and bare issue IDs. Neighbors of
+// the selected issue in another project keep their prefix.
+func (g *GraphModel) SetBareIDs(bare bool) {
+	g.bareIDs = bare
+}
+
+// displayID formats an issue ID for the node list and ego node.
+func (g *GraphModel) displayID(id string) string {
+	if g.bareIDs {
+		return BareID(id)
+	}
+	return id
+}
+
 // SetIssues updates the graph data preserving the selected issue if possible
 func (g *GraphModel) SetIssues(issues []model.Issue, insights *analysis.Insights) {
 	// Capture current selection
@@ -85,17 +129,94 @@ func (g *GraphModel) SetIssues(issues []model.Issue, insights *analysis.Insights
 	}
 }
 
+// rebuildGraph indexes the issues and restores their layout from the cache,
+// computing it only for an issue set (or analysis) not seen recently.
 func (g *GraphModel) rebuildGraph() {
 	size := len(g.issues)
 	g.issueMap = make(map[string]*model.Issue, size)
+	for i := range g.issues {
+		g.issueMap[g.issues[i].ID] = &g.issues[i]
+	}
+
+	var stats *analysis.GraphStats
+	if g.insights != nil {
+		stats = g.insights.Stats
+	}
+	if g.layouts == nil || stats != g.layoutStats || len(g.layouts) >= graphLayoutCacheSize {
+		g.layouts = make(map[uint64]*graphLayout)
+		g.layoutStats = stats
+	}
+	key := graphLayoutKey(g.issues, stats)
+	if l, ok := g.layouts[key]; ok {
+		g.useLayout(l)
+	} else {
+		g.computeLayout()
+		g.layouts[key] = &graphLayout{
+			blockers:         g.blockers,
+			dependents:       g.dependents,
+			sortedIDs:        g.sortedIDs,
+			rankPageRank:     g.rankPageRank,
+			rankBetweenness:  g.rankBetweenness,
+			rankEigenvector:  g.rankEigenvector,
+			rankHubs:         g.rankHubs,
+			rankAuthorities:  g.rankAuthorities,
+			rankCriticalPath: g.rankCriticalPath,
+			rankInDegree:     g.rankInDegree,
+			rankOutDegree:    g.rankOutDegree,
+		}
+	}
+
+	if g.selectedIdx >= len(g.sortedIDs) {
+		g.selectedIdx = 0
+	}
+}
+
+// useLayout switches to a cached layout
+func (g *GraphModel) useLayout(l *graphLayout) {
+	g.blockers = l.blockers
+	g.dependents = l.dependents
+	g.sortedIDs = l.sortedIDs
+	g.rankPageRank = l.rankPageRank
+	g.rankBetweenness = l.rankBetweenness
+	g.rankEigenvector = l.rankEigenvector
+	g.rankHubs = l.rankHubs
+	g.rankAuthorities = l.rankAuthorities
+	g.rankCriticalPath = l.rankCriticalPath
+	g.rankInDegree = l.rankInDegree
+	g.rankOutDegree = l.rankOutDegree
+}
+
+// graphLayoutKey hashes what a layout is computed from: the issue IDs and
+// blocking edges, in order, and whether the analysis has its Phase 2
+// metrics yet (ranks change when they arrive).
+func graphLayoutKey(issues []model.Issue, stats *analysis.GraphStats) uint64 {
+	h := fnv.New64a()
+	for i := range issues {
+		h.Write([]byte(issues[i].ID))
+		h.Write([]byte{0})
+		for _, dep := range issues[i].Dependencies {
+			if dep != nil && dep.Type.IsBlocking() {
+				h.Write([]byte(dep.DependsOnID))
+				h.Write([]byte{1})
+			}
+		}
+		h.Write([]byte{2})
+	}
+	if stats != nil && stats.IsPhase2Ready() {
+		h.Write([]byte{3})
+	}
+	return h.Sum64()
+}
+
+// computeLayout builds adjacency, node order and ranks from scratch
+func (g *GraphModel) computeLayout() {
+	size := len(g.issues)
 	g.blockers = make(map[string][]string, size)
 	g.dependents = make(map[string][]string, size)
 	g.sortedIDs = make([]string, 0, size)
 
 	for i := range g.issues {
-		issue := &g.issues[i]
-		g.issueMap[issue.ID] = issue
-		g.sortedIDs = append(g.sortedIDs, issue.ID)
+		g.sortedIDs = append(g.sortedIDs, g.issues[i].ID)
 	}
 
 	// Build relationships
@@ -124,10 +245,6 @@ func (g *GraphModel) rebuildGraph() {
 	} else {
 		sort.Strings(g.sortedIDs)
 	}
-
-	if g.selectedIdx >= len(g.sortedIDs) {
-		g.selectedIdx = 0
-	}
 }
 
 // computeRankings precomputes rankings for all metrics
@@ -347,7 +464,7 @@ func (g *GraphModel) renderNodeList(width, height int, t Theme) string {
 		isSelected := i == g.selectedIdx
 		statusIcon := getStatusIcon(issue.Status)
 		maxIDLen := width - 4
-		displayID := smartTruncateID(id, maxIDLen)
+		displayID := smartTruncateID(g.displayID(id), maxIDLen)
 		line := fmt.Sprintf("%s %s", statusIcon, displayID)
 
 		var style lipgloss.Style
@@ -359,7 +476,7 @@ func (g *GraphModel) renderNodeList(width, height int, t Theme) string {
 				Width(width)
 		} else {
 			style = t.Renderer.NewStyle().
-				Foreground(getStatusColor(issue.Status, t)).
+				Foreground(issueColor(issue, t)).
 				Width(width)
 		}
 		lines = append(lines, style.Render(line))
@@ -533,19 +650,25 @@ func (g *GraphModel) renderNodeBox(id string, boxWidth int, t Theme, isEgo bool)
 	issue := g.issueMap[id]
 
 	var statusIcon, displayID, title string
-	var statusColor lipgloss.AdaptiveColor
+	var statusColor lipgloss.TerminalColor
+	shownID := g.displayID(id)
+	if !isEgo {
+		if ego := g.SelectedIssue(); ego != nil {
+			shownID = DisplayRefID(ego.ID, id, g.bareIDs)
+		}
+	}
 
 	if issue != nil {
 		statusIcon = getStatusIcon(issue.Status)
-		statusColor = getStatusColor(issue.Status, t)
-		displayID = smartTruncateID(id, boxWidth-4)
+		statusColor = issueColor(issue, t)
+		displayID = smartTruncateID(shownID, boxWidth-4)
 		if issue.Title != "" {
 			title = truncateRunesHelper(issue.Title, boxWidth-4, "…")
 		}
 	} else {
 		statusIcon = "❓"
 		statusColor = t.Secondary
-		displayID = smartTruncateID(id, boxWidth-4)
+		displayID = smartTruncateID(shownID, boxWidth-4)
 		title = "(not in filter)"
 	}
 
@@ -603,7 +726,7 @@ func (g *GraphModel) renderEgoNode(id string, issue *model.Issue, width int, t T
 	}
 
 	icons := fmt.Sprintf("%s %s %s", statusIcon, prioIcon, typeIcon)
-	displayID := smartTruncateID(id, egoWidth-4)
+	displayID := smartTruncateID(g.displayID(id), egoWidth-4)
 	title := ""
 	if issue.Title != "" {
 		title = truncateRunesHelper(issue.Title, egoWidth-4, "…")
@@ -862,6 +985,15 @@ func getStatusIcon(status model.Status) string {
 	}
 }
 
+// issueColor returns the issue's color override if it has a valid one,
+// otherwise its status color.
+func issueColor(issue *model.Issue, t Theme) lipgloss.TerminalColor {
+	if c := issue.DisplayColor(); c != "" {
+		return lipgloss.Color(c)
+	}
+	return getStatusColor(issue.Status, t)
+}
+
 func getStatusColor(status model.Status, t Theme) lipgloss.AdaptiveColor {
 	switch status {
 	case model.StatusOpen:
@@ -204,3 +204,34 @@ func BenchmarkGraphModel_View_Wide_Layered1000(b *testing.B) {
 	}
 	runtime.KeepAlive(out)
 }
+
+// Re-filtering an unchanged issue set (SetIssues on every list update)
+// reuses the cached layout; the Recomputed variants rebuild it each time.
+func BenchmarkGraphModel_SetIssuesView_Cached_Layered5000(b *testing.B) {
+	issues, insights, theme := prepareGraphBench(50, 100)
+	g := ui.NewGraphModel(issues, insights, theme)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var out string
+	for i := 0; i < b.N; i++ {
+		g.SetIssues(issues, insights)
+		out = g.View(140, 40)
+	}
+	runtime.KeepAlive(out)
+}
+
+func BenchmarkGraphModel_SetIssuesView_Recomputed_Layered5000(b *testing.B) {
+	issues, insights, theme := prepareGraphBench(50, 100)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var out string
+	for i := 0; i < b.N; i++ {
+		g := ui.NewGraphModel(issues, insights, theme)
+		out = g.View(140, 40)
+	}
+	runtime.KeepAlive(out)
+}
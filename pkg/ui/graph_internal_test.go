@@ -3,6 +3,10 @@ package ui
 import (
 	"testing"
 	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	"github.com/charmbracelet/lipgloss"
 )
 
 func TestSmartTruncateID(t *testing.T) {
@@ -46,3 +50,55 @@ func TestSmartTruncateID(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueColorOverride(t *testing.T) {
+	theme := DefaultTheme(lipgloss.NewRenderer(nil))
+
+	colored := &model.Issue{ID: "a", Status: model.StatusOpen, Color: "#FF8800"}
+	if got := issueColor(colored, theme); got != lipgloss.Color("#ff8800") {
+		t.Errorf("issueColor = %v, want #ff8800", got)
+	}
+
+	invalid := &model.Issue{ID: "b", Status: model.StatusBlocked, Color: "sparkly"}
+	if got := issueColor(invalid, theme); got != theme.Blocked {
+		t.Errorf("invalid color should fall back to status color, got %v", got)
+	}
+}
+
+func TestGraphLayoutCache(t *testing.T) {
+	theme := DefaultTheme(lipgloss.NewRenderer(nil))
+	issues := []model.Issue{
+		{ID: "A", Title: "A", Status: model.StatusOpen},
+		{ID: "B", Title: "B", Status: model.StatusOpen, Dependencies: []*model.Dependency{{IssueID: "B", DependsOnID: "A", Type: model.DepBlocks}}},
+	}
+	g := NewGraphModel(issues, nil, theme)
+	first := g.layouts[graphLayoutKey(issues, nil)]
+	if first == nil || len(g.layouts) != 1 {
+		t.Fatalf("expected one cached layout, got %d", len(g.layouts))
+	}
+
+	// Same issue set, new content: the layout is reused, the content is not
+	edited := append([]model.Issue(nil), issues...)
+	edited[0].Title = "Renamed"
+	g.SetIssues(edited, nil)
+	if &g.sortedIDs[0] != &first.sortedIDs[0] || len(g.layouts) != 1 {
+		t.Error("expected the cached layout to be reused")
+	}
+	if g.issueMap["A"].Title != "Renamed" {
+		t.Errorf("issue content not refreshed: %q", g.issueMap["A"].Title)
+	}
+
+	// A new edge is a new layout
+	edited[0].Dependencies = []*model.Dependency{{IssueID: "A", DependsOnID: "C", Type: model.DepBlocks}}
+	g.SetIssues(edited, nil)
+	if len(g.layouts) != 2 || len(g.blockers["A"]) != 1 {
+		t.Errorf("expected a recomputed layout, got %d layouts, blockers %v", len(g.layouts), g.blockers["A"])
+	}
+
+	// A new analysis invalidates everything
+	stats := analysis.NewAnalyzer(edited).Analyze()
+	g.SetIssues(edited, &analysis.Insights{Stats: &stats})
+	if len(g.layouts) != 1 || g.layoutStats != &stats {
+		t.Errorf("expected the cache to be reset for new stats, got %d layouts", len(g.layouts))
+	}
+}
@@ -4,8 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"time"
-	"unicode/utf8"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/mattn/go-runewidth"
 )
@@ -59,13 +59,66 @@ func truncateRunesHelper(s string, maxWidth int, suffix string) string {
 	return runewidth.Truncate(s, targetWidth, "") + suffix
 }
 
-// padRight pads string s with spaces on the right to length width
+// truncateWithMode truncates a string to max visual width (cells) using the given
+// strategy, inserting ellipsis where text was removed. Cuts always fall on rune
+// boundaries so multi-byte characters (CJK, emoji) are never split.
+func truncateWithMode(s string, maxWidth int, mode config.TruncationMode, ellipsis string) string {
+	switch mode {
+	case config.TruncateLeft, config.TruncateMiddle:
+	default:
+		return truncateRunesHelper(s, maxWidth, ellipsis)
+	}
+
+	if maxWidth <= 0 {
+		return ""
+	}
+	if runewidth.StringWidth(s) <= maxWidth {
+		return s
+	}
+
+	ellipsisWidth := runewidth.StringWidth(ellipsis)
+	if ellipsisWidth > maxWidth {
+		return runewidth.Truncate(ellipsis, maxWidth, "")
+	}
+	budget := maxWidth - ellipsisWidth
+
+	if mode == config.TruncateLeft {
+		return ellipsis + tailWithinWidth(s, budget)
+	}
+
+	// Give any cells the head could not use (wide rune at the cut) to the tail.
+	head := runewidth.Truncate(s, (budget+1)/2, "")
+	tail := tailWithinWidth(s, budget-runewidth.StringWidth(head))
+	return head + ellipsis + tail
+}
+
+// tailWithinWidth returns the longest suffix of s that fits in maxWidth cells.
+func tailWithinWidth(s string, maxWidth int) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+	runes := []rune(s)
+	width := 0
+	start := len(runes)
+	for start > 0 {
+		w := runewidth.RuneWidth(runes[start-1])
+		if width+w > maxWidth {
+			break
+		}
+		width += w
+		start--
+	}
+	return string(runes[start:])
+}
+
+// padRight pads string s with spaces on the right to width display cells,
+// so wide characters (CJK, emoji) count as two.
 func padRight(s string, width int) string {
-	runeCount := utf8.RuneCountInString(s)
-	if runeCount >= width {
+	w := runewidth.StringWidth(s)
+	if w >= width {
 		return s
 	}
-	return s + strings.Repeat(" ", width-runeCount)
+	return s + strings.Repeat(" ", width-w)
 }
 
 // truncate truncates string s to maxRunes
@@ -204,11 +257,13 @@ func getDepTypeIcon(depType string) string {
 	switch depType {
 	case "root":
 		return "📍"
-	case "blocks":
+	case "blocks", "blocked_by":
 		return "⛔"
 	case "related":
 		return "🔗"
-	case "parent-child":
+	case "duplicate":
+		return "♊"
+	case "parent-child", "parent":
 		return "📦"
 	case "discovered-from":
 		return "🔍"
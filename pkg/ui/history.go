@@ -513,9 +513,7 @@ func (h *HistoryModel) renderBeadLine(idx int, hist correlation.BeadHistory, wid
 		maxTitleLen = 10
 	}
 	title := hist.Title
-	if len(title) > maxTitleLen {
-		title = title[:maxTitleLen-1] + "…"
-	}
+	title = truncateRunesHelper(title, maxTitleLen, "…")
 
 	// Build line
 	idStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Width(12)
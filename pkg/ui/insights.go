@@ -787,7 +787,7 @@ func (m *InsightsModel) renderCyclesPanel(width, height int, t Theme) string {
 
 	if len(cycles) == 0 {
 		healthyStyle := t.Renderer.NewStyle().
-			Foreground(t.Open).
+			Foreground(t.Healthy).
 			Bold(true)
 		sb.WriteString(healthyStyle.Render("✓ No cycles detected"))
 		sb.WriteString("\n")
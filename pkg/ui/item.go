@@ -46,6 +46,10 @@ type IssueItem struct {
 	IsQuickWin    bool     // True if identified as a quick win
 	IsBlocker     bool     // True if this item blocks significant downstream work
 	UnblocksCount int      // Number of items this unblocks
+
+	IsPinned  bool // Pinned to the top of the list (display config)
+	IsMarked  bool // Marked for a bulk action (space toggles)
+	IsWatched bool // Watched by the active view's watching user
 }
 
 func (i IssueItem) Title() string {
@@ -62,6 +66,10 @@ func (i IssueItem) FilterValue() string {
 	sb.WriteString(i.Issue.Title)
 	sb.WriteString(" ")
 	sb.WriteString(i.Issue.ID)
+	if i.Issue.ExternalID != "" {
+		sb.WriteString(" ")
+		sb.WriteString(i.Issue.ExternalID)
+	}
 	sb.WriteString(" ")
 	sb.WriteString(string(i.Issue.Status))
 	sb.WriteString(" ")
@@ -103,6 +111,26 @@ func ExtractRepoPrefix(id string) string {
 	return ""
 }
 
+// BareID strips the project prefix found by ExtractRepoPrefix, and its
+// separator, from an ID ("api-12" -> "12"). IDs without one are unchanged.
+func BareID(id string) string {
+	prefix := ExtractRepoPrefix(id)
+	if prefix == "" || len(id) <= len(prefix)+1 {
+		return id
+	}
+	return id[len(prefix)+1:]
+}
+
+// DisplayRefID formats refID as referenced from ownerID (a dependency or
+// graph neighbor). When bare is set it is shortened only if both IDs share
+// a project prefix, so cross-project references stay unambiguous.
+func DisplayRefID(ownerID, refID string, bare bool) string {
+	if !bare || !strings.EqualFold(ExtractRepoPrefix(ownerID), ExtractRepoPrefix(refID)) {
+		return refID
+	}
+	return BareID(refID)
+}
+
 // isAlphanumeric checks if a string contains only alphanumeric characters
 func isAlphanumeric(s string) bool {
 	for _, r := range s {
@@ -269,6 +269,19 @@ func TestIssueItemFilterValue(t *testing.T) {
 			},
 			shouldContain: []string{"ISSUE-6"},
 		},
+		{
+			name: "external ID included when present",
+			item: ui.IssueItem{
+				Issue: model.Issue{
+					ID:         "ISSUE-7",
+					Title:      "Synced Issue",
+					Status:     model.StatusOpen,
+					IssueType:  model.TypeTask,
+					ExternalID: "PROJ-123",
+				},
+			},
+			shouldContain: []string{"ISSUE-7", "PROJ-123"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -448,6 +461,31 @@ func TestExtractRepoPrefixSeparatorPriority(t *testing.T) {
 	}
 }
 
+func TestBareID(t *testing.T) {
+	tests := []struct{ id, want string }{
+		{"api-12", "12"},
+		{"api-bv-7", "bv-7"},
+		{"svc:task_1", "task_1"},
+		{"plain", "plain"},
+		{"api-", "api-"},
+	}
+	for _, tt := range tests {
+		if got := ui.BareID(tt.id); got != tt.want {
+			t.Errorf("BareID(%q) = %q, want %q", tt.id, got, tt.want)
+		}
+	}
+
+	if got := ui.DisplayRefID("api-1", "api-2", true); got != "2" {
+		t.Errorf("same-project ref = %q, want 2", got)
+	}
+	if got := ui.DisplayRefID("api-1", "web-2", true); got != "web-2" {
+		t.Errorf("cross-project ref = %q, want web-2 kept", got)
+	}
+	if got := ui.DisplayRefID("api-1", "api-2", false); got != "api-2" {
+		t.Errorf("namespaced ref = %q, want api-2", got)
+	}
+}
+
 // =============================================================================
 // IssueItem Triage Fields Tests
 // =============================================================================
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -13,11 +14,36 @@ import (
 // LabelDashboardModel renders a lightweight table of label health
 type LabelDashboardModel struct {
 	labels       []analysis.LabelHealth
-	cursor       int
-	scrollOffset int // Index of the first visible row
+	rows         []labelDashboardRow // Visible rows; one per label unless grouping
+	cursor       int                 // Index into rows
+	scrollOffset int                 // Index of the first visible row
 	width        int
 	height       int
 	theme        Theme
+	sortMode     config.LabelSort // Health (default) or blocked count
+
+	// Grouping by label prefix (text before ':', e.g. "area:backend")
+	groupByPrefix bool
+	groups        []*labelGroup
+	collapsed     map[string]bool // prefix -> collapsed
+}
+
+// labelDashboardRow is either a group header (group set, label -1) or a
+// label row (label indexes into labels; group set if it belongs to one).
+type labelDashboardRow struct {
+	group *labelGroup
+	label int
+}
+
+func (r labelDashboardRow) isHeader() bool {
+	return r.group != nil && r.label < 0
+}
+
+// labelGroup aggregates health across labels sharing a prefix.
+type labelGroup struct {
+	prefix string
+	labels []int // Indexes into labels, in dashboard sort order
+	health analysis.LabelHealth
 }
 
 func NewLabelDashboardModel(theme Theme) LabelDashboardModel {
@@ -30,50 +56,269 @@ func (m *LabelDashboardModel) SetSize(width, height int) {
 }
 
 func (m *LabelDashboardModel) SetData(labels []analysis.LabelHealth) {
+	prevLabel, prevGroup := m.cursorKey()
 	m.labels = labels
-	// Sort by health level (critical first), then blocked desc, then health asc, then name
+	m.sortLabels()
+	m.rebuildRows(prevLabel, prevGroup)
+}
+
+// SetSort changes the dashboard order, keeping the cursor on the same
+// label or group.
+func (m *LabelDashboardModel) SetSort(mode config.LabelSort) {
+	if !mode.IsValid() {
+		mode = config.LabelSortHealth
+	}
+	if m.sortMode == mode {
+		return
+	}
+	prevLabel, prevGroup := m.cursorKey()
+	m.sortMode = mode
+	m.sortLabels()
+	m.rebuildRows(prevLabel, prevGroup)
+}
+
+// Sort returns the dashboard order.
+func (m *LabelDashboardModel) Sort() config.LabelSort {
+	if m.sortMode == "" {
+		return config.LabelSortHealth
+	}
+	return m.sortMode
+}
+
+// sortLabels orders labels for the current sort mode.
+func (m *LabelDashboardModel) sortLabels() {
 	sort.SliceStable(m.labels, func(i, j int) bool {
-		li, lj := m.labels[i], m.labels[j]
-		levelRank := func(l string) int {
-			switch l {
-			case analysis.HealthLevelCritical:
-				return 0
-			case analysis.HealthLevelWarning:
-				return 1
-			default:
-				return 2
+		return m.less(m.labels[i], m.labels[j])
+	})
+}
+
+// less orders two labels (or group aggregates) for the current sort mode.
+func (m *LabelDashboardModel) less(li, lj analysis.LabelHealth) bool {
+	if m.sortMode == config.LabelSortBlocked && li.Blocked != lj.Blocked {
+		return li.Blocked > lj.Blocked
+	}
+	return labelHealthLess(li, lj)
+}
+
+// SetGroupByPrefix enables or disables grouping labels by their prefix.
+func (m *LabelDashboardModel) SetGroupByPrefix(enabled bool) {
+	if m.groupByPrefix == enabled {
+		return
+	}
+	prevLabel, prevGroup := m.cursorKey()
+	m.groupByPrefix = enabled
+	m.rebuildRows(prevLabel, prevGroup)
+}
+
+// GroupByPrefix reports whether labels are grouped by prefix.
+func (m *LabelDashboardModel) GroupByPrefix() bool {
+	return m.groupByPrefix
+}
+
+// SelectedLabel returns the label under the cursor; false on a group header.
+func (m *LabelDashboardModel) SelectedLabel() (analysis.LabelHealth, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.rows) {
+		return analysis.LabelHealth{}, false
+	}
+	row := m.rows[m.cursor]
+	if row.isHeader() || row.label >= len(m.labels) {
+		return analysis.LabelHealth{}, false
+	}
+	return m.labels[row.label], true
+}
+
+// labelPrefix returns the text before the first ':' or "" if there is none.
+func labelPrefix(label string) string {
+	if i := strings.Index(label, ":"); i > 0 {
+		return label[:i]
+	}
+	return ""
+}
+
+// cursorKey identifies the row under the cursor by label name or group prefix.
+func (m *LabelDashboardModel) cursorKey() (label, group string) {
+	if m.cursor < 0 || m.cursor >= len(m.rows) {
+		return "", ""
+	}
+	row := m.rows[m.cursor]
+	if row.isHeader() {
+		return "", row.group.prefix
+	}
+	if row.label < len(m.labels) {
+		return m.labels[row.label].Label, ""
+	}
+	return "", ""
+}
+
+// rebuildRows recomputes groups and visible rows, moving the cursor to the
+// previously selected label or group where possible.
+func (m *LabelDashboardModel) rebuildRows(prevLabel, prevGroup string) {
+
+	m.rows = m.rows[:0]
+	m.groups = nil
+
+	if !m.groupByPrefix {
+		for i := range m.labels {
+			m.rows = append(m.rows, labelDashboardRow{label: i})
+		}
+	} else {
+		m.buildGroups()
+		for _, g := range m.groups {
+			m.rows = append(m.rows, labelDashboardRow{group: g, label: -1})
+			if m.collapsed[g.prefix] {
+				continue
+			}
+			for _, idx := range g.labels {
+				m.rows = append(m.rows, labelDashboardRow{group: g, label: idx})
 			}
 		}
-		ri, rj := levelRank(li.HealthLevel), levelRank(lj.HealthLevel)
-		if ri != rj {
-			return ri < rj
+		// Labels without a prefix follow the groups, ungrouped
+		for i := range m.labels {
+			if labelPrefix(m.labels[i].Label) == "" {
+				m.rows = append(m.rows, labelDashboardRow{label: i})
+			}
+		}
+	}
+
+	for i, row := range m.rows {
+		if (prevGroup != "" && row.isHeader() && row.group.prefix == prevGroup) ||
+			(prevLabel != "" && !row.isHeader() && m.labels[row.label].Label == prevLabel) {
+			m.cursor = i
+			break
+		}
+	}
+	if m.cursor >= len(m.rows) {
+		m.cursor = len(m.rows) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+	m.clampScroll()
+}
+
+// buildGroups groups prefixed labels and orders groups using the same
+// ranking as individual labels.
+func (m *LabelDashboardModel) buildGroups() {
+	byPrefix := make(map[string]*labelGroup)
+	for i, lh := range m.labels {
+		prefix := labelPrefix(lh.Label)
+		if prefix == "" {
+			continue
+		}
+		g, ok := byPrefix[prefix]
+		if !ok {
+			g = &labelGroup{prefix: prefix}
+			byPrefix[prefix] = g
+			m.groups = append(m.groups, g)
 		}
-		if li.Blocked != lj.Blocked {
-			return li.Blocked > lj.Blocked
+		g.labels = append(g.labels, i)
+	}
+
+	for _, g := range m.groups {
+		agg := analysis.LabelHealth{Label: g.prefix, HealthLevel: analysis.HealthLevelHealthy}
+		healthSum, weightSum := 0, 0
+		for _, idx := range g.labels {
+			lh := m.labels[idx]
+			agg.IssueCount += lh.IssueCount
+			agg.Blocked += lh.Blocked
+			agg.Velocity.ClosedLast7Days += lh.Velocity.ClosedLast7Days
+			agg.Velocity.ClosedLast30Days += lh.Velocity.ClosedLast30Days
+			agg.Freshness.StaleCount += lh.Freshness.StaleCount
+			if labelLevelRank(lh.HealthLevel) < labelLevelRank(agg.HealthLevel) {
+				agg.HealthLevel = lh.HealthLevel
+			}
+			// Weight by issue count so a big unhealthy label dominates
+			w := lh.IssueCount
+			if w < 1 {
+				w = 1
+			}
+			healthSum += lh.Health * w
+			weightSum += w
 		}
-		if li.Health != lj.Health {
-			return li.Health < lj.Health
+		if weightSum > 0 {
+			agg.Health = healthSum / weightSum
 		}
-		return li.Label < lj.Label
+		g.health = agg
+	}
+
+	sort.SliceStable(m.groups, func(i, j int) bool {
+		return m.less(m.groups[i].health, m.groups[j].health)
 	})
-	if m.cursor >= len(labels) {
-		m.cursor = len(labels) - 1
-		if m.cursor < 0 {
-			m.cursor = 0
-		}
+}
+
+// toggleCollapsed collapses or expands the group under the cursor.
+func (m *LabelDashboardModel) toggleCollapsed() {
+	if m.cursor < 0 || m.cursor >= len(m.rows) || m.rows[m.cursor].group == nil {
+		return
+	}
+	prefix := m.rows[m.cursor].group.prefix
+	if m.collapsed == nil {
+		m.collapsed = make(map[string]bool)
 	}
+	m.collapsed[prefix] = !m.collapsed[prefix]
+	// Park the cursor on the header so it doesn't vanish into a collapsed group
+	m.rebuildRows("", prefix)
 }
 
-// Update handles navigation keys; returns selected label on enter
-func (m *LabelDashboardModel) Update(msg tea.KeyMsg) (string, tea.Cmd) {
+func (m *LabelDashboardModel) visibleRows() int {
 	visibleRows := m.height - 1
 	if visibleRows < 1 {
 		visibleRows = 1
 	}
+	return visibleRows
+}
+
+// clampScroll keeps the cursor inside the visible window.
+func (m *LabelDashboardModel) clampScroll() {
+	visibleRows := m.visibleRows()
+	if m.cursor < m.scrollOffset {
+		m.scrollOffset = m.cursor
+	}
+	if m.cursor >= m.scrollOffset+visibleRows {
+		m.scrollOffset = m.cursor - visibleRows + 1
+	}
+	if maxOffset := len(m.rows) - visibleRows; m.scrollOffset > maxOffset {
+		m.scrollOffset = maxOffset
+	}
+	if m.scrollOffset < 0 {
+		m.scrollOffset = 0
+	}
+}
+
+func labelLevelRank(level string) int {
+	switch level {
+	case analysis.HealthLevelCritical:
+		return 0
+	case analysis.HealthLevelWarning:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// labelHealthLess orders critical first, then blocked desc, health asc, name.
+func labelHealthLess(li, lj analysis.LabelHealth) bool {
+	ri, rj := labelLevelRank(li.HealthLevel), labelLevelRank(lj.HealthLevel)
+	if ri != rj {
+		return ri < rj
+	}
+	if li.Blocked != lj.Blocked {
+		return li.Blocked > lj.Blocked
+	}
+	if li.Health != lj.Health {
+		return li.Health < lj.Health
+	}
+	return li.Label < lj.Label
+}
+
+// Update handles navigation keys; returns selected label on enter.
+// With grouping enabled, enter (or space) on a group header toggles it.
+func (m *LabelDashboardModel) Update(msg tea.KeyMsg) (string, tea.Cmd) {
+	visibleRows := m.visibleRows()
 
 	switch msg.String() {
 	case "j", "down":
-		if m.cursor < len(m.labels)-1 {
+		if m.cursor < len(m.rows)-1 {
 			m.cursor++
 			// Scroll down if moving past bottom
 			if m.cursor >= m.scrollOffset+visibleRows {
@@ -92,18 +337,28 @@ func (m *LabelDashboardModel) Update(msg tea.KeyMsg) (string, tea.Cmd) {
 		m.cursor = 0
 		m.scrollOffset = 0
 	case "G", "end":
-		if len(m.labels) > 0 {
-			m.cursor = len(m.labels) - 1
+		if len(m.rows) > 0 {
+			m.cursor = len(m.rows) - 1
 			// Scroll to bottom
-			if len(m.labels) > visibleRows {
-				m.scrollOffset = len(m.labels) - visibleRows
+			if len(m.rows) > visibleRows {
+				m.scrollOffset = len(m.rows) - visibleRows
 			} else {
 				m.scrollOffset = 0
 			}
 		}
+	case "z":
+		m.SetGroupByPrefix(!m.groupByPrefix)
+	case " ":
+		if m.groupByPrefix {
+			m.toggleCollapsed()
+		}
 	case "enter":
-		if m.cursor >= 0 && m.cursor < len(m.labels) {
-			return m.labels[m.cursor].Label, nil
+		if m.cursor >= 0 && m.cursor < len(m.rows) && m.rows[m.cursor].isHeader() {
+			m.toggleCollapsed()
+			return "", nil
+		}
+		if lh, ok := m.SelectedLabel(); ok {
+			return lh.Label, nil
 		}
 	}
 	return "", nil
@@ -115,6 +370,11 @@ func (m LabelDashboardModel) View() string {
 	}
 
 	headers := []string{"Label", "Health", "Blocked", "Velocity 7d/30d", "Stale"}
+	if m.sortMode == config.LabelSortBlocked {
+		headers[2] = "Blocked ▼"
+	} else {
+		headers[1] = "Health ▲"
+	}
 	widths := m.computeColumnWidths(headers)
 
 	var b strings.Builder
@@ -123,20 +383,16 @@ func (m LabelDashboardModel) View() string {
 	b.WriteString(headerLine)
 	b.WriteString("\n")
 
-	visibleRows := m.height - 1
-	if visibleRows < 1 {
-		visibleRows = 1
-	}
+	visibleRows := m.visibleRows()
 
 	start := m.scrollOffset
 	end := start + visibleRows
-	if end > len(m.labels) {
-		end = len(m.labels)
+	if end > len(m.rows) {
+		end = len(m.rows)
 	}
 
 	for i := start; i < end; i++ {
-		lh := m.labels[i]
-		row := m.getRowCells(lh)
+		row := m.getCellsForRow(m.rows[i])
 		selected := i == m.cursor
 		b.WriteString(m.renderRow(row, widths, false, selected))
 		if i != end-1 {
@@ -147,6 +403,26 @@ func (m LabelDashboardModel) View() string {
 	return b.String()
 }
 
+// getCellsForRow returns the rendered cells for a dashboard row, including
+// group headers and the indentation of grouped labels.
+func (m LabelDashboardModel) getCellsForRow(row labelDashboardRow) []string {
+	if row.isHeader() {
+		g := row.group
+		marker := "▾"
+		if m.collapsed[g.prefix] {
+			marker = "▸"
+		}
+		cells := m.getRowCells(g.health)
+		cells[0] = m.theme.Base.Bold(true).Render(fmt.Sprintf("%s %s (%d)", marker, g.prefix, len(g.labels)))
+		return cells
+	}
+	cells := m.getRowCells(m.labels[row.label])
+	if row.group != nil {
+		cells[0] = "  " + cells[0]
+	}
+	return cells
+}
+
 // getRowCells returns the fully rendered (colored) cells for a label row
 func (m LabelDashboardModel) getRowCells(lh analysis.LabelHealth) []string {
 	return []string{
@@ -163,8 +439,8 @@ func (m LabelDashboardModel) computeColumnWidths(headers []string) []int {
 	for i, h := range headers {
 		widths[i] = lipgloss.Width(h)
 	}
-	for _, lh := range m.labels {
-		cells := m.getRowCells(lh)
+	for _, row := range m.rows {
+		cells := m.getCellsForRow(row)
 		for i, c := range cells {
 			w := lipgloss.Width(c)
 			if w > widths[i] {
@@ -234,9 +510,9 @@ func (m LabelDashboardModel) renderHealthCell(lh analysis.LabelHealth) string {
 	style := m.theme.Base
 	switch lh.HealthLevel {
 	case analysis.HealthLevelHealthy:
-		style = style.Foreground(m.theme.Open)
+		style = style.Foreground(m.theme.Healthy)
 	case analysis.HealthLevelWarning:
-		style = style.Foreground(m.theme.Feature) // orange-ish
+		style = style.Foreground(m.theme.Warning)
 	default:
 		style = style.Foreground(m.theme.Blocked)
 	}
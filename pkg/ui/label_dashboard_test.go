@@ -2,9 +2,12 @@ package ui
 
 import (
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -594,3 +597,178 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func groupedFixture() []analysis.LabelHealth {
+	return []analysis.LabelHealth{
+		{Label: "area:backend", HealthLevel: analysis.HealthLevelCritical, Blocked: 2, Health: 20, IssueCount: 3},
+		{Label: "area:frontend", HealthLevel: analysis.HealthLevelHealthy, Health: 80, IssueCount: 1},
+		{Label: "team:infra", HealthLevel: analysis.HealthLevelHealthy, Health: 90, IssueCount: 2},
+		{Label: "urgent", HealthLevel: analysis.HealthLevelWarning, Blocked: 1, Health: 50, IssueCount: 1},
+	}
+}
+
+func TestLabelDashboardModel_GroupByPrefixRows(t *testing.T) {
+	m := NewLabelDashboardModel(Theme{})
+	m.SetSize(80, 20)
+	m.SetData(groupedFixture())
+	m.SetGroupByPrefix(true)
+
+	// area header, 2 area labels, team header, 1 team label, then ungrouped "urgent"
+	if len(m.rows) != 6 {
+		t.Fatalf("rows=%d; want 6", len(m.rows))
+	}
+	if !m.rows[0].isHeader() || m.rows[0].group.prefix != "area" {
+		t.Fatalf("first row should be area header, got %+v", m.rows[0])
+	}
+	if !m.rows[3].isHeader() || m.rows[3].group.prefix != "team" {
+		t.Fatalf("fourth row should be team header, got %+v", m.rows[3])
+	}
+	if last := m.rows[5]; last.isHeader() || last.group != nil || m.labels[last.label].Label != "urgent" {
+		t.Fatalf("ungrouped label should come last, got %+v", last)
+	}
+
+	area := m.rows[0].group.health
+	if area.HealthLevel != analysis.HealthLevelCritical {
+		t.Errorf("group level=%q; want worst member level critical", area.HealthLevel)
+	}
+	if area.Blocked != 2 || area.IssueCount != 4 {
+		t.Errorf("group blocked=%d issues=%d; want 2/4", area.Blocked, area.IssueCount)
+	}
+	// Issue-weighted: (20*3 + 80*1) / 4 = 35
+	if area.Health != 35 {
+		t.Errorf("group health=%d; want 35", area.Health)
+	}
+
+	view := m.View()
+	if !strings.Contains(view, "▾ area (2)") || !strings.Contains(view, "▾ team (1)") {
+		t.Errorf("view missing group headers:\n%s", view)
+	}
+}
+
+func TestLabelDashboardModel_GroupNavigationAndCollapse(t *testing.T) {
+	m := NewLabelDashboardModel(Theme{})
+	m.SetSize(80, 20)
+	m.SetData(groupedFixture())
+	m.SetGroupByPrefix(true)
+
+	// Selection follows area:backend into its group; go to the header
+	if lh, _ := m.SelectedLabel(); lh.Label != "area:backend" {
+		t.Fatalf("selected=%q; want area:backend", lh.Label)
+	}
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+
+	// Enter on a header toggles collapse instead of selecting
+	if label, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); label != "" {
+		t.Fatalf("enter on header returned %q; want empty", label)
+	}
+	if !m.collapsed["area"] || len(m.rows) != 4 {
+		t.Fatalf("area should be collapsed: rows=%d", len(m.rows))
+	}
+	if _, ok := m.SelectedLabel(); ok {
+		t.Fatal("SelectedLabel should be false on a header")
+	}
+	if !strings.Contains(m.View(), "▸ area (2)") {
+		t.Errorf("collapsed header should use ▸ marker")
+	}
+
+	// j moves from the collapsed header straight to the next group
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	label, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if label != "team:infra" {
+		t.Fatalf("enter label=%q; want team:infra", label)
+	}
+
+	// Space expands again, cursor stays on the header
+	m.Update(tea.KeyMsg{Type: tea.KeyHome})
+	m.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if m.collapsed["area"] || len(m.rows) != 6 || m.cursor != 0 {
+		t.Fatalf("area should be expanded with cursor on header: rows=%d cursor=%d", len(m.rows), m.cursor)
+	}
+}
+
+func TestLabelDashboardModel_ToggleGroupingKeepsSelection(t *testing.T) {
+	m := NewLabelDashboardModel(Theme{})
+	m.SetSize(80, 20)
+	m.SetData(groupedFixture())
+
+	// Ungrouped order: critical backend, warning urgent, then healthy by health asc
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if lh, _ := m.SelectedLabel(); lh.Label != "urgent" {
+		t.Fatalf("selected=%q; want urgent", lh.Label)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	if !m.GroupByPrefix() {
+		t.Fatal("z should enable grouping")
+	}
+	if lh, ok := m.SelectedLabel(); !ok || lh.Label != "urgent" {
+		t.Fatalf("selection lost after grouping: %q", lh.Label)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	if m.GroupByPrefix() || len(m.rows) != 4 {
+		t.Fatalf("z should disable grouping: rows=%d", len(m.rows))
+	}
+	if lh, _ := m.SelectedLabel(); lh.Label != "urgent" {
+		t.Fatalf("selection lost after ungrouping: %q", lh.Label)
+	}
+}
+
+func TestLabelDashboardModel_SortByBlocked(t *testing.T) {
+	labels := func(m LabelDashboardModel) string {
+		var names []string
+		for _, lh := range m.labels {
+			names = append(names, lh.Label)
+		}
+		return strings.Join(names, ",")
+	}
+	m := NewLabelDashboardModel(createTheme())
+	m.SetSize(100, 10)
+	m.SetData([]analysis.LabelHealth{
+		{Label: "api", HealthLevel: analysis.HealthLevelCritical, Blocked: 1, Health: 20},
+		{Label: "web", HealthLevel: analysis.HealthLevelHealthy, Blocked: 6, Health: 80},
+		{Label: "db", HealthLevel: analysis.HealthLevelWarning, Blocked: 3, Health: 50},
+	})
+	if got := labels(m); got != "api,db,web" {
+		t.Fatalf("health order = %s, want api,db,web", got)
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")}) // select db
+	m.SetSort(config.LabelSortBlocked)
+	if got := labels(m); got != "web,db,api" {
+		t.Errorf("blocked order = %s, want web,db,api", got)
+	}
+	if lh, ok := m.SelectedLabel(); !ok || lh.Label != "db" {
+		t.Errorf("selection should stay on db, got %+v", lh)
+	}
+	if !strings.Contains(m.View(), "Blocked ▼") {
+		t.Error("header should mark the blocked column as sorted")
+	}
+}
+
+func TestLabelSortTogglePersists(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "A", Title: "Alpha", Status: model.StatusOpen, Labels: []string{"api"}}}, nil, "")
+	defer m.Stop()
+	var saved []config.LabelSort
+	m.SetDisplaySaver(func(cfg config.DisplayConfig) error {
+		saved = append(saved, cfg.LabelSort)
+		return nil
+	})
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(Model)
+	}
+
+	press("[")
+	press("s")
+	if m.labelDashboard.Sort() != config.LabelSortBlocked || m.focused != focusLabelDashboard {
+		t.Fatalf("s should sort the dashboard by blocked count, sort = %q", m.labelDashboard.Sort())
+	}
+	press("s")
+	if want := []config.LabelSort{config.LabelSortBlocked, config.LabelSortHealth}; len(saved) != 2 || saved[0] != want[0] || saved[1] != want[1] {
+		t.Errorf("saved = %v, want %v", saved, want)
+	}
+}
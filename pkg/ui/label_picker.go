@@ -192,6 +192,12 @@ func fuzzyScore(label, query string) int {
 	return 0
 }
 
+// FuzzyScore exposes the label picker's matcher so CLI flags such as --repo
+// match names the same way the TUI does
+func FuzzyScore(label, query string) int {
+	return fuzzyScore(label, query)
+}
+
 // View renders the label picker overlay
 func (m *LabelPickerModel) View() string {
 	if m.width == 0 {
@@ -1,11 +1,24 @@
 package ui
 
 import (
+	"strings"
+
 	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/glamour/ansi"
 	"github.com/charmbracelet/lipgloss"
+	xansi "github.com/charmbracelet/x/ansi"
+	"github.com/muesli/termenv"
 )
 
+// markdownOptions adds the options every glamour renderer shares: styling
+// is dropped when DisableColor was called.
+func markdownOptions(opts ...glamour.TermRendererOption) []glamour.TermRendererOption {
+	if colorDisabled {
+		opts = append(opts, glamour.WithColorProfile(termenv.Ascii))
+	}
+	return opts
+}
+
 // MarkdownRenderer provides theme-aware markdown rendering using glamour.
 // It detects the terminal's color scheme and uses appropriate styles.
 type MarkdownRenderer struct {
@@ -29,10 +42,10 @@ func NewMarkdownRenderer(width int) *MarkdownRenderer {
 		styleName = "light"
 	}
 
-	renderer, _ := glamour.NewTermRenderer(
+	renderer, _ := glamour.NewTermRenderer(markdownOptions(
 		glamour.WithStylePath(styleName),
 		glamour.WithWordWrap(width),
-	)
+	)...)
 
 	return &MarkdownRenderer{
 		renderer: renderer,
@@ -49,10 +62,10 @@ func NewMarkdownRendererWithTheme(width int, theme Theme) *MarkdownRenderer {
 	isDark := lipgloss.HasDarkBackground()
 	styleConfig := buildStyleFromTheme(theme, isDark)
 
-	renderer, err := glamour.NewTermRenderer(
+	renderer, err := glamour.NewTermRenderer(markdownOptions(
 		glamour.WithStyles(styleConfig),
 		glamour.WithWordWrap(width),
-	)
+	)...)
 	if err != nil {
 		// Fall back to built-in style if custom theme fails
 		var styleName string
@@ -61,10 +74,10 @@ func NewMarkdownRendererWithTheme(width int, theme Theme) *MarkdownRenderer {
 		} else {
 			styleName = "light"
 		}
-		renderer, _ = glamour.NewTermRenderer(
+		renderer, _ = glamour.NewTermRenderer(markdownOptions(
 			glamour.WithStylePath(styleName),
 			glamour.WithWordWrap(width),
-		)
+		)...)
 	}
 
 	return &MarkdownRenderer{
@@ -81,7 +94,12 @@ func (mr *MarkdownRenderer) Render(markdown string) (string, error) {
 	if mr.renderer == nil {
 		return markdown, nil
 	}
-	return mr.renderer.Render(markdown)
+	out, err := mr.renderer.Render(markdown)
+	if err == nil && colorDisabled {
+		// Glamour writes bold/italic sequences regardless of color profile.
+		out = xansi.Strip(out)
+	}
+	return out, err
 }
 
 // SetWidth updates the word wrap width and recreates the renderer.
@@ -95,10 +113,10 @@ func (mr *MarkdownRenderer) SetWidth(width int) {
 	// If created with a theme, preserve it
 	if mr.useTheme && mr.theme != nil {
 		styleConfig := buildStyleFromTheme(*mr.theme, mr.isDark)
-		if r, err := glamour.NewTermRenderer(
+		if r, err := glamour.NewTermRenderer(markdownOptions(
 			glamour.WithStyles(styleConfig),
 			glamour.WithWordWrap(width),
-		); err == nil {
+		)...); err == nil {
 			mr.renderer = r
 			mr.width = width
 		}
@@ -113,10 +131,10 @@ func (mr *MarkdownRenderer) SetWidth(width int) {
 		styleName = "light"
 	}
 
-	if r, err := glamour.NewTermRenderer(
+	if r, err := glamour.NewTermRenderer(markdownOptions(
 		glamour.WithStylePath(styleName),
 		glamour.WithWordWrap(width),
-	); err == nil {
+	)...); err == nil {
 		mr.renderer = r
 		mr.width = width
 	}
@@ -134,10 +152,10 @@ func (mr *MarkdownRenderer) SetWidthWithTheme(width int, theme Theme) {
 	// Allow recreation even if width is the same (theme might have changed)
 	styleConfig := buildStyleFromTheme(theme, mr.isDark)
 
-	r, err := glamour.NewTermRenderer(
+	r, err := glamour.NewTermRenderer(markdownOptions(
 		glamour.WithStyles(styleConfig),
 		glamour.WithWordWrap(width),
-	)
+	)...)
 	if err != nil {
 		// Fall back to built-in style if custom theme fails
 		var styleName string
@@ -146,10 +164,10 @@ func (mr *MarkdownRenderer) SetWidthWithTheme(width int, theme Theme) {
 		} else {
 			styleName = "light"
 		}
-		r, _ = glamour.NewTermRenderer(
+		r, _ = glamour.NewTermRenderer(markdownOptions(
 			glamour.WithStylePath(styleName),
 			glamour.WithWordWrap(width),
-		)
+		)...)
 	}
 	if r != nil {
 		mr.renderer = r
@@ -423,6 +441,49 @@ func buildStyleFromTheme(theme Theme, isDark bool) ansi.StyleConfig {
 	}
 }
 
+// nestMarkdownHeadings shifts ATX headings in user-authored markdown so the
+// top level renders at minLevel, keeping an issue body's own headings below
+// the detail pane's section headers. Fenced code blocks are left untouched.
+func nestMarkdownHeadings(md string, minLevel int) string {
+	shift := minLevel - 1
+	if shift <= 0 || !strings.Contains(md, "#") {
+		return md
+	}
+
+	lines := strings.Split(md, "\n")
+	fence := ""
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+		if len(line)-len(trimmed) > 3 {
+			continue // indented code block
+		}
+
+		level := 0
+		for level < len(trimmed) && trimmed[level] == '#' {
+			level++
+		}
+		if level == 0 || level > 6 || (level < len(trimmed) && trimmed[level] != ' ' && trimmed[level] != '\t') {
+			continue
+		}
+		newLevel := level + shift
+		if newLevel > 6 {
+			newLevel = 6
+		}
+		lines[i] = strings.Repeat("#", newLevel) + trimmed[level:]
+	}
+	return strings.Join(lines, "\n")
+}
+
 // extractHex gets the hex color string from an AdaptiveColor.
 func extractHex(ac lipgloss.AdaptiveColor, isDark bool) string {
 	if isDark {
@@ -223,3 +223,27 @@ func TestBuildStyleFromTheme(t *testing.T) {
 		t.Errorf("expected light mode BackgroundColor to be nil, got %v", lightConfig.Document.BackgroundColor)
 	}
 }
+
+func TestNestMarkdownHeadings(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		minLevel int
+		want     string
+	}{
+		{"no headings", "plain **text**", 4, "plain **text**"},
+		{"shift", "# Top\n## Sub\ntext", 4, "#### Top\n##### Sub\ntext"},
+		{"capped at six", "#### Deep", 4, "###### Deep"},
+		{"not a heading", "#hashtag and a # in text", 4, "#hashtag and a # in text"},
+		{"fenced code untouched", "```sh\n# comment\n```\n# After", 3, "```sh\n# comment\n```\n### After"},
+		{"indented code untouched", "    # code", 3, "    # code"},
+		{"no shift for level one", "# Top", 1, "# Top"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nestMarkdownHeadings(tt.input, tt.minLevel); got != tt.want {
+				t.Errorf("nestMarkdownHeadings() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
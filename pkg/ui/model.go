@@ -2,6 +2,7 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/baseline"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/correlation"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/drift"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/export"
@@ -58,6 +60,10 @@ const (
 	focusAttention
 	focusLabelPicker
 	focusSprint // Sprint dashboard view (bv-161)
+	focusViewPicker
+	focusReadyQueue
+	focusFilterMenu
+	focusDependencyEditor
 )
 
 // SortMode represents the current list sorting mode (bv-3ita)
@@ -88,6 +94,50 @@ func (s SortMode) String() string {
 	}
 }
 
+// Key returns the display config name for the sort mode
+func (s SortMode) Key() config.SortKey {
+	switch s {
+	case SortCreatedAsc:
+		return config.SortCreatedAsc
+	case SortCreatedDesc:
+		return config.SortCreatedDesc
+	case SortPriority:
+		return config.SortPriority
+	case SortUpdated:
+		return config.SortUpdated
+	default:
+		return config.SortDefault
+	}
+}
+
+// SortModeFromKey maps a display config sort key to a SortMode.
+// Unknown keys map to SortDefault.
+func SortModeFromKey(key config.SortKey) SortMode {
+	for s := SortDefault; s < numSortModes; s++ {
+		if s.Key() == key {
+			return s
+		}
+	}
+	return SortDefault
+}
+
+// column returns the list column heading the sort mode orders by
+func (s SortMode) column() string {
+	switch s {
+	case SortCreatedAsc, SortCreatedDesc:
+		return "CREATED"
+	case SortUpdated:
+		return "UPDATED"
+	default:
+		return "PRI"
+	}
+}
+
+// ascending reports the natural (unreversed) direction of the sort mode
+func (s SortMode) ascending() bool {
+	return s != SortCreatedDesc && s != SortUpdated
+}
+
 // LabelGraphAnalysisResult holds label-specific graph analysis results (bv-109)
 type LabelGraphAnalysisResult struct {
 	Label        string
@@ -118,6 +168,19 @@ func WaitForPhase2Cmd(stats *analysis.GraphStats) tea.Cmd {
 // FileChangedMsg is sent when the beads file changes on disk
 type FileChangedMsg struct{}
 
+// RefreshTickMsg is sent when the auto-refresh interval elapses
+type RefreshTickMsg struct{}
+
+// RefreshTickCmd returns a command that sends RefreshTickMsg after d
+func RefreshTickCmd(d time.Duration) tea.Cmd {
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return RefreshTickMsg{}
+	})
+}
+
+// IssueReloader re-reads the full issue set for auto-refresh
+type IssueReloader func() ([]model.Issue, error)
+
 // semanticDebounceTickMsg is sent after debounce delay to trigger semantic computation
 type semanticDebounceTickMsg struct{}
 
@@ -205,6 +268,16 @@ type Model struct {
 	beadsPath string           // Path to beads.jsonl for reloading
 	watcher   *watcher.Watcher // File watcher for live reload
 
+	// Options the beads file and its history are re-read with
+	parseOpts loader.ParseOptions
+
+	// Time-based reload (--refresh)
+	refreshInterval time.Duration // 0 disables
+	reloader        IssueReloader // nil means reload from beadsPath
+
+	// Alerts on newly blocked high-priority issues (--notify)
+	notifier *blockedNotifier // nil disables
+
 	// UI Components
 	list               list.Model
 	viewport           viewport.Model
@@ -233,6 +306,8 @@ type Model struct {
 	showHelp                 bool
 	helpScroll               int // Scroll offset for help overlay
 	showQuitConfirm          bool
+	showSourceInfo           bool       // I: where the selected issue was loaded from
+	sourceInfo               sourceInfo // Contents of the source overlay
 	ready                    bool
 	width                    int
 	height                   int
@@ -264,6 +339,7 @@ type Model struct {
 	// Filter and sort state
 	currentFilter         string
 	sortMode              SortMode // bv-3ita: current sort mode
+	sortReverse           bool     // Flip the direction of sortMode
 	semanticSearchEnabled bool
 	semanticIndexBuilding bool
 	semanticSearch        *SemanticSearch
@@ -288,9 +364,28 @@ type Model struct {
 	// Recipe picker
 	showRecipePicker bool
 	recipePicker     RecipePickerModel
+
+	// Detail pane folds: which sections are folded, and the sections shown
+	// for the current issue in order (the 1-9 keys index into it)
+	detailFolded map[config.DetailSection]bool
+	detailShown  []config.DetailSection
+
+	// Saved views (display.yaml "views"); activeView filters the list
+	showViewPicker bool
+	viewPicker     ViewPickerModel
+	activeView     *config.SavedView
+	activeViewName string
+
+	// Status/priority/type filter menu with live counts
+	showFilterMenu bool
+	filterMenu     FilterMenuModel
 	activeRecipe     *recipe.Recipe
 	recipeLoader     *recipe.Loader
 
+	// Dependency editor for the selected issue (--allow-write)
+	showDepEditor bool
+	depEditor     DependencyEditorModel
+
 	// Label picker (bv-126)
 	showLabelPicker bool
 	labelPicker     LabelPickerModel
@@ -326,7 +421,8 @@ type Model struct {
 	workspaceSummary string          // Summary text for footer (e.g., "3 repos")
 
 	// Multi-project CRUD context (maps repo prefix to beads file path)
-	projectPaths map[string]string // prefix -> beads file path for CRUD operations
+	projectPaths map[string]string   // prefix -> beads file path for CRUD operations
+	projectTags  map[string][]string // prefix -> tags shown in the project manager
 
 	// Alerts panel (bv-168)
 	alerts          []drift.Alert
@@ -342,6 +438,38 @@ type Model struct {
 	selectedSprint *model.Sprint
 	isSprintView   bool
 	sprintViewText string
+
+	// Ready queue (Q)
+	readyQueue ReadyQueueModel
+	focusMode  *focusModeState // Set while focus mode (Z) is on
+
+	// Display preferences (truncation strategy, ellipsis, sort)
+	display     config.DisplayConfig
+	saveDisplay func(config.DisplayConfig) error // Persists sort changes; nil disables
+
+	// saveProjects persists Project Manager edits when autosave_projects is
+	// on; nil when the project list did not come from a saved config
+	saveProjects func([]ProjectEntry) error
+	// unloadedProjects are saved projects the Project Manager lists without
+	// their issues: ones saved as disabled, or added this session
+	unloadedProjects []ProjectEntry
+
+	// allowWrite enables edits (</> priority, D dependencies) that write to beads.jsonl
+	allowWrite bool
+
+	// marked holds the IDs toggled with space for bulk actions
+	marked map[string]bool
+
+	// ignoreIssue drops issues excluded by display.yaml ignore rules on
+	// reload (nil keeps all); ignoredCount is how many are hidden
+	ignoreIssue  func(*model.Issue) bool
+	ignoredCount int
+
+	// scopeIDs limits the list, board and graph views to these IDs (e.g.
+	// --since-commit) while analysis still covers every issue; nil shows
+	// all. scope recomputes it on each reload.
+	scopeIDs map[string]bool
+	scope    func() []string
 }
 
 // labelCount is a simple label->count pair for display
@@ -426,7 +554,13 @@ type WorkspaceInfo struct {
 	FailedCount  int
 	TotalIssues  int
 	RepoPrefixes []string
-	ProjectPaths map[string]string // prefix -> beads file path for CRUD operations
+	ProjectPaths map[string]string   // prefix -> beads file path for CRUD operations
+	ProjectTags  map[string][]string // prefix -> tags from the project's .bv.yaml
+	RepoColors   map[string]string   // prefix -> badge color from the project's .bv.yaml
+	// UnloadedProjects are saved projects that were not loaded (enabled:
+	// false). With autosave the Project Manager lists them so they can be
+	// toggled back on for the next start.
+	UnloadedProjects []ProjectEntry
 }
 
 func (m *Model) updateSemanticIDs(items []list.Item) {
@@ -538,10 +672,11 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	}
 
 	// Theme
-	theme := DefaultTheme(lipgloss.NewRenderer(os.Stdout))
+	theme := UserTheme(newRenderer(os.Stdout))
+	display := config.DefaultDisplayConfig()
 
 	// List setup
-	delegate := IssueDelegate{Theme: theme, WorkspaceMode: false}
+	delegate := IssueDelegate{Theme: theme, WorkspaceMode: false, Display: display}
 	l := list.New(items, delegate, 0, 0)
 	l.Title = ""
 	l.SetShowTitle(false)
@@ -550,6 +685,8 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 	l.SetShowPagination(false)
 	l.SetFilteringEnabled(true)
 	l.DisableQuitKeybindings()
+	// "d" toggles row density; keep it out of bubbles' next-page keys
+	l.KeyMap.NextPage.SetKeys("right", "l", "pgdown", "f")
 	// Clear all default styles that might add extra lines
 	l.Styles.Title = lipgloss.NewStyle()
 	l.Styles.TitleBar = lipgloss.NewStyle()
@@ -716,6 +853,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		blockerSet:          blockerSet,
 		recipeLoader:        recipeLoader,
 		recipePicker:        recipePicker,
+		viewPicker:          NewViewPickerModel(nil, theme),
 		activeRecipe:        activeRecipe,
 		labelPicker:         labelPicker,
 		labelDrilldownCache: make(map[string][]model.Issue),
@@ -731,6 +869,7 @@ func NewModel(issues []model.Issue, activeRecipe *recipe.Recipe, beadsPath strin
 		dismissedAlerts: make(map[string]bool),
 		// Sprint view (bv-161)
 		sprints: sprints,
+		display: display,
 	}
 }
 
@@ -739,6 +878,9 @@ func (m Model) Init() tea.Cmd {
 	if m.watcher != nil {
 		cmds = append(cmds, WatchFileCmd(m.watcher))
 	}
+	if m.refreshInterval > 0 {
+		cmds = append(cmds, RefreshTickCmd(m.refreshInterval))
+	}
 	// Start loading history in background
 	if len(m.issues) > 0 {
 		cmds = append(cmds, LoadHistoryCmd(m.issues, m.beadsPath))
@@ -761,7 +903,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.Error != nil {
 			// If indexing fails, revert to fuzzy mode for predictable behavior.
 			m.semanticSearchEnabled = false
-			m.list.Filter = list.DefaultFilter
+			m.list.Filter = fuzzyFilterFor(m.display.Search.Scoring)
 			m.statusMsg = fmt.Sprintf("Semantic search unavailable: %v", msg.Error)
 			m.statusIsError = true
 			break
@@ -914,27 +1056,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
-		// Clear ephemeral overlays tied to old data
-		m.clearAttentionOverlay()
-
-		// Exit time-travel mode if active (file changed, show current state)
-		if m.timeTravelMode {
-			m.timeTravelMode = false
-			m.timeTravelDiff = nil
-			m.timeTravelSince = ""
-			m.newIssueIDs = nil
-			m.closedIssueIDs = nil
-			m.modifiedIssueIDs = nil
-		}
-
 		// Reload issues from disk
 		// Use custom warning handler to prevent stderr pollution during TUI render (bv-fix)
 		var reloadWarnings []string
-		newIssues, err := loader.LoadIssuesFromFileWithOptions(m.beadsPath, loader.ParseOptions{
-			WarningHandler: func(msg string) {
-				reloadWarnings = append(reloadWarnings, msg)
-			},
-		})
+		opts := m.parseOpts
+		opts.WarningHandler = func(msg string) {
+			reloadWarnings = append(reloadWarnings, msg)
+		}
+		newIssues, err := loader.LoadIssuesFromFileWithOptions(m.beadsPath, opts)
 		if err != nil {
 			m.statusMsg = fmt.Sprintf("Reload error: %v", err)
 			m.statusIsError = true
@@ -945,169 +1074,35 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
-		// Store selected issue ID to restore position after reload
-		var selectedID string
-		if sel := m.list.SelectedItem(); sel != nil {
-			if item, ok := sel.(IssueItem); ok {
-				selectedID = item.Issue.ID
-			}
-		}
-
-		// Apply default sorting (Open first, Priority, Date)
-		sort.Slice(newIssues, func(i, j int) bool {
-			iClosed := newIssues[i].Status == model.StatusClosed
-			jClosed := newIssues[j].Status == model.StatusClosed
-			if iClosed != jClosed {
-				return !iClosed
-			}
-			if newIssues[i].Priority != newIssues[j].Priority {
-				return newIssues[i].Priority < newIssues[j].Priority
-			}
-			return newIssues[i].CreatedAt.After(newIssues[j].CreatedAt)
-		})
-
-		// Recompute analysis (async Phase 1/Phase 2) with caching
-		m.issues = newIssues
-		cachedAnalyzer := analysis.NewCachedAnalyzer(newIssues, nil)
-		m.analyzer = cachedAnalyzer.Analyzer
-		m.analysis = cachedAnalyzer.AnalyzeAsync(context.Background())
-		cacheHit := cachedAnalyzer.WasCacheHit()
-		m.labelHealthCached = false
-		m.attentionCached = false
-		m.flowMatrixText = ""
-
-		// Rebuild lookup map
-		m.issueMap = make(map[string]*model.Issue, len(newIssues))
-		for i := range m.issues {
-			m.issueMap[m.issues[i].ID] = &m.issues[i]
-		}
-
-		// Clear stale priority hints (will be repopulated after Phase 2)
-		m.priorityHints = make(map[string]*analysis.PriorityRecommendation)
-
-		// Recompute stats
-		m.countOpen, m.countReady, m.countBlocked, m.countClosed = 0, 0, 0, 0
-		for i := range m.issues {
-			issue := &m.issues[i]
-			if issue.Status == model.StatusClosed {
-				m.countClosed++
-				continue
-			}
-			m.countOpen++
-			if issue.Status == model.StatusBlocked {
-				m.countBlocked++
-				continue
-			}
-			isBlocked := false
-			for _, dep := range issue.Dependencies {
-				if dep == nil || !dep.Type.IsBlocking() {
-					continue
-				}
-				if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
-					isBlocked = true
-					break
-				}
-			}
-			if !isBlocked {
-				m.countReady++
-			}
-		}
-
-		// Recompute alerts for refreshed dataset
-		m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
-		m.dismissedAlerts = make(map[string]bool)
-		m.showAlertsPanel = false
-
-		// Rebuild list items
-		items := make([]list.Item, len(m.issues))
-		for i := range m.issues {
-			items[i] = IssueItem{
-				Issue:      m.issues[i],
-				GraphScore: m.analysis.GetPageRankScore(m.issues[i].ID),
-				Impact:     m.analysis.GetCriticalPathScore(m.issues[i].ID),
-				RepoPrefix: ExtractRepoPrefix(m.issues[i].ID),
-			}
-		}
-		m.updateSemanticIDs(items)
-		m.list.SetItems(items)
-
-		// Restore selection position
-		if selectedID != "" {
-			for i, item := range m.list.Items() {
-				if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
-					m.list.Select(i)
-					break
-				}
-			}
-		}
-
-		// Regenerate sub-views (with Phase 1 data; Phase 2 will update via Phase2ReadyMsg)
-		ins := m.analysis.GenerateInsights(len(m.issues))
-		m.insightsPanel = NewInsightsModel(ins, m.issueMap, m.theme)
-		bodyHeight := m.height - 1
-		if bodyHeight < 5 {
-			bodyHeight = 5
-		}
-		m.insightsPanel.SetSize(m.width, bodyHeight)
-		m.graphView.SetIssues(m.issues, &ins)
-
-		// Generate priority recommendations now that Phase 2 is ready
-		m.board = NewBoardModel(m.issues, m.theme)
-
-		// Re-apply recipe filter if active
-		if m.activeRecipe != nil {
-			m.applyRecipe(m.activeRecipe)
-		}
+		cmds = append(cmds, m.applyReloadedIssues(newIssues, reloadWarnings)...)
 
-		// Reload sprints (bv-161)
-		if m.beadsPath != "" {
-			beadsDir := filepath.Dir(m.beadsPath)
-			if loaded, err := loader.LoadSprintsFromFile(filepath.Join(beadsDir, loader.SprintsFileName)); err == nil {
-				m.sprints = loaded
-				// If we have a selected sprint, try to refresh it
-				if m.selectedSprint != nil {
-					found := false
-					for i := range m.sprints {
-						if m.sprints[i].ID == m.selectedSprint.ID {
-							m.selectedSprint = &m.sprints[i]
-							m.sprintViewText = m.renderSprintDashboard()
-							found = true
-							break
-						}
-					}
-					if !found {
-						m.selectedSprint = nil
-						m.sprintViewText = "Sprint not found"
-					}
-				}
-			}
+		// Re-start watching for next change
+		if m.watcher != nil {
+			cmds = append(cmds, WatchFileCmd(m.watcher))
 		}
+		return m, tea.Batch(cmds...)
 
-		// Keep semantic index current when enabled.
-		if m.semanticSearchEnabled && !m.semanticIndexBuilding {
-			m.semanticIndexBuilding = true
-			cmds = append(cmds, BuildSemanticIndexCmd(m.issues))
+	case RefreshTickMsg:
+		// Time-based reload (--refresh); complements the file watcher
+		if m.refreshInterval <= 0 {
+			return m, nil
 		}
-
-		if cacheHit {
-			m.statusMsg = fmt.Sprintf("Reloaded %d issues (cached)", len(newIssues))
-		} else {
-			m.statusMsg = fmt.Sprintf("Reloaded %d issues", len(newIssues))
+		cmds = append(cmds, RefreshTickCmd(m.refreshInterval))
+		// Don't yank the user out of a time-travel comparison on a timer
+		if m.timeTravelMode {
+			return m, tea.Batch(cmds...)
 		}
-		if len(reloadWarnings) > 0 {
-			m.statusMsg += fmt.Sprintf(" (%d warnings)", len(reloadWarnings))
+		newIssues, reloadWarnings, err := m.loadRefreshIssues()
+		if err != nil {
+			m.statusMsg = fmt.Sprintf("Refresh error: %v", err)
+			m.statusIsError = true
+			return m, tea.Batch(cmds...)
 		}
-		m.statusIsError = false
-		// Invalidate label-derived caches
-		m.labelHealthCached = false
-		m.labelDrilldownCache = make(map[string][]model.Issue)
-		m.updateViewportContent()
-
-		// Re-start watching for next change + wait for Phase 2
-		if m.watcher != nil {
-			cmds = append(cmds, WatchFileCmd(m.watcher))
+		// Skip the rebuild (and UI reset) when nothing changed
+		if analysis.ComputeDataHash(newIssues) == analysis.ComputeDataHash(m.issues) {
+			return m, tea.Batch(cmds...)
 		}
-		cmds = append(cmds, WaitForPhase2Cmd(m.analysis))
+		cmds = append(cmds, m.applyReloadedIssues(newIssues, reloadWarnings)...)
 		return m, tea.Batch(cmds...)
 
 	case tea.KeyMsg:
@@ -1292,6 +1287,33 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Handle filter menu overlay before global keys
+		if m.showFilterMenu {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleFilterMenuKeys(msg)
+			return m, nil
+		}
+
+		// Handle dependency editor overlay before global keys
+		if m.showDepEditor {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m, cmd = m.handleDependencyEditorKeys(msg)
+			return m, cmd
+		}
+
+		// Handle saved-view picker overlay before global keys
+		if m.showViewPicker {
+			if msg.String() == "ctrl+c" {
+				return m, tea.Quit
+			}
+			m = m.handleViewPickerKeys(msg)
+			return m, nil
+		}
+
 		// Handle recipe picker overlay before global keys (esc/q/etc.)
 		if m.showRecipePicker {
 			if msg.String() == "ctrl+c" {
@@ -1301,6 +1323,15 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// The source overlay closes on any key; O also opens the file
+		if m.showSourceInfo {
+			m.showSourceInfo = false
+			if msg.String() == "O" {
+				m.openInEditor()
+			}
+			return m, nil
+		}
+
 		// Handle quit confirmation first
 		if m.showQuitConfirm {
 			switch msg.String() {
@@ -1368,12 +1399,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				} else {
 					m.semanticSearchEnabled = false
-					m.list.Filter = list.DefaultFilter
+					m.list.Filter = fuzzyFilterFor(m.display.Search.Scoring)
 					m.statusMsg = "Semantic search unavailable"
 					m.statusIsError = true
 				}
 			} else {
-				m.list.Filter = list.DefaultFilter
+				m.list.Filter = fuzzyFilterFor(m.display.Search.Scoring)
 				m.statusMsg = "Fuzzy search enabled"
 			}
 
@@ -1432,6 +1463,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.focused = focusList
 					return m, nil
 				}
+				if m.focused == focusReadyQueue {
+					if m.focusMode != nil {
+						m.exitFocusMode()
+						return m, nil
+					}
+					m.focused = focusList
+					return m, nil
+				}
 				return m, tea.Quit
 
 			case "esc":
@@ -1459,12 +1498,28 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.focused = focusList
 					return m, nil
 				}
+				if m.focused == focusReadyQueue {
+					if m.focusMode != nil {
+						m.exitFocusMode()
+						return m, nil
+					}
+					m.focused = focusList
+					return m, nil
+				}
 				if m.isHistoryView {
 					m.isHistoryView = false
 					m.focused = focusList
 					return m, nil
 				}
-				// At main list - first ESC clears filters, second shows quit confirm
+				// At main list - ESC leaves focus mode, clears marks, then filters, then shows quit confirm
+				if m.focusMode != nil {
+					m.exitFocusMode()
+					return m, nil
+				}
+				if len(m.marked) > 0 {
+					m.clearMarks()
+					return m, nil
+				}
 				if m.hasActiveFilters() {
 					m.clearAllFilters()
 					return m, nil
@@ -1483,6 +1538,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 				}
 
+			case "|":
+				// Toggle the detail pane (full-width list vs split view)
+				if m.focused == focusList || m.focused == focusDetail {
+					m.toggleDetailPane()
+					return m, nil
+				}
+
 			case "b":
 				m.clearAttentionOverlay()
 				m.isBoardView = !m.isBoardView
@@ -1516,6 +1578,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.isActionableView {
 					// Build execution plan
 					analyzer := analysis.NewAnalyzer(m.issues)
+					analyzer.SetPlanOptions(analysis.PlanOptions{IgnoreRelated: !m.display.Plan.GroupsRelated(), SplitProjects: m.display.Plan.SplitProjects})
 					plan := analyzer.GetExecutionPlan()
 					m.actionableView = NewActionableModel(plan, m.theme)
 					m.actionableView.SetSize(m.width, m.height-2)
@@ -1535,22 +1598,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.isBoardView = false
 					m.isActionableView = false
 					m.focused = focusInsights
-					// Refresh insights using latest analysis snapshot
-					if m.analysis != nil {
-						ins := m.analysis.GenerateInsights(len(m.issues))
-						m.insightsPanel = NewInsightsModel(ins, m.issueMap, m.theme)
-						// Include priority triage (bv-91) - reuse existing analyzer/stats (bv-runn.12)
-						triage := analysis.ComputeTriageFromAnalyzer(m.analyzer, m.analysis, m.issues, analysis.TriageOptions{}, time.Now())
-						m.insightsPanel.SetTopPicks(triage.QuickRef.TopPicks)
-						// Set full recommendations with breakdown for priority radar (bv-93)
-						dataHash := fmt.Sprintf("v%s@%s#%d", triage.Meta.Version, triage.Meta.GeneratedAt.Format("15:04:05"), triage.Meta.IssueCount)
-						m.insightsPanel.SetRecommendations(triage.Recommendations, dataHash)
-						panelHeight := m.height - 2
-						if panelHeight < 3 {
-							panelHeight = 3
-						}
-						m.insightsPanel.SetSize(m.width, panelHeight)
-					}
+					m.refreshInsightsPanel()
 				}
 				return m, nil
 
@@ -1558,12 +1606,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Toggle priority hints
 				m.showPriorityHints = !m.showPriorityHints
 				// Update delegate with new state
-				m.list.SetDelegate(IssueDelegate{
-					Theme:             m.theme,
-					ShowPriorityHints: m.showPriorityHints,
-					PriorityHints:     m.priorityHints,
-					WorkspaceMode:     m.workspaceMode,
-				})
+				m.list.SetDelegate(m.newIssueDelegate())
 				return m, nil
 
 			case "h":
@@ -1666,6 +1709,36 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 
+			case "#":
+				// Toggle namespaced / bare issue IDs
+				m.toggleBareIDs()
+				return m, nil
+
+			case "V":
+				// Open saved-view picker
+				if m.viewPicker.ViewCount() == 0 {
+					m.statusMsg = "No saved views (create one with bv --save-view NAME)"
+					m.statusIsError = false
+					return m, nil
+				}
+				m.showViewPicker = true
+				m.viewPicker.selectedIndex = 0
+				m.viewPicker.SetSize(m.width, m.height-1)
+				m.focused = focusViewPicker
+				return m, nil
+
+			case "F":
+				// Open status/priority/type filter menu
+				var v config.SavedView
+				if m.activeView != nil {
+					v = *m.activeView
+				}
+				m.filterMenu = NewFilterMenuModel(m.filterMenuCandidates(), v, m.theme)
+				m.filterMenu.SetSize(m.width, m.height-1)
+				m.showFilterMenu = true
+				m.focused = focusFilterMenu
+				return m, nil
+
 			case "'", "f5":
 				// Toggle recipe picker overlay
 				m.showRecipePicker = !m.showRecipePicker
@@ -1724,6 +1797,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.showProjectManager = !m.showProjectManager
 				if m.showProjectManager {
 					m.projectManager = NewProjectManagerModel(m.theme)
+					m.projectManager.SetDisplayConfig(m.display)
 					// Build project entries from current state
 					entries := m.buildProjectEntries()
 					m.projectManager.SetProjects(entries)
@@ -1765,10 +1839,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, cmd
 				}
 				// Open detail modal on 'h'
-				if msg.String() == "h" && len(m.labelDashboard.labels) > 0 {
-					idx := m.labelDashboard.cursor
-					if idx >= 0 && idx < len(m.labelDashboard.labels) {
-						lh := m.labelDashboard.labels[idx]
+				if msg.String() == "h" {
+					if lh, ok := m.labelDashboard.SelectedLabel(); ok {
 						m.showLabelHealthDetail = true
 						m.labelHealthDetail = &lh
 						// Precompute cross-label flows for this label
@@ -1776,11 +1848,14 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						return m, nil
 					}
 				}
+				// Switch between health and blocked-count order on 's'
+				if msg.String() == "s" {
+					m.toggleLabelSort()
+					return m, nil
+				}
 				// Open drilldown overlay on 'd'
-				if msg.String() == "d" && len(m.labelDashboard.labels) > 0 {
-					idx := m.labelDashboard.cursor
-					if idx >= 0 && idx < len(m.labelDashboard.labels) {
-						lh := m.labelDashboard.labels[idx]
+				if msg.String() == "d" {
+					if lh, ok := m.labelDashboard.SelectedLabel(); ok {
 						m.labelDrilldownLabel = lh.Label
 						m.labelDrilldownIssues = m.filterIssuesByLabel(lh.Label)
 						m.showLabelDrilldown = true
@@ -1800,10 +1875,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case focusSprint:
 				m = m.handleSprintKeys(msg)
 
+			case focusReadyQueue:
+				m = m.handleReadyQueueKeys(msg)
+
 			case focusList:
+				if m.showDetails && !m.isSplitView && m.toggleDetailFold(msg.String()) {
+					break
+				}
 				m = m.handleListKeys(msg)
 
 			case focusDetail:
+				if m.toggleDetailFold(msg.String()) {
+					break
+				}
 				m.viewport, cmd = m.viewport.Update(msg)
 				cmds = append(cmds, cmd)
 			}
@@ -1833,6 +1917,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.graphView.PageUp()
 			case focusActionable:
 				m.actionableView.MoveUp()
+			case focusReadyQueue:
+				m.readyQueue.MoveUp()
 			case focusHistory:
 				m.historyView.MoveUp()
 			}
@@ -1858,6 +1944,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.graphView.PageDown()
 			case focusActionable:
 				m.actionableView.MoveDown()
+			case focusReadyQueue:
+				m.readyQueue.MoveDown()
 			case focusHistory:
 				m.historyView.MoveDown()
 			}
@@ -1867,58 +1955,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		m.isSplitView = msg.Width > SplitViewThreshold
 		m.ready = true
-		bodyHeight := m.height - 1 // keep 1 row for footer
-		if bodyHeight < 5 {
-			bodyHeight = 5
-		}
-
-		if m.isSplitView {
-			// Calculate dimensions accounting for 2 panels with borders(2)+padding(2) = 4 overhead each
-			// Total overhead = 8
-			availWidth := msg.Width - 8
-			if availWidth < 10 {
-				availWidth = 10
-			}
-
-			listInnerWidth := int(float64(availWidth) * 0.4)
-			detailInnerWidth := availWidth - listInnerWidth
-
-			// listHeight fits header (1) + page line (1) inside a panel with Border (2)
-			listHeight := bodyHeight - 4
-			if listHeight < 3 {
-				listHeight = 3
-			}
-
-			m.list.SetSize(listInnerWidth, listHeight)
-			m.viewport = viewport.New(detailInnerWidth, bodyHeight-2) // Account for border
-
-			m.renderer.SetWidthWithTheme(detailInnerWidth, m.theme)
-		} else {
-			listHeight := bodyHeight - 2
-			if listHeight < 3 {
-				listHeight = 3
-			}
-			m.list.SetSize(msg.Width, listHeight)
-			m.viewport = viewport.New(msg.Width, bodyHeight-1)
-
-			// Update renderer for full width
-			m.renderer.SetWidthWithTheme(msg.Width, m.theme)
-		}
-
-		m.list.SetDelegate(IssueDelegate{
-			Theme:             m.theme,
-			ShowPriorityHints: m.showPriorityHints,
-			PriorityHints:     m.priorityHints,
-			WorkspaceMode:     m.workspaceMode,
-		})
-
-		// Resize label dashboard table and modal overlay sizing
-		m.labelDashboard.SetSize(m.width, bodyHeight)
-
-		m.insightsPanel.SetSize(m.width, bodyHeight)
-		m.updateViewportContent()
+		m.applyLayout()
 	}
 
 	// Update list for navigation, but NOT for WindowSizeMsg
@@ -2142,6 +2180,71 @@ func (m Model) handleHistoryKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
+// handleViewPickerKeys handles keyboard input when the saved-view picker is focused
+func (m Model) handleViewPickerKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.viewPicker.MoveDown()
+	case "k", "up":
+		m.viewPicker.MoveUp()
+	case "esc", "V":
+		m.showViewPicker = false
+		m.focused = focusList
+	case "enter":
+		m.showViewPicker = false
+		m.focused = focusList
+		if name, v, ok := m.viewPicker.SelectedView(); ok {
+			m.ApplySavedView(name, v)
+		} else {
+			m.ClearSavedView()
+		}
+	}
+	return m
+}
+
+// handleFilterMenuKeys handles keyboard input when the filter menu is focused.
+// Enter merges the selections into the active view (or starts an unnamed
+// one); a view left with no filters is cleared.
+func (m Model) handleFilterMenuKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "j", "down":
+		m.filterMenu.MoveDown()
+	case "k", "up":
+		m.filterMenu.MoveUp()
+	case " ", "space":
+		m.filterMenu.ToggleSelected()
+	case "x":
+		m.filterMenu.ClearAll()
+	case "esc", "q", "F":
+		m.showFilterMenu = false
+		m.focused = focusList
+	case "enter":
+		m.showFilterMenu = false
+		m.focused = focusList
+		var v config.SavedView
+		if m.activeView != nil {
+			v = *m.activeView
+		}
+		before := DescribeSavedView(v)
+		m.filterMenu.ApplyTo(&v)
+		if DescribeSavedView(v) == before {
+			return m
+		}
+		// Edited filters no longer match the saved view's name
+		m.activeView = &v
+		m.activeViewName = ""
+		if DescribeSavedView(v) == DescribeSavedView(config.SavedView{}) {
+			m.activeView = nil
+		}
+		if m.activeRecipe != nil {
+			m.applyRecipe(m.activeRecipe)
+		} else {
+			m.applyFilter()
+		}
+	}
+	return m
+}
+
 // handleRecipePickerKeys handles keyboard input when recipe picker is focused
 func (m Model) handleRecipePickerKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -2217,6 +2320,24 @@ func (m Model) handleProjectManagerKeys(msg tea.KeyMsg) Model {
 				m.projectManager.SetError("Path cannot be empty")
 				return m
 			}
+			// With autosave the path is saved for the next start; loading
+			// its issues now requires main.go changes
+			if m.display.AutosaveProjects && m.saveProjects != nil {
+				abs, err := filepath.Abs(path)
+				if err == nil {
+					_, err = os.Stat(filepath.Join(abs, ".beads"))
+				}
+				if err != nil {
+					m.projectManager.SetError(fmt.Sprintf("No .beads directory in %s", path))
+					return m
+				}
+				m.projectManager.ExitAddMode()
+				m.projectManager.AddProject(ProjectEntry{Name: filepath.Base(abs), Path: abs, IsActive: true})
+				m.autosaveProjects()
+				m.statusMsg = fmt.Sprintf("Added project: %s (its issues load on the next start)", filepath.Base(abs))
+				m.statusIsError = false
+				return m
+			}
 			// TODO: Validate path and add project
 			// For now, just show message since full reload requires main.go changes
 			m.projectManager.ExitAddMode()
@@ -2228,6 +2349,7 @@ func (m Model) handleProjectManagerKeys(msg tea.KeyMsg) Model {
 		return m
 	}
 
+	m.projectManager.ClearSaved()
 	switch msg.String() {
 	case "j", "down":
 		m.projectManager.MoveDown()
@@ -2235,20 +2357,32 @@ func (m Model) handleProjectManagerKeys(msg tea.KeyMsg) Model {
 		m.projectManager.MoveUp()
 	case " ", "space":
 		m.projectManager.ToggleActive()
+		m.autosaveProjects()
+		if p := m.projectManager.SelectedProject(); p != nil && p.Prefix == "" && p.IsActive && m.projectManager.saved {
+			m.statusMsg = fmt.Sprintf("Enabled project: %s (its issues load on the next start)", p.Name)
+			m.statusIsError = false
+		}
 	case "a":
 		m.projectManager.EnterAddMode()
 	case "d":
 		if removed := m.projectManager.RemoveSelected(); removed != nil {
 			m.statusMsg = fmt.Sprintf("Removed project: %s", removed.Name)
 			m.statusIsError = false
+			m.autosaveProjects()
 		}
 	case "esc", "q":
 		m.showProjectManager = false
 		m.focused = focusList
 	case "enter":
-		// Apply project selection as repo filter
-		active := m.projectManager.ActiveProjects()
-		if len(active) == 0 || len(active) == len(m.projectManager.AllProjects()) {
+		// Apply project selection as repo filter; projects that aren't
+		// loaded have no issues to filter
+		var active []ProjectEntry
+		for _, p := range m.projectManager.ActiveProjects() {
+			if p.Prefix != "" {
+				active = append(active, p)
+			}
+		}
+		if len(active) == 0 || len(active) == len(m.projectPaths) {
 			m.activeRepos = nil
 			m.statusMsg = "Project filter: all projects"
 		} else {
@@ -2277,6 +2411,28 @@ func (m Model) handleProjectManagerKeys(msg tea.KeyMsg) Model {
 	return m
 }
 
+// autosaveProjects saves the Project Manager's list after a change when
+// autosave_projects is on, flagging the overlay with "saved" or the error.
+func (m *Model) autosaveProjects() {
+	if !m.display.AutosaveProjects || m.saveProjects == nil {
+		return
+	}
+	all := m.projectManager.AllProjects()
+	if err := m.saveProjects(all); err != nil {
+		m.projectManager.SetError(fmt.Sprintf("Autosave failed: %v", err))
+		return
+	}
+	m.projectManager.MarkSaved()
+	// Keep listing the saved projects that aren't loaded when the manager
+	// is opened again
+	m.unloadedProjects = nil
+	for _, p := range all {
+		if p.Prefix == "" {
+			m.unloadedProjects = append(m.unloadedProjects, p)
+		}
+	}
+}
+
 // handleLabelPickerKeys handles keyboard input when label picker is focused (bv-126)
 func (m Model) handleLabelPickerKeys(msg tea.KeyMsg) Model {
 	switch msg.String() {
@@ -2366,7 +2522,7 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 		itemCount := len(m.list.Items())
 		if itemCount > 0 {
 			currentIdx := m.list.Index()
-			newIdx := currentIdx + m.height/3
+			newIdx := currentIdx + m.height/3/m.listRowLines()
 			if newIdx >= itemCount {
 				newIdx = itemCount - 1
 			}
@@ -2376,7 +2532,7 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 		// Page up
 		if len(m.list.Items()) > 0 {
 			currentIdx := m.list.Index()
-			newIdx := currentIdx - m.height/3
+			newIdx := currentIdx - m.height/3/m.listRowLines()
 			if newIdx < 0 {
 				newIdx = 0
 			}
@@ -2391,6 +2547,9 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 	case "r":
 		m.currentFilter = "ready"
 		m.applyFilter()
+	case "Z":
+		// Focus mode: the selected issue's project's ready work in the queue
+		m.toggleFocusMode()
 	case "a":
 		m.currentFilter = "all"
 		m.applyFilter()
@@ -2415,14 +2574,29 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 	case "C":
 		// Copy selected issue to clipboard
 		m.copyIssueToClipboard()
+	case " ", "space":
+		// Mark/unmark the selected issue for bulk actions
+		m.toggleMarkSelected()
+	case "y":
+		// Copy marked (or selected) issue IDs
+		m.copyMarkedIDs()
+	case "v":
+		// Show only the marked issues
+		m.filterToMarked()
 	case "O":
 		// Open beads.jsonl in editor
 		m.openInEditor()
+	case "I":
+		// Show where the selected issue was loaded from ("i" is insights)
+		m.openSourceInfo()
 	case "h":
 		// Toggle history view
 		if !m.isHistoryView {
 			m.enterHistoryView()
 		}
+	case "Q":
+		// Ready queue ("q" quits)
+		m.openReadyQueue()
 	case "S":
 		// Apply triage recipe - sort by triage score (bv-151)
 		if r := m.recipeLoader.Get("triage"); r != nil {
@@ -2432,6 +2606,24 @@ func (m Model) handleListKeys(msg tea.KeyMsg) Model {
 	case "s":
 		// Cycle sort mode (bv-3ita)
 		m.cycleSortMode()
+	case "R":
+		// Reverse current sort ("r" is the ready filter)
+		m.toggleSortReverse()
+	case "d":
+		// Toggle compact/comfortable row density
+		m.toggleDensity()
+	case "*":
+		// Pin/unpin the selected issue ("p" is priority hints)
+		m.togglePinSelected()
+	case ">":
+		// Raise priority (P2 -> P1)
+		m.adjustSelectedPriority(-1)
+	case "<":
+		// Lower priority (P2 -> P3)
+		m.adjustSelectedPriority(1)
+	case "D":
+		// Edit the selected issue's dependencies
+		m.openDependencyEditor()
 	}
 	return m
 }
@@ -2506,6 +2698,8 @@ func (m Model) View() string {
 	// Quit confirmation overlay takes highest priority
 	if m.showQuitConfirm {
 		body = m.renderQuitConfirm()
+	} else if m.showSourceInfo {
+		body = m.renderSourceInfo()
 	} else if m.showLabelHealthDetail && m.labelHealthDetail != nil {
 		body = m.renderLabelHealthDetail(*m.labelHealthDetail)
 	} else if m.showLabelGraphAnalysis && m.labelGraphAnalysisResult != nil {
@@ -2516,6 +2710,12 @@ func (m Model) View() string {
 		body = m.renderAlertsPanel()
 	} else if m.showTimeTravelPrompt {
 		body = m.renderTimeTravelPrompt()
+	} else if m.showViewPicker {
+		body = m.viewPicker.View()
+	} else if m.showFilterMenu {
+		body = m.filterMenu.View()
+	} else if m.showDepEditor {
+		body = m.depEditor.View()
 	} else if m.showRecipePicker {
 		body = m.recipePicker.View()
 	} else if m.showRepoPicker {
@@ -2528,6 +2728,9 @@ func (m Model) View() string {
 		body = m.renderHelpOverlay()
 	} else if m.focused == focusInsights {
 		body = m.insightsPanel.View()
+	} else if m.focused == focusReadyQueue {
+		m.readyQueue.SetSize(m.width, m.height-1)
+		body = m.readyQueue.View()
 	} else if m.isGraphView {
 		body = m.graphView.View(m.width, m.height-1)
 	} else if m.isBoardView {
@@ -2540,6 +2743,8 @@ func (m Model) View() string {
 		body = m.historyView.View()
 	} else if m.isSprintView {
 		body = m.sprintViewText
+	} else if len(m.issues) == 0 && !m.timeTravelMode {
+		body = m.renderEmptyState()
 	} else if m.isSplitView {
 		body = m.renderSplitView()
 	} else if m.focused == focusLabelDashboard {
@@ -2620,25 +2825,24 @@ func (m Model) renderListWithHeader() string {
 	}
 
 	// Render column header
-	headerStyle := t.Renderer.NewStyle().
-		Background(t.Primary).
-		Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#282A36"}).
-		Bold(true).
-		Width(m.width - 2)
-
 	headerText := "  TYPE PRI STATUS      ID                                   TITLE"
 	if m.workspaceMode {
 		// Account for repo badges like [API] shown in workspace mode.
 		headerText = "  REPO TYPE PRI STATUS      ID                               TITLE"
 	}
-	header := headerStyle.Render(headerText)
+	header := m.renderListColumnHeader(headerText, m.width-2)
 
 	// Page info
 	totalItems := len(m.list.Items())
 	currentIdx := m.list.Index()
-	itemsPerPage := availableHeight
+	// Rows can span several lines (comfortable density); the list's own
+	// paginator knows how many fit
+	itemsPerPage := m.list.Paginator.PerPage
 	if itemsPerPage < 1 {
-		itemsPerPage = 1
+		itemsPerPage = availableHeight / m.listRowLines()
+	}
+	if itemsPerPage < 1 {
+		itemsPerPage = 1
 	}
 	currentPage := (currentIdx / itemsPerPage) + 1
 	totalPages := (totalItems + itemsPerPage - 1) / itemsPerPage
@@ -2709,13 +2913,7 @@ func (m Model) renderSplitView() string {
 	panelHeight := m.height - 1
 
 	// Create header row for list
-	headerStyle := t.Renderer.NewStyle().
-		Background(t.Primary).
-		Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#282A36"}).
-		Bold(true).
-		Width(listInnerWidth)
-
-	header := headerStyle.Render("  TYPE PRI STATUS      ID                     TITLE")
+	header := m.renderListColumnHeader("  TYPE PRI STATUS      ID                     TITLE", listInnerWidth)
 
 	// Page info for list
 	totalItems := len(m.list.Items())
@@ -2854,6 +3052,8 @@ func (m *Model) renderHelpOverlay() string {
 		{"Tab", "Switch focus"},
 		{"Enter", "View details"},
 		{"Esc", "Back / close"},
+		{"1-9", "Fold detail section"},
+		{"z", "Fold / unfold all"},
 	}
 
 	viewsSection := []struct{ key, desc string }{
@@ -2862,6 +3062,8 @@ func (m *Model) renderHelpOverlay() string {
 		{"i", "Insights"},
 		{"h", "History view"},
 		{"a", "Actionable"},
+		{"Q", "Ready queue"},
+		{"Z", "Focus on project"},
 		{"f", "Flow matrix"},
 		{"[", "Label dashboard"},
 		{"]", "Attention view"},
@@ -2872,6 +3074,9 @@ func (m *Model) renderHelpOverlay() string {
 		{";", "Shortcuts bar"},
 		{"!", "Alerts panel"},
 		{"'", "Recipes"},
+		{"V", "Saved views"},
+		{"F", "Filter menu"},
+		{"#", "Bare / namespaced IDs"},
 		{"w", "Repo picker"},
 		{"q", "Back / Quit"},
 		{"Ctrl+c", "Force quit"},
@@ -2885,6 +3090,11 @@ func (m *Model) renderHelpOverlay() string {
 		{"r", "Ready (unblocked)"},
 		{"l", "Filter by label"},
 		{"s", "Cycle sort"},
+		{"R", "Reverse sort"},
+		{"d", "Row density"},
+		{"|", "Toggle detail pane"},
+		{"*", "Pin to top"},
+		{"D", "Edit dependencies"},
 		{"S", "Triage sort"},
 	}
 
@@ -2913,11 +3123,16 @@ func (m *Model) renderHelpOverlay() string {
 
 	actionsSection := []struct{ key, desc string }{
 		{"p", "Priority hints"},
+		{">/<", "Raise/lower priority"},
 		{"t", "Time-travel"},
 		{"T", "Quick time-travel"},
 		{"x", "Export markdown"},
 		{"C", "Copy to clipboard"},
 		{"O", "Open in editor"},
+		{"I", "Issue source info"},
+		{"space", "Select (bulk actions)"},
+		{"y", "Copy selected IDs"},
+		{"v", "Show selected only"},
 	}
 
 	// Build panels
@@ -3019,9 +3234,9 @@ func (m Model) renderLabelHealthDetail(lh analysis.LabelHealth) string {
 		style := t.Base
 		switch lvl {
 		case analysis.HealthLevelHealthy:
-			style = style.Foreground(t.Open)
+			style = style.Foreground(t.Healthy)
 		case analysis.HealthLevelWarning:
-			style = style.Foreground(t.Feature)
+			style = style.Foreground(t.Warning)
 		default:
 			style = style.Foreground(t.Blocked)
 		}
@@ -3041,7 +3256,7 @@ func (m Model) renderLabelHealthDetail(lh analysis.LabelHealth) string {
 		}
 		for i := 0; i < limit; i++ {
 			lc := items[i]
-			line := fmt.Sprintf("  %s %-16s %3d", arrow, lc.Label, lc.Count)
+			line := fmt.Sprintf("  %s %s %3d", arrow, padRight(lc.Label, 16), lc.Count)
 			b.WriteString(valStyle.Render(line))
 			b.WriteString("\n")
 		}
@@ -3206,9 +3421,9 @@ func (m Model) renderLabelDrilldown() string {
 		if lh != nil {
 			switch lh.HealthLevel {
 			case analysis.HealthLevelHealthy:
-				style = style.Foreground(t.Open)
+				style = style.Foreground(t.Healthy)
 			case analysis.HealthLevelWarning:
-				style = style.Foreground(t.Feature)
+				style = style.Foreground(t.Warning)
 			default:
 				style = style.Foreground(t.Blocked)
 			}
@@ -3260,7 +3475,7 @@ func (m Model) renderLabelDrilldown() string {
 			}
 			for i := 0; i < limit; i++ {
 				lc := items[i]
-				line := fmt.Sprintf("  %s %-14s %3d", arrow, lc.Label, lc.Count)
+				line := fmt.Sprintf("  %s %s %3d", arrow, padRight(lc.Label, 14), lc.Count)
 				sb.WriteString(valStyle.Render(line))
 				sb.WriteString("\n")
 			}
@@ -3368,9 +3583,7 @@ func (m Model) renderLabelGraphAnalysis() string {
 			if maxTitleLen < 20 {
 				maxTitleLen = 20
 			}
-			if len(title) > maxTitleLen {
-				title = title[:maxTitleLen-1] + "…"
-			}
+			title = truncateRunesHelper(title, maxTitleLen, "…")
 
 			height := r.CriticalPath.AllHeights[issueID]
 			line := fmt.Sprintf("%s %-12s [h=%d] %s", arrow, issueID, height, title)
@@ -3413,9 +3626,7 @@ func (m Model) renderLabelGraphAnalysis() string {
 			if maxTitleLen < 15 {
 				maxTitleLen = 15
 			}
-			if len(title) > maxTitleLen {
-				title = title[:maxTitleLen-1] + "…"
-			}
+			title = truncateRunesHelper(title, maxTitleLen, "…")
 
 			normalized := r.PageRank.Normalized[item.ID]
 			line := fmt.Sprintf("  %s %-12s PR=%.4f (%.0f%%) %s",
@@ -3479,7 +3690,10 @@ func (m *Model) renderFooter() string {
 	var filterTxt string
 	var filterIcon string
 	if m.focused == focusLabelDashboard {
-		filterTxt = "LABELS: j/k nav • h detail • d drilldown • enter filter"
+		filterTxt = "LABELS: j/k nav • h detail • d drilldown • enter filter • s sort • z group"
+		if m.labelDashboard.GroupByPrefix() {
+			filterTxt = "LABELS: j/k nav • h detail • d drilldown • enter filter/fold • s sort • z ungroup"
+		}
 		filterIcon = "🏷️"
 	} else if m.showLabelGraphAnalysis && m.labelGraphAnalysisResult != nil {
 		filterTxt = fmt.Sprintf("GRAPH %s: esc/q/g close", m.labelGraphAnalysisResult.Label)
@@ -3501,6 +3715,9 @@ func (m *Model) renderFooter() string {
 		case "ready":
 			filterTxt = "READY"
 			filterIcon = "🚀"
+		case "marked":
+			filterTxt = "SELECTED"
+			filterIcon = "✓"
 		default:
 			if strings.HasPrefix(m.currentFilter, "recipe:") {
 				filterTxt = strings.ToUpper(m.currentFilter[7:])
@@ -3521,12 +3738,30 @@ func (m *Model) renderFooter() string {
 
 	// Sort badge - only show when not default (bv-3ita)
 	sortBadge := ""
-	if m.sortMode != SortDefault {
+	if m.sortMode != SortDefault || m.sortReverse {
+		label := m.sortMode.String()
+		if m.sortReverse {
+			label += " (rev)"
+		}
 		sortBadge = lipgloss.NewStyle().
 			Background(ColorBgHighlight).
 			Foreground(ColorSecondary).
 			Padding(0, 1).
-			Render(fmt.Sprintf("↕ %s", m.sortMode.String()))
+			Render(fmt.Sprintf("↕ %s", label))
+	}
+
+	// Saved view badge
+	viewBadge := ""
+	if m.activeView != nil {
+		name := m.activeViewName
+		if name == "" {
+			name = "custom"
+		}
+		viewBadge = lipgloss.NewStyle().
+			Background(ColorBgHighlight).
+			Foreground(ColorSecondary).
+			Padding(0, 1).
+			Render("👁 " + name)
 	}
 
 	labelHint := lipgloss.NewStyle().
@@ -3679,10 +3914,16 @@ func (m *Model) renderFooter() string {
 	var keyHints []string
 	if m.showHelp {
 		keyHints = append(keyHints, "Press any key to close")
-	} else if m.showRecipePicker {
+	} else if m.showRecipePicker || m.showViewPicker {
 		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
-	} else if m.showRepoPicker {
+	} else if m.showRepoPicker || m.showFilterMenu {
 		keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("space")+" toggle", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
+	} else if m.showDepEditor {
+		if m.depEditor.IsAddMode() {
+			keyHints = append(keyHints, "type to search", keyStyle.Render("tab")+" type", keyStyle.Render("⏎")+" add", keyStyle.Render("esc")+" back")
+		} else {
+			keyHints = append(keyHints, keyStyle.Render("j/k")+" nav", keyStyle.Render("a")+" add", keyStyle.Render("x")+" remove", keyStyle.Render("esc")+" close")
+		}
 	} else if m.showProjectManager {
 		if m.projectManager.IsAddMode() {
 			keyHints = append(keyHints, "type path", keyStyle.Render("⏎")+" add", keyStyle.Render("esc")+" cancel")
@@ -3693,7 +3934,7 @@ func (m *Model) renderFooter() string {
 		keyHints = append(keyHints, "type to filter", keyStyle.Render("j/k")+" nav", keyStyle.Render("⏎")+" apply", keyStyle.Render("esc")+" cancel")
 	} else if m.focused == focusInsights {
 		keyHints = append(keyHints, keyStyle.Render("h/l")+" panels", keyStyle.Render("e")+" explain", keyStyle.Render("⏎")+" jump", keyStyle.Render("?")+" help")
-		keyHints = append(keyHints, keyStyle.Render("A")+" attention", keyStyle.Render("F")+" flow")
+		keyHints = append(keyHints, keyStyle.Render("A")+" attention", keyStyle.Render("f")+" flow")
 	} else if m.isGraphView {
 		keyHints = append(keyHints, keyStyle.Render("hjkl")+" nav", keyStyle.Render("H/L")+" scroll", keyStyle.Render("⏎")+" view", keyStyle.Render("g")+" list")
 	} else if m.isBoardView {
@@ -3739,7 +3980,7 @@ func (m *Model) renderFooter() string {
 	countBadge := lipgloss.NewStyle().
 		Foreground(ColorSecondary).
 		Padding(0, 1).
-		Render(fmt.Sprintf("%d issues", len(m.list.Items())))
+		Render(m.countLabel())
 
 	// ─────────────────────────────────────────────────────────────────────────
 	// ASSEMBLE FOOTER with proper spacing
@@ -3748,6 +3989,9 @@ func (m *Model) renderFooter() string {
 	if sortBadge != "" {
 		leftWidth += lipgloss.Width(sortBadge) + 1
 	}
+	if viewBadge != "" {
+		leftWidth += lipgloss.Width(viewBadge) + 1
+	}
 	if alertsSection != "" {
 		leftWidth += lipgloss.Width(alertsSection) + 1
 	}
@@ -3774,6 +4018,9 @@ func (m *Model) renderFooter() string {
 	if sortBadge != "" {
 		parts = append(parts, sortBadge)
 	}
+	if viewBadge != "" {
+		parts = append(parts, viewBadge)
+	}
 	parts = append(parts, labelHint)
 	if alertsSection != "" {
 		parts = append(parts, alertsSection)
@@ -3832,54 +4079,102 @@ func (m *Model) clearAllFilters() {
 	m.applyFilter()
 }
 
+// inActiveRepos applies the workspace repo filter (nil = all repos)
+func (m *Model) inActiveRepos(issue *model.Issue) bool {
+	if m.workspaceMode && m.activeRepos != nil {
+		repoKey := strings.ToLower(ExtractRepoPrefix(issue.ID))
+		if repoKey != "" && !m.activeRepos[repoKey] {
+			return false
+		}
+	}
+	return true
+}
+
+// inScope reports whether issue is inside the view scope set by
+// SetViewScope.
+func (m *Model) inScope(issue *model.Issue) bool {
+	return m.scopeIDs == nil || m.scopeIDs[issue.ID]
+}
+
+// currentFilterMatches applies the o/c/r/a, marked and label filter
+func (m *Model) currentFilterMatches(issue *model.Issue) bool {
+	switch m.currentFilter {
+	case "all":
+		return true
+	case "marked":
+		return m.marked[issue.ID]
+	case "open":
+		return issue.Status != model.StatusClosed
+	case "closed":
+		return issue.Status == model.StatusClosed
+	case "ready":
+		// Ready = Open/InProgress AND NO Open Blockers
+		if issue.Status == model.StatusClosed || issue.Status == model.StatusBlocked {
+			return false
+		}
+		for _, dep := range issue.Dependencies {
+			if dep.Type.IsBlocking() {
+				if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
+					return false
+				}
+			}
+		}
+		return true
+	default:
+		if strings.HasPrefix(m.currentFilter, "label:") {
+			label := strings.TrimPrefix(m.currentFilter, "label:")
+			for _, l := range issue.Labels {
+				if l == label {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// filterMenuCandidates returns the issues that pass every active filter
+// except the saved view's status, priority and type filters, which the
+// filter menu edits. A recipe's own filters are not applied.
+func (m *Model) filterMenuCandidates() []model.Issue {
+	var rest *config.SavedView
+	if m.activeView != nil {
+		v := *m.activeView
+		v.Status, v.Priority, v.Type = nil, nil, nil
+		rest = &v
+	}
+	var out []model.Issue
+	for i := range m.issues {
+		issue := &m.issues[i]
+		if !m.inActiveRepos(issue) || !m.inScope(issue) {
+			continue
+		}
+		if rest != nil && (!savedViewMatches(rest, issue) || !dependencyCountsMatch(rest, m.analysis, issue.ID)) {
+			continue
+		}
+		if m.activeRecipe == nil && !m.currentFilterMatches(issue) {
+			continue
+		}
+		out = append(out, *issue)
+	}
+	return out
+}
+
 func (m *Model) applyFilter() {
 	var filteredItems []list.Item
 	var filteredIssues []model.Issue
 
 	for _, issue := range m.issues {
-		// Workspace repo filter (nil = all repos)
-		if m.workspaceMode && m.activeRepos != nil {
-			repoKey := strings.ToLower(ExtractRepoPrefix(issue.ID))
-			if repoKey != "" && !m.activeRepos[repoKey] {
-				continue
-			}
+		if !m.inActiveRepos(&issue) || !m.inScope(&issue) {
+			continue
 		}
 
-		include := false
-		switch m.currentFilter {
-		case "all":
-			include = true
-		case "open":
-			include = issue.Status != model.StatusClosed
-		case "closed":
-			include = issue.Status == model.StatusClosed
-		case "ready":
-			// Ready = Open/InProgress AND NO Open Blockers
-			if issue.Status != model.StatusClosed && issue.Status != model.StatusBlocked {
-				isBlocked := false
-				for _, dep := range issue.Dependencies {
-					if dep.Type == model.DepBlocks {
-						if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
-							isBlocked = true
-							break
-						}
-					}
-				}
-				include = !isBlocked
-			}
-		default:
-			if strings.HasPrefix(m.currentFilter, "label:") {
-				label := strings.TrimPrefix(m.currentFilter, "label:")
-				for _, l := range issue.Labels {
-					if l == label {
-						include = true
-						break
-					}
-				}
-			}
+		// Saved view filters (repo/status/priority/type/assignee/dependency counts)
+		if m.activeView != nil && (!savedViewMatches(m.activeView, &issue) || !dependencyCountsMatch(m.activeView, m.analysis, issue.ID)) {
+			continue
 		}
 
-		if include {
+		if m.currentFilterMatches(&issue) {
 			// Use pre-computed graph scores (avoid redundant calculation)
 			item := IssueItem{
 				Issue:      issue,
@@ -3897,6 +4192,9 @@ func (m *Model) applyFilter() {
 			item.IsQuickWin = m.quickWinSet[issue.ID]
 			item.IsBlocker = m.blockerSet[issue.ID]
 			item.UnblocksCount = len(m.unblocksMap[issue.ID])
+			item.IsPinned = m.display.IsPinned(issue.ID)
+			item.IsMarked = m.marked[issue.ID]
+			item.IsWatched = watchedInView(m.activeView, &issue)
 			filteredItems = append(filteredItems, item)
 			filteredIssues = append(filteredIssues, issue)
 		}
@@ -3923,6 +4221,383 @@ func (m *Model) applyFilter() {
 func (m *Model) cycleSortMode() {
 	m.sortMode = (m.sortMode + 1) % numSortModes
 	m.applyFilter() // Re-apply filter with new sort
+	m.persistSort()
+}
+
+// toggleSortReverse flips the direction of the current sort mode
+func (m *Model) toggleSortReverse() {
+	m.sortReverse = !m.sortReverse
+	m.applyFilter()
+	m.persistSort()
+}
+
+// applyLayout sizes the list, detail viewport and panels for the terminal,
+// using the list/detail split when the terminal is wide enough and the
+// detail pane isn't hidden.
+func (m *Model) applyLayout() {
+	m.isSplitView = m.width > SplitViewThreshold && !m.display.HideDetail
+	bodyHeight := m.height - 1 // keep 1 row for footer
+	if bodyHeight < 5 {
+		bodyHeight = 5
+	}
+
+	if m.isSplitView {
+		// Calculate dimensions accounting for 2 panels with borders(2)+padding(2) = 4 overhead each
+		// Total overhead = 8
+		availWidth := m.width - 8
+		if availWidth < 10 {
+			availWidth = 10
+		}
+
+		listInnerWidth := int(float64(availWidth) * 0.4)
+		detailInnerWidth := availWidth - listInnerWidth
+
+		// listHeight fits header (1) + page line (1) inside a panel with Border (2)
+		listHeight := bodyHeight - 4
+		if listHeight < 3 {
+			listHeight = 3
+		}
+
+		m.list.SetSize(listInnerWidth, listHeight)
+		m.viewport = viewport.New(detailInnerWidth, bodyHeight-2) // Account for border
+
+		m.renderer.SetWidthWithTheme(detailInnerWidth, m.theme)
+	} else {
+		listHeight := bodyHeight - 2
+		if listHeight < 3 {
+			listHeight = 3
+		}
+		m.list.SetSize(m.width, listHeight)
+		m.viewport = viewport.New(m.width, bodyHeight-1)
+
+		// Update renderer for full width
+		m.renderer.SetWidthWithTheme(m.width, m.theme)
+	}
+
+	m.list.SetDelegate(m.newIssueDelegate())
+
+	// Resize label dashboard table and modal overlay sizing
+	m.labelDashboard.SetSize(m.width, bodyHeight)
+
+	m.insightsPanel.SetSize(m.width, bodyHeight)
+	m.updateViewportContent()
+}
+
+// toggleDetailPane switches between the list/detail split and a full-width
+// list, keeping the selected issue, and saves the choice to the display
+// config.
+func (m *Model) toggleDetailPane() {
+	m.display.HideDetail = !m.display.HideDetail
+	m.applyLayout()
+	if !m.isSplitView && m.focused == focusDetail {
+		m.focused = focusList
+	}
+	m.showDetails = false
+	switch {
+	case m.display.HideDetail:
+		m.statusMsg = "Detail pane hidden (enter opens an issue)"
+	case m.isSplitView:
+		m.statusMsg = "Detail pane shown"
+	default:
+		m.statusMsg = fmt.Sprintf("Detail pane shown once the terminal is wider than %d columns", SplitViewThreshold)
+	}
+	m.statusIsError = false
+	if m.saveDisplay == nil {
+		return
+	}
+	if err := m.saveDisplay(m.display); err != nil {
+		m.statusMsg = fmt.Sprintf("Failed to save detail pane setting: %v", err)
+		m.statusIsError = true
+	}
+}
+
+// toggleDensity switches the list between compact and comfortable rows and
+// saves the choice to the display config.
+func (m *Model) toggleDensity() {
+	m.display.Density = m.display.Density.Toggle()
+	m.list.SetDelegate(m.newIssueDelegate())
+	m.statusMsg = fmt.Sprintf("Row density: %s", m.display.Density)
+	m.statusIsError = false
+	if m.saveDisplay == nil {
+		return
+	}
+	if err := m.saveDisplay(m.display); err != nil {
+		m.statusMsg = fmt.Sprintf("Failed to save density: %v", err)
+		m.statusIsError = true
+	}
+}
+
+// toggleLabelSort switches the label dashboard between health and
+// blocked-count order and persists the choice.
+func (m *Model) toggleLabelSort() {
+	m.display.LabelSort = m.labelDashboard.Sort().Toggle()
+	m.labelDashboard.SetSort(m.display.LabelSort)
+	if m.display.LabelSort == config.LabelSortBlocked {
+		m.statusMsg = "Labels: most blocked first"
+	} else {
+		m.statusMsg = "Labels: by health"
+	}
+	m.statusIsError = false
+	if m.saveDisplay == nil {
+		return
+	}
+	if err := m.saveDisplay(m.display); err != nil {
+		m.statusMsg = fmt.Sprintf("Failed to save label sort: %v", err)
+		m.statusIsError = true
+	}
+}
+
+// toggleBareIDs switches the list, detail pane and graph between namespaced
+// and bare issue IDs and persists the choice.
+func (m *Model) toggleBareIDs() {
+	m.display.BareIDs = !m.display.BareIDs
+	m.list.SetDelegate(m.newIssueDelegate())
+	m.graphView.SetBareIDs(m.display.BareIDs)
+	m.updateViewportContent()
+	if m.display.BareIDs {
+		m.statusMsg = "IDs: bare (other projects keep their prefix)"
+	} else {
+		m.statusMsg = "IDs: namespaced"
+	}
+	m.statusIsError = false
+	if m.saveDisplay == nil {
+		return
+	}
+	if err := m.saveDisplay(m.display); err != nil {
+		m.statusMsg = fmt.Sprintf("Failed to save ID display: %v", err)
+		m.statusIsError = true
+	}
+}
+
+// displayID formats an issue ID per the bare-ID preference.
+func (m *Model) displayID(id string) string {
+	if m.display.BareIDs {
+		return BareID(id)
+	}
+	return id
+}
+
+// bareTreeIDs shortens the IDs in a dependency tree rooted at rootID,
+// keeping the prefix on issues from other projects.
+func bareTreeIDs(node *DependencyNode, rootID string) {
+	if node == nil {
+		return
+	}
+	node.ID = DisplayRefID(rootID, node.ID, true)
+	for _, child := range node.Children {
+		bareTreeIDs(child, rootID)
+	}
+}
+
+// listRowLines is the number of terminal lines one list row occupies,
+// including spacing, at the current density.
+func (m Model) listRowLines() int {
+	d := m.newIssueDelegate()
+	return d.Height() + d.Spacing()
+}
+
+// persistSort saves the sort mode and direction to the display config
+func (m *Model) persistSort() {
+	m.display.Sort = m.sortMode.Key()
+	m.display.SortReverse = m.sortReverse
+	if m.saveDisplay == nil {
+		return
+	}
+	if err := m.saveDisplay(m.display); err != nil {
+		m.statusMsg = fmt.Sprintf("Failed to save sort preference: %v", err)
+		m.statusIsError = true
+	}
+}
+
+// togglePinSelected pins or unpins the selected issue and saves the pin
+// list to the display config.
+func (m *Model) togglePinSelected() {
+	item, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		return
+	}
+	id := item.Issue.ID
+	pinned := m.display.TogglePin(id)
+	m.list.SetDelegate(m.newIssueDelegate())
+	m.applyFilter()
+
+	// Keep the cursor on the issue that just moved
+	for i, it := range m.list.Items() {
+		if ii, ok := it.(IssueItem); ok && ii.Issue.ID == id {
+			m.list.Select(i)
+			break
+		}
+	}
+
+	if pinned {
+		m.statusMsg = fmt.Sprintf("📌 Pinned %s", id)
+	} else {
+		m.statusMsg = fmt.Sprintf("Unpinned %s", id)
+	}
+	m.statusIsError = false
+	if m.saveDisplay == nil {
+		return
+	}
+	if err := m.saveDisplay(m.display); err != nil {
+		m.statusMsg = fmt.Sprintf("Failed to save pins: %v", err)
+		m.statusIsError = true
+	}
+}
+
+// adjustSelectedPriority moves the priority of the marked issues (or the
+// selected issue when none are marked) by delta (-1 is more urgent) and
+// writes it to each issue's beads.jsonl. Requires --allow-write.
+func (m *Model) adjustSelectedPriority(delta int) {
+	item, ok := m.list.SelectedItem().(IssueItem)
+	if !ok && len(m.marked) == 0 {
+		return
+	}
+	m.statusIsError = true
+	if !m.allowWrite {
+		m.statusMsg = "Read-only: restart with --allow-write to change priorities"
+		return
+	}
+	if m.timeTravelMode {
+		m.statusMsg = "Cannot change priorities while time-traveling"
+		return
+	}
+
+	ids := m.markedIDs()
+	if len(ids) == 0 {
+		ids = []string{item.Issue.ID}
+	}
+	changed, skipped := 0, 0
+	var lastFrom, lastTo int
+	for _, id := range ids {
+		issue, ok := m.issueMap[id]
+		if !ok {
+			continue
+		}
+		from := issue.Priority
+		to := from + delta
+		if to < 0 || to > 4 {
+			skipped++
+			if len(ids) == 1 {
+				m.statusMsg = fmt.Sprintf("%s is already P%d", id, from)
+				return
+			}
+			continue
+		}
+		if err := m.writeIssuePriority(id, to); err != nil {
+			m.statusMsg = fmt.Sprintf("Failed to update priority: %v", err)
+			m.applyFilter()
+			return
+		}
+		issue.Priority = to
+		changed++
+		lastFrom, lastTo = from, to
+	}
+
+	m.applyFilter()
+	if ok {
+		for i, it := range m.list.Items() {
+			if ii, isIssue := it.(IssueItem); isIssue && ii.Issue.ID == item.Issue.ID {
+				m.list.Select(i)
+				break
+			}
+		}
+	}
+	m.statusIsError = false
+	switch {
+	case len(ids) == 1:
+		m.statusMsg = fmt.Sprintf("%s priority P%d → P%d", ids[0], lastFrom, lastTo)
+	case skipped > 0:
+		m.statusMsg = fmt.Sprintf("Changed priority of %d issues (%d already at the limit)", changed, skipped)
+	default:
+		m.statusMsg = fmt.Sprintf("Changed priority of %d issues", changed)
+	}
+}
+
+// writeIssuePriority sets one issue's priority in the beads file that holds it.
+func (m *Model) writeIssuePriority(id string, priority int) error {
+	return m.writeIssue(id, func(path, id string) error {
+		return loader.SetIssuePriority(path, id, priority)
+	})
+}
+
+// writeIssue runs a loader edit against the beads file that holds the issue.
+func (m *Model) writeIssue(id string, edit func(path, id string) error) error {
+	path, localID := m.issueSourcePath(id)
+	if path == "" {
+		return fmt.Errorf("no beads file to update for %s", id)
+	}
+	err := edit(path, id)
+	if errors.Is(err, loader.ErrIssueNotFound) && localID != id {
+		// Workspace files store IDs without the project prefix
+		err = edit(path, localID)
+	}
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// issueSourcePath returns the beads file that holds an issue and the ID as
+// written there (without the workspace prefix), or "" if it is unknown.
+func (m *Model) issueSourcePath(id string) (string, string) {
+	if !m.workspaceMode {
+		return m.beadsPath, id
+	}
+	best := m.projectPrefixFor(id)
+	if best == "" {
+		return "", id
+	}
+	return m.projectPaths[best], id[len(best):]
+}
+
+// projectPrefixFor returns the workspace prefix of the project holding an
+// issue, or "" if none matches. The longest matching prefix wins so
+// "api-v2-" beats "api-".
+func (m *Model) projectPrefixFor(id string) string {
+	best := ""
+	for prefix := range m.projectPaths {
+		if len(prefix) > len(best) && strings.HasPrefix(strings.ToLower(id), strings.ToLower(prefix)) {
+			best = prefix
+		}
+	}
+	return best
+}
+
+// sortIndicator returns the active sort column and direction, e.g. "PRI ▲"
+func (m Model) sortIndicator() string {
+	arrow := "▼"
+	if m.sortMode.ascending() != m.sortReverse {
+		arrow = "▲"
+	}
+	return m.sortMode.column() + " " + arrow
+}
+
+// renderListColumnHeader renders the list column header with the active sort
+// column and direction on the right, using the shared column heading style.
+func (m Model) renderListColumnHeader(columns string, width int) string {
+	t := m.theme
+	if len(m.display.Pinned) > 0 && strings.HasPrefix(columns, "  ") {
+		// Line up with the pin indicator column in the rows
+		columns = "  📌 " + columns[2:]
+	}
+	indicator := t.ColumnHeaderStyle().
+		Background(t.Primary).
+		Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#282A36"}).
+		Bold(true).
+		Render(m.sortIndicator())
+	indicatorWidth := lipgloss.Width(indicator) + 1 // trailing pad
+
+	headerStyle := t.Renderer.NewStyle().
+		Background(t.Primary).
+		Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#282A36"}).
+		Bold(true)
+
+	leftWidth := width - indicatorWidth
+	if leftWidth < 1 {
+		return headerStyle.Width(width).MaxWidth(width).Render(truncateRunesHelper(columns, width, ""))
+	}
+	left := headerStyle.Width(leftWidth).Render(truncateRunesHelper(columns, leftWidth, ""))
+	return left + indicator + headerStyle.Render(" ")
 }
 
 // sortFilteredItems sorts the filtered items based on current sortMode (bv-3ita)
@@ -3941,6 +4616,14 @@ func (m *Model) sortFilteredItems(items []list.Item, issues []model.Issue) {
 		iItem := items[indices[i]].(IssueItem)
 		jItem := items[indices[j]].(IssueItem)
 
+		// Pinned issues stay on top regardless of mode or direction
+		if iItem.IsPinned != jItem.IsPinned {
+			return iItem.IsPinned
+		}
+		if m.sortReverse {
+			iItem, jItem = jItem, iItem
+		}
+
 		switch m.sortMode {
 		case SortCreatedAsc:
 			// Oldest first
@@ -3998,6 +4681,9 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 				include = false
 			}
 		}
+		if !m.inScope(&issue) {
+			include = false
+		}
 
 		// Apply status filter
 		if len(r.Filters.Status) > 0 {
@@ -4042,7 +4728,7 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 			// Check if issue is blocked
 			isBlocked := false
 			for _, dep := range issue.Dependencies {
-				if dep.Type == model.DepBlocks {
+				if dep.Type.IsBlocking() {
 					if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
 						isBlocked = true
 						break
@@ -4069,6 +4755,9 @@ func (m *Model) applyRecipe(r *recipe.Recipe) {
 			item.IsQuickWin = m.quickWinSet[issue.ID]
 			item.IsBlocker = m.blockerSet[issue.ID]
 			item.UnblocksCount = len(m.unblocksMap[issue.ID])
+			item.IsPinned = m.display.IsPinned(issue.ID)
+			item.IsMarked = m.marked[issue.ID]
+			item.IsWatched = watchedInView(m.activeView, &issue)
 			filteredItems = append(filteredItems, item)
 			filteredIssues = append(filteredIssues, issue)
 		}
@@ -4175,21 +4864,34 @@ func (m *Model) updateViewportContent() {
 	// Meta Table
 	sb.WriteString("| ID | Status | Priority | Assignee | Created |\n|---|---|---|---|---|\n")
 	sb.WriteString(fmt.Sprintf("| **%s** | **%s** | %s | @%s | %s |\n\n",
-		item.ID,
+		m.displayID(item.ID),
 		strings.ToUpper(string(item.Status)),
 		GetPriorityIcon(item.Priority),
 		item.Assignee,
 		item.CreatedAt.Format("2006-01-02"),
 	))
 
+	if item.ExternalID != "" {
+		sb.WriteString(fmt.Sprintf("**External ID:** %s\n\n", item.ExternalID))
+	}
+	if item.Status == model.StatusClosed && item.Resolution != "" {
+		sb.WriteString(fmt.Sprintf("**Resolution:** %s\n\n", item.Resolution))
+	}
+	if len(item.Watchers) > 0 {
+		sb.WriteString(fmt.Sprintf("**Watchers:** %s\n\n", strings.Join(item.Watchers, ", ")))
+	}
+
 	// Labels (bv-f103 fix: display labels in detail view)
 	if len(item.Labels) > 0 {
 		sb.WriteString(fmt.Sprintf("**Labels:** %s\n\n", strings.Join(item.Labels, ", ")))
 	}
 
+	// Foldable sections
+	sections := detailSectionWriter{sb: &sb, folded: m.detailFolded}
+
 	// Triage Insights (bv-151)
 	if issueItem.TriageScore > 0 || issueItem.TriageReason != "" || issueItem.UnblocksCount > 0 || issueItem.IsQuickWin || issueItem.IsBlocker {
-		sb.WriteString("### 🎯 Triage Insights\n")
+		var sb strings.Builder
 
 		// Score with visual indicator
 		scoreIcon := "🔵"
@@ -4227,6 +4929,7 @@ func (m *Model) updateViewportContent() {
 		}
 
 		sb.WriteString("\n")
+		sections.section(config.SectionTriage, "🎯 Triage Insights", sb.String())
 	}
 
 	// Graph Analysis (using thread-safe accessors)
@@ -4237,54 +4940,63 @@ func (m *Model) updateViewportContent() {
 	hub := m.analysis.GetHubScore(item.ID)
 	auth := m.analysis.GetAuthorityScore(item.ID)
 
-	sb.WriteString("### Graph Analysis\n")
-	sb.WriteString(fmt.Sprintf("- **Impact Depth**: %.0f (downstream chain length)\n", imp))
-	sb.WriteString(fmt.Sprintf("- **Centrality**: PR %.4f • BW %.4f • EV %.4f\n", pr, bt, ev))
-	sb.WriteString(fmt.Sprintf("- **Flow Role**: Hub %.4f • Authority %.4f\n\n", hub, auth))
+	sections.section(config.SectionGraph, "Graph Analysis",
+		fmt.Sprintf("- **Impact Depth**: %.0f (downstream chain length)\n", imp)+
+			fmt.Sprintf("- **Centrality**: PR %.4f • BW %.4f • EV %.4f\n", pr, bt, ev)+
+			fmt.Sprintf("- **Flow Role**: Hub %.4f • Authority %.4f\n\n", hub, auth))
 
-	// Description
-	if item.Description != "" {
-		sb.WriteString("### Description\n")
-		sb.WriteString(item.Description + "\n\n")
+	// Description (markdown; nested below the section header)
+	if body := item.MarkdownBody(); body != "" {
+		sections.section(config.SectionDescription, "Description", nestMarkdownHeadings(body, 4)+"\n\n")
 	}
 
 	// Acceptance Criteria
 	if item.AcceptanceCriteria != "" {
-		sb.WriteString("### Acceptance Criteria\n")
-		sb.WriteString(item.AcceptanceCriteria + "\n\n")
+		sections.section(config.SectionAcceptance, "Acceptance Criteria", item.AcceptanceCriteria+"\n\n")
 	}
 
 	// Notes
 	if item.Notes != "" {
-		sb.WriteString("### Notes\n")
-		sb.WriteString(item.Notes + "\n\n")
+		sections.section(config.SectionNotes, "Notes", item.Notes+"\n\n")
 	}
 
 	// Dependency Graph (Tree)
 	if len(item.Dependencies) > 0 {
 		rootNode := BuildDependencyTree(item.ID, m.issueMap, 3) // Max depth 3
+		if m.display.BareIDs {
+			bareTreeIDs(rootNode, item.ID)
+		}
 		treeStr := RenderDependencyTree(rootNode)
-		sb.WriteString("```\n" + treeStr + "```\n\n")
+		sections.section(config.SectionDependencies, "Dependencies", "```\n"+treeStr+"```\n\n")
 	}
 
-	// Comments
-	if len(item.Comments) > 0 {
-		sb.WriteString(fmt.Sprintf("### Comments (%d)\n", len(item.Comments)))
+	// Comments; records may carry only a count and the last comment time
+	if n := item.NumComments(); n > 0 {
+		var comments strings.Builder
 		for _, comment := range item.Comments {
-			sb.WriteString(fmt.Sprintf("> **%s** (%s)\n> \n> %s\n\n",
+			comments.WriteString(fmt.Sprintf("> **%s** (%s)\n> \n> %s\n\n",
 				comment.Author,
 				FormatTimeRel(comment.CreatedAt),
 				strings.ReplaceAll(comment.Text, "\n", "\n> ")))
 		}
+		if hidden := n - len(item.Comments); hidden > 0 {
+			note := fmt.Sprintf("%d comment(s) not included in this record", hidden)
+			if last := item.LastCommentTime(); !last.IsZero() {
+				note += fmt.Sprintf("; last comment %s", FormatTimeRel(last))
+			}
+			comments.WriteString("*" + note + "*\n\n")
+		}
+		sections.section(config.SectionComments, fmt.Sprintf("Comments (%d)", n), comments.String())
 	}
 
 	// History Section (if data is loaded)
 	if m.historyView.HasReport() {
 		historyMD := m.renderBeadHistoryMD(item.ID)
 		if historyMD != "" {
-			sb.WriteString(historyMD)
+			sections.section(config.SectionHistory, "📜 History", "\n"+historyMD)
 		}
 	}
+	m.detailShown = sections.shown
 
 	rendered, err := m.renderer.Render(sb.String())
 	if err != nil {
@@ -4294,6 +5006,233 @@ func (m *Model) updateViewportContent() {
 	}
 }
 
+// applyReloadedIssues swaps in a freshly loaded issue set, recomputing
+// analysis and rebuilding views while preserving the selected issue by ID.
+// Returns follow-up commands (Phase 2 wait, semantic index rebuild).
+func (m *Model) applyReloadedIssues(newIssues []model.Issue, reloadWarnings []string) []tea.Cmd {
+	if m.scope != nil {
+		m.scopeIDs = idSet(m.scope())
+	}
+	if m.ignoreIssue != nil {
+		kept := newIssues[:0]
+		for i := range newIssues {
+			if !m.ignoreIssue(&newIssues[i]) {
+				kept = append(kept, newIssues[i])
+			}
+		}
+		m.ignoredCount = len(newIssues) - len(kept)
+		newIssues = kept
+	}
+
+	var notifyIDs []string
+	if m.notifier != nil {
+		notifyIDs = m.notifier.newlyBlocked(m.issues, newIssues)
+	}
+	cacheHit, cmds := m.rebuildIssues(newIssues)
+
+	if cacheHit {
+		m.statusMsg = fmt.Sprintf("Reloaded %d issues (cached)", len(newIssues))
+	} else {
+		m.statusMsg = fmt.Sprintf("Reloaded %d issues", len(newIssues))
+	}
+	if len(reloadWarnings) > 0 {
+		m.statusMsg += fmt.Sprintf(" (%d warnings)", len(reloadWarnings))
+	}
+	m.statusIsError = false
+	if len(notifyIDs) > 0 {
+		m.statusMsg = m.notifier.banner(notifyIDs)
+		m.statusIsError = true
+		cmds = append(cmds, m.notifier.bellCmd())
+	}
+	return cmds
+}
+
+// rebuildIssues replaces the issue set and recomputes everything derived
+// from it (analysis, counts, list, sub-views), keeping the selection.
+// Reports whether the analysis came from cache; the commands include the
+// Phase 2 wait.
+func (m *Model) rebuildIssues(newIssues []model.Issue) (bool, []tea.Cmd) {
+	var cmds []tea.Cmd
+
+	// Clear ephemeral overlays tied to old data
+	m.clearAttentionOverlay()
+
+	// Exit time-travel mode if active (file changed, show current state)
+	if m.timeTravelMode {
+		m.timeTravelMode = false
+		m.timeTravelDiff = nil
+		m.timeTravelSince = ""
+		m.newIssueIDs = nil
+		m.closedIssueIDs = nil
+		m.modifiedIssueIDs = nil
+	}
+
+	// Store selected issue ID to restore position after reload
+	var selectedID string
+	if sel := m.list.SelectedItem(); sel != nil {
+		if item, ok := sel.(IssueItem); ok {
+			selectedID = item.Issue.ID
+		}
+	}
+
+	// Apply default sorting (Open first, Priority, Date)
+	sort.Slice(newIssues, func(i, j int) bool {
+		iClosed := newIssues[i].Status == model.StatusClosed
+		jClosed := newIssues[j].Status == model.StatusClosed
+		if iClosed != jClosed {
+			return !iClosed
+		}
+		if newIssues[i].Priority != newIssues[j].Priority {
+			return newIssues[i].Priority < newIssues[j].Priority
+		}
+		return newIssues[i].CreatedAt.After(newIssues[j].CreatedAt)
+	})
+
+	// Recompute analysis (async Phase 1/Phase 2) with caching
+	m.issues = newIssues
+	cachedAnalyzer := analysis.NewCachedAnalyzer(newIssues, nil)
+	m.analyzer = cachedAnalyzer.Analyzer
+	m.analysis = cachedAnalyzer.AnalyzeAsync(context.Background())
+	cacheHit := cachedAnalyzer.WasCacheHit()
+	m.labelHealthCached = false
+	m.attentionCached = false
+	m.flowMatrixText = ""
+
+	// Rebuild lookup map
+	m.issueMap = make(map[string]*model.Issue, len(newIssues))
+	for i := range m.issues {
+		m.issueMap[m.issues[i].ID] = &m.issues[i]
+	}
+
+	// Clear stale priority hints (will be repopulated after Phase 2)
+	m.priorityHints = make(map[string]*analysis.PriorityRecommendation)
+
+	// Recompute stats
+	m.countOpen, m.countReady, m.countBlocked, m.countClosed = 0, 0, 0, 0
+	for i := range m.issues {
+		issue := &m.issues[i]
+		if issue.Status == model.StatusClosed {
+			m.countClosed++
+			continue
+		}
+		m.countOpen++
+		if issue.Status == model.StatusBlocked {
+			m.countBlocked++
+			continue
+		}
+		isBlocked := false
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if blocker, exists := m.issueMap[dep.DependsOnID]; exists && blocker.Status != model.StatusClosed {
+				isBlocked = true
+				break
+			}
+		}
+		if !isBlocked {
+			m.countReady++
+		}
+	}
+
+	// Recompute alerts for refreshed dataset
+	m.alerts, m.alertsCritical, m.alertsWarning, m.alertsInfo = computeAlerts(m.issues, m.analysis, m.analyzer)
+	m.dismissedAlerts = make(map[string]bool)
+	m.showAlertsPanel = false
+
+	// Regenerate sub-views (with Phase 1 data; Phase 2 will update via Phase2ReadyMsg)
+	ins := m.analysis.GenerateInsights(len(m.issues))
+	m.insightsPanel = NewInsightsModel(ins, m.issueMap, m.theme)
+	bodyHeight := m.height - 1
+	if bodyHeight < 5 {
+		bodyHeight = 5
+	}
+	m.insightsPanel.SetSize(m.width, bodyHeight)
+	m.graphView.SetIssues(m.issues, &ins)
+
+	// Generate priority recommendations now that Phase 2 is ready
+	m.board = NewBoardModel(m.issues, m.theme)
+	m.board.SetWIPLimits(analysis.WIPLimits{Global: m.display.WIPLimit.Global, PerAssignee: m.display.WIPLimit.PerAssignee})
+
+	// Rebuild the list through the active recipe or the filters, so the
+	// saved view, scope, sort and pins survive the reload, then restore
+	// the selection by ID
+	if m.activeRecipe != nil {
+		m.applyRecipe(m.activeRecipe)
+	} else {
+		m.applyFilter()
+	}
+	if selectedID != "" {
+		for i, item := range m.list.Items() {
+			if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
+				m.list.Select(i)
+				break
+			}
+		}
+	}
+
+	// Reload sprints (bv-161)
+	if m.beadsPath != "" {
+		beadsDir := filepath.Dir(m.beadsPath)
+		if loaded, err := loader.LoadSprintsFromFile(filepath.Join(beadsDir, loader.SprintsFileName)); err == nil {
+			m.sprints = loaded
+			// If we have a selected sprint, try to refresh it
+			if m.selectedSprint != nil {
+				found := false
+				for i := range m.sprints {
+					if m.sprints[i].ID == m.selectedSprint.ID {
+						m.selectedSprint = &m.sprints[i]
+						m.sprintViewText = m.renderSprintDashboard()
+						found = true
+						break
+					}
+				}
+				if !found {
+					m.selectedSprint = nil
+					m.sprintViewText = "Sprint not found"
+				}
+			}
+		}
+	}
+
+	// Re-rank the ready queue so newly unblocked issues appear
+	if m.focused == focusReadyQueue {
+		m.readyQueue.SetIssues(m.issues)
+	}
+
+	// Keep semantic index current when enabled.
+	if m.semanticSearchEnabled && !m.semanticIndexBuilding {
+		m.semanticIndexBuilding = true
+		cmds = append(cmds, BuildSemanticIndexCmd(m.issues))
+	}
+
+	// Invalidate label-derived caches
+	m.labelHealthCached = false
+	m.labelDrilldownCache = make(map[string][]model.Issue)
+	m.updateViewportContent()
+
+	return cacheHit, append(cmds, WaitForPhase2Cmd(m.analysis))
+}
+
+// loadRefreshIssues re-reads issues for auto-refresh, using the configured
+// reloader (e.g., all projects in multi-project mode) or the beads file.
+func (m *Model) loadRefreshIssues() ([]model.Issue, []string, error) {
+	if m.reloader != nil {
+		issues, err := m.reloader()
+		return issues, nil, err
+	}
+	if m.beadsPath == "" {
+		return nil, nil, fmt.Errorf("no issue source to refresh from")
+	}
+	var reloadWarnings []string
+	opts := m.parseOpts
+	opts.WarningHandler = func(msg string) {
+		reloadWarnings = append(reloadWarnings, msg)
+	}
+	issues, err := loader.LoadIssuesFromFileWithOptions(m.beadsPath, opts)
+	return issues, reloadWarnings, err
+}
+
 // renderBeadHistoryMD generates markdown for a bead's history
 func (m *Model) renderBeadHistoryMD(beadID string) string {
 	hist := m.historyView.GetHistoryForBead(beadID)
@@ -4302,7 +5241,6 @@ func (m *Model) renderBeadHistoryMD(beadID string) string {
 	}
 
 	var sb strings.Builder
-	sb.WriteString("### 📜 History\n\n")
 
 	// Lifecycle milestones from events
 	if len(hist.Events) > 0 {
@@ -4372,20 +5310,13 @@ func getEventIcon(eventType correlation.EventType) string {
 	}
 }
 
-// truncateString truncates a string to maxLen runes with ellipsis.
-// Uses rune-based counting to safely handle UTF-8 multi-byte characters.
+// truncateString truncates a string to maxLen display cells with ellipsis.
+// Wide characters (CJK, emoji) count as two cells and are never split.
 func truncateString(s string, maxLen int) string {
-	if maxLen <= 0 {
-		return ""
-	}
-	runes := []rune(s)
-	if len(runes) <= maxLen {
-		return s
-	}
 	if maxLen <= 3 {
-		return string(runes[:maxLen])
+		return truncateRunesHelper(s, maxLen, "")
 	}
-	return string(runes[:maxLen-1]) + "…"
+	return truncateRunesHelper(s, maxLen, "…")
 }
 
 // GetTypeIconMD returns the emoji icon for an issue type (for markdown)
@@ -4424,12 +5355,62 @@ func (m Model) FilteredIssues() []model.Issue {
 	return issues
 }
 
+// SetIgnoreFilter hides issues for which ignore returns true whenever issues
+// are reloaded. hidden is how many the caller already dropped from the
+// initial set; the footer shows the count.
+func (m *Model) SetIgnoreFilter(ignore func(*model.Issue) bool, hidden int) {
+	m.ignoreIssue = ignore
+	m.ignoredCount = hidden
+}
+
+// SetViewScope limits the views to the issue IDs scope returns (e.g. the
+// issues changed since a commit), calling it again on every reload. Issues
+// outside the scope are hidden but still feed the analysis, so a scoped
+// issue's blockers and scores come from the whole graph.
+func (m *Model) SetViewScope(scope func() []string) {
+	m.scope = scope
+	m.scopeIDs = idSet(scope())
+	m.applyFilter()
+}
+
+// idSet builds a lookup set from ids.
+func idSet(ids []string) map[string]bool {
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
+
+// EnableAutoRefresh reloads issues every interval, for setups where file
+// watching is unreliable (e.g., network mounts). reload re-reads all issues;
+// if nil, the beads file is re-read. An interval <= 0 disables refresh.
+func (m *Model) EnableAutoRefresh(interval time.Duration, reload IssueReloader) {
+	if interval < 0 {
+		interval = 0
+	}
+	m.refreshInterval = interval
+	m.reloader = reload
+}
+
+// SetParseOptions sets the options the beads file is re-read with on
+// reloads and time travel, such as the default priority; warnings are
+// collected by the model either way.
+func (m *Model) SetParseOptions(opts loader.ParseOptions) {
+	m.parseOpts = opts
+}
+
 // EnableWorkspaceMode configures the model for workspace (multi-repo) view
 func (m *Model) EnableWorkspaceMode(info WorkspaceInfo) {
 	m.workspaceMode = info.Enabled
 	m.availableRepos = normalizeRepoPrefixes(info.RepoPrefixes)
 	m.activeRepos = nil // nil means all repos are active
 	m.projectPaths = info.ProjectPaths
+	m.projectTags = info.ProjectTags
+	m.unloadedProjects = info.UnloadedProjects
+	for prefix, color := range info.RepoColors {
+		SetRepoColor(prefix, color)
+	}
 
 	if info.RepoCount > 0 {
 		if info.FailedCount > 0 {
@@ -4440,12 +5421,139 @@ func (m *Model) EnableWorkspaceMode(info WorkspaceInfo) {
 	}
 
 	// Update delegate to show repo badges
-	m.list.SetDelegate(IssueDelegate{
+	m.list.SetDelegate(m.newIssueDelegate())
+}
+
+// newIssueDelegate builds a list delegate reflecting the current view state.
+func (m *Model) newIssueDelegate() IssueDelegate {
+	return IssueDelegate{
 		Theme:             m.theme,
 		ShowPriorityHints: m.showPriorityHints,
 		PriorityHints:     m.priorityHints,
 		WorkspaceMode:     m.workspaceMode,
-	})
+		ShowPins:          len(m.display.Pinned) > 0,
+		Display:           m.display,
+	}
+}
+
+// SetLabelGroupByPrefix groups the label dashboard by label prefix
+// (text before ':', e.g. "area:backend") into collapsible sections.
+func (m *Model) SetLabelGroupByPrefix(enabled bool) {
+	m.labelDashboard.SetGroupByPrefix(enabled)
+}
+
+// SetDisplayConfig applies user display preferences (truncation strategy, ellipsis).
+func (m *Model) SetDisplayConfig(cfg config.DisplayConfig) {
+	m.display = cfg
+	m.detailFolded = newDetailFolds(cfg)
+	m.projectManager.SetDisplayConfig(cfg)
+	m.labelDashboard.SetSort(cfg.LabelSort)
+	m.list.SetDelegate(m.newIssueDelegate())
+	m.graphView.SetBareIDs(cfg.BareIDs)
+	if !m.semanticSearchEnabled {
+		m.list.Filter = fuzzyFilterFor(cfg.Search.Scoring)
+	}
+	m.SetSortMode(SortModeFromKey(cfg.Sort), cfg.SortReverse)
+	m.viewPicker = NewViewPickerModel(cfg.Views, m.theme)
+	m.board.SetWIPLimits(analysis.WIPLimits{Global: cfg.WIPLimit.Global, PerAssignee: cfg.WIPLimit.PerAssignee})
+	if len(cfg.Pinned) > 0 {
+		m.applyFilter()
+	}
+	if m.ready {
+		m.applyLayout()
+	}
+}
+
+// refreshInsightsPanel rebuilds the insights panel from the latest analysis snapshot
+func (m *Model) refreshInsightsPanel() {
+	if m.analysis == nil {
+		return
+	}
+	ins := m.analysis.GenerateInsights(len(m.issues))
+	m.insightsPanel = NewInsightsModel(ins, m.issueMap, m.theme)
+	// Include priority triage (bv-91) - reuse existing analyzer/stats (bv-runn.12)
+	triage := analysis.ComputeTriageFromAnalyzer(m.analyzer, m.analysis, m.issues, analysis.TriageOptions{}, time.Now())
+	m.insightsPanel.SetTopPicks(triage.QuickRef.TopPicks)
+	// Set full recommendations with breakdown for priority radar (bv-93)
+	dataHash := fmt.Sprintf("v%s@%s#%d", triage.Meta.Version, triage.Meta.GeneratedAt.Format("15:04:05"), triage.Meta.IssueCount)
+	m.insightsPanel.SetRecommendations(triage.Recommendations, dataHash)
+	panelHeight := m.height - 2
+	if panelHeight < 3 {
+		panelHeight = 3
+	}
+	m.insightsPanel.SetSize(m.width, panelHeight)
+}
+
+// ApplySavedView filters the list by v and applies its sort and view type.
+// name is shown in the status bar; an empty name marks an ad-hoc view built
+// from command-line flags.
+func (m *Model) ApplySavedView(name string, v config.SavedView) {
+	m.activeView = &v
+	m.activeViewName = name
+	if v.Sort != "" {
+		m.sortMode = SortModeFromKey(v.Sort)
+		m.sortReverse = v.SortReverse
+	}
+	m.applyFilter()
+
+	m.clearAttentionOverlay()
+	m.isBoardView = false
+	m.isGraphView = false
+	m.isActionableView = false
+	switch v.ViewType {
+	case config.ViewBoard:
+		m.isBoardView = true
+		m.focused = focusBoard
+	case config.ViewGraph:
+		m.isGraphView = true
+		m.focused = focusGraph
+	case config.ViewInsights:
+		m.refreshInsightsPanel()
+		m.focused = focusInsights
+	default:
+		m.focused = focusList
+	}
+}
+
+// ClearSavedView removes the active saved view's filters
+func (m *Model) ClearSavedView() {
+	if m.activeView == nil {
+		return
+	}
+	m.activeView = nil
+	m.activeViewName = ""
+	m.applyFilter()
+}
+
+// SetAllowWrite enables TUI actions that modify issues on disk (</> to
+// change priority). Off by default so browsing can never change data.
+func (m *Model) SetAllowWrite(allow bool) {
+	m.allowWrite = allow
+}
+
+// SetDisplaySaver sets the callback used to persist sort changes made with
+// s/R. Without one, sort changes last only for the session.
+func (m *Model) SetDisplaySaver(save func(config.DisplayConfig) error) {
+	m.saveDisplay = save
+}
+
+// SetProjectsSaver sets the callback the Project Manager uses to save the
+// project list after each change when autosave_projects is on.
+func (m *Model) SetProjectsSaver(save func([]ProjectEntry) error) {
+	m.saveProjects = save
+}
+
+// SetSortMode sets the list sort order and direction without persisting it
+func (m *Model) SetSortMode(mode SortMode, reverse bool) {
+	if mode < SortDefault || mode >= numSortModes {
+		mode = SortDefault
+	}
+	if mode == m.sortMode && reverse == m.sortReverse {
+		return
+	}
+	m.sortMode = mode
+	m.sortReverse = reverse
+	m.applyFilter()
 }
 
 // IsWorkspaceMode returns whether workspace mode is active
@@ -4487,16 +5595,21 @@ func (m *Model) buildProjectEntries() []ProjectEntry {
 		return nil
 	}
 
-	// Count issues per prefix
+	// Count issues and collect close times per prefix
 	issueCounts := make(map[string]int)
+	closedAt := make(map[string][]time.Time)
 	for _, issue := range m.issues {
 		for prefix := range m.projectPaths {
 			if strings.HasPrefix(strings.ToLower(issue.ID), strings.ToLower(prefix)) {
 				issueCounts[prefix]++
+				if issue.Status == model.StatusClosed && issue.ClosedAt != nil {
+					closedAt[prefix] = append(closedAt[prefix], *issue.ClosedAt)
+				}
 				break
 			}
 		}
 	}
+	now := time.Now()
 
 	var entries []ProjectEntry
 	for prefix, beadsPath := range m.projectPaths {
@@ -4506,10 +5619,15 @@ func (m *Model) buildProjectEntries() []ProjectEntry {
 			Name:       filepath.Base(projectDir),
 			Path:       projectDir,
 			Prefix:     prefix,
+			Tags:       m.projectTags[prefix],
 			IssueCount: issueCounts[prefix],
 			IsActive:   isActive,
+			Activity:   closeActivity(closedAt[prefix], now, ProjectActivityDays),
 		})
 	}
+	if m.display.AutosaveProjects && m.saveProjects != nil {
+		entries = append(entries, m.unloadedProjects...)
+	}
 	return entries
 }
 
@@ -4565,6 +5683,7 @@ func (m *Model) enterTimeTravelMode(revision string) {
 	}
 
 	gitLoader := loader.NewGitLoader(cwd)
+	gitLoader.SetParseOptions(m.parseOpts)
 
 	// Check if we're in a git repo first
 	if _, err := gitLoader.ResolveRevision("HEAD"); err != nil {
@@ -4765,7 +5884,13 @@ func (m *Model) copyIssueToClipboard() {
 
 	sb.WriteString(fmt.Sprintf("# %s %s\n\n", GetTypeIconMD(string(issue.IssueType)), issue.Title))
 	sb.WriteString(fmt.Sprintf("**ID:** %s  \n", issue.ID))
+	if issue.ExternalID != "" {
+		sb.WriteString(fmt.Sprintf("**External ID:** %s  \n", issue.ExternalID))
+	}
 	sb.WriteString(fmt.Sprintf("**Status:** %s  \n", strings.ToUpper(string(issue.Status))))
+	if issue.Status == model.StatusClosed && issue.Resolution != "" {
+		sb.WriteString(fmt.Sprintf("**Resolution:** %s  \n", issue.Resolution))
+	}
 	sb.WriteString(fmt.Sprintf("**Priority:** P%d  \n", issue.Priority))
 	if issue.Assignee != "" {
 		sb.WriteString(fmt.Sprintf("**Assignee:** @%s  \n", issue.Assignee))
@@ -4776,8 +5901,8 @@ func (m *Model) copyIssueToClipboard() {
 		sb.WriteString(fmt.Sprintf("**Labels:** %s  \n", strings.Join(issue.Labels, ", ")))
 	}
 
-	if issue.Description != "" {
-		sb.WriteString(fmt.Sprintf("\n## Description\n\n%s\n", issue.Description))
+	if body := issue.MarkdownBody(); body != "" {
+		sb.WriteString(fmt.Sprintf("\n## Description\n\n%s\n", nestMarkdownHeadings(body, 3)))
 	}
 
 	if issue.AcceptanceCriteria != "" {
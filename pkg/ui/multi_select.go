@@ -0,0 +1,114 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atotto/clipboard"
+)
+
+// toggleMarkSelected marks or unmarks the selected issue for bulk actions.
+func (m *Model) toggleMarkSelected() {
+	idx := m.list.Index()
+	item, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		return
+	}
+	id := item.Issue.ID
+	if m.marked[id] {
+		delete(m.marked, id)
+	} else {
+		if m.marked == nil {
+			m.marked = make(map[string]bool)
+		}
+		m.marked[id] = true
+	}
+	item.IsMarked = m.marked[id]
+	m.list.SetItem(idx, item)
+	m.statusMsg = ""
+	m.statusIsError = false
+}
+
+// markedIDs returns the marked issue IDs in list order, followed by any
+// marked issues the current filter hides.
+func (m Model) markedIDs() []string {
+	if len(m.marked) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(m.marked))
+	seen := make(map[string]bool, len(m.marked))
+	for _, it := range m.list.Items() {
+		if ii, ok := it.(IssueItem); ok && m.marked[ii.Issue.ID] {
+			ids = append(ids, ii.Issue.ID)
+			seen[ii.Issue.ID] = true
+		}
+	}
+	for _, issue := range m.issues {
+		if m.marked[issue.ID] && !seen[issue.ID] {
+			ids = append(ids, issue.ID)
+		}
+	}
+	return ids
+}
+
+// clearMarks unmarks every issue.
+func (m *Model) clearMarks() {
+	m.marked = nil
+	if m.currentFilter == "marked" {
+		m.currentFilter = "all"
+	}
+	m.applyFilter()
+	m.statusMsg = "Selection cleared"
+	m.statusIsError = false
+}
+
+// copyMarkedIDs copies the marked issue IDs (or the selected issue's ID when
+// none are marked) to the clipboard, space-separated for pasting into bd.
+func (m *Model) copyMarkedIDs() {
+	ids := m.markedIDs()
+	if len(ids) == 0 {
+		item, ok := m.list.SelectedItem().(IssueItem)
+		if !ok {
+			return
+		}
+		ids = []string{item.Issue.ID}
+	}
+	if err := clipboard.WriteAll(strings.Join(ids, " ")); err != nil {
+		m.statusMsg = fmt.Sprintf("❌ Clipboard error: %v", err)
+		m.statusIsError = true
+		return
+	}
+	if len(ids) == 1 {
+		m.statusMsg = fmt.Sprintf("📋 Copied %s to clipboard", ids[0])
+	} else {
+		m.statusMsg = fmt.Sprintf("📋 Copied %d IDs to clipboard", len(ids))
+	}
+	m.statusIsError = false
+}
+
+// filterToMarked narrows the list to the marked issues.
+func (m *Model) filterToMarked() {
+	if len(m.marked) == 0 {
+		m.statusMsg = "No issues selected: press space to select"
+		m.statusIsError = true
+		return
+	}
+	m.currentFilter = "marked"
+	m.activeRecipe = nil
+	m.applyFilter()
+	m.statusMsg = ""
+	m.statusIsError = false
+}
+
+// countLabel is the footer count, noting ignored issues and the selection
+// size when issues are marked.
+func (m Model) countLabel() string {
+	label := fmt.Sprintf("%d issues", len(m.list.Items()))
+	if m.ignoredCount > 0 {
+		label += fmt.Sprintf(" (%d ignored)", m.ignoredCount)
+	}
+	if n := len(m.marked); n > 0 {
+		label += fmt.Sprintf(" • ✓ %d selected", n)
+	}
+	return label
+}
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMultiSelectBulkActions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.jsonl")
+	lines := []string{
+		`{"id":"A","title":"Alpha","status":"open","priority":3,"issue_type":"task"}`,
+		`{"id":"B","title":"Beta","status":"open","priority":0,"issue_type":"task"}`,
+		`{"id":"C","title":"Gamma","status":"open","priority":2,"issue_type":"task"}`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 3},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Priority: 0},
+		{ID: "C", Title: "Gamma", Status: model.StatusOpen, Priority: 2},
+	}
+	m := NewModel(issues, nil, path)
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = updated.(Model)
+	press := func(msg tea.KeyMsg) {
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+	}
+	space := tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+	key := func(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+	// Order is B,C,A; mark B and A
+	press(space)
+	m.list.Select(2)
+	press(space)
+	if got := strings.Join(m.markedIDs(), ","); got != "B,A" {
+		t.Fatalf("marked = %s, want B,A", got)
+	}
+	if !strings.Contains(m.View(), "2 selected") {
+		t.Errorf("footer should show the selected count")
+	}
+
+	// Filter to the selection
+	press(key("v"))
+	if got := listIDs(m); got != "B,A" {
+		t.Errorf("filtered list = %s, want B,A", got)
+	}
+
+	// Bulk lower: both marked issues move, C is untouched
+	m.SetAllowWrite(true)
+	press(key("<"))
+	if m.statusIsError {
+		t.Fatalf("unexpected error: %s", m.statusMsg)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"id":"A","title":"Alpha","status":"open","priority":4`, `"id":"B","title":"Beta","status":"open","priority":1`, `"id":"C","title":"Gamma","status":"open","priority":2`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("beads.jsonl missing %s:\n%s", want, data)
+		}
+	}
+
+	// A is already P4: it is skipped, B still moves
+	press(key("<"))
+	if !strings.Contains(m.statusMsg, "1 already at the limit") {
+		t.Errorf("status = %q, want skipped count", m.statusMsg)
+	}
+	if m.issueMap["B"].Priority != 2 || m.issueMap["A"].Priority != 4 {
+		t.Errorf("priorities = A:%d B:%d, want A:4 B:2", m.issueMap["A"].Priority, m.issueMap["B"].Priority)
+	}
+
+	// Esc clears the selection and the selection filter
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if len(m.marked) != 0 || m.currentFilter != "all" {
+		t.Errorf("after esc: marked=%v filter=%q", m.marked, m.currentFilter)
+	}
+	if got := len(m.list.Items()); got != 3 {
+		t.Errorf("list has %d items after esc, want 3", got)
+	}
+}
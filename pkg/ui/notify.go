@@ -0,0 +1,105 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// blockedNotifier alerts when a reload blocks a high-priority issue
+// (--notify), so an unattended TUI can act as a passive monitor.
+type blockedNotifier struct {
+	maxPriority int       // Issues at or above this priority (numerically <=) alert
+	bell        io.Writer // Receives the terminal bell; os.Stdout by default
+}
+
+// EnableBlockedNotify rings the terminal bell and shows a banner when a
+// reload moves an issue of priority maxPriority or higher (P0 highest) to
+// blocked, either by status or by a new open blocker.
+func (m *Model) EnableBlockedNotify(maxPriority int) {
+	m.notifier = &blockedNotifier{maxPriority: maxPriority, bell: os.Stdout}
+}
+
+// blockedIssueIDs returns the non-closed issues that are blocked: status
+// blocked, or a blocking dependency on an issue that is not closed.
+func blockedIssueIDs(issues []model.Issue) map[string]bool {
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+	blocked := make(map[string]bool)
+	for i := range issues {
+		issue := &issues[i]
+		if issue.Status == model.StatusClosed {
+			continue
+		}
+		if issue.Status == model.StatusBlocked {
+			blocked[issue.ID] = true
+			continue
+		}
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if blocker, ok := byID[dep.DependsOnID]; ok && blocker.Status != model.StatusClosed {
+				blocked[issue.ID] = true
+				break
+			}
+		}
+	}
+	return blocked
+}
+
+// newlyBlocked returns the IDs, sorted, of issues within the priority
+// threshold that are blocked in after but were not blocked in before.
+// Issues new to after count too: a new issue can arrive blocked.
+func (n *blockedNotifier) newlyBlocked(before, after []model.Issue) []string {
+	was := blockedIssueIDs(before)
+	var ids []string
+	for id := range blockedIssueIDs(after) {
+		if !was[id] {
+			ids = append(ids, id)
+		}
+	}
+	priority := make(map[string]int, len(after))
+	for i := range after {
+		priority[after[i].ID] = after[i].Priority
+	}
+	kept := ids[:0]
+	for _, id := range ids {
+		if priority[id] <= n.maxPriority {
+			kept = append(kept, id)
+		}
+	}
+	sort.Strings(kept)
+	return kept
+}
+
+// banner describes the newly blocked issues for the status bar
+func (n *blockedNotifier) banner(ids []string) string {
+	const shown = 3
+	list := ids
+	if len(list) > shown {
+		list = list[:shown]
+	}
+	msg := fmt.Sprintf("Newly blocked (P0-P%d): %s", n.maxPriority, strings.Join(list, ", "))
+	if extra := len(ids) - len(list); extra > 0 {
+		msg += fmt.Sprintf(" +%d more", extra)
+	}
+	return msg
+}
+
+// bellCmd rings the terminal bell
+func (n *blockedNotifier) bellCmd() tea.Cmd {
+	w := n.bell
+	return func() tea.Msg {
+		_, _ = io.WriteString(w, "\a")
+		return nil
+	}
+}
@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPriorityKeysWriteBeadsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.jsonl")
+	lines := []string{
+		`{"id":"A","title":"Alpha","status":"open","priority":3,"issue_type":"task","labels":["x"]}`,
+		`{"id":"B","title":"Beta","status":"open","priority":0,"issue_type":"task"}`,
+		`{"id":"C","title":"Gamma","status":"open","priority":2,"issue_type":"task"}`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 3},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Priority: 0},
+		{ID: "C", Title: "Gamma", Status: model.StatusOpen, Priority: 2},
+	}
+	m := NewModel(issues, nil, path)
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 30})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(Model)
+	}
+
+	if got := listIDs(m); got != "B,C,A" {
+		t.Fatalf("initial order = %s, want B,C,A", got)
+	}
+	m.list.Select(2)
+
+	// Read-only by default
+	press(">")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "--allow-write") {
+		t.Errorf("status = %q, want read-only hint", m.statusMsg)
+	}
+
+	m.SetAllowWrite(true)
+	press(">")
+	press(">")
+	if got := listIDs(m); got != "B,A,C" {
+		t.Fatalf("order after raising A = %s, want B,A,C", got)
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != "A" || sel.Issue.Priority != 1 {
+		t.Errorf("expected selection to stay on A at P1, got %+v", sel.Issue)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Replace(strings.Join(lines, "\n")+"\n", `"priority":3`, `"priority":1`, 1)
+	if string(data) != want {
+		t.Errorf("beads.jsonl =\n%s\nwant:\n%s", data, want)
+	}
+
+	// Bounds are enforced without touching the file
+	m.list.Select(0)
+	press(">")
+	if !strings.Contains(m.statusMsg, "already P0") {
+		t.Errorf("status = %q, want already P0", m.statusMsg)
+	}
+}
+
+func TestIssueSourcePathWorkspace(t *testing.T) {
+	m := NewModel(nil, nil, "")
+	m.EnableWorkspaceMode(WorkspaceInfo{
+		Enabled:      true,
+		RepoPrefixes: []string{"api-", "api-v2-"},
+		ProjectPaths: map[string]string{"api-": "/code/api/.beads/beads.jsonl", "api-v2-": "/code/v2/.beads/beads.jsonl"},
+	})
+
+	path, local := m.issueSourcePath("api-v2-7")
+	if path != "/code/v2/.beads/beads.jsonl" || local != "7" {
+		t.Errorf("issueSourcePath(api-v2-7) = %q, %q", path, local)
+	}
+	if path, _ := m.issueSourcePath("web-1"); path != "" {
+		t.Errorf("expected no path for unknown prefix, got %q", path)
+	}
+}
@@ -4,18 +4,82 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
 )
 
 // ProjectEntry represents a project in the project manager.
 type ProjectEntry struct {
-	Name       string // Display name
-	Path       string // Absolute path to project directory
-	Prefix     string // Namespace prefix (e.g., "api-")
-	IssueCount int    // Number of issues from this project
-	IsActive   bool   // Whether currently included in view
+	Name       string   // Display name
+	Path       string   // Absolute path to project directory
+	Prefix     string   // Namespace prefix (e.g., "api-")
+	Tags       []string // Tags from the project's .bv.yaml
+	IssueCount int      // Number of issues from this project
+	IsActive   bool     // Whether currently included in view
+	Activity   []int    // Issues closed per day, oldest first (see ProjectActivityDays)
+}
+
+// ProjectActivityDays is how many days of close activity the project
+// manager's sparkline covers.
+const ProjectActivityDays = 14
+
+// closeActivity counts the issues closed on each of the days days up to
+// now, oldest first. Issues without a close timestamp are not counted.
+func closeActivity(closedAt []time.Time, now time.Time, days int) []int {
+	counts := make([]int, days)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	for _, t := range closedAt {
+		t = t.In(now.Location())
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, now.Location())
+		ago := int(today.Sub(day).Hours()/24 + 0.5)
+		if ago >= 0 && ago < days {
+			counts[days-1-ago]++
+		}
+	}
+	return counts
+}
+
+// activitySparkline renders counts as block characters in width cells,
+// summing neighbouring days when there are more days than cells. Days with
+// no closes sit on the baseline, so an idle project is a flat line.
+func activitySparkline(counts []int, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if len(counts) == 0 {
+		return strings.Repeat("▁", width)
+	}
+	if width > len(counts) {
+		width = len(counts)
+	}
+	buckets := make([]int, width)
+	for i, c := range counts {
+		buckets[i*width/len(counts)] += c
+	}
+	maxVal := 0
+	for _, b := range buckets {
+		if b > maxVal {
+			maxVal = b
+		}
+	}
+
+	blocks := []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+	var sb strings.Builder
+	for _, b := range buckets {
+		level := 0
+		if b > 0 {
+			// Any activity rises above the baseline; the busiest cell is full
+			top := len(blocks) - 1
+			level = (b*top + maxVal - 1) / maxVal
+		}
+		sb.WriteRune(blocks[level])
+	}
+	return sb.String()
 }
 
 // ProjectManagerModel represents the project manager overlay.
@@ -28,6 +92,9 @@ type ProjectManagerModel struct {
 	height        int
 	theme         Theme
 	errorMsg      string
+	display       config.DisplayConfig
+
+	saved bool // The last change was autosaved
 }
 
 // NewProjectManagerModel creates a new project manager.
@@ -42,9 +109,15 @@ func NewProjectManagerModel(theme Theme) ProjectManagerModel {
 		projects:  []ProjectEntry{},
 		pathInput: ti,
 		theme:     theme,
+		display:   config.DefaultDisplayConfig(),
 	}
 }
 
+// SetDisplayConfig sets the truncation strategy and ellipsis used for rows.
+func (m *ProjectManagerModel) SetDisplayConfig(cfg config.DisplayConfig) {
+	m.display = cfg
+}
+
 // SetProjects sets the project list.
 func (m *ProjectManagerModel) SetProjects(projects []ProjectEntry) {
 	m.projects = projects
@@ -136,6 +209,18 @@ func (m *ProjectManagerModel) ClearError() {
 	m.errorMsg = ""
 }
 
+// MarkSaved shows the "saved" indicator until the next key.
+func (m *ProjectManagerModel) MarkSaved() {
+	m.saved = true
+	m.errorMsg = ""
+}
+
+// ClearSaved hides the "saved" indicator and any autosave error.
+func (m *ProjectManagerModel) ClearSaved() {
+	m.saved = false
+	m.errorMsg = ""
+}
+
 // UpdateInput updates the text input with a key message.
 func (m *ProjectManagerModel) UpdateInput(msg interface{}) {
 	var cmd interface{}
@@ -196,14 +281,23 @@ func (m *ProjectManagerModel) View() string {
 
 	t := m.theme
 
-	// Calculate box dimensions
+	// Calculate box dimensions, widening for the activity column when the
+	// terminal has room
 	boxWidth := 70
+	if m.width >= 96 {
+		boxWidth = 70 + ProjectActivityDays + 2
+	}
 	if m.width < 80 {
 		boxWidth = m.width - 10
 	}
 	if boxWidth < 40 {
 		boxWidth = 40
 	}
+	// Cells left for the sparkline after the fixed columns and box padding
+	sparkWidth := min(boxWidth-4-62, ProjectActivityDays)
+	if sparkWidth < 4 {
+		sparkWidth = 0
+	}
 
 	var lines []string
 
@@ -239,8 +333,11 @@ func (m *ProjectManagerModel) View() string {
 			lines = append(lines, emptyStyle.Render("Use 'a' to add a project."))
 		} else {
 			// Header
-			headerStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Underline(true)
+			headerStyle := t.ColumnHeaderStyle()
 			header := "  Name                 Path                              Issues"
+			if sparkWidth > 0 {
+				header += fmt.Sprintf(" %-*s", sparkWidth, fmt.Sprintf("%dd", ProjectActivityDays))
+			}
 			lines = append(lines, headerStyle.Render(header))
 
 			// Project rows
@@ -267,14 +364,31 @@ func (m *ProjectManagerModel) View() string {
 
 				// Truncate name and path for display
 				name := truncateString(proj.Name, 16)
-				path := truncatePathMiddle(proj.Path, 30)
+				path := truncatePath(proj.Path, 30, m.display)
 
-				line := cursor + check + " " + padRight(name, 16) + " " + padRight(path, 32) + " " + padLeftPM(fmt.Sprintf("%d", proj.IssueCount), 5)
+				// Saved projects that weren't loaded (no prefix) have no count
+				count := fmt.Sprintf("%d", proj.IssueCount)
+				if proj.Prefix == "" {
+					count = "-"
+				}
+				line := cursor + check + " " + padRight(name, 16) + " " + padRight(path, 32) + " " + padLeftPM(count, 5)
+				if sparkWidth > 0 {
+					line += " " + activitySparkline(proj.Activity, sparkWidth)
+				}
+				if len(proj.Tags) > 0 {
+					line += "  #" + strings.Join(proj.Tags, " #")
+				}
 				lines = append(lines, nameStyle.Render(line))
 			}
 		}
 
 		lines = append(lines, "")
+		switch {
+		case m.errorMsg != "":
+			lines = append(lines, t.Renderer.NewStyle().Foreground(t.Blocked).Render(m.errorMsg))
+		case m.saved:
+			lines = append(lines, t.Renderer.NewStyle().Foreground(t.Open).Render("✓ saved"))
+		}
 		footerStyle := t.Renderer.NewStyle().
 			Foreground(t.Secondary).
 			Italic(true)
@@ -299,26 +413,28 @@ func (m *ProjectManagerModel) View() string {
 	)
 }
 
-// truncatePathMiddle truncates a path in the middle, preserving start and end.
-func truncatePathMiddle(path string, max int) string {
-	if len(path) <= max {
-		return path
+// truncatePath truncates a path to max display cells using the configured
+// path strategy (middle by default, preserving start and end).
+func truncatePath(path string, max int, display config.DisplayConfig) string {
+	ellipsis := display.Ellipsis
+	if ellipsis == "" {
+		ellipsis = config.DefaultEllipsis
 	}
-	if max <= 5 {
-		return path[:max]
+	mode := display.Truncation.Path
+	if !mode.IsValid() {
+		mode = config.TruncateMiddle
 	}
-
-	// Show first part and last part
-	half := (max - 3) / 2
-	return path[:half] + "..." + path[len(path)-half:]
+	return truncateWithMode(path, max, mode, ellipsis)
 }
 
-// padLeftPM pads a string to the left with spaces (project manager specific).
+// padLeftPM pads a string to the left with spaces to width display cells
+// (project manager specific).
 func padLeftPM(s string, width int) string {
-	if len(s) >= width {
+	w := runewidth.StringWidth(s)
+	if w >= width {
 		return s
 	}
-	return strings.Repeat(" ", width-len(s)) + s
+	return strings.Repeat(" ", width-w) + s
 }
 
 // BuildProjectEntriesFromPaths creates ProjectEntry slice from paths and issue counts.
@@ -0,0 +1,208 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	xansi "github.com/charmbracelet/x/ansi"
+	"github.com/mattn/go-runewidth"
+)
+
+func TestCloseActivity(t *testing.T) {
+	now := time.Date(2025, 3, 10, 15, 0, 0, 0, time.UTC)
+	closed := []time.Time{
+		now.Add(-time.Hour),         // today
+		now.Add(-14 * time.Hour),    // today, early morning
+		now.AddDate(0, 0, -1),       // yesterday
+		now.AddDate(0, 0, -3),       // three days ago
+		now.AddDate(0, 0, -4),       // outside a four-day window
+		now.Add(24 * time.Hour * 2), // future timestamps are ignored
+	}
+	got := closeActivity(closed, now, 4)
+	want := []int{1, 0, 1, 2}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("closeActivity = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestActivitySparkline(t *testing.T) {
+	if got := activitySparkline(make([]int, 14), 7); got != "▁▁▁▁▁▁▁" {
+		t.Errorf("idle project = %q, want a flat line", got)
+	}
+	if got := activitySparkline(nil, 3); got != "▁▁▁" {
+		t.Errorf("no data = %q, want a flat line", got)
+	}
+	if got := activitySparkline([]int{0, 1, 0, 4}, 4); got != "▁▃▁█" {
+		t.Errorf("sparkline = %q, want ▁▃▁█", got)
+	}
+	// More days than cells sums neighbouring days
+	if got := activitySparkline([]int{1, 1, 0, 0}, 2); got != "█▁" {
+		t.Errorf("bucketed sparkline = %q, want █▁", got)
+	}
+}
+
+func TestProjectManagerViewShowsActivity(t *testing.T) {
+	m := NewProjectManagerModel(DefaultTheme(nil))
+	m.SetSize(120, 30)
+	m.SetProjects([]ProjectEntry{
+		{Name: "api", Path: "/code/api", IssueCount: 3, IsActive: true, Activity: []int{0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}},
+		{Name: "web", Path: "/code/web", IssueCount: 1, IsActive: true},
+	})
+	view := m.View()
+	if !strings.Contains(view, "14d") || !strings.Contains(view, "▁▁█▁▁▁▁▁▁▁▁▁▁▅") {
+		t.Errorf("expected an activity column for api:\n%s", view)
+	}
+	if !strings.Contains(view, strings.Repeat("▁", ProjectActivityDays)) {
+		t.Errorf("expected a flat line for web:\n%s", view)
+	}
+}
+
+func TestProjectManagerViewAlignsWideNames(t *testing.T) {
+	m := NewProjectManagerModel(DefaultTheme(nil))
+	m.SetSize(120, 30)
+	m.SetProjects([]ProjectEntry{
+		{Name: "api", Path: "/code/api", IssueCount: 3, IsActive: true},
+		{Name: "日本語プロジェクト", Path: "/code/日本語", IssueCount: 12, IsActive: true},
+		{Name: "🚀rocket", Path: "/code/🚀", IssueCount: 7},
+	})
+
+	// Every row's sparkline starts in the same column, however wide the
+	// characters in the name and path are
+	var columns []int
+	for _, line := range strings.Split(xansi.Strip(m.View()), "\n") {
+		if !strings.Contains(line, "[x]") && !strings.Contains(line, "[ ]") {
+			continue
+		}
+		i := strings.Index(line, "▁")
+		if i < 0 {
+			t.Fatalf("row has no activity column: %q", line)
+		}
+		columns = append(columns, runewidth.StringWidth(line[:i]))
+	}
+	if len(columns) != 3 {
+		t.Fatalf("found %d project rows, want 3", len(columns))
+	}
+	for _, c := range columns[1:] {
+		if c != columns[0] {
+			t.Errorf("project rows are misaligned: activity starts at columns %v", columns)
+			break
+		}
+	}
+}
+
+func TestProjectManagerAutosave(t *testing.T) {
+	open := func(autosave bool, saves *[][]ProjectEntry) Model {
+		m := NewModel([]model.Issue{
+			{ID: "api-1", Title: "Auth", Status: model.StatusOpen},
+			{ID: "web-1", Title: "Page", Status: model.StatusOpen},
+		}, nil, "")
+		m.EnableWorkspaceMode(WorkspaceInfo{
+			Enabled:      true,
+			RepoPrefixes: []string{"api-", "web-"},
+			ProjectPaths: map[string]string{"api-": "/code/api/.beads/beads.jsonl", "web-": "/code/web/.beads/beads.jsonl"},
+		})
+		cfg := config.DefaultDisplayConfig()
+		cfg.AutosaveProjects = autosave
+		m.SetDisplayConfig(cfg)
+		m.SetProjectsSaver(func(entries []ProjectEntry) error {
+			*saves = append(*saves, append([]ProjectEntry(nil), entries...))
+			return nil
+		})
+		updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+		m = updated.(Model)
+		updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")})
+		return updated.(Model)
+	}
+	press := func(m Model, key string) Model {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		return updated.(Model)
+	}
+
+	var saves [][]ProjectEntry
+	m := press(open(false, &saves), " ")
+	if len(saves) != 0 {
+		t.Fatalf("autosave off: saver called %d times", len(saves))
+	}
+
+	m = press(open(true, &saves), " ")
+	if len(saves) != 1 || len(saves[0]) != 2 {
+		t.Fatalf("toggle should save both projects once, got %+v", saves)
+	}
+	inactive := 0
+	for _, e := range saves[0] {
+		if !e.IsActive {
+			inactive++
+		}
+	}
+	if inactive != 1 {
+		t.Errorf("saved entries = %+v, want one toggled off", saves[0])
+	}
+	if !strings.Contains(xansi.Strip(m.View()), "✓ saved") {
+		t.Errorf("expected a saved indicator after autosave:\n%s", xansi.Strip(m.View()))
+	}
+
+	m = press(m, "j")
+	if strings.Contains(xansi.Strip(m.View()), "✓ saved") {
+		t.Error("saved indicator should clear on the next key")
+	}
+	m = press(m, "d")
+	if len(saves) != 2 || len(saves[1]) != 1 {
+		t.Errorf("remove should save the remaining project, got %+v", saves)
+	}
+	if !m.showProjectManager {
+		t.Error("autosave should leave the Project Manager open")
+	}
+}
+
+func TestProjectManagerListsDisabledProjects(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "api-1", Title: "Auth", Status: model.StatusOpen}}, nil, "")
+	m.EnableWorkspaceMode(WorkspaceInfo{
+		Enabled:          true,
+		RepoPrefixes:     []string{"api-"},
+		ProjectPaths:     map[string]string{"api-": "/code/api/.beads/beads.jsonl"},
+		UnloadedProjects: []ProjectEntry{{Name: "parked", Path: "/code/parked"}},
+	})
+	cfg := config.DefaultDisplayConfig()
+	cfg.AutosaveProjects = true
+	m.SetDisplayConfig(cfg)
+	var saves [][]ProjectEntry
+	m.SetProjectsSaver(func(entries []ProjectEntry) error {
+		saves = append(saves, append([]ProjectEntry(nil), entries...))
+		return nil
+	})
+	press := func(key string) {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(Model)
+	}
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+	m = updated.(Model)
+
+	press("P")
+	view := xansi.Strip(m.View())
+	if !strings.Contains(view, "[ ] parked") {
+		t.Fatalf("disabled project should be listed unchecked:\n%s", view)
+	}
+
+	press("j")
+	press(" ")
+	if len(saves) != 1 || len(saves[0]) != 2 || !saves[0][1].IsActive {
+		t.Fatalf("toggling parked on should save it enabled, got %+v", saves)
+	}
+	if !strings.Contains(m.statusMsg, "next start") {
+		t.Errorf("status = %q, want a note that it loads on the next start", m.statusMsg)
+	}
+
+	// Reopening the manager keeps the unloaded project and its state
+	press("q")
+	press("P")
+	if view := xansi.Strip(m.View()); !strings.Contains(view, "[x] parked") {
+		t.Errorf("reopened manager lost the enabled project:\n%s", view)
+	}
+}
@@ -0,0 +1,503 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ReadyQueueEntry is one issue in the ready queue.
+type ReadyQueueEntry struct {
+	Issue    model.Issue
+	Unblocks int  // Open issues for which this is the last open blocker
+	New      bool // Became ready while the queue was open
+}
+
+// ReadyQueueModel is a work queue of the issues that can be started now:
+// not closed and with no open blocking dependency. It is ordered by priority,
+// then by how many issues finishing the entry would unblock.
+type ReadyQueueModel struct {
+	entries      []ReadyQueueEntry
+	initial      map[string]bool // Ready when the queue was opened
+	project      string          // Only issues with this repo prefix (focus mode); "" is all
+	cursor       int
+	scrollOffset int
+	width        int
+	height       int
+	theme        Theme
+}
+
+// NewReadyQueueModel creates a ready queue for the given issues.
+func NewReadyQueueModel(issues []model.Issue, theme Theme) ReadyQueueModel {
+	return newProjectReadyQueue(issues, "", theme)
+}
+
+// newProjectReadyQueue creates a ready queue limited to one project's
+// issues (by lowercased repo prefix). Blockers in other projects still
+// count, so an issue waiting on another repo is not ready.
+func newProjectReadyQueue(issues []model.Issue, project string, theme Theme) ReadyQueueModel {
+	m := ReadyQueueModel{theme: theme, project: project}
+	m.entries = m.build(issues)
+	m.initial = make(map[string]bool, len(m.entries))
+	for _, e := range m.entries {
+		m.initial[e.Issue.ID] = true
+	}
+	return m
+}
+
+// SetIssues rebuilds the queue after issues changed, marking issues that
+// were not ready when the queue was opened as new. The selection stays on
+// the same issue while it is still ready.
+func (m *ReadyQueueModel) SetIssues(issues []model.Issue) {
+	selected := m.SelectedIssueID()
+	m.entries = m.build(issues)
+	for i := range m.entries {
+		m.entries[i].New = !m.initial[m.entries[i].Issue.ID]
+	}
+	for i, e := range m.entries {
+		if e.Issue.ID == selected {
+			m.cursor = i
+			m.ensureVisible()
+			return
+		}
+	}
+	if m.cursor >= len(m.entries) {
+		m.cursor = len(m.entries) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+	m.ensureVisible()
+}
+
+// build returns the queue entries for issues, keeping only the queue's
+// project when it has one.
+func (m *ReadyQueueModel) build(issues []model.Issue) []ReadyQueueEntry {
+	entries := buildReadyQueue(issues)
+	if m.project == "" {
+		return entries
+	}
+	kept := entries[:0]
+	for _, e := range entries {
+		if strings.ToLower(ExtractRepoPrefix(e.Issue.ID)) == m.project {
+			kept = append(kept, e)
+		}
+	}
+	return kept
+}
+
+// buildReadyQueue returns the ready issues in queue order. Like
+// GetActionableIssues, a blocker that is not loaded does not block.
+func buildReadyQueue(issues []model.Issue) []ReadyQueueEntry {
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
+	}
+
+	unblocks := make(map[string]int)
+	var ready []ReadyQueueEntry
+	for i := range issues {
+		issue := &issues[i]
+		if issue.Status == model.StatusClosed {
+			continue
+		}
+		openBlockers := make(map[string]bool)
+		for _, dep := range issue.Dependencies {
+			if dep == nil || !dep.Type.IsBlocking() {
+				continue
+			}
+			if blocker, ok := byID[dep.DependsOnID]; ok && blocker.Status != model.StatusClosed {
+				openBlockers[blocker.ID] = true
+			}
+		}
+		switch len(openBlockers) {
+		case 0:
+			ready = append(ready, ReadyQueueEntry{Issue: *issue})
+		case 1:
+			for id := range openBlockers {
+				unblocks[id]++
+			}
+		}
+	}
+
+	for i := range ready {
+		ready[i].Unblocks = unblocks[ready[i].Issue.ID]
+	}
+	sort.SliceStable(ready, func(i, j int) bool {
+		a, b := ready[i], ready[j]
+		if a.Issue.Priority != b.Issue.Priority {
+			return a.Issue.Priority < b.Issue.Priority
+		}
+		if a.Unblocks != b.Unblocks {
+			return a.Unblocks > b.Unblocks
+		}
+		return a.Issue.ID < b.Issue.ID
+	})
+	return ready
+}
+
+// SetSize updates the view dimensions.
+func (m *ReadyQueueModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+	m.ensureVisible()
+}
+
+// Len returns the number of ready issues.
+func (m *ReadyQueueModel) Len() int {
+	return len(m.entries)
+}
+
+// MoveUp moves the selection up.
+func (m *ReadyQueueModel) MoveUp() {
+	if m.cursor > 0 {
+		m.cursor--
+	}
+	m.ensureVisible()
+}
+
+// MoveDown moves the selection down.
+func (m *ReadyQueueModel) MoveDown() {
+	if m.cursor < len(m.entries)-1 {
+		m.cursor++
+	}
+	m.ensureVisible()
+}
+
+// SelectedIssueID returns the ID of the selected issue, or "" if the queue is empty.
+func (m *ReadyQueueModel) SelectedIssueID() string {
+	if m.cursor < 0 || m.cursor >= len(m.entries) {
+		return ""
+	}
+	return m.entries[m.cursor].Issue.ID
+}
+
+// visibleRows is how many entries fit below the header and above the hint line.
+func (m *ReadyQueueModel) visibleRows() int {
+	rows := m.height - 4
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+// ensureVisible adjusts scroll to keep the selection visible.
+func (m *ReadyQueueModel) ensureVisible() {
+	rows := m.visibleRows()
+	if m.cursor < m.scrollOffset {
+		m.scrollOffset = m.cursor
+	}
+	if m.cursor >= m.scrollOffset+rows {
+		m.scrollOffset = m.cursor - rows + 1
+	}
+	if m.scrollOffset < 0 {
+		m.scrollOffset = 0
+	}
+}
+
+// View renders the ready queue.
+func (m *ReadyQueueModel) View() string {
+	if m.width == 0 || m.height == 0 {
+		return ""
+	}
+	t := m.theme
+	var lines []string
+
+	headerStyle := t.Renderer.NewStyle().
+		Bold(true).
+		Foreground(t.Base.GetForeground()).
+		Background(t.Primary).
+		Padding(0, 2).
+		Width(m.width - 4)
+	newCount := 0
+	for _, e := range m.entries {
+		if e.New {
+			newCount++
+		}
+	}
+	header := fmt.Sprintf("▶ READY QUEUE  │  %d ready", len(m.entries))
+	if m.project != "" {
+		header = fmt.Sprintf("▶ FOCUS: %s  │  %d ready", m.project, len(m.entries))
+	}
+	if newCount > 0 {
+		header += fmt.Sprintf("  │  %d newly ready", newCount)
+	}
+	lines = append(lines, headerStyle.Render(header), "")
+
+	if len(m.entries) == 0 {
+		emptyStyle := t.Renderer.NewStyle().
+			Foreground(t.Subtext).
+			Italic(true).
+			Padding(2, 4).
+			Width(m.width - 4).
+			Align(lipgloss.Center)
+		lines = append(lines, emptyStyle.Render("✓ Nothing is ready. Every open issue is waiting on a blocker."))
+		return strings.Join(lines, "\n")
+	}
+
+	end := m.scrollOffset + m.visibleRows()
+	if end > len(m.entries) {
+		end = len(m.entries)
+	}
+	for i := m.scrollOffset; i < end; i++ {
+		e := m.entries[i]
+		selected := i == m.cursor
+
+		var row strings.Builder
+		if selected {
+			row.WriteString(t.Renderer.NewStyle().Foreground(t.Primary).Bold(true).Render("▸ "))
+		} else {
+			row.WriteString("  ")
+		}
+		row.WriteString(t.Renderer.NewStyle().Foreground(t.Subtext).Render(fmt.Sprintf("%3d. ", i+1)))
+		row.WriteString(GetPriorityIcon(e.Issue.Priority))
+		row.WriteString(" ")
+		idStyle := t.Renderer.NewStyle().Foreground(t.Secondary)
+		if selected {
+			idStyle = idStyle.Bold(true)
+		}
+		row.WriteString(idStyle.Render(e.Issue.ID))
+		row.WriteString(" ")
+
+		var badges string
+		if e.Unblocks > 0 {
+			badges += t.Renderer.NewStyle().Foreground(t.Open).Bold(true).Render(fmt.Sprintf(" →%d", e.Unblocks))
+		}
+		if e.New {
+			badges += " " + t.Renderer.NewStyle().Foreground(t.Base.GetForeground()).Background(t.Open).Bold(true).Render("NEW")
+		}
+
+		maxTitleLen := m.width - lipgloss.Width(row.String()) - lipgloss.Width(badges) - 4
+		if maxTitleLen < 10 {
+			maxTitleLen = 10
+		}
+		titleStyle := t.Renderer.NewStyle()
+		if selected {
+			titleStyle = titleStyle.Foreground(t.Primary).Bold(true)
+		}
+		row.WriteString(titleStyle.Render(truncateRunesHelper(e.Issue.Title, maxTitleLen, "…")))
+		row.WriteString(badges)
+
+		lineStyle := t.Renderer.NewStyle().Width(m.width - 2)
+		if selected {
+			lineStyle = lineStyle.Background(t.Highlight)
+		}
+		lines = append(lines, lineStyle.Render(row.String()))
+	}
+
+	hint := "j/k move • enter open • c close • >/< priority (--allow-write) • Q/esc back"
+	if m.project != "" {
+		hint = "j/k move • enter open • c close • >/< priority (--allow-write) • Z/esc leave focus"
+	}
+	lines = append(lines, "", t.Renderer.NewStyle().Foreground(t.Muted).Render(hint))
+	return strings.Join(lines, "\n")
+}
+
+// openReadyQueue shows the ready queue built from the current issues.
+func (m *Model) openReadyQueue() {
+	m.clearAttentionOverlay()
+	m.isGraphView = false
+	m.isBoardView = false
+	m.isActionableView = false
+	project := ""
+	if m.focusMode != nil {
+		project = m.focusMode.project
+	}
+	m.readyQueue = newProjectReadyQueue(m.issues, project, m.theme)
+	m.readyQueue.SetSize(m.width, m.height-1)
+	m.focused = focusReadyQueue
+}
+
+// focusModeState is the view focus mode (Z) replaced, restored on exit.
+type focusModeState struct {
+	project       string // Lowercased repo prefix; "" outside workspace mode
+	activeRepos   map[string]bool
+	currentFilter string
+	selectedID    string
+}
+
+// toggleFocusMode enters focus mode for the selected issue's project, or
+// leaves it if already focused.
+func (m *Model) toggleFocusMode() {
+	if m.focusMode != nil {
+		m.exitFocusMode()
+		return
+	}
+	selected, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		m.statusMsg = "Select an issue to focus on its project"
+		m.statusIsError = true
+		return
+	}
+	state := &focusModeState{
+		activeRepos:   m.activeRepos,
+		currentFilter: m.currentFilter,
+		selectedID:    selected.Issue.ID,
+	}
+	if m.workspaceMode {
+		state.project = strings.ToLower(ExtractRepoPrefix(selected.Issue.ID))
+	}
+	m.focusMode = state
+	if state.project != "" {
+		m.activeRepos = map[string]bool{state.project: true}
+	}
+	m.currentFilter = "ready"
+	m.applyFilter()
+	m.openReadyQueue()
+
+	scope := "ready work"
+	if state.project != "" {
+		scope = state.project + " ready work"
+	}
+	m.statusMsg = fmt.Sprintf("Focus: %s (%d) • Z/esc to leave", scope, m.readyQueue.Len())
+	m.statusIsError = false
+}
+
+// exitFocusMode restores the repo filter, list filter and selection from
+// before focus mode and returns to the list.
+func (m *Model) exitFocusMode() {
+	state := m.focusMode
+	if state == nil {
+		return
+	}
+	m.focusMode = nil
+	m.activeRepos = state.activeRepos
+	m.currentFilter = state.currentFilter
+	m.applyFilter()
+	for i, item := range m.list.Items() {
+		if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == state.selectedID {
+			m.list.Select(i)
+			break
+		}
+	}
+	m.focused = focusList
+	m.statusMsg = "Left focus mode"
+	m.statusIsError = false
+}
+
+// handleReadyQueueKeys handles keyboard input when the ready queue is focused.
+func (m Model) handleReadyQueueKeys(msg tea.KeyMsg) Model {
+	switch msg.String() {
+	case "Q":
+		if m.focusMode != nil {
+			m.exitFocusMode()
+			break
+		}
+		m.focused = focusList
+	case "Z":
+		m.toggleFocusMode()
+	case "j", "down":
+		m.readyQueue.MoveDown()
+	case "k", "up":
+		m.readyQueue.MoveUp()
+	case "enter":
+		// Jump to the selected issue in the list
+		selectedID := m.readyQueue.SelectedIssueID()
+		if selectedID == "" {
+			return m
+		}
+		for i, item := range m.list.Items() {
+			if issueItem, ok := item.(IssueItem); ok && issueItem.Issue.ID == selectedID {
+				m.list.Select(i)
+				break
+			}
+		}
+		m.focused = focusList
+		if m.isSplitView {
+			m.focused = focusDetail
+		} else {
+			m.showDetails = true
+		}
+	case "c":
+		m.closeReadyQueueIssue()
+	case ">":
+		m.adjustReadyQueuePriority(-1)
+	case "<":
+		m.adjustReadyQueuePriority(1)
+	}
+	return m
+}
+
+// readyQueueWritable reports whether queue edits may write to disk, setting
+// an error status when they may not.
+func (m *Model) readyQueueWritable() bool {
+	m.statusIsError = true
+	if !m.allowWrite {
+		m.statusMsg = "Read-only: restart with --allow-write to edit issues"
+		return false
+	}
+	if m.timeTravelMode {
+		m.statusMsg = "Cannot edit issues while time-traveling"
+		return false
+	}
+	m.statusIsError = false
+	return true
+}
+
+// closeReadyQueueIssue closes the selected queue issue in its beads.jsonl
+// and rebuilds the queue, so the issues it was blocking join it right away.
+func (m *Model) closeReadyQueueIssue() {
+	id := m.readyQueue.SelectedIssueID()
+	issue, ok := m.issueMap[id]
+	if !ok || !m.readyQueueWritable() {
+		return
+	}
+	now := time.Now().UTC()
+	if err := m.writeIssue(id, func(path, id string) error { return loader.CloseIssue(path, id, now) }); err != nil {
+		m.statusMsg = fmt.Sprintf("Failed to close %s: %v", id, err)
+		m.statusIsError = true
+		return
+	}
+	wasReady := make(map[string]bool, m.readyQueue.Len())
+	for _, e := range m.readyQueue.entries {
+		wasReady[e.Issue.ID] = true
+	}
+	issue.Status = model.StatusClosed
+	issue.ClosedAt = &now
+	issue.UpdatedAt = now
+	m.applyFilter()
+	m.readyQueue.SetIssues(m.issues)
+	m.statusMsg = fmt.Sprintf("Closed %s", id)
+	promoted := 0
+	for _, e := range m.readyQueue.entries {
+		if !wasReady[e.Issue.ID] {
+			promoted++
+		}
+	}
+	if promoted > 0 {
+		m.statusMsg += fmt.Sprintf(" • %d newly ready", promoted)
+	}
+}
+
+// adjustReadyQueuePriority moves the selected queue issue's priority by
+// delta (-1 is more urgent) and re-sorts the queue.
+func (m *Model) adjustReadyQueuePriority(delta int) {
+	id := m.readyQueue.SelectedIssueID()
+	issue, ok := m.issueMap[id]
+	if !ok || !m.readyQueueWritable() {
+		return
+	}
+	from := issue.Priority
+	to := from + delta
+	if to < 0 || to > 4 {
+		m.statusMsg = fmt.Sprintf("%s is already P%d", id, from)
+		m.statusIsError = true
+		return
+	}
+	if err := m.writeIssuePriority(id, to); err != nil {
+		m.statusMsg = fmt.Sprintf("Failed to update priority: %v", err)
+		m.statusIsError = true
+		return
+	}
+	issue.Priority = to
+	m.applyFilter()
+	m.readyQueue.SetIssues(m.issues)
+	m.statusMsg = fmt.Sprintf("%s priority P%d → P%d", id, from, to)
+}
@@ -0,0 +1,173 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func readyQueueIDs(entries []ReadyQueueEntry) string {
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.Issue.ID
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestBuildReadyQueueOrdersByPriorityThenFanOut(t *testing.T) {
+	blocks := func(from, to string) *model.Dependency {
+		return &model.Dependency{IssueID: from, DependsOnID: to, Type: model.DepBlocks}
+	}
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusOpen, Priority: 1},
+		{ID: "B", Status: model.StatusOpen, Priority: 1},
+		{ID: "C", Status: model.StatusOpen, Priority: 0},
+		{ID: "D", Status: model.StatusOpen, Priority: 2, Dependencies: []*model.Dependency{blocks("D", "B")}},
+		{ID: "E", Status: model.StatusOpen, Priority: 2, Dependencies: []*model.Dependency{blocks("E", "B")}},
+		{ID: "F", Status: model.StatusOpen, Priority: 2, Dependencies: []*model.Dependency{blocks("F", "A"), blocks("F", "B")}},
+		{ID: "G", Status: model.StatusClosed, Priority: 0},
+		{ID: "H", Status: model.StatusOpen, Priority: 3, Dependencies: []*model.Dependency{blocks("H", "G"), blocks("H", "missing")}},
+		{ID: "I", Status: model.StatusInProgress, Priority: 3,
+			Dependencies: []*model.Dependency{{IssueID: "I", DependsOnID: "A", Type: model.DepRelated}}},
+	}
+
+	queue := buildReadyQueue(issues)
+	if got := readyQueueIDs(queue); got != "C,B,A,H,I" {
+		t.Fatalf("queue = %s, want C,B,A,H,I", got)
+	}
+	// F is blocked by both A and B, so neither unblocks it alone
+	if queue[1].Unblocks != 2 || queue[2].Unblocks != 0 {
+		t.Errorf("unblocks B=%d A=%d; want 2 and 0", queue[1].Unblocks, queue[2].Unblocks)
+	}
+}
+
+func TestReadyQueueCloseRePromotes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "beads.jsonl")
+	lines := []string{
+		`{"id":"A","title":"Alpha","status":"open","priority":1,"issue_type":"task"}`,
+		`{"id":"B","title":"Beta","status":"open","priority":2,"issue_type":"task","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"blocks"}]}`,
+		`{"id":"C","title":"Gamma","status":"open","priority":3,"issue_type":"task"}`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 1},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Priority: 2,
+			Dependencies: []*model.Dependency{{IssueID: "B", DependsOnID: "A", Type: model.DepBlocks}}},
+		{ID: "C", Title: "Gamma", Status: model.StatusOpen, Priority: 3},
+	}
+	m := NewModel(issues, nil, path)
+	defer m.Stop()
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = updated.(Model)
+	press := func(key string) {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		m = updated.(Model)
+	}
+
+	press("Q")
+	if m.focused != focusReadyQueue {
+		t.Fatalf("focus = %v, want ready queue", m.focused)
+	}
+	if got := readyQueueIDs(m.readyQueue.entries); got != "A,C" {
+		t.Fatalf("queue = %s, want A,C", got)
+	}
+	if !strings.Contains(m.View(), "READY QUEUE") {
+		t.Error("view should render the ready queue")
+	}
+
+	// Read-only by default
+	press("c")
+	if !m.statusIsError || !strings.Contains(m.statusMsg, "--allow-write") {
+		t.Errorf("status = %q, want read-only hint", m.statusMsg)
+	}
+
+	m.SetAllowWrite(true)
+	press("c")
+	if got := readyQueueIDs(m.readyQueue.entries); got != "B,C" {
+		t.Fatalf("queue after closing A = %s, want B,C", got)
+	}
+	if !m.readyQueue.entries[0].New || m.readyQueue.entries[1].New {
+		t.Errorf("only B should be marked new: %+v", m.readyQueue.entries)
+	}
+	if !strings.Contains(m.statusMsg, "1 newly ready") {
+		t.Errorf("status = %q, want newly ready count", m.statusMsg)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(strings.Split(string(data), "\n")[0], `"status":"closed"`) {
+		t.Errorf("A not closed on disk:\n%s", data)
+	}
+
+	// Priority edits re-rank the queue and keep the selection
+	press("j")
+	press(">")
+	press(">")
+	if got := readyQueueIDs(m.readyQueue.entries); got != "C,B" || m.readyQueue.SelectedIssueID() != "C" {
+		t.Errorf("queue = %s selected %s; want C,B with C selected", got, m.readyQueue.SelectedIssueID())
+	}
+
+	press("q")
+	if m.focused != focusList {
+		t.Errorf("q should return to the list, focus = %v", m.focused)
+	}
+}
+
+func TestFocusModeShowsProjectReadyWorkAndRestores(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api-1", Title: "Endpoint", Status: model.StatusOpen, Priority: 1},
+		{ID: "api-2", Title: "Client", Status: model.StatusOpen, Priority: 2,
+			Dependencies: []*model.Dependency{{IssueID: "api-2", DependsOnID: "api-1", Type: model.DepBlocks}}},
+		{ID: "api-3", Title: "Waiting on web", Status: model.StatusOpen, Priority: 0,
+			Dependencies: []*model.Dependency{{IssueID: "api-3", DependsOnID: "web-1", Type: model.DepBlocks}}},
+		{ID: "api-4", Title: "Docs", Status: model.StatusClosed, Priority: 1},
+		{ID: "web-1", Title: "Page", Status: model.StatusOpen, Priority: 0},
+	}
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+	m.EnableWorkspaceMode(WorkspaceInfo{Enabled: true, RepoCount: 2, RepoPrefixes: []string{"api", "web"}})
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = updated.(Model)
+	press := func(key tea.KeyMsg) {
+		updated, _ := m.Update(key)
+		m = updated.(Model)
+	}
+	for i, item := range m.list.Items() {
+		if item.(IssueItem).Issue.ID == "api-2" {
+			m.list.Select(i)
+		}
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Z")})
+	if m.focused != focusReadyQueue || m.focusMode == nil {
+		t.Fatalf("focus = %v, want focus mode in the ready queue", m.focused)
+	}
+	// Blockers in other projects still block
+	if got := readyQueueIDs(m.readyQueue.entries); got != "api-1" {
+		t.Errorf("queue = %s, want api-1", got)
+	}
+	if !strings.Contains(m.View(), "FOCUS: api") {
+		t.Error("view should name the focused project")
+	}
+	if len(m.list.Items()) != 1 {
+		t.Errorf("list should hold api's ready issues, got %d items", len(m.list.Items()))
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.focused != focusList || m.focusMode != nil {
+		t.Fatalf("esc should leave focus mode, focus = %v", m.focused)
+	}
+	if m.activeRepos != nil || m.currentFilter != "all" || len(m.list.Items()) != len(issues) {
+		t.Errorf("filters not restored: repos=%v filter=%q items=%d", m.activeRepos, m.currentFilter, len(m.list.Items()))
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != "api-2" {
+		t.Errorf("selection not restored: %+v", m.list.SelectedItem())
+	}
+}
@@ -0,0 +1,99 @@
+package ui
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// fuzzyFilterFor returns the list filter for a search scoring mode:
+// density ranking (the bubbles default) or plain subsequence matching.
+func fuzzyFilterFor(scoring config.SearchScoring) list.FilterFunc {
+	if scoring == config.SearchScoringSubsequence {
+		return subsequenceFilter
+	}
+	return list.DefaultFilter
+}
+
+// subsequenceFilter is a list.FilterFunc that keeps the targets containing
+// term's characters in order, ignoring case, without reordering them. The
+// earliest matching positions are reported for highlighting, as byte
+// offsets like list.DefaultFilter.
+func subsequenceFilter(term string, targets []string) []list.Rank {
+	query := []rune(strings.ToLower(term))
+	var ranks []list.Rank
+	for i, target := range targets {
+		var matched []int
+		qi := 0
+		for ti, r := range target {
+			if qi == len(query) {
+				break
+			}
+			if unicode.ToLower(r) == query[qi] {
+				matched = append(matched, ti)
+				qi++
+			}
+		}
+		if qi == len(query) {
+			ranks = append(ranks, list.Rank{Index: i, MatchedIndexes: matched})
+		}
+	}
+	return ranks
+}
+
+// searchHighlightStyle styles matched characters in list titles, using the
+// display config's highlight color when set.
+func searchHighlightStyle(t Theme, display config.DisplayConfig) lipgloss.Style {
+	style := t.Renderer.NewStyle().Bold(true).Underline(true)
+	if c := display.Search.HighlightColor; c != "" {
+		return style.Foreground(lipgloss.Color(c))
+	}
+	return style.Foreground(lipgloss.AdaptiveColor{Light: "#D75F00", Dark: "#FFB86C"})
+}
+
+// highlightMatches renders title in base with the runes of original at the
+// matched byte offsets in highlight. title may be a truncated or padded
+// copy of original; only the prefix the two share is highlighted.
+func highlightMatches(title, original string, matches []int, base, highlight lipgloss.Style) string {
+	if len(matches) == 0 {
+		return base.Render(title)
+	}
+	matched := make(map[int]bool, len(matches))
+	for _, idx := range matches {
+		matched[idx] = true
+	}
+	titleRunes := []rune(title)
+	hit := make(map[int]bool, len(matches))
+	ri := 0
+	for offset, r := range original {
+		if ri >= len(titleRunes) || titleRunes[ri] != r {
+			break
+		}
+		if matched[offset] {
+			hit[ri] = true
+		}
+		ri++
+	}
+	if len(hit) == 0 {
+		return base.Render(title)
+	}
+
+	var sb strings.Builder
+	start := 0
+	for i := 1; i <= len(titleRunes); i++ {
+		if i < len(titleRunes) && hit[i] == hit[start] {
+			continue
+		}
+		style := base
+		if hit[start] {
+			style = highlight
+		}
+		sb.WriteString(style.Render(string(titleRunes[start:i])))
+		start = i
+	}
+	return sb.String()
+}
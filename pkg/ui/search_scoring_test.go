@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestSearchScoringChangesRanking(t *testing.T) {
+	targets := []string{"faxxbxxc", "abc parser"}
+	order := func(scoring config.SearchScoring) string {
+		var got []string
+		for _, r := range fuzzyFilterFor(scoring)("abc", targets) {
+			got = append(got, targets[r.Index])
+		}
+		return strings.Join(got, ",")
+	}
+
+	density := order(config.SearchScoringDensity)
+	if density != "abc parser,faxxbxxc" {
+		t.Errorf("density: tight match should rank first, got %s", density)
+	}
+	if got := order(config.SearchScoringSubsequence); got != "faxxbxxc,abc parser" {
+		t.Errorf("subsequence: want list order, got %s", got)
+	}
+	if got := subsequenceFilter("xyz", targets); len(got) != 0 {
+		t.Errorf("non-matching query kept %d targets", len(got))
+	}
+}
+
+func TestSearchScoringFromDisplayConfig(t *testing.T) {
+	m := sortTestModel()
+	cfg := config.DefaultDisplayConfig()
+	cfg.Search.Scoring = config.SearchScoringSubsequence
+	m.SetDisplayConfig(cfg)
+	if got := m.list.Filter("lh", []string{"Alpha", "Gamma"}); len(got) != 1 || got[0].Index != 0 {
+		t.Errorf("subsequence filter from config = %+v", got)
+	}
+}
+
+func TestHighlightMatches(t *testing.T) {
+	base := lipgloss.NewStyle()
+	upper := lipgloss.NewStyle().Transform(strings.ToUpper)
+
+	if got := highlightMatches("fix login", "fix login", []int{0, 4, 5}, base, upper); got != "Fix LOgin" {
+		t.Errorf("highlight = %q, want %q", got, "Fix LOgin")
+	}
+	// Only the part that survived truncation is highlighted
+	if got := highlightMatches("fix l…", "fix login", []int{4, 8}, base, upper); got != "fix L…" {
+		t.Errorf("truncated highlight = %q, want %q", got, "fix L…")
+	}
+	// Offsets are bytes, as list.DefaultFilter reports them
+	if got := highlightMatches("café bar", "café bar", []int{6}, base, upper); got != "café Bar" {
+		t.Errorf("multibyte highlight = %q, want %q", got, "café Bar")
+	}
+}
@@ -99,11 +99,20 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"Esc", "Back / close"},
 			},
 		},
+		{
+			title:    "Detail",
+			contexts: []string{"detail"},
+			items: []shortcutItem{
+				{"1-9", "Fold section"},
+				{"z", "Fold / unfold all"},
+			},
+		},
 		{
 			title:    "Views",
 			contexts: []string{"list", "detail", "split"},
 			items: []shortcutItem{
 				{"a", "Actionable view"},
+				{"Q", "Ready queue"},
 				{"b", "Kanban board"},
 				{"g", "Graph view"},
 				{"h", "History view"},
@@ -162,6 +171,11 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"r", "Ready (unblocked)"},
 				{"L", "Label picker"},
 				{"/", "Fuzzy search"},
+				{"s", "Cycle sort"},
+				{"R", "Reverse sort"},
+				{"d", "Row density"},
+				{"|", "Toggle detail pane"},
+				{"#", "Bare / namespaced IDs"},
 			},
 		},
 		{
@@ -172,7 +186,16 @@ func (s *ShortcutsSidebar) allSections() []shortcutSection {
 				{"E", "Export Markdown"},
 				{"C", "Copy to clipboard"},
 				{"O", "Open in editor"},
-				{"R", "Recipe picker"},
+				{"I", "Source info"},
+				{"'", "Recipe picker"},
+				{"V", "Saved views"},
+				{"F", "Filter menu"},
+				{"*", "Pin to top"},
+				{">/<", "Priority (--allow-write)"},
+				{"D", "Dependencies (--allow-write)"},
+				{"space", "Select for bulk"},
+				{"y", "Copy selected IDs"},
+				{"v", "Show selected only"},
 			},
 		},
 	}
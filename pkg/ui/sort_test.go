@@ -0,0 +1,335 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func sortTestModel() Model {
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 2},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Priority: 0},
+		{ID: "C", Title: "Gamma", Status: model.StatusOpen, Priority: 1},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 30})
+	return updated.(Model)
+}
+
+func listIDs(m Model) string {
+	var ids []string
+	for _, it := range m.list.Items() {
+		ids = append(ids, it.(IssueItem).Issue.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestSortReverseKeyFlipsOrderAndIndicator(t *testing.T) {
+	m := sortTestModel()
+	var saved []config.DisplayConfig
+	m.SetDisplaySaver(func(cfg config.DisplayConfig) error {
+		saved = append(saved, cfg)
+		return nil
+	})
+
+	if got := listIDs(m); got != "B,C,A" {
+		t.Fatalf("default order = %s, want B,C,A", got)
+	}
+	if got := m.sortIndicator(); got != "PRI ▲" {
+		t.Fatalf("indicator = %q, want %q", got, "PRI ▲")
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	m = updated.(Model)
+	if got := listIDs(m); got != "A,C,B" {
+		t.Fatalf("reversed order = %s, want A,C,B", got)
+	}
+	if got := m.sortIndicator(); got != "PRI ▼" {
+		t.Fatalf("indicator = %q, want %q", got, "PRI ▼")
+	}
+	if !strings.Contains(m.renderListWithHeader(), "PRI ▼") {
+		t.Error("expected list header to show the sort indicator")
+	}
+
+	if len(saved) != 1 || !saved[0].SortReverse || saved[0].Sort != config.SortDefault {
+		t.Fatalf("expected reverse to be persisted with sort key, got %+v", saved)
+	}
+
+	// Reverse persists across sort mode changes
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	m = updated.(Model)
+	if m.sortMode != SortCreatedAsc || !m.sortReverse {
+		t.Fatalf("sort = %v reverse=%v, want created-asc reversed", m.sortMode, m.sortReverse)
+	}
+	if got := m.sortIndicator(); got != "CREATED ▼" {
+		t.Errorf("indicator = %q, want %q", got, "CREATED ▼")
+	}
+	if last := saved[len(saved)-1]; last.Sort != config.SortCreatedAsc || !last.SortReverse {
+		t.Errorf("last saved = %+v", last)
+	}
+}
+
+func TestSetDisplayConfigAppliesSort(t *testing.T) {
+	m := sortTestModel()
+	cfg := config.DefaultDisplayConfig()
+	cfg.Sort = config.SortPriority
+	cfg.SortReverse = true
+	m.SetDisplayConfig(cfg)
+
+	if m.sortMode != SortPriority || !m.sortReverse {
+		t.Fatalf("sort = %v reverse=%v, want priority reversed", m.sortMode, m.sortReverse)
+	}
+	if got := listIDs(m); got != "A,C,B" {
+		t.Errorf("order = %s, want A,C,B", got)
+	}
+}
+
+func TestSortModeKeyRoundTrip(t *testing.T) {
+	for s := SortDefault; s < numSortModes; s++ {
+		if !s.Key().IsValid() {
+			t.Errorf("%v has invalid key %q", s, s.Key())
+		}
+		if got := SortModeFromKey(s.Key()); got != s {
+			t.Errorf("SortModeFromKey(%q) = %v, want %v", s.Key(), got, s)
+		}
+	}
+	if got := SortModeFromKey("bogus"); got != SortDefault {
+		t.Errorf("unknown key = %v, want default", got)
+	}
+}
+
+func TestPinKeyKeepsPinnedIssuesOnTop(t *testing.T) {
+	m := sortTestModel()
+	var saved []config.DisplayConfig
+	m.SetDisplaySaver(func(cfg config.DisplayConfig) error {
+		saved = append(saved, cfg)
+		return nil
+	})
+
+	// Select A (last in default order) and pin it
+	m.list.Select(2)
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+	m = updated.(Model)
+	if got := listIDs(m); got != "A,B,C" {
+		t.Fatalf("order after pin = %s, want A,B,C", got)
+	}
+	if sel, ok := m.list.SelectedItem().(IssueItem); !ok || sel.Issue.ID != "A" {
+		t.Errorf("expected selection to follow the pinned issue")
+	}
+	if len(saved) != 1 || !saved[0].IsPinned("A") {
+		t.Fatalf("expected pin to be persisted, got %+v", saved)
+	}
+	if !strings.Contains(m.renderListWithHeader(), "📌") {
+		t.Error("expected pin indicator to render")
+	}
+
+	// Reversing the sort leaves pins on top
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	m = updated.(Model)
+	if got := listIDs(m); got != "A,C,B" {
+		t.Fatalf("reversed order = %s, want A,C,B", got)
+	}
+
+	// Toggling again unpins
+	m.list.Select(0)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("*")})
+	m = updated.(Model)
+	if last := saved[len(saved)-1]; len(last.Pinned) != 0 {
+		t.Errorf("expected no pins, got %v", last.Pinned)
+	}
+	if got := listIDs(m); got != "A,C,B" {
+		t.Errorf("order after unpin = %s, want A,C,B", got)
+	}
+}
+
+func TestSetDisplayConfigAppliesPins(t *testing.T) {
+	m := sortTestModel()
+	cfg := config.DefaultDisplayConfig()
+	cfg.Pinned = []string{"C"}
+	m.SetDisplayConfig(cfg)
+
+	if got := listIDs(m); got != "C,B,A" {
+		t.Errorf("order = %s, want C,B,A", got)
+	}
+}
+
+func TestPinsSurviveReload(t *testing.T) {
+	m := sortTestModel()
+	cfg := config.DefaultDisplayConfig()
+	cfg.Pinned = []string{"C"}
+	m.SetDisplayConfig(cfg)
+
+	m = reloadEdited(t, m)
+	if got := listIDs(m); got != "C,B,A" {
+		t.Fatalf("order after reload = %s, want C,B,A", got)
+	}
+	if first := m.list.Items()[0].(IssueItem); !first.IsPinned {
+		t.Error("expected the pinned issue to keep its pin after a reload")
+	}
+}
+
+func TestSortSurvivesReload(t *testing.T) {
+	m := sortTestModel()
+	cfg := config.DefaultDisplayConfig()
+	cfg.Sort = config.SortPriority
+	cfg.SortReverse = true
+	m.SetDisplayConfig(cfg)
+
+	m = reloadEdited(t, m)
+	if m.sortMode != SortPriority || !m.sortReverse {
+		t.Fatalf("sort = %v reverse=%v after reload, want priority reversed", m.sortMode, m.sortReverse)
+	}
+	if got := listIDs(m); got != "A,C,B" {
+		t.Errorf("order after reload = %s, want A,C,B", got)
+	}
+}
+
+// reloadEdited runs a refresh tick whose loader returns the model's issues
+// with A retitled, so the data hash changes and the list is rebuilt
+func reloadEdited(t *testing.T, m Model) Model {
+	t.Helper()
+	edited := make([]model.Issue, len(m.issues))
+	copy(edited, m.issues)
+	for i := range edited {
+		if edited[i].ID == "A" {
+			edited[i].Title += " (edited)"
+		}
+	}
+	m.EnableAutoRefresh(time.Second, func() ([]model.Issue, error) { return edited, nil })
+	updated, _ := m.Update(RefreshTickMsg{})
+	return updated.(Model)
+}
+
+func TestDensityKeyTogglesAndPersists(t *testing.T) {
+	m := sortTestModel()
+	var saved []config.DisplayConfig
+	m.SetDisplaySaver(func(cfg config.DisplayConfig) error {
+		saved = append(saved, cfg)
+		return nil
+	})
+	press := func() {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+		m = updated.(Model)
+	}
+
+	press()
+	if m.display.Density != config.DensityComfortable || m.listRowLines() != 3 {
+		t.Fatalf("after d: density=%q rowLines=%d, want comfortable/3", m.display.Density, m.listRowLines())
+	}
+	if len(saved) != 1 || saved[0].Density != config.DensityComfortable {
+		t.Errorf("density not saved: %+v", saved)
+	}
+	if perPage := m.list.Paginator.PerPage; perPage < 2 || perPage > m.list.Height()/3 {
+		t.Errorf("paginator shows %d rows on a %d-line list, want at most %d", perPage, m.list.Height(), m.list.Height()/3)
+	}
+
+	press()
+	if m.display.Density != config.DensityCompact || m.listRowLines() != 1 {
+		t.Errorf("second d: density=%q rowLines=%d, want compact/1", m.display.Density, m.listRowLines())
+	}
+}
+
+func TestDensityKeyKeepsSelection(t *testing.T) {
+	var issues []model.Issue
+	for i := 0; i < 60; i++ {
+		issues = append(issues, model.Issue{ID: fmt.Sprintf("T-%02d", i), Title: "Task", Status: model.StatusOpen, Priority: 2})
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 30})
+	m = updated.(Model)
+	m.SetDisplaySaver(func(config.DisplayConfig) error { return nil })
+	m.list.Select(2)
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	m = updated.(Model)
+	if got := m.list.Index(); got != 2 {
+		t.Errorf("selection moved to %d after d, want 2", got)
+	}
+}
+
+func TestBareIDsKeyTogglesAndPersists(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api-1", Title: "Gateway", Status: model.StatusOpen, Priority: 1, Dependencies: []*model.Dependency{
+			{IssueID: "api-1", DependsOnID: "api-2", Type: model.DepBlocks},
+			{IssueID: "api-1", DependsOnID: "web-3", Type: model.DepBlocks},
+		}},
+		{ID: "api-2", Title: "Auth", Status: model.StatusOpen, Priority: 2},
+		{ID: "web-3", Title: "Login page", Status: model.StatusOpen, Priority: 2},
+	}
+	m := NewModel(issues, nil, "")
+	defer m.Stop()
+	var saved []config.DisplayConfig
+	m.SetDisplaySaver(func(cfg config.DisplayConfig) error {
+		saved = append(saved, cfg)
+		return nil
+	})
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m = updated.(Model)
+	m.list.Select(0)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("#")})
+	m = updated.(Model)
+
+	if !m.display.BareIDs || len(saved) != 1 || !saved[0].BareIDs {
+		t.Fatalf("bare IDs not enabled and saved: display=%v saved=%+v", m.display.BareIDs, saved)
+	}
+	detail := m.viewport.View()
+	if strings.Contains(detail, "api-") || !strings.Contains(detail, "web-3") {
+		t.Errorf("detail should show api IDs bare and keep web-3:\n%s", detail)
+	}
+	if !m.graphView.bareIDs {
+		t.Error("graph view should show bare IDs")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("#")})
+	m = updated.(Model)
+	if m.display.BareIDs || !strings.Contains(m.viewport.View(), "api-2") {
+		t.Error("second # should restore namespaced IDs")
+	}
+}
+
+func TestDetailPaneKeyTogglesAndPersists(t *testing.T) {
+	m := sortTestModel()
+	var saved []config.DisplayConfig
+	m.SetDisplaySaver(func(cfg config.DisplayConfig) error {
+		saved = append(saved, cfg)
+		return nil
+	})
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m = updated.(Model)
+	m.list.Select(1)
+	m.focused = focusDetail
+	press := func() {
+		updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("|")})
+		m = updated.(Model)
+	}
+
+	press()
+	if m.isSplitView || !m.display.HideDetail || m.focused != focusList {
+		t.Fatalf("after |: split=%v hide=%v focus=%v, want full-width list", m.isSplitView, m.display.HideDetail, m.focused)
+	}
+	if len(saved) != 1 || !saved[0].HideDetail {
+		t.Errorf("hide_detail not saved: %+v", saved)
+	}
+	if m.list.Index() != 1 {
+		t.Errorf("selection moved to %d", m.list.Index())
+	}
+
+	press()
+	if !m.isSplitView || m.display.HideDetail || m.list.Index() != 1 {
+		t.Errorf("second |: split=%v hide=%v index=%d, want split view restored", m.isSplitView, m.display.HideDetail, m.list.Index())
+	}
+
+	// A saved setting applies on startup
+	m = sortTestModel()
+	m.SetDisplayConfig(config.DisplayConfig{HideDetail: true})
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	if updated.(Model).isSplitView {
+		t.Error("hide_detail should start with a full-width list")
+	}
+}
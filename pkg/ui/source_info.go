@@ -0,0 +1,120 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sourceInfo describes where an issue was loaded from, for the I overlay.
+type sourceInfo struct {
+	ID      string
+	Project string // Project directory (the parent of .beads); "" if unknown
+	File    string // Issues file the record was read from
+	Line    int    // 1-based line the record starts on; 0 if unknown
+	Prefix  string // Workspace prefix, or the ID's own prefix outside a workspace
+	LocalID string // The ID as written in File, when it differs from ID
+	Repo    string // source_repo recorded on the issue
+}
+
+// issueSourceInfo gathers what is known about where issue came from. The
+// file and line come from the loader; issues read from git history fall
+// back to the beads file the model would write to.
+func (m *Model) issueSourceInfo(issue *model.Issue) sourceInfo {
+	info := sourceInfo{ID: issue.ID, File: issue.SourcePath, Line: issue.SourceLine, Repo: issue.SourceRepo}
+	path, localID := m.issueSourcePath(issue.ID)
+	if info.File == "" {
+		info.File = path
+	}
+	if localID != issue.ID {
+		info.LocalID = localID
+	}
+	if m.workspaceMode {
+		info.Prefix = m.projectPrefixFor(issue.ID)
+	} else if prefix := ExtractRepoPrefix(issue.ID); prefix != "" {
+		info.Prefix = prefix
+	}
+	if info.File != "" {
+		info.Project = filepath.Dir(info.File)
+		if filepath.Base(info.Project) == ".beads" {
+			info.Project = filepath.Dir(info.Project)
+		}
+	}
+	return info
+}
+
+// openSourceInfo shows the source overlay for the selected issue.
+func (m *Model) openSourceInfo() {
+	selected, ok := m.list.SelectedItem().(IssueItem)
+	if !ok {
+		m.statusMsg = "❌ No issue selected"
+		m.statusIsError = true
+		return
+	}
+	m.sourceInfo = m.issueSourceInfo(&selected.Issue)
+	m.showSourceInfo = true
+}
+
+// renderSourceInfo renders the read-only source overlay.
+func (m Model) renderSourceInfo() string {
+	t := m.theme
+	info := m.sourceInfo
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2)
+	titleStyle := t.Renderer.NewStyle().Foreground(t.Primary).Bold(true)
+	labelStyle := t.Renderer.NewStyle().Foreground(t.Secondary).Width(10)
+	valueStyle := t.Renderer.NewStyle().Foreground(t.Base.GetForeground())
+	mutedStyle := t.Renderer.NewStyle().Foreground(t.Muted).Italic(true)
+
+	unknown := mutedStyle.Render("unknown")
+	value := func(s string) string {
+		if s == "" {
+			return unknown
+		}
+		return valueStyle.Render(s)
+	}
+	line := unknown
+	if info.Line > 0 {
+		line = valueStyle.Render(fmt.Sprintf("%d", info.Line))
+	}
+	prefix := mutedStyle.Render("none")
+	if info.Prefix != "" {
+		prefix = valueStyle.Render(info.Prefix)
+	}
+
+	rows := [][2]string{
+		{"Project", value(info.Project)},
+		{"File", value(info.File)},
+		{"Line", line},
+		{"Prefix", prefix},
+	}
+	if info.LocalID != "" {
+		rows = append(rows, [2]string{"Local ID", valueStyle.Render(info.LocalID)})
+	}
+	if info.Repo != "" {
+		rows = append(rows, [2]string{"Repo", valueStyle.Render(info.Repo)})
+	}
+
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render("ℹ Source of " + info.ID))
+	sb.WriteString("\n\n")
+	for _, row := range rows {
+		sb.WriteString(labelStyle.Render(row[0]) + row[1] + "\n")
+	}
+	sb.WriteString("\n" + mutedStyle.Render("O opens the file in $EDITOR • any key closes"))
+
+	return lipgloss.Place(
+		m.width,
+		m.height-1,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(sb.String()),
+	)
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	tea "github.com/charmbracelet/bubbletea"
+	xansi "github.com/charmbracelet/x/ansi"
+)
+
+func TestSourceInfoOverlay(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "api-12", Title: "Auth", Status: model.StatusOpen, SourcePath: "/code/api/.beads/beads.jsonl", SourceLine: 7},
+	}
+	m := NewModel(issues, nil, "")
+	m.EnableWorkspaceMode(WorkspaceInfo{
+		Enabled:      true,
+		RepoPrefixes: []string{"api-"},
+		ProjectPaths: map[string]string{"api-": "/code/api/.beads/beads.jsonl"},
+	})
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = updated.(Model)
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("I")})
+	m = updated.(Model)
+	if !m.showSourceInfo {
+		t.Fatal("I should open the source overlay")
+	}
+	view := xansi.Strip(m.View())
+	for _, want := range []string{"Source of api-12", "/code/api/.beads/beads.jsonl", "/code/api ", "7", "api-", "Local ID  12"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("overlay missing %q:\n%s", want, view)
+		}
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m = updated.(Model)
+	if m.showSourceInfo {
+		t.Error("any key should close the overlay")
+	}
+}
+
+func TestIssueSourceInfoWithoutLoaderPosition(t *testing.T) {
+	// Issues from git history have no source position; the model's beads
+	// file is the best guess and the line is unknown
+	m := NewModel(nil, nil, "/repo/.beads/beads.jsonl")
+	info := m.issueSourceInfo(&model.Issue{ID: "bv-3"})
+	if info.File != "/repo/.beads/beads.jsonl" || info.Project != "/repo" || info.Line != 0 || info.Prefix != "bv" || info.LocalID != "" {
+		t.Errorf("info = %+v", info)
+	}
+}
@@ -25,9 +25,17 @@ func (m Model) renderSprintDashboard() string {
 
 	var sb strings.Builder
 
-	// Title
+	progress := computeSprintProgress(sprint, m.issues)
+
+	// Title with at-a-glance completion
 	titleStyle := t.Renderer.NewStyle().Bold(true).Foreground(t.Primary)
 	sb.WriteString(titleStyle.Render(fmt.Sprintf("📅 Sprint: %s", sprint.Name)))
+	if progress.Total > 0 {
+		pct := progress.Fraction()
+		sb.WriteString("  ")
+		sb.WriteString(renderSprintMiniBar(t, pct, 10))
+		sb.WriteString(t.Renderer.NewStyle().Bold(true).Render(fmt.Sprintf(" %.0f%%", pct*100)))
+	}
 	sb.WriteString("\n\n")
 
 	// Date range and days remaining
@@ -75,58 +83,37 @@ func (m Model) renderSprintDashboard() string {
 	sb.WriteString(daysStyle.Render(fmt.Sprintf(" %d days", daysRemaining)))
 	sb.WriteString("\n\n")
 
-	// Compute bead stats
-	var totalBeads, closedBeads, openBeads, blockedBeads, inProgressBeads int
-	var sprintIssues []model.Issue
-	beadIDSet := make(map[string]bool)
-	for _, id := range sprint.BeadIDs {
-		beadIDSet[id] = true
-	}
-	for _, iss := range m.issues {
-		if beadIDSet[iss.ID] {
-			totalBeads++
-			sprintIssues = append(sprintIssues, iss)
-			switch iss.Status {
-			case model.StatusClosed:
-				closedBeads++
-			case model.StatusBlocked:
-				blockedBeads++
-				openBeads++
-			case model.StatusInProgress:
-				inProgressBeads++
-				openBeads++
-			default:
-				openBeads++
-			}
-		}
-	}
+	sprintIssues := progress.Issues
+	totalBeads, closedBeads := progress.Total, progress.Closed
 
-	// Progress bar
+	// Progress counts (the bar is in the header)
 	sb.WriteString(labelStyle.Render("Progress: "))
-	progressPct := 0.0
-	if totalBeads > 0 {
-		progressPct = float64(closedBeads) / float64(totalBeads)
-	}
-	barWidth := innerWidth - 20
-	if barWidth < 10 {
-		barWidth = 10
+	sb.WriteString(valStyle.Render(fmt.Sprintf("%d/%d beads closed", closedBeads, totalBeads)))
+	sb.WriteString("\n")
+
+	// Estimate-weighted completion, when any sprint bead carries an estimate
+	if progress.EstimatedTotal > 0 {
+		sb.WriteString(labelStyle.Render("Estimate: "))
+		sb.WriteString(valStyle.Render(fmt.Sprintf("%s/%s done (%.0f%%)",
+			formatSprintMinutes(progress.EstimatedClosed), formatSprintMinutes(progress.EstimatedTotal),
+			progress.EstimateFraction()*100)))
+		sb.WriteString("\n")
 	}
-	filled := int(float64(barWidth) * progressPct)
-	if filled > barWidth {
-		filled = barWidth
+
+	// Beads listed in the sprint that are no longer in the data
+	if len(progress.Missing) > 0 {
+		note := fmt.Sprintf("%d sprint bead(s) not found, excluded: %s",
+			len(progress.Missing), strings.Join(progress.Missing, ", "))
+		sb.WriteString(t.Renderer.NewStyle().Foreground(t.Muted).Italic(true).Render(truncateStrSprint(note, innerWidth)))
+		sb.WriteString("\n")
 	}
-	barStyle := t.Renderer.NewStyle().Foreground(t.Open)
-	emptyStyle := t.Renderer.NewStyle().Foreground(t.Muted)
-	sb.WriteString(barStyle.Render(strings.Repeat("█", filled)))
-	sb.WriteString(emptyStyle.Render(strings.Repeat("░", barWidth-filled)))
-	sb.WriteString(fmt.Sprintf(" %d/%d (%.0f%%)\n", closedBeads, totalBeads, progressPct*100))
 
 	// Status breakdown
 	sb.WriteString(labelStyle.Render("Status:   "))
 	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Open).Render(fmt.Sprintf("✓%d ", closedBeads)))
-	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Feature).Render(fmt.Sprintf("⏳%d ", inProgressBeads)))
-	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Blocked).Render(fmt.Sprintf("⛔%d ", blockedBeads)))
-	sb.WriteString(valStyle.Render(fmt.Sprintf("○%d", openBeads-inProgressBeads-blockedBeads)))
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Feature).Render(fmt.Sprintf("⏳%d ", progress.InProgress)))
+	sb.WriteString(t.Renderer.NewStyle().Foreground(t.Blocked).Render(fmt.Sprintf("⛔%d ", progress.Blocked)))
+	sb.WriteString(valStyle.Render(fmt.Sprintf("○%d", totalBeads-closedBeads-progress.InProgress-progress.Blocked)))
 	sb.WriteString("\n\n")
 
 	// Simple burndown chart (ASCII)
@@ -250,17 +237,104 @@ func (m Model) renderSprintDashboard() string {
 	)
 }
 
-// truncateStrSprint truncates a string to maxLen runes, adding ellipsis if needed.
-// Uses rune-based counting to safely handle UTF-8 multi-byte characters.
-func truncateStrSprint(s string, maxLen int) string {
-	runes := []rune(s)
-	if len(runes) <= maxLen {
-		return s
+// sprintProgress summarizes how much of a sprint is done.
+type sprintProgress struct {
+	Issues          []model.Issue // Sprint beads that exist in the loaded issues, in sprint order
+	Total           int           // len(Issues)
+	Closed          int           // Of those, how many are closed
+	InProgress      int           // Of those, how many are in progress
+	Blocked         int           // Of those, how many are blocked
+	EstimatedTotal  int           // Sum of estimated_minutes over existing beads
+	EstimatedClosed int           // Sum of estimated_minutes over closed beads
+	Missing         []string      // Bead IDs listed in the sprint but not loaded, in sprint order
+}
+
+// Fraction returns closed/total by count, or 0 for an empty sprint.
+func (p sprintProgress) Fraction() float64 {
+	if p.Total == 0 {
+		return 0
+	}
+	return float64(p.Closed) / float64(p.Total)
+}
+
+// EstimateFraction returns closed/total by estimated minutes, or 0 when no
+// sprint bead has an estimate.
+func (p sprintProgress) EstimateFraction() float64 {
+	if p.EstimatedTotal == 0 {
+		return 0
+	}
+	return float64(p.EstimatedClosed) / float64(p.EstimatedTotal)
+}
+
+// computeSprintProgress tallies a sprint's BeadIDs against the loaded issues.
+// IDs that no longer exist are reported in Missing rather than counted.
+func computeSprintProgress(sprint *model.Sprint, issues []model.Issue) sprintProgress {
+	byID := make(map[string]*model.Issue, len(issues))
+	for i := range issues {
+		byID[issues[i].ID] = &issues[i]
 	}
+
+	var p sprintProgress
+	seen := make(map[string]bool, len(sprint.BeadIDs))
+	for _, id := range sprint.BeadIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		iss, ok := byID[id]
+		if !ok {
+			p.Missing = append(p.Missing, id)
+			continue
+		}
+		p.Issues = append(p.Issues, *iss)
+		p.Total++
+		closed := iss.Status == model.StatusClosed
+		switch iss.Status {
+		case model.StatusClosed:
+			p.Closed++
+		case model.StatusInProgress:
+			p.InProgress++
+		case model.StatusBlocked:
+			p.Blocked++
+		}
+		if iss.EstimatedMinutes != nil && *iss.EstimatedMinutes > 0 {
+			p.EstimatedTotal += *iss.EstimatedMinutes
+			if closed {
+				p.EstimatedClosed += *iss.EstimatedMinutes
+			}
+		}
+	}
+	return p
+}
+
+// renderSprintMiniBar draws a fixed-width completion bar for the header.
+func renderSprintMiniBar(t Theme, pct float64, width int) string {
+	filled := int(float64(width)*pct + 0.5)
+	if filled > width {
+		filled = width
+	}
+	return t.Renderer.NewStyle().Foreground(t.Open).Render(strings.Repeat("█", filled)) +
+		t.Renderer.NewStyle().Foreground(t.Muted).Render(strings.Repeat("░", width-filled))
+}
+
+// formatSprintMinutes renders an estimate total as hours when it is large enough.
+func formatSprintMinutes(mins int) string {
+	if mins < 60 {
+		return fmt.Sprintf("%dm", mins)
+	}
+	if mins%60 == 0 {
+		return fmt.Sprintf("%dh", mins/60)
+	}
+	return fmt.Sprintf("%.1fh", float64(mins)/60)
+}
+
+// truncateStrSprint truncates a string to maxLen display cells, adding ellipsis if needed.
+// Wide characters (CJK, emoji) count as two cells and are never split.
+func truncateStrSprint(s string, maxLen int) string {
 	if maxLen <= 3 {
-		return string(runes[:maxLen])
+		return truncateRunesHelper(s, maxLen, "")
 	}
-	return string(runes[:maxLen-1]) + "…"
+	return truncateRunesHelper(s, maxLen, "…")
 }
 
 // handleSprintKeys handles keyboard input when in sprint view (bv-161)
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -120,7 +121,7 @@ func TestTruncateStrSprint(t *testing.T) {
 			name:     "unicode string truncation",
 			input:    "日本語テスト",
 			maxLen:   4,
-			expected: "日本語…",
+			expected: "日…",
 		},
 		{
 			name:     "mixed unicode",
@@ -547,6 +548,67 @@ func TestHandleSprintKeys_NilSelectedSprint(t *testing.T) {
 	}
 }
 
+func TestComputeSprintProgress(t *testing.T) {
+	est := func(m int) *int { return &m }
+	sprint := model.Sprint{ID: "s1", Name: "Sprint 1", BeadIDs: []string{"A", "B", "GONE", "C", "A"}}
+	issues := []model.Issue{
+		{ID: "A", Status: model.StatusClosed, EstimatedMinutes: est(90)},
+		{ID: "B", Status: model.StatusOpen, EstimatedMinutes: est(30)},
+		{ID: "C", Status: model.StatusInProgress},
+		{ID: "D", Status: model.StatusClosed},
+	}
+
+	p := computeSprintProgress(&sprint, issues)
+	if p.Total != 3 || p.Closed != 1 || p.InProgress != 1 || p.Blocked != 0 {
+		t.Errorf("Total=%d Closed=%d InProgress=%d Blocked=%d; want 3, 1, 1, 0", p.Total, p.Closed, p.InProgress, p.Blocked)
+	}
+	if len(p.Issues) != 3 || p.Issues[0].ID != "A" || p.Issues[2].ID != "C" {
+		t.Errorf("Issues should list each loaded bead once in sprint order, got %v", p.Issues)
+	}
+	if p.EstimatedTotal != 120 || p.EstimatedClosed != 90 {
+		t.Errorf("EstimatedTotal=%d EstimatedClosed=%d; want 120 and 90", p.EstimatedTotal, p.EstimatedClosed)
+	}
+	if len(p.Missing) != 1 || p.Missing[0] != "GONE" {
+		t.Errorf("Missing=%v; want [GONE]", p.Missing)
+	}
+	if got := p.EstimateFraction(); got != 0.75 {
+		t.Errorf("EstimateFraction()=%v; want 0.75", got)
+	}
+
+	if empty := computeSprintProgress(&model.Sprint{BeadIDs: []string{"X"}}, issues); empty.Fraction() != 0 || empty.EstimateFraction() != 0 {
+		t.Errorf("sprint with no loaded beads should report 0%%, got %+v", empty)
+	}
+}
+
+func TestRenderSprintDashboard_HeaderProgress(t *testing.T) {
+	est := 60
+	sprint := model.Sprint{ID: "s1", Name: "Sprint 1", BeadIDs: []string{"A", "B", "GONE"}}
+	m := Model{
+		theme:          DefaultTheme(lipgloss.NewRenderer(nil)),
+		width:          100,
+		height:         40,
+		selectedSprint: &sprint,
+		issues: []model.Issue{
+			{ID: "A", Title: "Issue A", Status: model.StatusClosed, EstimatedMinutes: &est},
+			{ID: "B", Title: "Issue B", Status: model.StatusOpen, EstimatedMinutes: &est},
+		},
+	}
+
+	result := m.renderSprintDashboard()
+	if !containsStr(result, "Sprint 1  █████░░░░░ 50%") {
+		t.Errorf("header should show a completion bar and percentage:\n%s", result)
+	}
+	if n := strings.Count(result, "░"); n != 5 {
+		t.Errorf("expected a single 10-cell progress bar, found %d empty cells:\n%s", n, result)
+	}
+	if !containsStr(result, "1h/2h done (50%)") {
+		t.Errorf("expected estimate-based progress:\n%s", result)
+	}
+	if !containsStr(result, "1 sprint bead(s) not found, excluded: GONE") {
+		t.Errorf("expected a note about the missing bead:\n%s", result)
+	}
+}
+
 // Helper function
 func containsStr(s, substr string) bool {
 	for i := 0; i <= len(s)-len(substr); i++ {
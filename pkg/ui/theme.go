@@ -1,9 +1,36 @@
 package ui
 
 import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 )
 
+// colorDisabled is set by DisableColor.
+var colorDisabled bool
+
+// DisableColor turns off all styling for the rest of the process (--no-color
+// or NO_COLOR): colors, bold and the like are dropped from every renderer,
+// including markdown, so views render as plain text. Call it before NewModel.
+func DisableColor() {
+	colorDisabled = true
+	lipgloss.SetColorProfile(termenv.Ascii)
+}
+
+// newRenderer returns a lipgloss renderer for w that honors DisableColor.
+func newRenderer(w io.Writer) *lipgloss.Renderer {
+	r := lipgloss.NewRenderer(w)
+	if colorDisabled {
+		r.SetColorProfile(termenv.Ascii)
+	}
+	return r
+}
+
 type Theme struct {
 	Renderer *lipgloss.Renderer
 
@@ -30,6 +57,10 @@ type Theme struct {
 	Highlight lipgloss.AdaptiveColor
 	Muted     lipgloss.AdaptiveColor
 
+	// Health (label and project health bars)
+	Healthy lipgloss.AdaptiveColor
+	Warning lipgloss.AdaptiveColor
+
 	// Styles
 	Base     lipgloss.Style
 	Selected lipgloss.Style
@@ -62,8 +93,17 @@ func DefaultTheme(r *lipgloss.Renderer) Theme {
 		Border:    lipgloss.AdaptiveColor{Light: "#AAAAAA", Dark: "#44475A"}, // Border (was #DDDDDD)
 		Highlight: lipgloss.AdaptiveColor{Light: "#E0E0E0", Dark: "#44475A"}, // Slightly darker
 		Muted:     lipgloss.AdaptiveColor{Light: "#555555", Dark: "#6272A4"}, // Dimmed text (was #888888, now ~7:1)
+
+		Healthy: lipgloss.AdaptiveColor{Light: "#007700", Dark: "#50FA7B"}, // Green, as Open
+		Warning: lipgloss.AdaptiveColor{Light: "#B06800", Dark: "#FFB86C"}, // Orange, as Feature
 	}
+	t.initStyles()
+	return t
+}
 
+// initStyles derives the composite styles from the theme colors.
+func (t *Theme) initStyles() {
+	r := t.Renderer
 	t.Base = r.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "#000000", Dark: "#F8F8F2"})
 
 	t.Selected = r.NewStyle().
@@ -78,7 +118,102 @@ func DefaultTheme(r *lipgloss.Renderer) Theme {
 		Foreground(lipgloss.AdaptiveColor{Light: "#FFFFFF", Dark: "#282A36"}).
 		Bold(true).
 		Padding(0, 1)
+}
+
+// ThemeRoles are the color roles a theme file can set.
+var ThemeRoles = []string{
+	"Primary", "Secondary", "Subtext",
+	"Open", "InProgress", "Blocked", "Closed",
+	"Bug", "Feature", "Task", "Epic", "Chore",
+	"Border", "Highlight", "Muted",
+	"Healthy", "Warning",
+}
 
+// role returns the color field for a role name. Matching ignores case, "_"
+// and "-", so "InProgress", "in_progress" and "inprogress" are the same role.
+func (t *Theme) role(name string) *lipgloss.AdaptiveColor {
+	switch strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name)) {
+	case "primary":
+		return &t.Primary
+	case "secondary":
+		return &t.Secondary
+	case "subtext":
+		return &t.Subtext
+	case "open":
+		return &t.Open
+	case "inprogress":
+		return &t.InProgress
+	case "blocked":
+		return &t.Blocked
+	case "closed":
+		return &t.Closed
+	case "bug":
+		return &t.Bug
+	case "feature":
+		return &t.Feature
+	case "task":
+		return &t.Task
+	case "epic":
+		return &t.Epic
+	case "chore":
+		return &t.Chore
+	case "border":
+		return &t.Border
+	case "highlight":
+		return &t.Highlight
+	case "muted":
+		return &t.Muted
+	case "healthy":
+		return &t.Healthy
+	case "warning":
+		return &t.Warning
+	}
+	return nil
+}
+
+var hexColorPattern = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// customThemeColors holds the role colors from the user's theme file.
+var customThemeColors = map[string]string{}
+
+// SetThemeColors sets the custom colors applied by UserTheme, keyed by role
+// name (see ThemeRoles). Each color is a hex value used in both light and
+// dark terminals. Unknown roles and invalid colors are skipped, leaving the
+// default for that role, and are returned as problems in role order.
+func SetThemeColors(colors map[string]string) []string {
+	customThemeColors = map[string]string{}
+	var probe Theme
+	var problems []string
+	names := make([]string, 0, len(colors))
+	for name := range colors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		color := strings.TrimSpace(colors[name])
+		switch {
+		case probe.role(name) == nil:
+			problems = append(problems, fmt.Sprintf("unknown theme role %q", name))
+		case !hexColorPattern.MatchString(color):
+			problems = append(problems, fmt.Sprintf("invalid color %q for %s (expected #RGB or #RRGGBB)", colors[name], name))
+		default:
+			customThemeColors[name] = color
+		}
+	}
+	return problems
+}
+
+// UserTheme returns the default theme with the colors from SetThemeColors
+// applied.
+func UserTheme(r *lipgloss.Renderer) Theme {
+	t := DefaultTheme(r)
+	if len(customThemeColors) == 0 {
+		return t
+	}
+	for name, color := range customThemeColors {
+		*t.role(name) = lipgloss.AdaptiveColor{Light: color, Dark: color}
+	}
+	t.initStyles()
 	return t
 }
 
@@ -97,6 +232,11 @@ func (t Theme) GetStatusColor(s string) lipgloss.AdaptiveColor {
 	}
 }
 
+// ColumnHeaderStyle is the underlined style used for table column headings.
+func (t Theme) ColumnHeaderStyle() lipgloss.Style {
+	return t.Renderer.NewStyle().Foreground(t.Secondary).Underline(true)
+}
+
 func (t Theme) GetTypeIcon(typ string) (string, lipgloss.AdaptiveColor) {
 	switch typ {
 	case "bug":
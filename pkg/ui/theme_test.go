@@ -1,9 +1,13 @@
 package ui
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 )
 
 func TestDefaultTheme(t *testing.T) {
@@ -77,3 +81,105 @@ func TestGetTypeIcon(t *testing.T) {
 		}
 	}
 }
+
+func TestUserThemeAppliesCustomColors(t *testing.T) {
+	t.Cleanup(func() { SetThemeColors(nil) })
+	problems := SetThemeColors(map[string]string{
+		"Primary":     "#112233",
+		"in_progress": "#abc",
+		"Healthy":     "#00FF00",
+		"Blocked":     "red",     // not hex
+		"Sparkle":     "#FFFFFF", // unknown role
+	})
+	if len(problems) != 2 {
+		t.Fatalf("problems = %q, want 2 (bad color, unknown role)", problems)
+	}
+
+	renderer := lipgloss.NewRenderer(nil)
+	defaults := DefaultTheme(renderer)
+	theme := UserTheme(renderer)
+
+	want := lipgloss.AdaptiveColor{Light: "#112233", Dark: "#112233"}
+	if theme.Primary != want {
+		t.Errorf("Primary = %+v, want %+v", theme.Primary, want)
+	}
+	if theme.InProgress.Dark != "#abc" {
+		t.Errorf("InProgress = %+v, want #abc", theme.InProgress)
+	}
+	if theme.Healthy.Dark != "#00FF00" {
+		t.Errorf("Healthy = %+v, want #00FF00", theme.Healthy)
+	}
+	if theme.Blocked != defaults.Blocked {
+		t.Errorf("Blocked = %+v, want default %+v after invalid color", theme.Blocked, defaults.Blocked)
+	}
+	if theme.Open != defaults.Open {
+		t.Errorf("Open = %+v, want default when not set", theme.Open)
+	}
+	// Derived styles follow the custom colors
+	if got := theme.Header.GetBackground(); got != want {
+		t.Errorf("Header background = %+v, want %+v", got, want)
+	}
+
+	SetThemeColors(nil)
+	if got := UserTheme(renderer).Primary; got != defaults.Primary {
+		t.Errorf("Primary after reset = %+v, want default", got)
+	}
+}
+
+func TestThemeRolesAreAllSettable(t *testing.T) {
+	var theme Theme
+	for _, name := range ThemeRoles {
+		if theme.role(name) == nil {
+			t.Errorf("ThemeRoles lists %q but it has no color field", name)
+		}
+	}
+}
+
+func TestDisableColorRendersWithoutEscapeSequences(t *testing.T) {
+	prev := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	t.Cleanup(func() {
+		colorDisabled = false
+		lipgloss.SetColorProfile(prev)
+	})
+
+	style := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#FF5555"))
+	md := NewMarkdownRenderer(60)
+	colored, err := md.Render("# Title\n\nSome **bold** text")
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if !strings.Contains(style.Render("x"), "\x1b[") || !strings.Contains(colored, "\x1b[") {
+		t.Fatal("expected ANSI styling before DisableColor")
+	}
+
+	DisableColor()
+
+	issues := []model.Issue{
+		{ID: "A", Title: "Open bug", Status: model.StatusOpen, Priority: 0, IssueType: model.TypeBug, Labels: []string{"api"}},
+		{ID: "B", Title: "Blocked", Status: model.StatusBlocked, Priority: 1, IssueType: model.TypeTask,
+			Dependencies: []*model.Dependency{{DependsOnID: "A", Type: model.DepBlocks}}},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m = updated.(Model)
+
+	plain, err := NewMarkdownRenderer(60).Render("# Title\n\nSome **bold** text")
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	outputs := map[string]string{
+		"style":    style.Render("x"),
+		"theme":    m.theme.Renderer.NewStyle().Foreground(m.theme.Primary).Bold(true).Render("x"),
+		"markdown": plain,
+		"list":     m.View(),
+	}
+	board, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")})
+	outputs["board"] = board.(Model).View()
+
+	for name, out := range outputs {
+		if strings.Contains(out, "\x1b[") {
+			t.Errorf("%s output contains ANSI escape sequences after DisableColor: %q", name, out)
+		}
+	}
+}
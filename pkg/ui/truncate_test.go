@@ -3,6 +3,9 @@ package ui
 import (
 	"testing"
 	"unicode/utf8"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/mattn/go-runewidth"
 )
 
 func TestTruncateString_UTF8Safe(t *testing.T) {
@@ -14,8 +17,10 @@ func TestTruncateString_UTF8Safe(t *testing.T) {
 	}{
 		{name: "zero max", input: "hello", maxLen: 0, want: ""},
 		{name: "fits", input: "hello", maxLen: 10, want: "hello"},
-		{name: "small max no ellipsis", input: "こんにちは", maxLen: 3, want: "こんに"},
-		{name: "ellipsis", input: "a🙂b🙂c", maxLen: 4, want: "a🙂b…"},
+		{name: "small max no ellipsis", input: "こんにちは", maxLen: 3, want: "こ"},
+		{name: "ellipsis", input: "a🙂b🙂c", maxLen: 4, want: "a🙂…"},
+		{name: "wide fits by cells", input: "日本語", maxLen: 6, want: "日本語"},
+		{name: "wide does not split", input: "日本語テスト", maxLen: 8, want: "日本語…"},
 	}
 
 	for _, tt := range tests {
@@ -27,8 +32,8 @@ func TestTruncateString_UTF8Safe(t *testing.T) {
 			if !utf8.ValidString(got) {
 				t.Fatalf("truncateString output is not valid UTF-8: %q", got)
 			}
-			if tt.maxLen >= 0 && len([]rune(got)) > tt.maxLen {
-				t.Fatalf("truncateString output has %d runes; max %d", len([]rune(got)), tt.maxLen)
+			if w := runewidth.StringWidth(got); tt.maxLen >= 0 && w > tt.maxLen {
+				t.Fatalf("truncateString output is %d cells wide; max %d", w, tt.maxLen)
 			}
 		})
 	}
@@ -43,8 +48,8 @@ func TestTruncateStrSprint_UTF8Safe(t *testing.T) {
 	}{
 		{name: "zero max", input: "hello", maxLen: 0, want: ""},
 		{name: "fits", input: "hello", maxLen: 10, want: "hello"},
-		{name: "small max no ellipsis", input: "🙂🙂🙂", maxLen: 2, want: "🙂🙂"},
-		{name: "ellipsis", input: "a🙂b🙂c", maxLen: 4, want: "a🙂b…"},
+		{name: "small max no ellipsis", input: "🙂🙂🙂", maxLen: 2, want: "🙂"},
+		{name: "ellipsis", input: "a🙂b🙂c", maxLen: 4, want: "a🙂…"},
 	}
 
 	for _, tt := range tests {
@@ -56,9 +61,81 @@ func TestTruncateStrSprint_UTF8Safe(t *testing.T) {
 			if !utf8.ValidString(got) {
 				t.Fatalf("truncateStrSprint output is not valid UTF-8: %q", got)
 			}
-			if tt.maxLen >= 0 && len([]rune(got)) > tt.maxLen {
-				t.Fatalf("truncateStrSprint output has %d runes; max %d", len([]rune(got)), tt.maxLen)
+			if w := runewidth.StringWidth(got); tt.maxLen >= 0 && w > tt.maxLen {
+				t.Fatalf("truncateStrSprint output is %d cells wide; max %d", w, tt.maxLen)
 			}
 		})
 	}
 }
+
+func TestPadWideCharacters(t *testing.T) {
+	for _, s := range []string{"abc", "日本", "🙂x", "café"} {
+		if w := runewidth.StringWidth(padRight(s, 8)); w != 8 {
+			t.Errorf("padRight(%q, 8) is %d cells wide; want 8", s, w)
+		}
+		if w := runewidth.StringWidth(padLeftPM(s, 8)); w != 8 {
+			t.Errorf("padLeftPM(%q, 8) is %d cells wide; want 8", s, w)
+		}
+	}
+	if got := padRight("日本語", 4); got != "日本語" {
+		t.Errorf("padRight of a wider string = %q; want it unchanged", got)
+	}
+	if got := padLeftPM("日本", 5); got != " 日本" {
+		t.Errorf("padLeftPM(%q, 5) = %q; want one leading space", "日本", got)
+	}
+}
+
+func TestTruncateWithMode_RuneSafe(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		maxWidth int
+		mode     config.TruncationMode
+		ellipsis string
+		want     string
+	}{
+		{name: "fits", input: "hello", maxWidth: 10, mode: config.TruncateMiddle, ellipsis: "…", want: "hello"},
+		{name: "zero width", input: "hello", maxWidth: 0, mode: config.TruncateLeft, ellipsis: "…", want: ""},
+		{name: "right ascii", input: "abcdefghij", maxWidth: 6, mode: config.TruncateRight, ellipsis: "…", want: "abcde…"},
+		{name: "left ascii", input: "abcdefghij", maxWidth: 6, mode: config.TruncateLeft, ellipsis: "…", want: "…fghij"},
+		{name: "middle ascii", input: "abcdefghij", maxWidth: 6, mode: config.TruncateMiddle, ellipsis: "…", want: "abc…ij"},
+		{name: "middle three dots", input: "/home/user/code/project", maxWidth: 13, mode: config.TruncateMiddle, ellipsis: "...", want: "/home...oject"},
+		{name: "unknown mode falls back to right", input: "abcdefghij", maxWidth: 6, mode: "sideways", ellipsis: "…", want: "abcde…"},
+		{name: "right cjk", input: "こんにちは世界", maxWidth: 7, mode: config.TruncateRight, ellipsis: "…", want: "こんに…"},
+		{name: "left cjk", input: "こんにちは世界", maxWidth: 7, mode: config.TruncateLeft, ellipsis: "…", want: "…は世界"},
+		{name: "middle cjk", input: "こんにちは世界", maxWidth: 7, mode: config.TruncateMiddle, ellipsis: "…", want: "こ…世界"},
+		{name: "left emoji", input: "🙂🙃😀😁😂", maxWidth: 5, mode: config.TruncateLeft, ellipsis: "…", want: "…😁😂"},
+		{name: "middle emoji", input: "🙂🙃😀😁😂", maxWidth: 7, mode: config.TruncateMiddle, ellipsis: "…", want: "🙂…😁😂"},
+		{name: "ellipsis wider than max", input: "abcdefghij", maxWidth: 2, mode: config.TruncateLeft, ellipsis: "...", want: ".."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncateWithMode(tt.input, tt.maxWidth, tt.mode, tt.ellipsis)
+			if got != tt.want {
+				t.Fatalf("truncateWithMode(%q, %d, %q, %q) = %q; want %q", tt.input, tt.maxWidth, tt.mode, tt.ellipsis, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Fatalf("truncateWithMode output is not valid UTF-8: %q", got)
+			}
+			if w := runewidth.StringWidth(got); w > tt.maxWidth {
+				t.Fatalf("truncateWithMode output is %d cells wide; max %d", w, tt.maxWidth)
+			}
+		})
+	}
+}
+
+func TestTruncatePath_UsesDisplayConfig(t *testing.T) {
+	path := "/home/user/code/very/long/project"
+
+	if got := truncatePath(path, 12, config.DisplayConfig{}); got != "/home/…oject" {
+		t.Fatalf("default truncatePath = %q; want middle truncation", got)
+	}
+
+	cfg := config.DefaultDisplayConfig()
+	cfg.Ellipsis = "..."
+	cfg.Truncation.Path = config.TruncateLeft
+	if got := truncatePath(path, 12, cfg); got != "...g/project" {
+		t.Fatalf("left truncatePath = %q; want %q", got, "...g/project")
+	}
+}
@@ -1,11 +1,16 @@
 package ui
 
 import (
+	"bytes"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/charmbracelet/bubbles/list"
 )
@@ -106,3 +111,222 @@ func TestUpdateFileChangedReloadsSelection(t *testing.T) {
 		t.Fatalf("expected successful reload, got error %q", m2.statusMsg)
 	}
 }
+
+func TestUpdateRefreshTickReloadsPreservingSelection(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 1},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Priority: 2},
+	}
+	m := NewModel(issues, nil, "")
+	m.width, m.height = 120, 40
+
+	reloaded := []model.Issue{
+		{ID: "C", Title: "Gamma", Status: model.StatusOpen, Priority: 0},
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 1},
+		{ID: "B", Title: "Beta (edited)", Status: model.StatusOpen, Priority: 2},
+	}
+	calls := 0
+	m.EnableAutoRefresh(time.Minute, func() ([]model.Issue, error) {
+		calls++
+		return reloaded, nil
+	})
+	m.list.Select(1) // B
+
+	updated, cmd := m.Update(RefreshTickMsg{})
+	m2 := updated.(Model)
+	if calls != 1 {
+		t.Fatalf("expected reloader to be called once, got %d", calls)
+	}
+	if cmd == nil {
+		t.Fatal("expected refresh tick to be re-armed")
+	}
+	if len(m2.issues) != 3 {
+		t.Fatalf("expected 3 issues after refresh, got %d", len(m2.issues))
+	}
+	sel, ok := m2.list.SelectedItem().(IssueItem)
+	if !ok || sel.Issue.ID != "B" || sel.Issue.Title != "Beta (edited)" {
+		t.Fatalf("expected selection to stay on refreshed B, got %+v", m2.list.SelectedItem())
+	}
+
+	// Unchanged data is a no-op
+	m2.list.Select(0)
+	m2.statusMsg = ""
+	updated, _ = m2.Update(RefreshTickMsg{})
+	m3 := updated.(Model)
+	if calls != 2 {
+		t.Fatalf("expected reloader to be called again, got %d", calls)
+	}
+	if m3.list.Index() != 0 || m3.statusMsg != "" {
+		t.Fatalf("expected no rebuild for unchanged data, index=%d status=%q", m3.list.Index(), m3.statusMsg)
+	}
+}
+
+func TestUpdateRefreshTickKeepsFilterAndSortOrder(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 2},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Priority: 0},
+		{ID: "C", Title: "Gamma", Status: model.StatusClosed, Priority: 1},
+		{ID: "D", Title: "Delta", Status: model.StatusOpen, Priority: 1},
+	}
+	m := NewModel(issues, nil, "")
+	m.width, m.height = 120, 40
+	cfg := config.DefaultDisplayConfig()
+	cfg.Sort = config.SortPriority
+	cfg.SortReverse = true
+	m.SetDisplayConfig(cfg)
+	m.currentFilter = "open"
+	m.applyFilter()
+	if got := listIDs(m); got != "A,D,B" {
+		t.Fatalf("order before refresh = %s, want A,D,B", got)
+	}
+	m.list.Select(1) // D
+
+	reloaded := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 2},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Priority: 0},
+		{ID: "C", Title: "Gamma", Status: model.StatusClosed, Priority: 1},
+		{ID: "D", Title: "Delta (edited)", Status: model.StatusOpen, Priority: 1},
+		{ID: "E", Title: "Epsilon", Status: model.StatusOpen, Priority: 3},
+	}
+	m.EnableAutoRefresh(time.Minute, func() ([]model.Issue, error) { return reloaded, nil })
+	updated, _ := m.Update(RefreshTickMsg{})
+	m2 := updated.(Model)
+	if got := listIDs(m2); got != "E,A,D,B" {
+		t.Fatalf("order after refresh = %s, want E,A,D,B (open only, priority reversed)", got)
+	}
+	sel, ok := m2.list.SelectedItem().(IssueItem)
+	if !ok || sel.Issue.ID != "D" || sel.Issue.Title != "Delta (edited)" {
+		t.Fatalf("expected selection to stay on refreshed D, got %+v", m2.list.SelectedItem())
+	}
+}
+
+func TestUpdateRefreshTickDisabled(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "A", Title: "Alpha", Status: model.StatusOpen}}, nil, "")
+	m.EnableAutoRefresh(0, func() ([]model.Issue, error) {
+		t.Fatal("reloader should not run when refresh is disabled")
+		return nil, nil
+	})
+	if _, cmd := m.Update(RefreshTickMsg{}); cmd != nil {
+		t.Fatal("expected no command when refresh is disabled")
+	}
+}
+
+func TestUpdateRefreshTickCountsIgnored(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "A", Title: "Alpha", Status: model.StatusOpen}}, nil, "")
+	m.SetIgnoreFilter(func(issue *model.Issue) bool { return issue.ID == "X" }, 0)
+	m.EnableAutoRefresh(time.Second, func() ([]model.Issue, error) {
+		return []model.Issue{
+			{ID: "A", Title: "Alpha", Status: model.StatusOpen},
+			{ID: "X", Title: "Noise", Status: model.StatusOpen},
+		}, nil
+	})
+	updated, _ := m.Update(RefreshTickMsg{})
+	m2 := updated.(Model)
+	if len(m2.issues) != 1 || m2.ignoredCount != 1 {
+		t.Fatalf("after refresh: %d issues, %d ignored; want 1 and 1", len(m2.issues), m2.ignoredCount)
+	}
+}
+
+func TestUpdateRefreshTickKeepsViewScope(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "")
+	scope := []string{"A"}
+	m.SetViewScope(func() []string { return scope })
+	if got := m.FilteredIssues(); len(got) != 1 || got[0].ID != "A" {
+		t.Fatalf("scoped view = %v, want only A", got)
+	}
+
+	scope = []string{"B"}
+	edited := []model.Issue{issues[0], {ID: "B", Title: "Beta (edited)", Status: model.StatusOpen}}
+	m.EnableAutoRefresh(time.Second, func() ([]model.Issue, error) { return edited, nil })
+	updated, _ := m.Update(RefreshTickMsg{})
+	m2 := updated.(Model)
+	if len(m2.issues) != 2 {
+		t.Fatalf("scope should hide issues, not drop them: %d loaded", len(m2.issues))
+	}
+	if got := m2.FilteredIssues(); len(got) != 1 || got[0].ID != "B" {
+		t.Fatalf("after refresh = %v, want only B", got)
+	}
+}
+
+func TestUpdateRefreshTickKeepsSavedView(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Assignee: "ana"},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Assignee: "bob"},
+	}
+	m := NewModel(issues, nil, "")
+	m.ApplySavedView("mine", config.SavedView{Assignee: "ana"})
+
+	edited := []model.Issue{issues[0], {ID: "B", Title: "Beta (edited)", Status: model.StatusOpen, Assignee: "bob"}}
+	m.EnableAutoRefresh(time.Second, func() ([]model.Issue, error) { return edited, nil })
+	updated, _ := m.Update(RefreshTickMsg{})
+	m2 := updated.(Model)
+	if m2.activeViewName != "mine" {
+		t.Fatalf("active view = %q after refresh, want mine", m2.activeViewName)
+	}
+	if got := m2.FilteredIssues(); len(got) != 1 || got[0].ID != "A" {
+		t.Fatalf("after refresh = %v, want only A (the view's assignee filter)", got)
+	}
+}
+
+func TestUpdateRefreshTickError(t *testing.T) {
+	m := NewModel([]model.Issue{{ID: "A", Title: "Alpha", Status: model.StatusOpen}}, nil, "")
+	m.EnableAutoRefresh(time.Second, func() ([]model.Issue, error) {
+		return nil, errors.New("mount offline")
+	})
+	updated, cmd := m.Update(RefreshTickMsg{})
+	m2 := updated.(Model)
+	if !m2.statusIsError || !strings.Contains(m2.statusMsg, "mount offline") {
+		t.Fatalf("expected refresh error status, got %q", m2.statusMsg)
+	}
+	if cmd == nil {
+		t.Fatal("expected refresh to keep ticking after an error")
+	}
+	if len(m2.issues) != 1 {
+		t.Fatalf("expected issues to be kept on error, got %d", len(m2.issues))
+	}
+}
+
+func TestUpdateNotifyNewlyBlocked(t *testing.T) {
+	blocker := &model.Dependency{IssueID: "A", DependsOnID: "C", Type: model.DepBlocks}
+	issues := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 0},
+		{ID: "B", Title: "Beta", Status: model.StatusOpen, Priority: 3},
+		{ID: "D", Title: "Delta", Status: model.StatusBlocked, Priority: 1},
+	}
+	reloaded := []model.Issue{
+		{ID: "A", Title: "Alpha", Status: model.StatusOpen, Priority: 0, Dependencies: []*model.Dependency{blocker}},
+		{ID: "B", Title: "Beta", Status: model.StatusBlocked, Priority: 3},
+		{ID: "C", Title: "Gamma", Status: model.StatusOpen, Priority: 2},
+		{ID: "D", Title: "Delta", Status: model.StatusBlocked, Priority: 1},
+		{ID: "E", Title: "Epsilon", Status: model.StatusBlocked, Priority: 1},
+	}
+	m := NewModel(issues, nil, "")
+	m.EnableAutoRefresh(time.Minute, func() ([]model.Issue, error) { return reloaded, nil })
+	m.EnableBlockedNotify(1)
+	var bell bytes.Buffer
+	m.notifier.bell = &bell
+
+	updated, _ := m.Update(RefreshTickMsg{})
+	m2 := updated.(Model)
+	// A gained an open blocker and E arrived blocked; B is below the
+	// threshold and D was already blocked
+	if !m2.statusIsError || m2.statusMsg != "Newly blocked (P0-P1): A, E" {
+		t.Fatalf("expected a newly-blocked banner, got %q (error=%v)", m2.statusMsg, m2.statusIsError)
+	}
+	m2.notifier.bellCmd()()
+	if bell.String() != "\a" {
+		t.Errorf("bell wrote %q", bell.String())
+	}
+
+	// Without --notify a reload just reports the count
+	m = NewModel(issues, nil, "")
+	m.EnableAutoRefresh(time.Minute, func() ([]model.Issue, error) { return reloaded, nil })
+	updated, _ = m.Update(RefreshTickMsg{})
+	if m3 := updated.(Model); m3.statusIsError || !strings.HasPrefix(m3.statusMsg, "Reloaded 5 issues") {
+		t.Errorf("expected a plain reload status, got %q", m3.statusMsg)
+	}
+}
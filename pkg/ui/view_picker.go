@@ -0,0 +1,264 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/analysis"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ViewPickerModel represents the saved-view picker overlay. The first row
+// clears the active view; the rest are saved views in name order.
+type ViewPickerModel struct {
+	names         []string
+	views         map[string]config.SavedView
+	selectedIndex int
+	width         int
+	height        int
+	theme         Theme
+}
+
+// NewViewPickerModel creates a new view picker
+func NewViewPickerModel(views map[string]config.SavedView, theme Theme) ViewPickerModel {
+	cfg := config.DisplayConfig{Views: views}
+	return ViewPickerModel{
+		names: cfg.ViewNames(),
+		views: views,
+		theme: theme,
+	}
+}
+
+// SetSize updates the picker dimensions
+func (m *ViewPickerModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// MoveUp moves selection up
+func (m *ViewPickerModel) MoveUp() {
+	if m.selectedIndex > 0 {
+		m.selectedIndex--
+	}
+}
+
+// MoveDown moves selection down
+func (m *ViewPickerModel) MoveDown() {
+	if m.selectedIndex < len(m.names) {
+		m.selectedIndex++
+	}
+}
+
+// SelectedView returns the selected view name and whether a view (rather
+// than the clear row) is selected.
+func (m *ViewPickerModel) SelectedView() (string, config.SavedView, bool) {
+	if m.selectedIndex == 0 || m.selectedIndex > len(m.names) {
+		return "", config.SavedView{}, false
+	}
+	name := m.names[m.selectedIndex-1]
+	return name, m.views[name], true
+}
+
+// ViewCount returns the number of saved views
+func (m *ViewPickerModel) ViewCount() int {
+	return len(m.names)
+}
+
+// View renders the view picker overlay
+func (m *ViewPickerModel) View() string {
+	if m.width == 0 {
+		m.width = 60
+	}
+	if m.height == 0 {
+		m.height = 20
+	}
+
+	t := m.theme
+
+	boxWidth := 56
+	if m.width < 66 {
+		boxWidth = m.width - 10
+	}
+	if boxWidth < 30 {
+		boxWidth = 30
+	}
+
+	var lines []string
+
+	titleStyle := t.Renderer.NewStyle().
+		Foreground(t.Primary).
+		Bold(true).
+		MarginBottom(1)
+	lines = append(lines, titleStyle.Render("Saved Views"))
+	lines = append(lines, "")
+
+	rows := append([]string{"(no view)"}, m.names...)
+	for i, name := range rows {
+		isSelected := i == m.selectedIndex
+
+		nameStyle := t.Renderer.NewStyle()
+		if isSelected {
+			nameStyle = nameStyle.Foreground(t.Primary).Bold(true)
+		} else {
+			nameStyle = nameStyle.Foreground(t.Base.GetForeground())
+		}
+
+		prefix := "  "
+		if isSelected {
+			prefix = "▸ "
+		}
+		lines = append(lines, nameStyle.Render(prefix+name))
+
+		if i > 0 {
+			descStyle := t.Renderer.NewStyle().
+				Foreground(t.Secondary).
+				Italic(true)
+			desc := "    " + truncateRunesHelper(DescribeSavedView(m.views[name]), boxWidth-8, "…")
+			lines = append(lines, descStyle.Render(desc))
+		}
+	}
+
+	lines = append(lines, "")
+	footerStyle := t.Renderer.NewStyle().
+		Foreground(t.Secondary).
+		Italic(true)
+	lines = append(lines, footerStyle.Render("j/k: navigate • enter: apply • esc: cancel"))
+
+	content := strings.Join(lines, "\n")
+
+	boxStyle := t.Renderer.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(t.Primary).
+		Padding(1, 2).
+		Width(boxWidth)
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		boxStyle.Render(content),
+	)
+}
+
+// DescribeSavedView summarizes a saved view's settings on one line,
+// e.g. "repo api • status open • type bug • sort priority".
+func DescribeSavedView(v config.SavedView) string {
+	var parts []string
+	if v.Repo != "" {
+		parts = append(parts, "repo "+v.Repo)
+	}
+	if len(v.Status) > 0 {
+		parts = append(parts, "status "+strings.Join(v.Status, ","))
+	}
+	if len(v.Priority) > 0 {
+		prios := make([]string, len(v.Priority))
+		for i, p := range v.Priority {
+			prios[i] = fmt.Sprintf("P%d", p)
+		}
+		parts = append(parts, "priority "+strings.Join(prios, ","))
+	}
+	if len(v.Type) > 0 {
+		parts = append(parts, "type "+strings.Join(v.Type, ","))
+	}
+	if len(v.Resolution) > 0 {
+		parts = append(parts, "resolution "+strings.Join(v.Resolution, ","))
+	}
+	switch {
+	case v.Assignee != "" && v.Watching != "":
+		parts = append(parts, "@"+v.Assignee+" or watched by "+v.Watching)
+	case v.Assignee != "":
+		parts = append(parts, "@"+v.Assignee)
+	case v.Watching != "":
+		parts = append(parts, "watched by "+v.Watching)
+	}
+	if v.MinDeps > 0 {
+		parts = append(parts, fmt.Sprintf("deps≥%d", v.MinDeps))
+	}
+	if v.MinDependents > 0 {
+		parts = append(parts, fmt.Sprintf("dependents≥%d", v.MinDependents))
+	}
+	if v.Sort != "" {
+		sort := "sort " + string(v.Sort)
+		if v.SortReverse {
+			sort += " (rev)"
+		}
+		parts = append(parts, sort)
+	}
+	if v.ViewType != "" {
+		parts = append(parts, "opens "+string(v.ViewType))
+	}
+	if len(parts) == 0 {
+		return "all issues"
+	}
+	return strings.Join(parts, " • ")
+}
+
+// savedViewMatches reports whether issue passes the view's filters
+func savedViewMatches(v *config.SavedView, issue *model.Issue) bool {
+	if v.Repo != "" && !analysis.IssueInProject(issue, v.Repo) {
+		return false
+	}
+	if len(v.Status) > 0 && !containsFold(v.Status, string(issue.Status)) {
+		return false
+	}
+	if len(v.Type) > 0 && !containsFold(v.Type, string(issue.IssueType)) {
+		return false
+	}
+	if len(v.Resolution) > 0 && !analysis.HasResolution(issue, v.Resolution) {
+		return false
+	}
+	if len(v.Priority) > 0 {
+		found := false
+		for _, p := range v.Priority {
+			if p == issue.Priority {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	watched := v.Watching != "" && issue.IsWatchedBy(v.Watching)
+	if v.Assignee != "" {
+		assigned := strings.EqualFold(v.Assignee, issue.Assignee)
+		if analysis.IsAssigneeGlob(v.Assignee) {
+			assigned = analysis.MatchAssigneeGlob(v.Assignee, issue.Assignee)
+		}
+		return assigned || watched
+	}
+	return v.Watching == "" || watched
+}
+
+// watchedInView reports whether issue is watched by the active view's
+// watching user, for highlighting watched rows.
+func watchedInView(v *config.SavedView, issue *model.Issue) bool {
+	return v != nil && v.Watching != "" && issue.IsWatchedBy(v.Watching)
+}
+
+// dependencyCountsMatch reports whether the issue has at least the view's
+// minimum number of dependencies and dependents in the loaded graph (which,
+// with several projects loaded, includes cross-project edges).
+func dependencyCountsMatch(v *config.SavedView, stats *analysis.GraphStats, id string) bool {
+	if v.MinDeps <= 0 && v.MinDependents <= 0 {
+		return true
+	}
+	if stats == nil {
+		return false
+	}
+	return stats.OutDegree[id] >= v.MinDeps && stats.InDegree[id] >= v.MinDependents
+}
+
+// containsFold reports whether values contains s, ignoring case
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
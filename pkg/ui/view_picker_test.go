@@ -0,0 +1,206 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/config"
+	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func viewTestModel() Model {
+	issues := []model.Issue{
+		{ID: "api-1", Title: "Auth", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeBug, Assignee: "Ana"},
+		{ID: "api-2", Title: "Rate limit", Status: model.StatusInProgress, Priority: 2, IssueType: model.TypeFeature, Watchers: []string{"ana"}},
+		{ID: "web-1", Title: "Login", Status: model.StatusOpen, Priority: 0, IssueType: model.TypeBug, Assignee: "ana"},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	return updated.(Model)
+}
+
+func TestSavedViewMatches(t *testing.T) {
+	issue := model.Issue{ID: "api-1", Status: model.StatusOpen, Priority: 1, IssueType: model.TypeBug, Assignee: "Ana", Watchers: []string{"Cy"}}
+	tests := []struct {
+		name string
+		view config.SavedView
+		want bool
+	}{
+		{"empty view", config.SavedView{}, true},
+		{"repo match", config.SavedView{Repo: "api"}, true},
+		{"repo mismatch", config.SavedView{Repo: "web"}, false},
+		{"status match", config.SavedView{Status: []string{"closed", "open"}}, true},
+		{"status mismatch", config.SavedView{Status: []string{"closed"}}, false},
+		{"priority match", config.SavedView{Priority: []int{0, 1}}, true},
+		{"priority mismatch", config.SavedView{Priority: []int{3}}, false},
+		{"type match", config.SavedView{Type: []string{"BUG"}}, true},
+		{"type mismatch", config.SavedView{Type: []string{"epic"}}, false},
+		{"assignee ignores case", config.SavedView{Assignee: "ana"}, true},
+		{"assignee mismatch", config.SavedView{Assignee: "bo"}, false},
+		{"assignee glob match", config.SavedView{Assignee: "a*"}, true},
+		{"assignee glob mismatch", config.SavedView{Assignee: "*@example.com"}, false},
+		{"watching match", config.SavedView{Watching: "cy"}, true},
+		{"watching mismatch", config.SavedView{Watching: "ana"}, false},
+		{"mine or watched: watched", config.SavedView{Assignee: "bo", Watching: "cy"}, true},
+		{"mine or watched: mine", config.SavedView{Assignee: "ana", Watching: "bo"}, true},
+		{"mine or watched: neither", config.SavedView{Assignee: "bo", Watching: "dee"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := savedViewMatches(&tt.view, &issue); got != tt.want {
+				t.Errorf("savedViewMatches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplySavedViewFiltersSortsAndSwitchesView(t *testing.T) {
+	m := viewTestModel()
+	m.ApplySavedView("my-bugs", config.SavedView{
+		Type:     []string{"bug"},
+		Sort:     config.SortPriority,
+		ViewType: config.ViewBoard,
+	})
+
+	if got := listIDs(m); got != "web-1,api-1" {
+		t.Fatalf("list = %s, want web-1,api-1", got)
+	}
+	if m.sortMode != SortPriority {
+		t.Errorf("sortMode = %v, want SortPriority", m.sortMode)
+	}
+	if !m.isBoardView || m.focused != focusBoard {
+		t.Errorf("expected board view, got board=%v focus=%v", m.isBoardView, m.focused)
+	}
+	if !strings.Contains(m.View(), "my-bugs") {
+		t.Error("expected status bar to show the active view name")
+	}
+
+	m.ClearSavedView()
+	if got := len(m.list.Items()); got != 3 {
+		t.Errorf("after clear got %d items, want 3", got)
+	}
+}
+
+func TestApplySavedViewMineOrWatchedHighlightsWatched(t *testing.T) {
+	m := viewTestModel()
+	m.ApplySavedView("", config.SavedView{Assignee: "ana", Watching: "ana"})
+
+	if got := listIDs(m); got != "web-1,api-1,api-2" {
+		t.Fatalf("list = %s, want all three (two assigned, one watched)", got)
+	}
+	for _, it := range m.list.Items() {
+		item := it.(IssueItem)
+		if want := item.Issue.ID == "api-2"; item.IsWatched != want {
+			t.Errorf("%s IsWatched = %v, want %v", item.Issue.ID, item.IsWatched, want)
+		}
+	}
+	if !strings.Contains(m.View(), watchedMarker) {
+		t.Error("expected the watched marker in the list")
+	}
+}
+
+func TestApplySavedViewFiltersByDependencyCounts(t *testing.T) {
+	blockedBy := func(ids ...string) []*model.Dependency {
+		var deps []*model.Dependency
+		for _, id := range ids {
+			deps = append(deps, &model.Dependency{DependsOnID: id, Type: model.DepBlocks})
+		}
+		return deps
+	}
+	issues := []model.Issue{
+		{ID: "api-1", Title: "Core", Status: model.StatusOpen, IssueType: model.TypeTask},
+		{ID: "api-2", Title: "Uses core", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: blockedBy("api-1")},
+		{ID: "web-1", Title: "Uses both", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: blockedBy("api-1", "api-2")},
+		{ID: "web-2", Title: "Also core", Status: model.StatusOpen, IssueType: model.TypeTask, Dependencies: blockedBy("api-1")},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	m = updated.(Model)
+
+	m.ApplySavedView("", config.SavedView{MinDependents: 3})
+	if got := listIDs(m); got != "api-1" {
+		t.Errorf("min dependents 3: list = %s, want api-1", got)
+	}
+
+	m.ApplySavedView("", config.SavedView{MinDeps: 2})
+	if got := listIDs(m); got != "web-1" {
+		t.Errorf("min deps 2: list = %s, want web-1", got)
+	}
+
+	// Combines with the other view filters
+	m.ApplySavedView("", config.SavedView{MinDeps: 1, Repo: "web"})
+	if got := listIDs(m); got != "web-1,web-2" && got != "web-2,web-1" {
+		t.Errorf("min deps 1 in web: list = %s, want web-1 and web-2", got)
+	}
+}
+
+func TestViewPickerKey(t *testing.T) {
+	m := viewTestModel()
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")})
+	m = updated.(Model)
+	if m.showViewPicker || !strings.Contains(m.statusMsg, "--save-view") {
+		t.Fatalf("expected hint when no views are saved, got show=%v msg=%q", m.showViewPicker, m.statusMsg)
+	}
+
+	cfg := config.DefaultDisplayConfig()
+	cfg.SaveView("api", config.SavedView{Repo: "api"})
+	cfg.SaveView("mine", config.SavedView{Assignee: "ana"})
+	m.SetDisplayConfig(cfg)
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")})
+	m = updated.(Model)
+	if !m.showViewPicker || m.focused != focusViewPicker {
+		t.Fatal("expected view picker to open")
+	}
+	if !strings.Contains(m.View(), "Saved Views") {
+		t.Error("expected picker overlay to render")
+	}
+
+	// Row 0 clears; row 1 is "api"
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.showViewPicker || m.activeViewName != "api" {
+		t.Fatalf("expected api view to be applied, got show=%v name=%q", m.showViewPicker, m.activeViewName)
+	}
+	if got := listIDs(m); got != "api-1,api-2" {
+		t.Errorf("list = %s, want api-1,api-2", got)
+	}
+
+	// Selecting the clear row removes the filter
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.activeView != nil || len(m.list.Items()) != 3 {
+		t.Errorf("expected view cleared, got %q with %d items", m.activeViewName, len(m.list.Items()))
+	}
+}
+
+func TestSavedViewResolutionFilter(t *testing.T) {
+	issues := []model.Issue{
+		{ID: "A", Title: "Shipped", Status: model.StatusClosed, Resolution: "fixed"},
+		{ID: "B", Title: "Dropped", Status: model.StatusClosed, Resolution: "wontfix"},
+		{ID: "C", Title: "Still open", Status: model.StatusOpen},
+	}
+	m := NewModel(issues, nil, "")
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	m = updated.(Model)
+
+	view := config.SavedView{Resolution: []string{"wontfix"}}
+	m.ApplySavedView("", view)
+	if got := listIDs(m); got != "B" {
+		t.Fatalf("list = %s, want B", got)
+	}
+	if got := DescribeSavedView(view); got != "resolution wontfix" {
+		t.Errorf("description = %q", got)
+	}
+
+	m.updateViewportContent()
+	if !strings.Contains(m.viewport.View(), "Resolution:") || !strings.Contains(m.viewport.View(), "wontfix") {
+		t.Errorf("detail should show the resolution:\n%s", m.viewport.View())
+	}
+}
@@ -85,11 +85,33 @@ var RepoColors = []lipgloss.Color{
 	lipgloss.Color("#85C1E9"), // Light blue
 }
 
-// GetRepoColor returns a consistent color for a repo prefix based on hash
+// repoColorOverrides holds badge colors configured per project, keyed by
+// normalized prefix
+var repoColorOverrides = map[string]lipgloss.Color{}
+
+// SetRepoColor pins the badge color for a repo prefix ("api-" and "api" are
+// the same repo). An empty color removes the override.
+func SetRepoColor(prefix, color string) {
+	key := normalizeRepoKey(prefix)
+	if key == "" {
+		return
+	}
+	if color == "" {
+		delete(repoColorOverrides, key)
+		return
+	}
+	repoColorOverrides[key] = lipgloss.Color(color)
+}
+
+// GetRepoColor returns the configured color for a repo prefix, or a
+// consistent one based on hash
 func GetRepoColor(prefix string) lipgloss.Color {
 	if prefix == "" {
 		return ColorMuted
 	}
+	if c, ok := repoColorOverrides[normalizeRepoKey(prefix)]; ok {
+		return c
+	}
 	// Simple hash based on prefix characters
 	hash := 0
 	for _, c := range prefix {
@@ -49,4 +49,17 @@ func TestRenderSparkline(t *testing.T) {
 			}
 		})
 	}
-}
\ No newline at end of file
+}
+func TestSetRepoColorOverridesHash(t *testing.T) {
+	defer ui.SetRepoColor("api", "")
+
+	hashed := ui.GetRepoColor("api")
+	ui.SetRepoColor("API-", "#123456")
+	if got := ui.GetRepoColor("api"); got != "#123456" {
+		t.Errorf("GetRepoColor(api) = %q, want #123456", got)
+	}
+	ui.SetRepoColor("api-", "")
+	if got := ui.GetRepoColor("api"); got != hashed {
+		t.Errorf("after clearing, GetRepoColor(api) = %q, want %q", got, hashed)
+	}
+}
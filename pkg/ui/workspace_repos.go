@@ -16,9 +16,7 @@ func normalizeRepoPrefixes(prefixes []string) []string {
 	seen := make(map[string]bool, len(prefixes))
 	var out []string
 	for _, raw := range prefixes {
-		p := strings.TrimSpace(raw)
-		p = strings.TrimRight(p, "-:_")
-		p = strings.ToLower(p)
+		p := normalizeRepoKey(raw)
 		if p == "" {
 			continue
 		}
@@ -32,6 +30,11 @@ func normalizeRepoPrefixes(prefixes []string) []string {
 	return out
 }
 
+// normalizeRepoKey normalizes a single repo prefix (e.g., "API-" -> "api").
+func normalizeRepoKey(prefix string) string {
+	return strings.ToLower(strings.TrimRight(strings.TrimSpace(prefix), "-:_"))
+}
+
 func sortedRepoKeys(selected map[string]bool) []string {
 	if len(selected) == 0 {
 		return nil
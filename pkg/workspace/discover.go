@@ -0,0 +1,129 @@
+package workspace
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DiscoverProjectRoot treats root as a monorepo whose own .beads directory
+// is the main project and returns it followed by every nested directory
+// with a .beads directory, as absolute paths sorted by path. Names are
+// hierarchical: each nested project is named under the closest project
+// above it ("mono/services/api/worker"). Prefixes are flat, so every ID
+// still has a single project segment: each project's prefix is its
+// directory name with only letters and digits kept ("mono-", "api-",
+// "worker-"), and projects whose directory names clash use their path
+// relative to the root instead ("appsapi-", "servicesapi-"). Hidden
+// directories and those matching exclude (base-name globs, e.g.
+// DefaultExcludePatterns) are not searched.
+func DiscoverProjectRoot(root string, exclude []string) ([]RepoConfig, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project root %s: %w", root, err)
+	}
+	if info, err := os.Stat(filepath.Join(absRoot, ".beads")); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("no .beads directory found in project root %s", absRoot)
+	}
+
+	var nested []string
+	err = filepath.WalkDir(absRoot, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == absRoot {
+				return err
+			}
+			return fs.SkipDir // Unreadable subtree; keep going
+		}
+		if !d.IsDir() || path == absRoot {
+			return nil
+		}
+		name := d.Name()
+		if strings.HasPrefix(name, ".") || excluded(name, exclude) {
+			return fs.SkipDir
+		}
+		if info, err := os.Stat(filepath.Join(path, ".beads")); err == nil && info.IsDir() {
+			nested = append(nested, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(nested)
+
+	rootName := filepath.Base(absRoot)
+	repos := []RepoConfig{{Name: rootName, Path: absRoot, Prefix: prefixPart(rootName) + "-"}}
+	byPath := map[string]int{absRoot: 0}
+
+	// Group each nested project under the closest project above it
+	isProject := map[string]bool{absRoot: true}
+	for _, path := range nested {
+		isProject[path] = true
+	}
+	parentOf := make(map[string]string, len(nested))
+	for _, path := range nested {
+		parent := filepath.Dir(path)
+		for !isProject[parent] {
+			parent = filepath.Dir(parent)
+		}
+		parentOf[path] = parent
+	}
+
+	// Directory names used by more than one project need their full path
+	baseCount := map[string]int{prefixPart(rootName): 1}
+	for _, path := range nested {
+		baseCount[prefixPart(filepath.Base(path))]++
+	}
+	used := map[string]bool{repos[0].Prefix: true}
+	for _, path := range nested {
+		parent := parentOf[path]
+		rel, _ := filepath.Rel(parent, path)
+		part := prefixPart(filepath.Base(path))
+		if baseCount[part] > 1 {
+			fromRoot, _ := filepath.Rel(absRoot, path)
+			part = prefixPart(fromRoot)
+		}
+		prefix := part + "-"
+		for n := 2; used[prefix]; n++ {
+			prefix = fmt.Sprintf("%s%d-", part, n)
+		}
+		used[prefix] = true
+		byPath[path] = len(repos)
+		repos = append(repos, RepoConfig{
+			Name:   repos[byPath[parent]].Name + "/" + filepath.ToSlash(rel),
+			Path:   path,
+			Prefix: prefix,
+		})
+	}
+	return repos, nil
+}
+
+// excluded reports whether a directory name matches any exclude pattern.
+func excluded(name string, patterns []string) bool {
+	for _, p := range patterns {
+		if ok, _ := filepath.Match(p, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixPart lowercases a directory name or relative path for use in a
+// prefix, keeping only letters and digits so the prefix holds no separator
+// ("services/api" becomes "servicesapi"). A name with none of either
+// becomes "project".
+func prefixPart(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	if b.Len() == 0 {
+		return "project"
+	}
+	return b.String()
+}
@@ -0,0 +1,57 @@
+package workspace_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Dicklesworthstone/beads_viewer/pkg/workspace"
+)
+
+func TestDiscoverProjectRoot(t *testing.T) {
+	root := filepath.Join(t.TempDir(), "Mono")
+	for _, dir := range []string{
+		".",
+		"services/api",
+		"services/api/worker",
+		"apps/web",
+		"apps/api",
+		"node_modules/pkg",
+		".cache/tool",
+	} {
+		if err := os.MkdirAll(filepath.Join(root, dir, ".beads"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A directory without .beads in between does not break nesting
+	if err := os.MkdirAll(filepath.Join(root, "services", "api", "cmd", "tool", ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	repos, err := workspace.DiscoverProjectRoot(root, workspace.DefaultExcludePatterns())
+	if err != nil {
+		t.Fatalf("DiscoverProjectRoot: %v", err)
+	}
+
+	want := []struct{ rel, name, prefix string }{
+		{".", "Mono", "mono-"},
+		{"apps/api", "Mono/apps/api", "appsapi-"},
+		{"apps/web", "Mono/apps/web", "web-"},
+		{"services/api", "Mono/services/api", "servicesapi-"},
+		{"services/api/cmd/tool", "Mono/services/api/cmd/tool", "tool-"},
+		{"services/api/worker", "Mono/services/api/worker", "worker-"},
+	}
+	if len(repos) != len(want) {
+		t.Fatalf("got %d projects, want %d: %+v", len(repos), len(want), repos)
+	}
+	for i, w := range want {
+		r := repos[i]
+		if r.Path != filepath.Join(root, w.rel) || r.Name != w.name || r.Prefix != w.prefix {
+			t.Errorf("repo[%d] = {%s %s %s}, want {%s %s %s}", i, r.Path, r.Name, r.Prefix, filepath.Join(root, w.rel), w.name, w.prefix)
+		}
+	}
+
+	if _, err := workspace.DiscoverProjectRoot(filepath.Join(root, "apps"), nil); err == nil {
+		t.Error("expected an error for a root without .beads")
+	}
+}
@@ -2,10 +2,12 @@ package workspace
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"path/filepath"
+	"strings"
 
 	"golang.org/x/sync/errgroup"
 
@@ -24,8 +26,34 @@ type LoadResult struct {
 	// Issues are the loaded issues with namespaced IDs
 	Issues []model.Issue
 
+	// Path is the data file the issues were read from
+	Path string
+
+	// Format is the detected layout of the data file
+	Format loader.Format
+
+	// Warnings are the parser's messages about skipped or suspect records,
+	// collected only when verbose logging is on (see SetVerbosity)
+	Warnings []string
+
+	// Rewrites counts how dependency references were namespaced
+	Rewrites RewriteCounts
+
+	// Empty is set when the repo had no issues file yet and was loaded
+	// with no issues (see SetAllowEmpty); Path is then the file to create
+	Empty bool
+
 	// Error is set if loading failed
 	Error error
+
+	trace []string // Per-record rewrites, kept at verbosity 2
+}
+
+// RewriteCounts tallies how namespaceIssues resolved dependency targets.
+type RewriteCounts struct {
+	Local    int // Target is an issue in the same repo; prefixed
+	External int // Target already carries another repo's prefix; kept
+	Assumed  int // Target is unknown; assumed local and prefixed
 }
 
 // AggregateLoader loads issues from multiple repositories in a workspace
@@ -33,6 +61,9 @@ type AggregateLoader struct {
 	config        *Config
 	workspaceRoot string
 	logger        *log.Logger
+	verbosity     int
+	allowEmpty    bool
+	parseOpts     loader.ParseOptions
 }
 
 // NewAggregateLoader creates a new aggregate loader for the given workspace config
@@ -52,6 +83,30 @@ func (l *AggregateLoader) SetLogger(logger *log.Logger) {
 	l.logger = logger
 }
 
+// SetVerbosity makes LoadAll explain itself through the logger. At 1 it
+// logs each repo's path, prefix and where it came from, issue counts, how
+// dependencies were rewritten, and every skipped or suspect record. At 2
+// it also logs each namespaced ID and each rewritten dependency. Repos are
+// logged in config order once loading finishes, so parallel loads don't
+// interleave.
+func (l *AggregateLoader) SetVerbosity(level int) {
+	l.verbosity = level
+}
+
+// SetAllowEmpty makes a repo whose beads directory has no issues file
+// load with no issues (LoadResult.Empty) instead of failing. A repo with
+// no beads directory still fails.
+func (l *AggregateLoader) SetAllowEmpty(allow bool) {
+	l.allowEmpty = allow
+}
+
+// SetParseOptions sets the options each repo's beads file is parsed with,
+// such as the default priority. The loader sets its own FormatHandler, and
+// its WarningHandler when verbose.
+func (l *AggregateLoader) SetParseOptions(opts loader.ParseOptions) {
+	l.parseOpts = opts
+}
+
 // LoadAll loads issues from all enabled repositories in the workspace.
 // Returns the merged list of issues with namespaced IDs.
 // Failed repos are logged but don't break the overall loading process.
@@ -74,7 +129,8 @@ func (l *AggregateLoader) LoadAll(ctx context.Context) ([]model.Issue, []LoadRes
 
 	// Merge all successfully loaded issues
 	var allIssues []model.Issue
-	for _, result := range results {
+	for i, result := range results {
+		l.logRepoTrace(enabledRepos[i], result)
 		if result.Error != nil {
 			// Log but continue - individual repo failures don't break the whole load
 			l.logRepoError(result.RepoName, result.Error)
@@ -82,6 +138,9 @@ func (l *AggregateLoader) LoadAll(ctx context.Context) ([]model.Issue, []LoadRes
 		}
 		allIssues = append(allIssues, result.Issues...)
 	}
+	if l.verbosity > 0 && l.logger != nil {
+		l.logger.Printf("Merged %d issues from %d repos", len(allIssues), len(results))
+	}
 
 	return allIssues, results, nil
 }
@@ -120,14 +179,11 @@ func (l *AggregateLoader) loadReposParallel(ctx context.Context, repos []RepoCon
 			default:
 			}
 
-			issues, err := l.loadSingleRepo(repo)
-
 			results[i] = LoadResult{
 				RepoName: repo.GetName(),
 				Prefix:   repo.GetPrefix(),
-				Issues:   issues,
-				Error:    err,
 			}
+			results[i].Issues, results[i].Error = l.loadSingleRepo(repo, &results[i])
 
 			return nil // Individual repo errors are captured in results, not propagated
 		})
@@ -145,8 +201,9 @@ func (l *AggregateLoader) loadReposParallel(ctx context.Context, repos []RepoCon
 	return results, nil
 }
 
-// loadSingleRepo loads issues from a single repository and namespaced them
-func (l *AggregateLoader) loadSingleRepo(repo RepoConfig) ([]model.Issue, error) {
+// loadSingleRepo loads issues from a single repository and namespaced them,
+// recording the data file and its format in res
+func (l *AggregateLoader) loadSingleRepo(repo RepoConfig, res *LoadResult) ([]model.Issue, error) {
 	// Resolve the repo path relative to workspace root
 	repoPath := repo.Path
 	if !filepath.IsAbs(repoPath) {
@@ -157,9 +214,20 @@ func (l *AggregateLoader) loadSingleRepo(repo RepoConfig) ([]model.Issue, error)
 	beadsDir := filepath.Join(repoPath, repo.GetBeadsPath())
 	jsonlPath, err := loader.FindJSONLPath(beadsDir)
 	if err != nil {
+		if l.allowEmpty && errors.Is(err, loader.ErrNoIssuesFile) {
+			res.Path = filepath.Join(beadsDir, loader.NewIssuesFileName)
+			res.Empty = true
+			return []model.Issue{}, nil
+		}
 		return nil, fmt.Errorf("failed to load issues from %s: %w", repo.GetName(), err)
 	}
-	issues, err := loader.LoadIssuesFromFile(jsonlPath)
+	res.Path = jsonlPath
+	opts := l.parseOpts
+	opts.FormatHandler = func(f loader.Format) { res.Format = f }
+	if l.verbosity > 0 {
+		opts.WarningHandler = func(msg string) { res.Warnings = append(res.Warnings, msg) }
+	}
+	issues, err := loader.LoadIssuesFromFileWithOptions(jsonlPath, opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load issues from %s: %w", repo.GetName(), err)
 	}
@@ -172,18 +240,24 @@ func (l *AggregateLoader) loadSingleRepo(repo RepoConfig) ([]model.Issue, error)
 
 	// Apply namespacing to all IDs
 	prefix := repo.GetPrefix()
-	namespacedIssues := l.namespaceIssues(issues, prefix, localIDs)
+	namespacedIssues := l.namespaceIssues(issues, prefix, localIDs, res)
 
 	return namespacedIssues, nil
 }
 
-// namespaceIssues adds the prefix to all issue IDs and dependency references
+// namespaceIssues adds the prefix to all issue IDs and dependency references,
+// counting how each dependency was resolved in res. At verbosity 2 each
+// rewrite is also recorded there for logging.
 // It mutates the issues slice in place to reduce allocations.
-func (l *AggregateLoader) namespaceIssues(issues []model.Issue, prefix string, localIDs map[string]bool) []model.Issue {
+func (l *AggregateLoader) namespaceIssues(issues []model.Issue, prefix string, localIDs map[string]bool, res *LoadResult) []model.Issue {
 	for i := range issues {
 		// Mutate issue in place
 		issue := &issues[i]
+		localID := issue.ID
 		issue.ID = QualifyID(issue.ID, prefix)
+		if l.verbosity >= 2 && issue.ID != localID {
+			res.trace = append(res.trace, fmt.Sprintf("%s -> %s", localID, issue.ID))
+		}
 
 		// Namespace dependency references in place
 		for _, dep := range issue.Dependencies {
@@ -191,15 +265,25 @@ func (l *AggregateLoader) namespaceIssues(issues []model.Issue, prefix string, l
 				continue
 			}
 			dep.IssueID = QualifyID(dep.IssueID, prefix)
-			
+
 			// Resolve DependsOnID
+			target := dep.DependsOnID
+			how := "local"
 			if localIDs[dep.DependsOnID] {
 				dep.DependsOnID = QualifyID(dep.DependsOnID, prefix)
+				res.Rewrites.Local++
 			} else if l.hasKnownPrefix(dep.DependsOnID) {
 				// External reference, keep as is
+				res.Rewrites.External++
+				how = "external, kept"
 			} else {
 				// Assume local
 				dep.DependsOnID = QualifyID(dep.DependsOnID, prefix)
+				res.Rewrites.Assumed++
+				how = "unknown, assumed local"
+			}
+			if l.verbosity >= 2 {
+				res.trace = append(res.trace, fmt.Sprintf("%s depends on %s -> %s (%s)", issue.ID, target, dep.DependsOnID, how))
 			}
 		}
 
@@ -226,6 +310,53 @@ func (l *AggregateLoader) hasKnownPrefix(id string) bool {
 	return false
 }
 
+// logRepoTrace logs what loading repo did (see SetVerbosity).
+func (l *AggregateLoader) logRepoTrace(repo RepoConfig, res LoadResult) {
+	if l.verbosity <= 0 || l.logger == nil {
+		return
+	}
+	source := "from name"
+	if repo.Prefix != "" {
+		source = "configured"
+	}
+	name := res.RepoName
+	if base := filepath.Base(repo.Path); isDisambiguated(name, base) {
+		name = fmt.Sprintf("%s (disambiguated from %s)", name, base)
+	}
+	l.logger.Printf("%s: path %s, prefix %q (%s)", name, repo.Path, res.Prefix, source)
+	if res.Error != nil {
+		return // logRepoError reports it
+	}
+	if res.Empty {
+		l.logger.Printf("%s: no issues file yet; loaded as empty (new issues go to %s)", res.RepoName, res.Path)
+		return
+	}
+	l.logger.Printf("%s: loaded %d issues from %s (%s)", res.RepoName, len(res.Issues), res.Path, res.Format)
+	r := res.Rewrites
+	l.logger.Printf("%s: dependencies: %d local prefixed, %d external kept, %d unknown assumed local", res.RepoName, r.Local, r.External, r.Assumed)
+	for _, w := range res.Warnings {
+		l.logger.Printf("%s: %s", res.RepoName, w)
+	}
+	for _, line := range res.trace {
+		l.logger.Printf("%s:   %s", res.RepoName, line)
+	}
+}
+
+// isDisambiguated reports whether name is base with the "_2", "_3", ...
+// suffix given to repeated directory names.
+func isDisambiguated(name, base string) bool {
+	suffix, ok := strings.CutPrefix(name, base+"_")
+	if !ok || suffix == "" {
+		return false
+	}
+	for _, r := range suffix {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // logRepoError logs an error for a repo that failed to load
 func (l *AggregateLoader) logRepoError(repoName string, err error) {
 	if l.logger != nil {
@@ -235,15 +366,23 @@ func (l *AggregateLoader) logRepoError(repoName string, err error) {
 
 // LoadAllFromConfig is a convenience function that loads a workspace config and all its repos
 func LoadAllFromConfig(ctx context.Context, configPath string) ([]model.Issue, []LoadResult, error) {
+	loader, err := NewAggregateLoaderFromConfig(configPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return loader.LoadAll(ctx)
+}
+
+// NewAggregateLoaderFromConfig loads a workspace config and returns a loader
+// for its repos, rooted at the directory holding .bv/workspace.yaml
+func NewAggregateLoaderFromConfig(configPath string) (*AggregateLoader, error) {
 	config, err := LoadConfig(configPath)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to load workspace config: %w", err)
+		return nil, fmt.Errorf("failed to load workspace config: %w", err)
 	}
 
 	workspaceRoot := filepath.Dir(filepath.Dir(configPath)) // .bv/workspace.yaml -> workspace root
-	loader := NewAggregateLoader(config, workspaceRoot)
-
-	return loader.LoadAll(ctx)
+	return NewAggregateLoader(config, workspaceRoot), nil
 }
 
 // Summary returns a summary of load results
@@ -1,13 +1,17 @@
 package workspace_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/Dicklesworthstone/beads_viewer/pkg/loader"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/model"
 	"github.com/Dicklesworthstone/beads_viewer/pkg/workspace"
 )
@@ -161,6 +165,84 @@ func TestAggregateLoaderPartialFailure(t *testing.T) {
 	}
 }
 
+func TestAggregateLoaderAllowEmpty(t *testing.T) {
+	tmpDir := t.TempDir()
+	apiRepo := filepath.Join(tmpDir, "api")
+	createTestBeadsFile(t, apiRepo, []model.Issue{
+		{ID: "AUTH-1", Title: "Auth feature", Status: model.StatusOpen, Priority: 1},
+	})
+	// A brand-new project: .beads exists, no issues file yet
+	if err := os.MkdirAll(filepath.Join(tmpDir, "fresh", ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &workspace.Config{
+		Repos: []workspace.RepoConfig{
+			{Name: "api", Path: "api"},
+			{Name: "fresh", Path: "fresh"},
+			{Name: "gone", Path: "gone"}, // No .beads at all
+		},
+	}
+
+	l := workspace.NewAggregateLoader(config, tmpDir)
+	_, results, err := l.LoadAll(context.Background())
+	if err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+	if results[1].Error == nil {
+		t.Error("without SetAllowEmpty a project with no issues file should fail")
+	}
+
+	l.SetAllowEmpty(true)
+	issues, results, err := l.LoadAll(context.Background())
+	if err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+	if len(issues) != 1 {
+		t.Errorf("len(issues) = %d, want 1", len(issues))
+	}
+	fresh := results[1]
+	if fresh.Error != nil || !fresh.Empty || len(fresh.Issues) != 0 {
+		t.Errorf("fresh = %+v, want an empty load", fresh)
+	}
+	if want := filepath.Join(tmpDir, "fresh", ".beads", "beads.jsonl"); fresh.Path != want {
+		t.Errorf("fresh path = %q, want %q", fresh.Path, want)
+	}
+	if results[2].Error == nil {
+		t.Error("a project without .beads should still fail")
+	}
+	if summary := workspace.Summarize(results); summary.FailedRepos != 1 {
+		t.Errorf("failed repos = %d, want 1", summary.FailedRepos)
+	}
+}
+
+func TestAggregateLoaderParseOptions(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, "api", ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `{"id":"AUTH-1","title":"Auth feature","status":"open","issue_type":"task","priority":9}` + "\n"
+	if err := os.WriteFile(filepath.Join(beadsDir, "beads.jsonl"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &workspace.Config{Repos: []workspace.RepoConfig{{Name: "api", Path: "api"}}}
+	l := workspace.NewAggregateLoader(config, tmpDir)
+	backlog := 3
+	l.SetParseOptions(loader.ParseOptions{DefaultPriority: &backlog})
+	issues, results, err := l.LoadAll(context.Background())
+	if err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+	if len(issues) != 1 || issues[0].Priority != 3 || !issues[0].PriorityDefaulted {
+		t.Fatalf("issues = %+v, want AUTH-1 given the default P3", issues)
+	}
+	if results[0].Format != loader.FormatJSONL {
+		t.Errorf("format = %q, want the loader's FormatHandler to still run", results[0].Format)
+	}
+}
+
 func TestAggregateLoaderNamespacesDependencies(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -489,3 +571,115 @@ func TestAggregateLoaderCustomBeadsPath(t *testing.T) {
 		t.Errorf("expected namespaced ID svc-CUST-1, got %s", issues[0].ID)
 	}
 }
+
+func TestAggregateLoaderMixedFormats(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	apiRepo := filepath.Join(tmpDir, "api")
+	createTestBeadsFile(t, apiRepo, []model.Issue{
+		{ID: "AUTH-1", Title: "Auth feature", Priority: 1},
+	})
+
+	// The web repo keeps its issues as a single JSON array
+	webBeads := filepath.Join(tmpDir, "web", ".beads")
+	if err := os.MkdirAll(webBeads, 0755); err != nil {
+		t.Fatal(err)
+	}
+	array := `[
+  {"id":"UI-1","title":"UI feature","status":"open","priority":1,"issue_type":"task"},
+  {"id":"UI-2","title":"UI bug","status":"open","priority":2,"issue_type":"bug","dependencies":[{"issue_id":"UI-2","depends_on_id":"UI-1","type":"blocks"}]}
+]`
+	if err := os.WriteFile(filepath.Join(webBeads, "issues.json"), []byte(array), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &workspace.Config{
+		Repos: []workspace.RepoConfig{
+			{Name: "api", Path: "api", Prefix: "api-"},
+			{Name: "web", Path: "web", Prefix: "web-"},
+		},
+	}
+	issues, results, err := workspace.NewAggregateLoader(config, tmpDir).LoadAll(context.Background())
+	if err != nil {
+		t.Fatalf("LoadAll() error = %v", err)
+	}
+	if len(issues) != 3 {
+		t.Fatalf("len(issues) = %d, want 3", len(issues))
+	}
+	if results[0].Format != "jsonl" || results[1].Format != "json-array" {
+		t.Errorf("formats = %q, %q; want jsonl, json-array", results[0].Format, results[1].Format)
+	}
+	if filepath.Base(results[1].Path) != "issues.json" {
+		t.Errorf("web path = %q, want issues.json", results[1].Path)
+	}
+	for _, issue := range issues {
+		if issue.ID == "web-UI-2" && (len(issue.Dependencies) != 1 || issue.Dependencies[0].DependsOnID != "web-UI-1") {
+			t.Errorf("web-UI-2 dependencies = %+v, want namespaced web-UI-1", issue.Dependencies)
+		}
+	}
+}
+
+func TestAggregateLoaderVerboseLogging(t *testing.T) {
+	tmpDir := t.TempDir()
+	beadsDir := filepath.Join(tmpDir, "api", ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `{"id":"A-1","title":"One","status":"open","issue_type":"task","dependencies":[{"issue_id":"A-1","depends_on_id":"A-2","type":"blocks"},{"issue_id":"A-1","depends_on_id":"web-UI-1","type":"blocks"},{"issue_id":"A-1","depends_on_id":"gone","type":"blocks"}]}
+{"id":"A-2","title":"Two","status":"open","issue_type":"task"}
+{"id":"A-3","status":"open"}
+`
+	if err := os.WriteFile(filepath.Join(beadsDir, "beads.jsonl"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	config := &workspace.Config{
+		Repos: []workspace.RepoConfig{
+			{Name: "api_2", Path: "api"},
+			{Name: "web", Path: "missing", Prefix: "web-"},
+		},
+	}
+
+	load := func(level int) (string, []workspace.LoadResult) {
+		var buf bytes.Buffer
+		l := workspace.NewAggregateLoader(config, tmpDir)
+		l.SetLogger(log.New(&buf, "", 0))
+		l.SetVerbosity(level)
+		_, results, err := l.LoadAll(context.Background())
+		if err != nil {
+			t.Fatalf("LoadAll() error = %v", err)
+		}
+		return buf.String(), results
+	}
+
+	out, results := load(1)
+	if r := results[0].Rewrites; r.Local != 1 || r.External != 1 || r.Assumed != 1 {
+		t.Errorf("rewrites = %+v, want 1 of each", r)
+	}
+	for _, want := range []string{
+		`api_2 (disambiguated from api): path api, prefix "api_2-" (from name)`,
+		"api_2: loaded 2 issues from",
+		"api_2: dependencies: 1 local prefixed, 1 external kept, 1 unknown assumed local",
+		"api_2: skipping invalid issue on line 3",
+		`web: path missing, prefix "web-" (configured)`,
+		`Failed to load repo "web"`,
+		"Merged 2 issues from 2 repos",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("verbose log missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, " -> ") {
+		t.Errorf("per-record detail logged at level 1:\n%s", out)
+	}
+
+	out, _ = load(2)
+	for _, want := range []string{"A-1 -> api_2-A-1", "api_2-A-1 depends on gone -> api_2-gone (unknown, assumed local)", "depends on web-UI-1 -> web-UI-1 (external, kept)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("-vv log missing %q:\n%s", want, out)
+		}
+	}
+
+	if out, _ := load(0); strings.Contains(out, "api_2") {
+		t.Errorf("quiet load logged:\n%s", out)
+	}
+}
@@ -0,0 +1,126 @@
+package main_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+const danglingBeads = `{"id":"api-1","title":"Endpoint","status":"open","priority":1,"issue_type":"task"}
+{"id":"web-1","title":"Page","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"web-1","depends_on_id":"api-1","type":"blocks"},{"issue_id":"web-1","depends_on_id":"web-9","type":"blocks"}]}`
+
+func TestValidateReportsDanglingDeps(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, danglingBeads)
+
+	cmd := exec.Command(bv, "--validate", "--repo", "web")
+	cmd.Dir = env
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+		t.Fatalf("expected exit 1, got %v\n%s", err, out)
+	}
+	for _, want := range []string{`web-1 → api-1 (blocks): project "api" not loaded`, `web-1 → web-9 (blocks): not found in loaded project "web"`} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("report missing %q:\n%s", want, out)
+		}
+	}
+
+	// With both projects loaded only the missing ID remains
+	cmd = exec.Command(bv, "--validate")
+	cmd.Dir = env
+	out, _ = cmd.Output()
+	if strings.Contains(string(out), "not loaded") || !strings.Contains(string(out), "web-9") {
+		t.Errorf("unexpected report:\n%s", out)
+	}
+}
+
+func TestValidateReportsInvalidFields(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"api-1","title":"Endpoint","status":"open","priority":9,"issue_type":"task"}
+{"id":"api-2","title":"Loop","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"api-2","depends_on_id":"api-2","type":"blocks"}]}
+{"id":"api-3","title":"","status":"open","priority":1,"issue_type":"task"}`)
+
+	cmd := exec.Command(bv, "--validate")
+	cmd.Dir = env
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+		t.Fatalf("expected exit 1, got %v\n%s", err, out)
+	}
+	for _, want := range []string{"Invalid fields (2):", "api-1 priority: invalid priority: 9", "api-2 dependencies: issue depends on itself (blocks)"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("report missing %q:\n%s", want, out)
+		}
+	}
+	// The untitled issue is skipped at load, with a warning on stderr
+	if strings.Contains(string(out), "api-3") {
+		t.Errorf("skipped issue should not be reported:\n%s", out)
+	}
+}
+
+func TestValidateReportsDuplicateDependencies(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"api-1","title":"Endpoint","status":"open","priority":1,"issue_type":"task"}
+{"id":"api-2","title":"Client","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"api-2","depends_on_id":"api-1","type":"related"},{"issue_id":"api-2","depends_on_id":"api-1","type":"blocks"}]}`)
+
+	cmd := exec.Command(bv, "--validate")
+	cmd.Dir = env
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+		t.Fatalf("expected exit 1, got %v\n%s", err, out)
+	}
+	for _, want := range []string{"Duplicate dependencies (1):", "api-2 → api-1: 2 entries merged (related, blocks)"} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("report missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRobotOutputIncludesDanglingDeps(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, danglingBeads)
+
+	cmd := exec.Command(bv, "--robot-next", "--repo", "web")
+	cmd.Dir = env
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("--robot-next failed: %v", err)
+	}
+	var payload struct {
+		DanglingDeps []struct {
+			DependsOnID string `json:"depends_on_id"`
+			Reason      string `json:"reason"`
+		} `json:"dangling_deps"`
+	}
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	reasons := map[string]string{}
+	for _, d := range payload.DanglingDeps {
+		reasons[d.DependsOnID] = d.Reason
+	}
+	if reasons["api-1"] != "project_not_loaded" || reasons["web-9"] != "not_found" {
+		t.Errorf("dangling_deps = %+v", payload.DanglingDeps)
+	}
+
+	// A complete graph leaves the field out
+	clean := t.TempDir()
+	writeBeads(t, clean, `{"id":"A","title":"Solo","status":"open","priority":1,"issue_type":"task"}`)
+	cmd = exec.Command(bv, "--robot-next")
+	cmd.Dir = clean
+	out, err = cmd.Output()
+	if err != nil {
+		t.Fatalf("--robot-next failed: %v", err)
+	}
+	if strings.Contains(string(out), "dangling_deps") {
+		t.Errorf("expected no dangling_deps:\n%s", out)
+	}
+}
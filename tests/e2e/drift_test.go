@@ -110,6 +110,9 @@ func TestEndToEndDriftWorkflow(t *testing.T) {
 		t.Fatalf("Failed to parse JSON output: %v\n%s", err, outJson)
 	}
 
+	if _, ok := result["schema_version"].(string); !ok {
+		t.Error("JSON output should include schema_version")
+	}
 	if hasDrift, ok := result["has_drift"].(bool); !ok || !hasDrift {
 		t.Error("JSON output has_drift should be true")
 	}
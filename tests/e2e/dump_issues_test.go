@@ -0,0 +1,77 @@
+package main_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestDumpIssues_NewlineTerminatedJSONL(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"First","status":"open","priority":1,"issue_type":"task"}
+{"id":"B","title":"Second","status":"closed","priority":2,"issue_type":"bug"}`)
+
+	cmd := exec.Command(bv, "--dump-issues")
+	cmd.Dir = env
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("--dump-issues failed: %v", err)
+	}
+	if !bytes.HasSuffix(out, []byte("\n")) {
+		t.Fatalf("output does not end in a newline: %q", out)
+	}
+	lines := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), out)
+	}
+	for i, want := range []string{"A", "B"} {
+		var rec struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal([]byte(lines[i]), &rec); err != nil || rec.ID != want {
+			t.Errorf("line %d = %q (err %v), want issue %s", i, lines[i], err, want)
+		}
+	}
+}
+
+func TestDumpIssues_BrokenPipeExitsCleanly(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+
+	// Enough output to overflow the pipe buffer once the reader is gone
+	var content strings.Builder
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&content, `{"id":"ISS-%d","title":"Issue number %d with some padding text","status":"open","priority":2,"issue_type":"task"}`+"\n", i, i)
+	}
+	writeBeads(t, env, content.String())
+
+	cmd := exec.Command(bv, "--dump-issues")
+	cmd.Dir = env
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatalf("stdout pipe: %v", err)
+	}
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("start: %v", err)
+	}
+
+	// Behave like `head -1`: read one line, then hang up
+	if _, err := bufio.NewReader(stdout).ReadString('\n'); err != nil {
+		t.Fatalf("read first line: %v", err)
+	}
+	stdout.Close()
+
+	if err := cmd.Wait(); err != nil {
+		t.Fatalf("exit after reader closed: %v\nstderr:\n%s", err, stderr.String())
+	}
+	if strings.Contains(stderr.String(), "panic") || strings.Contains(stderr.String(), "broken pipe") {
+		t.Errorf("stderr should be quiet on a closed pipe:\n%s", stderr.String())
+	}
+}
@@ -0,0 +1,64 @@
+package main_test
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIgnoreRulesHideIssuesUnlessShowIgnored(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"Live","status":"open","priority":1,"issue_type":"task"}
+{"id":"B","title":"Parked","status":"wontfix","priority":1,"issue_type":"task"}
+{"id":"C","title":"Iced","status":"open","priority":1,"issue_type":"task","labels":["ignore"]}`)
+	xdg := filepath.Join(env, "xdg")
+	if err := os.MkdirAll(filepath.Join(xdg, "bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(xdg, "bv", "display.yaml"), []byte("ignore_statuses: [wontfix]\nignore_labels: [ignore]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(args ...string) []byte {
+		cmd := exec.Command(bv, args...)
+		cmd.Dir = env
+		cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+xdg)
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("bv %v failed: %v", args, err)
+		}
+		return out
+	}
+
+	var triage struct {
+		IgnoredCount int `json:"ignored_count"`
+		Triage       struct {
+			QuickRef struct {
+				OpenCount int `json:"open_count"`
+			} `json:"quick_ref"`
+		} `json:"triage"`
+	}
+	if err := json.Unmarshal(run("--robot-triage"), &triage); err != nil {
+		t.Fatal(err)
+	}
+	if triage.IgnoredCount != 2 || triage.Triage.QuickRef.OpenCount != 1 {
+		t.Errorf("ignored_count=%d open_count=%d, want 2 and 1", triage.IgnoredCount, triage.Triage.QuickRef.OpenCount)
+	}
+
+	// --show-ignored brings both back, including the custom status
+	lines := strings.Split(strings.TrimSpace(string(run("--dump-issues", "--show-ignored"))), "\n")
+	if len(lines) != 3 {
+		t.Errorf("--show-ignored dumped %d issues, want 3", len(lines))
+	}
+	if out := run("--robot-next", "--show-ignored"); strings.Contains(string(out), "ignored_count") {
+		t.Errorf("--show-ignored should not report ignored issues:\n%s", out)
+	}
+	// ...and --validate accepts it rather than flagging an invalid status
+	if out := run("--validate", "--show-ignored"); strings.Contains(string(out), "invalid status") {
+		t.Errorf("--validate flagged the ignored status:\n%s", out)
+	}
+}
@@ -80,6 +80,9 @@ func TestEndToEndRobotPlan(t *testing.T) {
 	if _, ok := plan["summary"]; !ok {
 		t.Error("--robot-plan output missing 'plan.summary' field")
 	}
+	if _, ok := plan["track_order"].([]interface{}); !ok {
+		t.Errorf("'plan.track_order' is not an array: %T", plan["track_order"])
+	}
 
 	// 7. Verify tracks is an array
 	tracks, ok := plan["tracks"].([]interface{})
@@ -91,6 +94,16 @@ func TestEndToEndRobotPlan(t *testing.T) {
 	if len(tracks) == 0 {
 		t.Error("Expected at least one track in execution plan")
 	}
+	for _, raw := range tracks {
+		track, _ := raw.(map[string]interface{})
+		if _, ok := track["risk"].(float64); !ok {
+			t.Errorf("track %v missing numeric 'risk'", track["track_id"])
+		}
+	}
+	summary, _ := plan["summary"].(map[string]interface{})
+	if id, _ := summary["riskiest_track"].(string); len(tracks) > 0 && id == "" {
+		t.Error("'plan.summary.riskiest_track' is empty")
+	}
 }
 
 func TestEndToEndRobotInsights(t *testing.T) {
@@ -263,6 +263,119 @@ func TestMultiProject_LoadSavedProjects(t *testing.T) {
 	}
 }
 
+// TestMultiProject_SaveProjectsRelative verifies --project-path-mode config
+// stores paths relative to projects.yaml and resolves them on load
+func TestMultiProject_SaveProjectsRelative(t *testing.T) {
+	bv := buildBvBinary(t)
+	root := t.TempDir()
+	configDir := filepath.Join(root, "config")
+
+	apiDir := createTestProject(t, root, "api", []string{"API Task"})
+	webDir := createTestProject(t, root, "web", []string{"Web Task"})
+
+	cmd := exec.Command(bv, "--project", apiDir, "--project", webDir,
+		"--save-projects", "--project-path-mode", "config", "--robot-triage")
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+configDir)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("bv failed: %v\n%s", err, out)
+	}
+
+	data, err := os.ReadFile(filepath.Join(configDir, "bv", "projects.yaml"))
+	if err != nil {
+		t.Fatalf("projects.yaml not created: %v", err)
+	}
+	if !strings.Contains(string(data), `path: "../../api"`) || strings.Contains(string(data), root) {
+		t.Fatalf("expected relative paths in projects.yaml, got:\n%s", data)
+	}
+
+	// Load from an unrelated working directory
+	cmd = exec.Command(bv, "--robot-triage")
+	cmd.Dir = t.TempDir()
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+configDir)
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("bv failed: %v\n%s", err, out)
+	}
+
+	var result struct {
+		Triage struct {
+			QuickRef struct {
+				OpenCount int `json:"open_count"`
+			} `json:"quick_ref"`
+		} `json:"triage"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if result.Triage.QuickRef.OpenCount != 2 {
+		t.Errorf("expected 2 open issues from relative projects, got %d", result.Triage.QuickRef.OpenCount)
+	}
+}
+
+// TestMultiProject_Profiles verifies --profile saves and loads a named
+// project set without touching projects.yaml
+func TestMultiProject_Profiles(t *testing.T) {
+	bv := buildBvBinary(t)
+	baseDir := t.TempDir()
+	configDir := t.TempDir()
+
+	apiDir := createTestProject(t, baseDir, "api", []string{"API Task"})
+	webDir := createTestProject(t, baseDir, "web", []string{"Web Task", "Web Task 2"})
+	run := func(args ...string) ([]byte, error) {
+		cmd := exec.Command(bv, args...)
+		cmd.Dir = t.TempDir()
+		cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+configDir)
+		return cmd.CombinedOutput()
+	}
+	openCount := func(out []byte) int {
+		t.Helper()
+		var result struct {
+			Triage struct {
+				QuickRef struct {
+					OpenCount int `json:"open_count"`
+				} `json:"quick_ref"`
+			} `json:"triage"`
+		}
+		if err := json.Unmarshal(out, &result); err != nil {
+			t.Fatalf("invalid JSON: %v\n%s", err, out)
+		}
+		return result.Triage.QuickRef.OpenCount
+	}
+
+	if out, err := run("--project", apiDir, "--save-projects", "--profile", "work", "--robot-triage"); err != nil {
+		t.Fatalf("save work: %v\n%s", err, out)
+	}
+	if out, err := run("--project", webDir, "--save-projects", "--profile", "oss", "--robot-triage"); err != nil {
+		t.Fatalf("save oss: %v\n%s", err, out)
+	}
+	if _, err := os.Stat(filepath.Join(configDir, "bv", "profiles", "work.yaml")); err != nil {
+		t.Fatalf("work.yaml not created: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(configDir, "bv", "projects.yaml")); !os.IsNotExist(err) {
+		t.Error("saving a profile should not write projects.yaml")
+	}
+
+	cmd := exec.Command(bv, "--profile", "oss", "--robot-triage")
+	cmd.Dir = t.TempDir()
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+configDir)
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("load oss: %v\n%s", err, out)
+	}
+	if got := openCount(out); got != 2 {
+		t.Errorf("oss profile: expected 2 open issues, got %d", got)
+	}
+
+	// An unknown profile fails and lists the saved ones
+	out, err = run("--profile", "home", "--robot-triage")
+	if err == nil {
+		t.Fatalf("expected unknown profile to fail\n%s", out)
+	}
+	if !strings.Contains(string(out), "Available profiles: oss, work") {
+		t.Errorf("expected available profiles in error, got:\n%s", out)
+	}
+}
+
 // TestMultiProject_ClearProjects verifies --clear-projects removes config
 func TestMultiProject_ClearProjects(t *testing.T) {
 	bv := buildBvBinary(t)
@@ -283,12 +396,26 @@ func TestMultiProject_ClearProjects(t *testing.T) {
 		t.Fatal("projects.yaml should exist before clear")
 	}
 
-	// Run bv --clear-projects
+	// Without a TTY, --clear-projects refuses unless --yes is given
 	cmd := exec.Command(bv, "--clear-projects")
 	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+configDir)
 	out, err := cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("expected --clear-projects without --yes to fail when not a TTY\n%s", out)
+	}
+	if !strings.Contains(string(out), "--yes") {
+		t.Errorf("expected refusal to mention --yes, got:\n%s", out)
+	}
+	if _, err := os.Stat(projectsPath); err != nil {
+		t.Fatalf("projects.yaml should survive a refused clear: %v", err)
+	}
+
+	// Run bv --clear-projects --yes
+	cmd = exec.Command(bv, "--clear-projects", "--yes")
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+configDir)
+	out, err = cmd.CombinedOutput()
 	if err != nil {
-		t.Fatalf("bv --clear-projects failed: %v\n%s", err, out)
+		t.Fatalf("bv --clear-projects --yes failed: %v\n%s", err, out)
 	}
 
 	// Verify file is removed
@@ -451,3 +578,228 @@ func TestMultiProject_CrossProjectDependencies(t *testing.T) {
 		t.Error("expected 'web-WEB-1' in plan output")
 	}
 }
+
+// TestMultiProject_IsolateProject verifies --robot-plan --isolate reports
+// issues stranded by removing a project
+func TestMultiProject_IsolateProject(t *testing.T) {
+	bv := buildBvBinary(t)
+	baseDir := t.TempDir()
+
+	apiDir := createTestProjectWithIssueID(t, baseDir, "api", "API-1", "API Endpoint")
+
+	webDir := filepath.Join(baseDir, "web")
+	beadsDir := filepath.Join(webDir, ".beads")
+	if err := os.MkdirAll(beadsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	webIssues := strings.Join([]string{
+		`{"id":"WEB-1","title":"Web Dashboard","status":"open","priority":1,"issue_type":"task","dependencies":[{"depends_on_id":"api-API-1","type":"blocks"}]}`,
+		`{"id":"WEB-2","title":"Dashboard Polish","status":"open","priority":2,"issue_type":"task","dependencies":[{"depends_on_id":"WEB-1","type":"blocks"}]}`,
+		`{"id":"WEB-3","title":"Standalone","status":"open","priority":2,"issue_type":"task"}`,
+	}, "\n")
+	if err := os.WriteFile(filepath.Join(beadsDir, "beads.jsonl"), []byte(webIssues), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(bv, "--project", apiDir, "--project", webDir, "--robot-plan", "--isolate", "api")
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("bv failed: %v\n%s", err, out)
+	}
+
+	var result struct {
+		Isolation *struct {
+			Project       string `json:"project"`
+			RemovedIssues int    `json:"removed_issues"`
+			WouldBlock    []struct {
+				ID        string   `json:"id"`
+				BlockedBy []string `json:"blocked_by"`
+				Depth     int      `json:"depth"`
+			} `json:"would_block"`
+		} `json:"isolation"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if result.Isolation == nil {
+		t.Fatalf("missing isolation field\n%s", out)
+	}
+	if result.Isolation.RemovedIssues != 1 {
+		t.Errorf("removed_issues = %d, want 1", result.Isolation.RemovedIssues)
+	}
+	if len(result.Isolation.WouldBlock) != 2 {
+		t.Fatalf("would_block = %+v, want web-WEB-1 and web-WEB-2", result.Isolation.WouldBlock)
+	}
+	first := result.Isolation.WouldBlock[0]
+	if first.ID != "web-WEB-1" || first.Depth != 1 || len(first.BlockedBy) != 1 || first.BlockedBy[0] != "api-API-1" {
+		t.Errorf("unexpected direct entry: %+v", first)
+	}
+	if second := result.Isolation.WouldBlock[1]; second.ID != "web-WEB-2" || second.Depth != 2 {
+		t.Errorf("unexpected transitive entry: %+v", second)
+	}
+}
+
+// TestMultiProject_VerboseLogsToStderr verifies --verbose and -vv explain
+// the merge on stderr without touching robot stdout
+func TestMultiProject_VerboseLogsToStderr(t *testing.T) {
+	bv := buildBvBinary(t)
+	baseDir := t.TempDir()
+
+	projA := createTestProject(t, filepath.Join(baseDir, "a"), "myproject", []string{"Task A"})
+	projB := createTestProject(t, filepath.Join(baseDir, "b"), "myproject", []string{"Task B"})
+
+	run := func(flag string) (string, string) {
+		cmd := exec.Command(bv, "--project", projA, "--project", projB, flag, "--robot-triage")
+		var stderr strings.Builder
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("bv %s failed: %v\n%s", flag, err, stderr.String())
+		}
+		var result map[string]interface{}
+		if err := json.Unmarshal(out, &result); err != nil {
+			t.Fatalf("stdout is not JSON with %s: %v\n%s", flag, err, out)
+		}
+		return string(out), stderr.String()
+	}
+
+	_, stderr := run("--verbose")
+	for _, want := range []string{
+		`myproject_2 (disambiguated from myproject): path ` + projB + `, prefix "myproject_2-" (from name)`,
+		"myproject: loaded 1 issues from " + filepath.Join(projA, ".beads", "beads.jsonl") + " (JSONL)",
+		"Merged 2 issues from 2 repos",
+	} {
+		if !strings.Contains(stderr, want) {
+			t.Errorf("--verbose stderr missing %q:\n%s", want, stderr)
+		}
+	}
+	if strings.Contains(stderr, "MYPROJECT-1 -> ") {
+		t.Errorf("--verbose logged per-record detail:\n%s", stderr)
+	}
+
+	if _, stderr := run("-vv"); !strings.Contains(stderr, "myproject_2:   MYPROJECT-1 -> myproject_2-MYPROJECT-1") {
+		t.Errorf("-vv stderr missing the namespaced ID:\n%s", stderr)
+	}
+}
+
+func TestMultiProject_DiffConfig(t *testing.T) {
+	bv := buildBvBinary(t)
+	baseDir := t.TempDir()
+
+	apiDir := createTestProject(t, baseDir, "api", []string{"API Task"})
+	webDir := createTestProject(t, baseDir, "web", []string{"Web Task"})
+	docsDir := createTestProject(t, baseDir, "docs", []string{"Guide", "FAQ"})
+
+	writeConfig := func(name string, dirs ...string) string {
+		var sb strings.Builder
+		sb.WriteString("projects:\n")
+		for _, dir := range dirs {
+			sb.WriteString("  - path: " + dir + "\n")
+		}
+		path := filepath.Join(baseDir, name)
+		if err := os.WriteFile(path, []byte(sb.String()), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	oldConfig := writeConfig("old.yaml", apiDir, webDir)
+	newConfig := writeConfig("new.yaml", apiDir, docsDir)
+
+	// No project in the working directory is needed
+	cmd := exec.Command(bv, "--diff-config", oldConfig, "--diff-config-to", newConfig, "--robot-diff")
+	cmd.Dir = t.TempDir()
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+t.TempDir())
+	var stderr strings.Builder
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("bv --diff-config failed: %v\n%s", err, stderr.String())
+	}
+
+	var result struct {
+		FromConfig string `json:"from_config"`
+		Diff       struct {
+			AddedProjects   []struct{ Name string } `json:"added_projects"`
+			RemovedProjects []struct{ Name string } `json:"removed_projects"`
+			AddedIssues     []string                `json:"added_issues"`
+			RemovedIssues   []string                `json:"removed_issues"`
+			Summary         map[string]int          `json:"summary"`
+		} `json:"diff"`
+	}
+	if err := json.Unmarshal(out, &result); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if result.FromConfig != oldConfig {
+		t.Errorf("from_config = %q, want %q", result.FromConfig, oldConfig)
+	}
+	if len(result.Diff.AddedProjects) != 1 || result.Diff.AddedProjects[0].Name != "docs" {
+		t.Errorf("added_projects = %+v, want docs", result.Diff.AddedProjects)
+	}
+	if len(result.Diff.RemovedProjects) != 1 || result.Diff.RemovedProjects[0].Name != "web" {
+		t.Errorf("removed_projects = %+v, want web", result.Diff.RemovedProjects)
+	}
+	if len(result.Diff.AddedIssues) != 2 || len(result.Diff.RemovedIssues) != 1 {
+		t.Errorf("added %v, removed %v; want the docs and web issues", result.Diff.AddedIssues, result.Diff.RemovedIssues)
+	}
+	if s := result.Diff.Summary; s["projects_unchanged"] != 1 || s["projects_changed"] != 0 {
+		t.Errorf("summary = %v, want api unchanged", s)
+	}
+
+	// A project renamed in the new file is matched by its path
+	renamed := filepath.Join(baseDir, "renamed.yaml")
+	renamedYAML := "projects:\n  - path: " + apiDir + "\n    name: backend\n  - path: " + webDir + "\n"
+	if err := os.WriteFile(renamed, []byte(renamedYAML), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cmd = exec.Command(bv, "--diff-config", oldConfig, "--diff-config-to", renamed, "--robot-diff")
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+t.TempDir())
+	out, err = cmd.Output()
+	if err != nil {
+		t.Fatalf("bv --diff-config (renamed) failed: %v", err)
+	}
+	result.Diff.AddedProjects, result.Diff.RemovedProjects = nil, nil
+	if err := json.Unmarshal(out, &result); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(result.Diff.AddedProjects) != 0 || len(result.Diff.RemovedProjects) != 0 || result.Diff.Summary["projects_unchanged"] != 2 {
+		t.Errorf("renamed project not matched by path: %s", out)
+	}
+
+	// The second file is required
+	cmd = exec.Command(bv, "--diff-config", oldConfig)
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+t.TempDir())
+	if out, err := cmd.CombinedOutput(); err == nil || !strings.Contains(string(out), "needs two files") {
+		t.Errorf("--diff-config with one file: err=%v\n%s", err, out)
+	}
+}
+
+func TestMultiProject_EmptyProjectLoads(t *testing.T) {
+	bv := buildBvBinary(t)
+	baseDir := t.TempDir()
+
+	apiDir := createTestProject(t, baseDir, "api", []string{"API Task"})
+	freshDir := filepath.Join(baseDir, "fresh")
+	if err := os.MkdirAll(filepath.Join(freshDir, ".beads"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	run := func(extra ...string) string {
+		args := append([]string{"--project", apiDir, "--project", freshDir, "--robot-triage", "--verbose"}, extra...)
+		cmd := exec.Command(bv, args...)
+		var stderr strings.Builder
+		cmd.Stderr = &stderr
+		if out, err := cmd.Output(); err != nil {
+			t.Fatalf("bv %v failed: %v\n%s", extra, err, stderr.String())
+		} else if !json.Valid(out) {
+			t.Fatalf("stdout is not JSON: %s", out)
+		}
+		return stderr.String()
+	}
+
+	if stderr := run(); !strings.Contains(stderr, "fresh: no issues file yet; loaded as empty") || strings.Contains(stderr, "Failed to load") {
+		t.Errorf("a project without an issues file should load as empty:\n%s", stderr)
+	}
+	if stderr := run("--strict"); !strings.Contains(stderr, `Failed to load repo "fresh"`) {
+		t.Errorf("--strict should fail the empty project:\n%s", stderr)
+	}
+}
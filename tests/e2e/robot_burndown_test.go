@@ -57,6 +57,7 @@ func TestRobotBurndown_CurrentSprint(t *testing.T) {
 	}
 
 	var payload struct {
+		SchemaVersion   string `json:"schema_version"`
 		SprintID        string `json:"sprint_id"`
 		TotalDays       int    `json:"total_days"`
 		ElapsedDays     int    `json:"elapsed_days"`
@@ -75,6 +76,9 @@ func TestRobotBurndown_CurrentSprint(t *testing.T) {
 		t.Fatalf("json decode: %v\nout=%s", err, out)
 	}
 
+	if payload.SchemaVersion == "" {
+		t.Fatal("missing schema_version")
+	}
 	if payload.SprintID != "sprint-1" {
 		t.Fatalf("sprint_id=%q; want sprint-1", payload.SprintID)
 	}
@@ -2,9 +2,12 @@ package main_test
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -32,10 +35,17 @@ func runRobotJSON(t *testing.T, bv, dir string, flag string, v any) {
 }
 
 // runCommand is a tiny helper to exec the bv binary with a single flag.
+// It returns stdout only, so JSON decoding fails if anything else leaks
+// there; stderr is folded into the error on failure.
 func runCommand(bv, dir, flag string) ([]byte, error) {
 	cmd := execCommand(bv, flag)
 	cmd.Dir = dir
-	return cmd.CombinedOutput()
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		err = fmt.Errorf("%w\nstderr=%s", err, exitErr.Stderr)
+	}
+	return out, err
 }
 
 // execCommand is defined in other e2e tests; redeclare wrapper to avoid imports.
@@ -513,3 +523,330 @@ func TestRobotUsageHintsPresent(t *testing.T) {
 		})
 	}
 }
+
+func TestRobotSchemaVersionPresent(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"Test","status":"open","priority":1,"issue_type":"task","labels":["api"]}`)
+
+	// Modes that need git history, sprints, or a baseline assert
+	// schema_version in their own e2e tests.
+	tests := [][]string{
+		{"--robot-insights"},
+		{"--robot-plan"},
+		{"--robot-priority"},
+		{"--robot-triage"},
+		{"--robot-triage-by-track"},
+		{"--robot-triage-by-label"},
+		{"--robot-next"},
+		{"--robot-recipes"},
+		{"--robot-label-health"},
+		{"--robot-label-flow"},
+		{"--robot-label-attention"},
+		{"--robot-stats"},
+		{"--robot-alerts"},
+		{"--robot-inversions"},
+		{"--robot-deadends"},
+		{"--robot-zombies"},
+		{"--robot-ready-wait"},
+		{"--robot-duplicates"},
+		{"--robot-bottlenecks"},
+		{"--robot-stale"},
+		{"--robot-compare-projects"},
+		{"--robot-similar"},
+		{"--robot-explain", "A"},
+		{"--robot-suggest"},
+		{"--robot-graph"},
+		{"--robot-sprint-list"},
+		{"--robot-forecast", "all"},
+		{"--robot-capacity"},
+		{"--robot-search", "--search", "test"},
+	}
+
+	for _, args := range tests {
+		t.Run(args[0], func(t *testing.T) {
+			cmd := execCommand(bv, args...)
+			cmd.Dir = env
+			out, err := cmd.Output()
+			if err != nil {
+				t.Fatalf("%v failed: %v\n%s", args, err, out)
+			}
+			var payload map[string]any
+			if err := json.Unmarshal(out, &payload); err != nil {
+				t.Fatalf("%v json decode: %v\nout=%s", args, err, out)
+			}
+			if v, ok := payload["schema_version"].(string); !ok || v == "" {
+				t.Fatalf("%v missing schema_version", args)
+			}
+		})
+	}
+}
+
+func TestRobotInversionsContract(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"Launch","status":"open","priority":0,"issue_type":"task","dependencies":[{"issue_id":"A","depends_on_id":"B","type":"blocks"}]}
+{"id":"B","title":"Cleanup","status":"open","priority":3,"issue_type":"task"}
+{"id":"C","title":"Minor","status":"open","priority":3,"issue_type":"task","dependencies":[{"issue_id":"C","depends_on_id":"B","type":"blocks"}]}`)
+
+	var payload struct {
+		DataHash   string `json:"data_hash"`
+		Count      int    `json:"count"`
+		Inversions []struct {
+			BlockedID         string `json:"blocked_id"`
+			BlockedPriority   int    `json:"blocked_priority"`
+			BlockerID         string `json:"blocker_id"`
+			BlockerPriority   int    `json:"blocker_priority"`
+			SuggestedPriority int    `json:"suggested_priority"`
+		} `json:"inversions"`
+	}
+	runRobotJSON(t, bv, env, "--robot-inversions", &payload)
+
+	if payload.DataHash == "" {
+		t.Fatal("robot-inversions missing data_hash")
+	}
+	if payload.Count != 1 || len(payload.Inversions) != 1 {
+		t.Fatalf("expected exactly one inversion, got %+v", payload)
+	}
+	inv := payload.Inversions[0]
+	if inv.BlockedID != "A" || inv.BlockedPriority != 0 || inv.BlockerID != "B" || inv.BlockerPriority != 3 || inv.SuggestedPriority != 0 {
+		t.Fatalf("unexpected inversion: %+v", inv)
+	}
+}
+
+func TestRobotBottlenecksContract(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"Core","status":"open","priority":2,"issue_type":"task"}
+{"id":"B","title":"Mid","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"blocks"}]}
+{"id":"C","title":"Leaf","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"C","depends_on_id":"B","type":"blocks"}]}
+{"id":"D","title":"Done","status":"closed","priority":1,"issue_type":"task","dependencies":[{"issue_id":"D","depends_on_id":"A","type":"blocks"}]}`)
+
+	var payload struct {
+		DataHash    string `json:"data_hash"`
+		Count       int    `json:"count"`
+		Bottlenecks []struct {
+			ID            string `json:"id"`
+			UnblocksCount int    `json:"unblocks_count"`
+			DirectCount   int    `json:"direct_count"`
+		} `json:"bottlenecks"`
+	}
+	runRobotJSON(t, bv, env, "--robot-bottlenecks", &payload)
+
+	if payload.DataHash == "" {
+		t.Fatal("robot-bottlenecks missing data_hash")
+	}
+	if payload.Count != 2 || len(payload.Bottlenecks) != 2 {
+		t.Fatalf("expected two bottlenecks, got %+v", payload)
+	}
+	top := payload.Bottlenecks[0]
+	if top.ID != "A" || top.UnblocksCount != 2 || top.DirectCount != 1 {
+		t.Fatalf("unexpected top bottleneck: %+v", top)
+	}
+}
+
+func TestRobotStaleContract(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"Ancient","status":"open","priority":3,"issue_type":"task","updated_at":"2020-01-01T00:00:00Z"}
+{"id":"B","title":"Needed","status":"open","priority":2,"issue_type":"task","updated_at":"2020-01-01T00:00:00Z"}
+{"id":"C","title":"Waiter","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"C","depends_on_id":"B","type":"blocks"}]}`)
+
+	var payload struct {
+		DataHash string `json:"data_hash"`
+		Count    int    `json:"count"`
+		Stale    []struct {
+			ID         string `json:"id"`
+			Dependents int    `json:"dependents"`
+		} `json:"stale"`
+		SuggestClose []struct {
+			ID string `json:"id"`
+		} `json:"suggest_close"`
+	}
+	runRobotJSON(t, bv, env, "--robot-stale", &payload)
+
+	if payload.DataHash == "" {
+		t.Fatal("robot-stale missing data_hash")
+	}
+	if payload.Count != 2 || len(payload.Stale) != 2 {
+		t.Fatalf("expected A and B stale, got %+v", payload)
+	}
+	if len(payload.SuggestClose) != 1 || payload.SuggestClose[0].ID != "A" {
+		t.Fatalf("expected only A suggested for closing (B has a dependent), got %+v", payload.SuggestClose)
+	}
+}
+
+func TestRobotDuplicatesContract(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"Cannot log in","status":"open","priority":1,"issue_type":"bug"}
+{"id":"B","title":"Login broken","status":"open","priority":1,"issue_type":"bug","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"duplicate"}]}
+{"id":"C","title":"Auth docs","status":"open","priority":2,"issue_type":"task","dependencies":[{"issue_id":"C","depends_on_id":"A","type":"related"}]}`)
+
+	var payload struct {
+		DataHash   string `json:"data_hash"`
+		Count      int    `json:"count"`
+		OpenCount  int    `json:"open_count"`
+		Duplicates []struct {
+			IssueID       string `json:"issue_id"`
+			DuplicateOfID string `json:"duplicate_of_id"`
+			BothOpen      bool   `json:"both_open"`
+		} `json:"duplicates"`
+	}
+	runRobotJSON(t, bv, env, "--robot-duplicates", &payload)
+
+	if payload.DataHash == "" {
+		t.Fatal("robot-duplicates missing data_hash")
+	}
+	if payload.Count != 1 || payload.OpenCount != 1 || len(payload.Duplicates) != 1 {
+		t.Fatalf("expected exactly one open duplicate link, got %+v", payload)
+	}
+	if d := payload.Duplicates[0]; d.IssueID != "B" || d.DuplicateOfID != "A" || !d.BothOpen {
+		t.Errorf("unexpected duplicate link: %+v", d)
+	}
+}
+
+func TestRobotDeadendsContract(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"Old API","status":"wontfix","priority":2,"issue_type":"task"}
+{"id":"B","title":"Spike","status":"closed","priority":2,"issue_type":"task","close_reason":"Cancelled: approach dropped"}
+{"id":"C","title":"Shipped","status":"closed","priority":2,"issue_type":"task","close_reason":"Done"}
+{"id":"D","title":"Migrate","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"D","depends_on_id":"A","type":"blocks"},{"issue_id":"D","depends_on_id":"C","type":"blocks"}]}
+{"id":"E","title":"Follow-up","status":"open","priority":0,"issue_type":"task","dependencies":[{"issue_id":"E","depends_on_id":"B","type":"blocks"}]}
+{"id":"F","title":"Ready","status":"open","priority":0,"issue_type":"task","dependencies":[{"issue_id":"F","depends_on_id":"C","type":"blocks"}]}`)
+
+	var payload struct {
+		DataHash string `json:"data_hash"`
+		Count    int    `json:"count"`
+		DeadEnds []struct {
+			ID       string `json:"id"`
+			Blockers []struct {
+				ID          string `json:"id"`
+				Status      string `json:"status"`
+				CloseReason string `json:"close_reason"`
+			} `json:"blockers"`
+		} `json:"dead_ends"`
+	}
+	runRobotJSON(t, bv, env, "--robot-deadends", &payload)
+
+	if payload.DataHash == "" {
+		t.Fatal("robot-deadends missing data_hash")
+	}
+	if payload.Count != 2 || len(payload.DeadEnds) != 2 || payload.DeadEnds[0].ID != "E" || payload.DeadEnds[1].ID != "D" {
+		t.Fatalf("expected dead ends E then D, got %+v", payload)
+	}
+	if b := payload.DeadEnds[0].Blockers; len(b) != 1 || b[0].ID != "B" || b[0].CloseReason == "" {
+		t.Errorf("E blockers = %+v, want B with its close reason", b)
+	}
+	if b := payload.DeadEnds[1].Blockers; len(b) != 1 || b[0].ID != "A" || b[0].Status != "wontfix" {
+		t.Errorf("D blockers = %+v, want only A (wontfix)", b)
+	}
+}
+
+func TestRobotZombiesContract(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	// api-99 was deleted; docs-1 is in a project that isn't loaded
+	writeBeads(t, env, `{"id":"api-1","title":"Endpoint","status":"open","priority":1,"issue_type":"task"}
+{"id":"web-1","title":"Page","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"web-1","depends_on_id":"api-99","type":"blocks"},{"issue_id":"web-1","depends_on_id":"docs-1","type":"blocks"}]}
+{"id":"web-2","title":"Form","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"web-2","depends_on_id":"api-99","type":"blocks"},{"issue_id":"web-2","depends_on_id":"api-1","type":"blocks"}]}`)
+
+	var payload struct {
+		DataHash string `json:"data_hash"`
+		Count    int    `json:"count"`
+		Zombies  []struct {
+			ID             string   `json:"id"`
+			Project        string   `json:"project"`
+			ReferencedBy   []string `json:"referenced_by"`
+			ReferenceCount int      `json:"reference_count"`
+		} `json:"zombies"`
+	}
+	runRobotJSON(t, bv, env, "--robot-zombies", &payload)
+
+	if payload.DataHash == "" {
+		t.Fatal("robot-zombies missing data_hash")
+	}
+	if payload.Count != 1 || len(payload.Zombies) != 1 {
+		t.Fatalf("expected only api-99 (docs is not loaded), got %+v", payload)
+	}
+	if z := payload.Zombies[0]; z.ID != "api-99" || z.Project != "api" || z.ReferenceCount != 2 || len(z.ReferencedBy) != 2 {
+		t.Errorf("zombie = %+v, want api-99 referenced by web-1 and web-2", z)
+	}
+}
+
+func TestRobotReadyWaitContract(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	// B was unblocked when A closed; C is still waiting on B; D never had a blocker
+	writeBeads(t, env, `{"id":"A","title":"Schema","status":"closed","priority":1,"issue_type":"task","created_at":"2025-01-01T00:00:00Z","closed_at":"2025-03-01T00:00:00Z"}
+{"id":"B","title":"API","status":"open","priority":1,"issue_type":"task","created_at":"2025-01-02T00:00:00Z","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"blocks"}]}
+{"id":"C","title":"UI","status":"open","priority":1,"issue_type":"task","created_at":"2025-01-03T00:00:00Z","dependencies":[{"issue_id":"C","depends_on_id":"B","type":"blocks"}]}
+{"id":"D","title":"Docs","status":"open","priority":2,"issue_type":"task","created_at":"2025-02-01T00:00:00Z"}
+{"id":"E","title":"Started","status":"in_progress","priority":1,"issue_type":"task","created_at":"2025-01-01T00:00:00Z"}`)
+
+	var payload struct {
+		DataHash   string `json:"data_hash"`
+		Count      int    `json:"count"`
+		ReadyWaits []struct {
+			ID          string `json:"id"`
+			ReadySince  string `json:"ready_since"`
+			Source      string `json:"source"`
+			LastBlocker string `json:"last_blocker"`
+			WaitDays    int    `json:"wait_days"`
+		} `json:"ready_waits"`
+	}
+	runRobotJSON(t, bv, env, "--robot-ready-wait", &payload)
+
+	if payload.DataHash == "" {
+		t.Fatal("robot-ready-wait missing data_hash")
+	}
+	if payload.Count != 2 || len(payload.ReadyWaits) != 2 {
+		t.Fatalf("expected D and B, got %+v", payload)
+	}
+	if d := payload.ReadyWaits[0]; d.ID != "D" || d.Source != "created" || d.ReadySince != "2025-02-01T00:00:00Z" || d.WaitDays <= 0 {
+		t.Errorf("first = %+v, want D ready since creation", d)
+	}
+	if b := payload.ReadyWaits[1]; b.ID != "B" || b.Source != "blocker_closed" || b.LastBlocker != "A" || b.ReadySince != "2025-03-01T00:00:00Z" {
+		t.Errorf("second = %+v, want B ready since A closed", b)
+	}
+}
+
+func TestRobotStatsContract(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"Core","status":"open","priority":2,"issue_type":"task","labels":["api"]}
+{"id":"B","title":"Mid","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"blocks"}]}
+{"id":"C","title":"Done","status":"closed","priority":1,"issue_type":"task"}`)
+
+	out, err := runCommand(bv, env, "--robot-stats")
+	if err != nil {
+		t.Fatalf("--robot-stats failed: %v\n%s", err, out)
+	}
+	if lines := strings.Count(strings.TrimSpace(string(out)), "\n"); lines != 0 {
+		t.Fatalf("expected one line, got:\n%s", out)
+	}
+	// No envelope beyond a flat schema_version: every other key is a metric
+	var payload map[string]any
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("json decode: %v\nout=%s", err, out)
+	}
+	if v, ok := payload["schema_version"].(string); !ok || v == "" {
+		t.Fatalf("missing schema_version: %v", payload)
+	}
+	delete(payload, "schema_version")
+	keys := []string{"timestamp", "total", "open", "closed", "blocked", "ready", "avg_age_days", "median_age_days",
+		"cycle_count", "bottleneck_count", "label_health_avg", "closed_fixed", "closed_wontfix", "closed_duplicate",
+		"closed_invalid", "closed_other", "closed_unresolved"}
+	if len(payload) != len(keys) {
+		t.Fatalf("expected exactly %d keys, got %v", len(keys), payload)
+	}
+	for _, k := range keys {
+		if _, ok := payload[k].(float64); !ok {
+			t.Fatalf("%s is missing or not a number: %v", k, payload[k])
+		}
+	}
+	if payload["total"] != 3.0 || payload["open"] != 2.0 || payload["blocked"] != 1.0 || payload["ready"] != 1.0 || payload["bottleneck_count"] != 1.0 {
+		t.Fatalf("unexpected counts: %v", payload)
+	}
+}
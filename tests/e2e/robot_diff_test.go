@@ -119,6 +119,7 @@ func TestRobotDiffIncludesHashesAndNewIssues(t *testing.T) {
 	}
 
 	var payload struct {
+		SchemaVersion    string `json:"schema_version"`
 		GeneratedAt      string `json:"generated_at"`
 		ResolvedRevision string `json:"resolved_revision"`
 		FromDataHash     string `json:"from_data_hash"`
@@ -136,6 +137,9 @@ func TestRobotDiffIncludesHashesAndNewIssues(t *testing.T) {
 		t.Fatalf("json decode: %v\nout=%s", err, out)
 	}
 
+	if payload.SchemaVersion == "" {
+		t.Fatal("schema_version missing")
+	}
 	if payload.GeneratedAt == "" {
 		t.Fatal("generated_at missing")
 	}
@@ -245,3 +249,101 @@ func TestRobotOutputsShareDataHashAndStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestSinceCommitScopesToChangedIssues(t *testing.T) {
+	bv := buildBvBinary(t)
+	repoDir, _ := initGitRepo(t)
+
+	// An uncommitted edit to A counts too
+	current := `{"id":"A","title":"Alpha (edited)","status":"open","priority":1,"issue_type":"task"}
+{"id":"B","title":"Beta","status":"open","priority":2,"issue_type":"task"}`
+	if err := os.WriteFile(filepath.Join(repoDir, ".beads", "beads.jsonl"), []byte(current), 0o644); err != nil {
+		t.Fatalf("write beads: %v", err)
+	}
+
+	ids := func(ref string) []string {
+		t.Helper()
+		cmd := exec.Command(bv, "--dump-issues", "--since-commit", ref)
+		cmd.Dir = repoDir
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("--since-commit %s failed: %v\n%s", ref, err, out)
+		}
+		var got []string
+		for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+			var issue struct {
+				ID string `json:"id"`
+			}
+			if err := json.Unmarshal([]byte(line), &issue); err != nil {
+				t.Fatalf("bad JSONL line %q: %v", line, err)
+			}
+			got = append(got, issue.ID)
+		}
+		return got
+	}
+
+	if got := ids("HEAD"); len(got) != 1 || got[0] != "A" {
+		t.Errorf("since HEAD = %v, want [A]", got)
+	}
+	if got := ids("HEAD~1"); len(got) != 2 {
+		t.Errorf("since HEAD~1 = %v, want A and B", got)
+	}
+
+	// Outside git every issue is shown, with a warning
+	dir := t.TempDir()
+	writeBeads(t, dir, `{"id":"X","title":"Only","status":"open","priority":1,"issue_type":"task"}`)
+	cmd := exec.Command(bv, "--dump-issues", "--since-commit", "main")
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("--since-commit outside git failed: %v\n%s", err, stderr.String())
+	}
+	if !strings.Contains(string(out), `"X"`) || !strings.Contains(stderr.String(), "showing all issues") {
+		t.Errorf("expected all issues and a warning, got stdout=%s stderr=%s", out, stderr.String())
+	}
+}
+
+func TestSinceCommitKeepsBlockersOutsideTheSet(t *testing.T) {
+	bv := buildBvBinary(t)
+	repoDir, _ := initGitRepo(t)
+
+	// Only A changes: it now waits on B, which is unchanged
+	current := `{"id":"A","title":"Alpha","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"A","depends_on_id":"B","type":"blocks"}]}
+{"id":"B","title":"Beta","status":"open","priority":2,"issue_type":"task"}`
+	if err := os.WriteFile(filepath.Join(repoDir, ".beads", "beads.jsonl"), []byte(current), 0o644); err != nil {
+		t.Fatalf("write beads: %v", err)
+	}
+
+	cmd := exec.Command(bv, "--robot-plan", "--since-commit", "HEAD")
+	cmd.Dir = repoDir
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("--robot-plan --since-commit failed: %v\n%s", err, out)
+	}
+	var payload struct {
+		Plan struct {
+			Tracks          []json.RawMessage `json:"tracks"`
+			TotalActionable int               `json:"total_actionable"`
+			TotalBlocked    int               `json:"total_blocked"`
+			StartSchedule   struct {
+				Issues []struct {
+					ID        string `json:"id"`
+					StartStep int    `json:"start_step"`
+				} `json:"issues"`
+			} `json:"start_schedule"`
+		} `json:"plan"`
+	}
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("json decode: %v\nout=%s", err, out)
+	}
+	plan := payload.Plan
+	if len(plan.Tracks) != 0 || plan.TotalActionable != 0 || plan.TotalBlocked != 1 {
+		t.Errorf("A is blocked by B and should not be actionable: tracks=%d actionable=%d blocked=%d",
+			len(plan.Tracks), plan.TotalActionable, plan.TotalBlocked)
+	}
+	if s := plan.StartSchedule.Issues; len(s) != 1 || s[0].ID != "A" || s[0].StartStep != 1 {
+		t.Errorf("start_schedule.issues = %+v, want only A at step 1", s)
+	}
+}
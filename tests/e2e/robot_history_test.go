@@ -87,6 +87,7 @@ func TestRobotHistoryIncludesEventsAndCommitIndex(t *testing.T) {
 	}
 
 	var payload struct {
+		SchemaVersion   string `json:"schema_version"`
 		GeneratedAt     string `json:"generated_at"`
 		DataHash        string `json:"data_hash"`
 		GitRange        string `json:"git_range"`
@@ -118,6 +119,9 @@ func TestRobotHistoryIncludesEventsAndCommitIndex(t *testing.T) {
 		t.Fatalf("json decode: %v\nout=%s", err, out)
 	}
 
+	if payload.SchemaVersion == "" {
+		t.Fatal("missing schema_version")
+	}
 	if payload.DataHash == "" {
 		t.Fatal("missing data_hash")
 	}
@@ -0,0 +1,112 @@
+package main_test
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestRobotPlanOpenOnly(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	// B and C both waited on A, which is done; only A connects them
+	writeBeads(t, env, `{"id":"A","title":"Schema","status":"closed","priority":1,"issue_type":"task"}
+{"id":"B","title":"API","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"blocks"}]}
+{"id":"C","title":"UI","status":"open","priority":2,"issue_type":"task","dependencies":[{"issue_id":"C","depends_on_id":"A","type":"blocks"}]}`)
+
+	type planOutput struct {
+		OpenOnly     bool `json:"open_only"`
+		PrunedClosed int  `json:"pruned_closed"`
+		Plan         struct {
+			Tracks []struct {
+				Items []struct {
+					ID string `json:"id"`
+				} `json:"items"`
+			} `json:"tracks"`
+			TotalActionable int `json:"total_actionable"`
+		} `json:"plan"`
+	}
+	run := func(args ...string) planOutput {
+		cmd := exec.Command(bv, append([]string{"--robot-plan"}, args...)...)
+		cmd.Dir = env
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("bv --robot-plan %v failed: %v", args, err)
+		}
+		var p planOutput
+		if err := json.Unmarshal(out, &p); err != nil {
+			t.Fatalf("invalid JSON: %v\n%s", err, out)
+		}
+		return p
+	}
+
+	full := run()
+	if full.OpenOnly || full.PrunedClosed != 0 {
+		t.Errorf("default plan reported pruning: open_only=%v pruned_closed=%d", full.OpenOnly, full.PrunedClosed)
+	}
+	if len(full.Plan.Tracks) != 1 || len(full.Plan.Tracks[0].Items) != 2 {
+		t.Fatalf("default plan: want B and C in one track joined by closed A, got %+v", full.Plan.Tracks)
+	}
+
+	open := run("--plan-open-only")
+	if !open.OpenOnly || open.PrunedClosed != 1 {
+		t.Errorf("open_only=%v pruned_closed=%d, want true and 1", open.OpenOnly, open.PrunedClosed)
+	}
+	if len(open.Plan.Tracks) != 2 {
+		t.Fatalf("open-only plan: want separate tracks for B and C, got %+v", open.Plan.Tracks)
+	}
+	if open.Plan.TotalActionable != 2 {
+		t.Errorf("total_actionable = %d, want 2", open.Plan.TotalActionable)
+	}
+}
+
+func TestRobotPlanSplitProjectsTrackOrder(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	// web-1 waits on api-1 and on web-2, so the stream spans both projects
+	writeBeads(t, env, `{"id":"api-1","title":"Endpoint","status":"open","priority":1,"issue_type":"task"}
+{"id":"web-1","title":"Page","status":"open","priority":1,"issue_type":"task","dependencies":[{"issue_id":"web-1","depends_on_id":"api-1","type":"blocks"},{"issue_id":"web-1","depends_on_id":"web-2","type":"blocks"}]}
+{"id":"web-2","title":"Layout","status":"open","priority":2,"issue_type":"task"}`)
+	xdg := filepath.Join(env, "xdg")
+	if err := os.MkdirAll(filepath.Join(xdg, "bv"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(xdg, "bv", "display.yaml"), []byte("plan:\n  split_projects: true\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(bv, "--robot-plan")
+	cmd.Dir = env
+	cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+xdg)
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("bv --robot-plan failed: %v", err)
+	}
+	var payload struct {
+		Plan struct {
+			Tracks []struct {
+				TrackID string `json:"track_id"`
+				Items   []struct {
+					ID string `json:"id"`
+				} `json:"items"`
+			} `json:"tracks"`
+			TrackOrder []struct {
+				TrackID   string   `json:"track_id"`
+				DependsOn []string `json:"depends_on"`
+			} `json:"track_order"`
+		} `json:"plan"`
+	}
+	if err := json.Unmarshal(out, &payload); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	tracks, order := payload.Plan.Tracks, payload.Plan.TrackOrder
+	if len(tracks) != 2 || tracks[0].Items[0].ID != "api-1" || tracks[1].Items[0].ID != "web-2" {
+		t.Fatalf("tracks = %+v, want api-1's track then web-2's", tracks)
+	}
+	if len(order) != 2 || order[0].TrackID != tracks[0].TrackID || len(order[0].DependsOn) != 0 ||
+		order[1].TrackID != tracks[1].TrackID || len(order[1].DependsOn) != 1 || order[1].DependsOn[0] != tracks[0].TrackID {
+		t.Errorf("track_order = %+v, want the web track waiting on the api track", order)
+	}
+}
@@ -0,0 +1,69 @@
+package main_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os/exec"
+	"reflect"
+	"testing"
+)
+
+func TestRobotPrettyRoundTripsToCompact(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"Schema","status":"open","priority":1,"issue_type":"task"}
+{"id":"B","title":"API","status":"open","priority":2,"issue_type":"task","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"blocks"}]}
+{"id":"C","title":"Docs","status":"closed","priority":3,"issue_type":"task"}`)
+
+	run := func(args ...string) []byte {
+		cmd := exec.Command(bv, args...)
+		cmd.Dir = env
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("bv %v failed: %v", args, err)
+		}
+		return out
+	}
+	decode := func(flag string, out []byte) map[string]any {
+		var v map[string]any
+		if err := json.Unmarshal(out, &v); err != nil {
+			t.Fatalf("%s: invalid JSON: %v\n%s", flag, err, out)
+		}
+		// Timestamps and metric timings differ between the two runs
+		dropRunTimings(v)
+		return v
+	}
+
+	for _, flag := range []string{"--robot-plan", "--robot-triage", "--robot-stale", "--robot-stats"} {
+		compact := run(flag)
+		pretty := run(flag, "--pretty")
+
+		if n := bytes.Count(bytes.TrimRight(compact, "\n"), []byte("\n")); n != 0 {
+			t.Errorf("%s: default output should be one line, got %d newlines", flag, n)
+		}
+		if !bytes.Contains(pretty, []byte("\n  \"")) {
+			t.Errorf("%s --pretty: output is not indented:\n%.200s", flag, pretty)
+		}
+		if c, p := decode(flag, compact), decode(flag+" --pretty", pretty); !reflect.DeepEqual(c, p) {
+			t.Errorf("%s: --pretty decodes differently from compact output", flag)
+		}
+	}
+}
+
+func dropRunTimings(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			switch k {
+			case "generated_at", "timestamp", "ms":
+				delete(v, k)
+			default:
+				dropRunTimings(child)
+			}
+		}
+	case []any:
+		for _, child := range v {
+			dropRunTimings(child)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -93,3 +94,96 @@ func TestRobotSprintList_MalformedSprintLine_NoStderr(t *testing.T) {
 		t.Fatalf("expected sprint-1 only, got %+v", payload.Sprints)
 	}
 }
+
+// TestRobotModes_StdoutIsJSONWithParseWarnings runs every --robot-* mode
+// against data that triggers parse warnings and decodes stdout on its own,
+// so `bv --robot-x 2>/dev/null | jq` is guaranteed to work.
+func TestRobotModes_StdoutIsJSONWithParseWarnings(t *testing.T) {
+	bv := buildBvBinary(t)
+	repoDir := initGitRepoWithMalformedIssues(t)
+	sprints := `{"id":"sprint-1","name":"Sprint 1","bead_ids":["A"]}` + "\n" + `{this is not json}` + "\n"
+	if err := os.WriteFile(filepath.Join(repoDir, ".beads", "sprints.jsonl"), []byte(sprints), 0o644); err != nil {
+		t.Fatalf("write sprints.jsonl: %v", err)
+	}
+
+	modes := [][]string{
+		{"--robot-plan"},
+		{"--robot-insights"},
+		{"--robot-priority"},
+		{"--robot-triage"},
+		{"--robot-triage-by-track"},
+		{"--robot-triage-by-label"},
+		{"--robot-next"},
+		{"--robot-diff", "--diff-since", "HEAD~1"},
+		{"--diff-since", "HEAD~1"}, // auto-JSON on non-TTY stdout
+		{"--robot-recipes"},
+		{"--robot-label-health"},
+		{"--robot-label-flow"},
+		{"--robot-label-attention"},
+		{"--robot-alerts"},
+		{"--robot-inversions"},
+		{"--robot-deadends"},
+		{"--robot-zombies"},
+		{"--robot-ready-wait"},
+		{"--robot-duplicates"},
+		{"--robot-bottlenecks"},
+		{"--robot-stale"},
+		{"--robot-compare-projects"},
+		{"--robot-similar"},
+		{"--robot-explain", "A"},
+		{"--robot-suggest"},
+		{"--robot-graph"},
+		{"--robot-search", "--search", "alpha"},
+		{"--robot-history"},
+		{"--robot-sprint-list"},
+		{"--robot-sprint-show", "sprint-1"},
+		{"--robot-forecast", "all"},
+		{"--robot-capacity"},
+		{"--robot-triage", "--robot-by-label", "none"},
+	}
+	for _, args := range modes {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			cmd := exec.Command(bv, args...)
+			cmd.Dir = repoDir
+			var stdout, stderr bytes.Buffer
+			cmd.Stdout = &stdout
+			cmd.Stderr = &stderr
+			if err := cmd.Run(); err != nil {
+				t.Fatalf("failed: %v\nstderr=%s", err, stderr.String())
+			}
+
+			var payload map[string]any
+			if err := json.Unmarshal(stdout.Bytes(), &payload); err != nil {
+				t.Fatalf("stdout is not JSON: %v\nstdout=%s", err, stdout.String())
+			}
+			if payload["schema_version"] == nil {
+				t.Errorf("stdout JSON missing schema_version")
+			}
+		})
+	}
+}
+
+// TestRobotMode_TextCommandsWriteToStderr verifies commands that print text
+// keep stdout clean (or JSON) when combined with a robot flag.
+func TestRobotMode_TextCommandsWriteToStderr(t *testing.T) {
+	bv := buildBvBinary(t)
+	repoDir := initGitRepoWithMalformedIssues(t)
+
+	for _, args := range [][]string{
+		{"--robot-triage", "--baseline-info"},
+		{"--robot-triage", "--save-baseline", "ci"},
+		{"--robot-triage", "--profile-startup"},
+	} {
+		cmd := exec.Command(bv, args...)
+		cmd.Dir = repoDir
+		var stdout, stderr bytes.Buffer
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			t.Fatalf("%v failed: %v\nstderr=%s", args, err, stderr.String())
+		}
+		if out := bytes.TrimSpace(stdout.Bytes()); len(out) > 0 && !json.Valid(out) {
+			t.Errorf("%v wrote non-JSON to stdout:\n%s", args, out)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package main_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestRobotStreamNDJSON(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"First","status":"open","priority":1,"issue_type":"task","updated_at":"2020-01-01T00:00:00Z"}
+{"id":"B","title":"Second","status":"open","priority":2,"issue_type":"task","updated_at":"2020-01-01T00:00:00Z","dependencies":[{"issue_id":"B","depends_on_id":"A","type":"blocks"}]}
+{"id":"C","title":"Third","status":"open","priority":3,"issue_type":"bug","updated_at":"2020-01-01T00:00:00Z"}`)
+
+	tests := []struct {
+		args   []string
+		record string // record type whose count is checked
+		want   int
+	}{
+		{[]string{"--robot-triage", "--stream"}, "recommendation", 3},
+		{[]string{"--robot-plan", "--stream"}, "start_step", 3},
+		{[]string{"--robot-stale", "--stream", "--stale-days", "30"}, "stale", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.args[0], func(t *testing.T) {
+			cmd := exec.Command(bv, tt.args...)
+			cmd.Dir = env
+			cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+filepath.Join(env, "xdg"))
+			out, err := cmd.Output()
+			if err != nil {
+				t.Fatalf("bv %v failed: %v", tt.args, err)
+			}
+
+			var header struct {
+				Record        string         `json:"record"`
+				SchemaVersion string         `json:"schema_version"`
+				DataHash      string         `json:"data_hash"`
+				Totals        map[string]int `json:"totals"`
+			}
+			counts := make(map[string]int)
+			scanner := bufio.NewScanner(bytes.NewReader(out))
+			scanner.Buffer(make([]byte, 1<<20), 1<<20)
+			for line := 0; scanner.Scan(); line++ {
+				if line == 0 {
+					if err := json.Unmarshal(scanner.Bytes(), &header); err != nil {
+						t.Fatalf("header: %v\n%s", err, scanner.Text())
+					}
+					continue
+				}
+				var rec struct {
+					Record string `json:"record"`
+				}
+				if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil {
+					t.Fatalf("line %d: %v\n%s", line+1, err, scanner.Text())
+				}
+				counts[rec.Record]++
+			}
+			if header.Record != "header" || header.SchemaVersion == "" || header.DataHash == "" {
+				t.Fatalf("bad header: %+v", header)
+			}
+			if counts[tt.record] != tt.want || header.Totals[tt.record] != tt.want {
+				t.Errorf("%s records = %d (totals %d), want %d", tt.record, counts[tt.record], header.Totals[tt.record], tt.want)
+			}
+			for record, n := range header.Totals {
+				if counts[record] != n {
+					t.Errorf("totals[%s] = %d but %d records followed", record, n, counts[record])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package main_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSavedViews_SaveAndLookup verifies --save-view writes display.yaml and
+// --view rejects unknown names with the list of saved views.
+func TestSavedViews_SaveAndLookup(t *testing.T) {
+	bv := buildBvBinary(t)
+	configDir := filepath.Join(t.TempDir(), "config")
+	env := append(os.Environ(), "XDG_CONFIG_HOME="+configDir)
+
+	cmd := exec.Command(bv, "--save-view", "hot-bugs", "--status", "open,in_progress",
+		"--priority", "0,1", "--type", "bug", "--sort", "priority", "--start-view", "board")
+	cmd.Dir = t.TempDir()
+	cmd.Env = env
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("bv --save-view failed: %v\n%s", err, out)
+	}
+	if !strings.Contains(string(out), `Saved view "hot-bugs"`) {
+		t.Errorf("unexpected output: %s", out)
+	}
+
+	data, err := os.ReadFile(filepath.Join(configDir, "bv", "display.yaml"))
+	if err != nil {
+		t.Fatalf("display.yaml not written: %v", err)
+	}
+	for _, want := range []string{"hot-bugs:", "- in_progress", "- bug", "sort: priority", "view_type: board"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("display.yaml missing %q:\n%s", want, data)
+		}
+	}
+
+	cmd = exec.Command(bv, "--view", "nope")
+	cmd.Dir = t.TempDir()
+	cmd.Env = env
+	out, err = cmd.CombinedOutput()
+	if err == nil {
+		t.Fatalf("expected --view with unknown name to fail, got:\n%s", out)
+	}
+	if !strings.Contains(string(out), "Available views: hot-bugs") {
+		t.Errorf("expected available views to be listed, got:\n%s", out)
+	}
+
+	cmd = exec.Command(bv, "--save-view", "bad", "--status", "done")
+	cmd.Dir = t.TempDir()
+	cmd.Env = env
+	out, err = cmd.CombinedOutput()
+	if err == nil || !strings.Contains(string(out), `invalid --status "done"`) {
+		t.Errorf("expected invalid status error, got err=%v:\n%s", err, out)
+	}
+}
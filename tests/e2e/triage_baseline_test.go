@@ -0,0 +1,77 @@
+package main_test
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestRobotTriageBaselineDelta(t *testing.T) {
+	bv := buildBvBinary(t)
+	env := t.TempDir()
+	writeBeads(t, env, `{"id":"A","title":"First","status":"open","priority":1,"issue_type":"task"}
+{"id":"B","title":"Second","status":"open","priority":2,"issue_type":"task"}`)
+
+	run := func(args ...string) []byte {
+		cmd := exec.Command(bv, args...)
+		cmd.Dir = env
+		cmd.Env = append(os.Environ(), "XDG_CONFIG_HOME="+filepath.Join(env, "xdg"))
+		out, err := cmd.Output()
+		if err != nil {
+			t.Fatalf("bv %v failed: %v", args, err)
+		}
+		return out
+	}
+
+	base := filepath.Join(env, "baseline.json")
+	if err := os.WriteFile(base, run("--robot-triage"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	writeBeads(t, env, `{"id":"A","title":"First","status":"closed","priority":1,"issue_type":"task"}
+{"id":"B","title":"Second","status":"open","priority":2,"issue_type":"task"}
+{"id":"C","title":"Third","status":"open","priority":1,"issue_type":"task"}`)
+
+	var out struct {
+		Delta *struct {
+			New []struct {
+				ID string `json:"id"`
+			} `json:"new_recommendations"`
+			Resolved []struct {
+				ID     string `json:"id"`
+				Status string `json:"status"`
+			} `json:"resolved_recommendations"`
+			QuickRef map[string]struct {
+				Baseline int `json:"baseline"`
+				Current  int `json:"current"`
+				Change   int `json:"change"`
+			} `json:"quick_ref"`
+		} `json:"delta"`
+	}
+	if err := json.Unmarshal(run("--robot-triage", "--baseline", base), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Delta == nil {
+		t.Fatal("expected a delta object with --baseline")
+	}
+	if len(out.Delta.New) != 1 || out.Delta.New[0].ID != "C" {
+		t.Errorf("new_recommendations = %+v, want C", out.Delta.New)
+	}
+	if len(out.Delta.Resolved) != 1 || out.Delta.Resolved[0].ID != "A" || out.Delta.Resolved[0].Status != "closed" {
+		t.Errorf("resolved_recommendations = %+v, want A (closed)", out.Delta.Resolved)
+	}
+	if open := out.Delta.QuickRef["open_count"]; open.Baseline != 2 || open.Current != 2 || open.Change != 0 {
+		t.Errorf("open_count delta = %+v, want 2 -> 2", open)
+	}
+
+	// Without --baseline there is no delta
+	var plain map[string]json.RawMessage
+	if err := json.Unmarshal(run("--robot-triage"), &plain); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := plain["delta"]; ok {
+		t.Error("delta should only be present with --baseline")
+	}
+}